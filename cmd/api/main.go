@@ -19,6 +19,8 @@ import (
 	infraHttp "github.com/danicc097/todo-ddd-example/internal/infrastructure/http"
 	"github.com/danicc097/todo-ddd-example/internal/infrastructure/logger"
 	"github.com/danicc097/todo-ddd-example/internal/infrastructure/outbox"
+	scheduleWorker "github.com/danicc097/todo-ddd-example/internal/modules/schedule/infrastructure/worker"
+	todoWorker "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/worker"
 	sharedHttp "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/http"
 )
 
@@ -73,7 +75,21 @@ func run() error {
 	relay := outbox.NewRelay(container.Pool, container.MultiBroker)
 	go relay.Start(ctx)
 
-	closers, err := infrastructure.RegisterSubscribers(container.MQConn, services.ScheduleRepo)
+	rollover := scheduleWorker.NewRolloverWorker(services.Rollover, 1*time.Minute)
+	go rollover.Start(ctx)
+
+	focusSweeper := todoWorker.NewFocusSweeperWorker(services.FocusSweeper, 1*time.Minute)
+	go focusSweeper.Start(ctx)
+
+	closers, err := infrastructure.RegisterSubscribers(
+		container.MQConn,
+		services.ScheduleRepo,
+		services.TodoRepo,
+		services.UnitOfWork,
+		services.AuditErasure,
+		services.AuthAudit,
+		services.DataExporter,
+	)
 	if err != nil {
 		return fmt.Errorf("failed to register subscribers: %w", err)
 	}
@@ -87,6 +103,7 @@ func run() error {
 		Pool:          container.Pool,
 		Redis:         container.Redis,
 		TokenVerifier: services.TokenProvider.Verifier,
+		Revocations:   services.TokenDenylist,
 		Handler:       handler,
 		WSHandler:     handler.WS,
 	})
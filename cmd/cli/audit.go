@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/danicc097/todo-ddd-example/internal/generated/client"
+	workspaceDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+)
+
+func newAuditCmd(getClient func() (*client.ClientWithResponses, context.Context)) *cobra.Command {
+	auditCmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Inspect audit trails",
+	}
+
+	verifyCmd := &cobra.Command{
+		Use:           "verify [workspaceId]",
+		Short:         "Verify a workspace audit trail, or the global one without a workspace, and report the first broken link",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if len(args) == 0 {
+				resp, err := c.VerifyGlobalAuditChainsWithResponse(ctx)
+				if err != nil {
+					return err
+				}
+
+				if resp.JSON200 == nil {
+					return printAuditFailure(resp.StatusCode(), resp.Body)
+				}
+
+				v := resp.JSON200
+				if v.FirstBrokenLink == nil {
+					fmt.Println(styleSuccess.Render(fmt.Sprintf("Global audit chains intact: %d chains, %d entries checked", v.CheckedChains, v.CheckedEntries)))
+					return nil
+				}
+
+				b := v.FirstBrokenLink
+
+				chain := "global audit chain"
+				if b.AggregateType != nil && b.AggregateId != nil {
+					chain = fmt.Sprintf("audit chain of %s %s", *b.AggregateType, *b.AggregateId)
+				}
+
+				fmt.Println(styleError.Render(fmt.Sprintf("The %s is broken at entry %d (%s): %s", chain, b.Position, b.LogId, b.Reason)))
+
+				return fmt.Errorf("audit chain broken")
+			}
+
+			wsID, err := uuid.Parse(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid workspace id: %w", err)
+			}
+
+			resp, err := c.VerifyWorkspaceAuditChainWithResponse(ctx, workspaceDomain.WorkspaceID(wsID))
+			if err != nil {
+				return err
+			}
+
+			if resp.JSON200 == nil {
+				return printAuditFailure(resp.StatusCode(), resp.Body)
+			}
+
+			v := resp.JSON200
+			if v.FirstBrokenLink == nil {
+				fmt.Println(styleSuccess.Render(fmt.Sprintf("Audit chain intact: %d entries checked", v.CheckedEntries)))
+				return nil
+			}
+
+			b := v.FirstBrokenLink
+			fmt.Println(styleError.Render(fmt.Sprintf("Audit chain broken at entry %d (%s): %s", b.Position, b.LogId, b.Reason)))
+
+			return fmt.Errorf("audit chain broken")
+		},
+	}
+
+	auditCmd.AddCommand(verifyCmd)
+
+	return auditCmd
+}
+
+func printAuditFailure(status int, body []byte) error {
+	fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", status)))
+	fmt.Printf("%s\n", string(body))
+
+	return fmt.Errorf("request failed with status %d", status)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
@@ -20,12 +21,13 @@ import (
 var _ = todoDomain.TodoID{}
 var _ = userDomain.UserID{}
 var _ = workspaceDomain.WorkspaceID{}
+var _ = time.Time{}
 
 func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client.ClientWithResponses, context.Context)) {
 
-	cmdLogin := &cobra.Command{
-		Use:           "login",
-		Short:         "Login with email and password",
+	cmdVerifyGlobalAuditChains := &cobra.Command{
+		Use:           "verify-global-audit-chains",
+		Short:         "Verify the global audit trail has not been tampered with",
 		SilenceUsage:  true,
 		SilenceErrors: true,
 
@@ -33,23 +35,10 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			c, ctx := getClient()
 
 			if debug {
-				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing Login"))
-			}
-
-			bodyStr, _ := cmd.Flags().GetString("payload")
-
-			if debug && bodyStr != "" {
-				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
-			}
-
-			var reqBody client.LoginJSONRequestBody
-			if bodyStr != "" {
-				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
-					return fmt.Errorf("invalid json payload: %w", err)
-				}
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing VerifyGlobalAuditChains"))
 			}
 
-			resp, err := c.LoginWithResponse(ctx, reqBody)
+			resp, err := c.VerifyGlobalAuditChainsWithResponse(ctx)
 			if err != nil {
 				return err
 			}
@@ -77,13 +66,12 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			return nil
 		},
 	}
-	cmdLogin.Flags().StringP("payload", "p", "", "JSON payload for the request body")
 
-	rootCmd.AddCommand(cmdLogin)
+	rootCmd.AddCommand(cmdVerifyGlobalAuditChains)
 
-	cmdRegister := &cobra.Command{
-		Use:           "register",
-		Short:         "Register a new user with password",
+	cmdLogin := &cobra.Command{
+		Use:           "login",
+		Short:         "Login with email and password",
 		SilenceUsage:  true,
 		SilenceErrors: true,
 
@@ -91,13 +79,7 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			c, ctx := getClient()
 
 			if debug {
-				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing Register"))
-			}
-
-			params := &client.RegisterParams{}
-			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
-				u := uuid.MustParse(val)
-				params.IdempotencyKey = &u
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing Login"))
 			}
 
 			bodyStr, _ := cmd.Flags().GetString("payload")
@@ -106,14 +88,14 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
 			}
 
-			var reqBody client.RegisterJSONRequestBody
+			var reqBody client.LoginJSONRequestBody
 			if bodyStr != "" {
 				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
 					return fmt.Errorf("invalid json payload: %w", err)
 				}
 			}
 
-			resp, err := c.RegisterWithResponse(ctx, params, reqBody)
+			resp, err := c.LoginWithResponse(ctx, reqBody)
 			if err != nil {
 				return err
 			}
@@ -141,14 +123,13 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			return nil
 		},
 	}
-	cmdRegister.Flags().StringP("payload", "p", "", "JSON payload for the request body")
-	cmdRegister.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+	cmdLogin.Flags().StringP("payload", "p", "", "JSON payload for the request body")
 
-	rootCmd.AddCommand(cmdRegister)
+	rootCmd.AddCommand(cmdLogin)
 
-	cmdInitiateTOTP := &cobra.Command{
-		Use:           "initiate-totp",
-		Short:         "Initiate TOTP setup for the user",
+	cmdLogout := &cobra.Command{
+		Use:           "logout",
+		Short:         "Log out of the current session",
 		SilenceUsage:  true,
 		SilenceErrors: true,
 
@@ -156,10 +137,23 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			c, ctx := getClient()
 
 			if debug {
-				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing InitiateTOTP"))
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing Logout"))
 			}
 
-			resp, err := c.InitiateTOTPWithResponse(ctx)
+			bodyStr, _ := cmd.Flags().GetString("payload")
+
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			}
+
+			var reqBody client.LogoutJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.LogoutWithResponse(ctx, reqBody)
 			if err != nil {
 				return err
 			}
@@ -187,12 +181,13 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			return nil
 		},
 	}
+	cmdLogout.Flags().StringP("payload", "p", "", "JSON payload for the request body")
 
-	rootCmd.AddCommand(cmdInitiateTOTP)
+	rootCmd.AddCommand(cmdLogout)
 
-	cmdVerifyTOTP := &cobra.Command{
-		Use:           "verify-totp",
-		Short:         "Verify and activate TOTP",
+	cmdLogoutAll := &cobra.Command{
+		Use:           "logout-all",
+		Short:         "Log out of every session of the current user",
 		SilenceUsage:  true,
 		SilenceErrors: true,
 
@@ -200,23 +195,10 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			c, ctx := getClient()
 
 			if debug {
-				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing VerifyTOTP"))
-			}
-
-			bodyStr, _ := cmd.Flags().GetString("payload")
-
-			if debug && bodyStr != "" {
-				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
-			}
-
-			var reqBody client.VerifyTOTPJSONRequestBody
-			if bodyStr != "" {
-				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
-					return fmt.Errorf("invalid json payload: %w", err)
-				}
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing LogoutAll"))
 			}
 
-			resp, err := c.VerifyTOTPWithResponse(ctx, reqBody)
+			resp, err := c.LogoutAllWithResponse(ctx)
 			if err != nil {
 				return err
 			}
@@ -244,13 +226,12 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			return nil
 		},
 	}
-	cmdVerifyTOTP.Flags().StringP("payload", "p", "", "JSON payload for the request body")
 
-	rootCmd.AddCommand(cmdVerifyTOTP)
+	rootCmd.AddCommand(cmdLogoutAll)
 
-	cmdHealthz := &cobra.Command{
-		Use:           "healthz",
-		Short:         "Health check",
+	cmdReauthenticate := &cobra.Command{
+		Use:           "reauthenticate",
+		Short:         "Step up with the password, for users without TOTP",
 		SilenceUsage:  true,
 		SilenceErrors: true,
 
@@ -258,10 +239,23 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			c, ctx := getClient()
 
 			if debug {
-				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing Healthz"))
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing Reauthenticate"))
 			}
 
-			resp, err := c.HealthzWithResponse(ctx)
+			bodyStr, _ := cmd.Flags().GetString("payload")
+
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			}
+
+			var reqBody client.ReauthenticateJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.ReauthenticateWithResponse(ctx, reqBody)
 			if err != nil {
 				return err
 			}
@@ -289,12 +283,13 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			return nil
 		},
 	}
+	cmdReauthenticate.Flags().StringP("payload", "p", "", "JSON payload for the request body")
 
-	rootCmd.AddCommand(cmdHealthz)
+	rootCmd.AddCommand(cmdReauthenticate)
 
-	cmdCommitTask := &cobra.Command{
-		Use:           "commit-task",
-		Short:         "Commit a task to daily schedule",
+	cmdRefreshSession := &cobra.Command{
+		Use:           "refresh-session",
+		Short:         "Exchange a refresh token for a new access and refresh token",
 		SilenceUsage:  true,
 		SilenceErrors: true,
 
@@ -302,7 +297,7 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			c, ctx := getClient()
 
 			if debug {
-				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing CommitTask"))
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing RefreshSession"))
 			}
 
 			bodyStr, _ := cmd.Flags().GetString("payload")
@@ -311,14 +306,14 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
 			}
 
-			var reqBody client.CommitTaskJSONRequestBody
+			var reqBody client.RefreshSessionJSONRequestBody
 			if bodyStr != "" {
 				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
 					return fmt.Errorf("invalid json payload: %w", err)
 				}
 			}
 
-			resp, err := c.CommitTaskWithResponse(ctx, reqBody)
+			resp, err := c.RefreshSessionWithResponse(ctx, reqBody)
 			if err != nil {
 				return err
 			}
@@ -346,26 +341,43 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			return nil
 		},
 	}
-	cmdCommitTask.Flags().StringP("payload", "p", "", "JSON payload for the request body")
+	cmdRefreshSession.Flags().StringP("payload", "p", "", "JSON payload for the request body")
 
-	rootCmd.AddCommand(cmdCommitTask)
+	rootCmd.AddCommand(cmdRefreshSession)
 
-	cmdGetTodoByID := &cobra.Command{
-		Use:           "get-todo-by-id [id]",
-		Short:         "Get a todo by ID",
+	cmdRegister := &cobra.Command{
+		Use:           "register",
+		Short:         "Register a new user with password",
 		SilenceUsage:  true,
 		SilenceErrors: true,
-		Args:          cobra.ExactArgs(1),
+
 		RunE: func(cmd *cobra.Command, args []string) error {
 			c, ctx := getClient()
 
 			if debug {
-				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing GetTodoByID"))
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing Register"))
 			}
 
-			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+			params := &client.RegisterParams{}
+			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+				u := uuid.MustParse(val)
+				params.IdempotencyKey = &u
+			}
 
-			resp, err := c.GetTodoByIDWithResponse(ctx, paramid)
+			bodyStr, _ := cmd.Flags().GetString("payload")
+
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			}
+
+			var reqBody client.RegisterJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.RegisterWithResponse(ctx, params, reqBody)
 			if err != nil {
 				return err
 			}
@@ -393,31 +405,38 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			return nil
 		},
 	}
+	cmdRegister.Flags().StringP("payload", "p", "", "JSON payload for the request body")
+	cmdRegister.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
 
-	rootCmd.AddCommand(cmdGetTodoByID)
+	rootCmd.AddCommand(cmdRegister)
 
-	cmdCompleteTodo := &cobra.Command{
-		Use:           "complete-todo [id]",
-		Short:         "Complete a todo",
+	cmdDisableTOTP := &cobra.Command{
+		Use:           "disable-totp",
+		Short:         "Disable TOTP for the user",
 		SilenceUsage:  true,
 		SilenceErrors: true,
-		Args:          cobra.ExactArgs(1),
+
 		RunE: func(cmd *cobra.Command, args []string) error {
 			c, ctx := getClient()
 
 			if debug {
-				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing CompleteTodo"))
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing DisableTOTP"))
 			}
 
-			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+			bodyStr, _ := cmd.Flags().GetString("payload")
 
-			params := &client.CompleteTodoParams{}
-			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
-				u := uuid.MustParse(val)
-				params.IdempotencyKey = &u
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
 			}
 
-			resp, err := c.CompleteTodoWithResponse(ctx, paramid, params)
+			var reqBody client.DisableTOTPJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.DisableTOTPWithResponse(ctx, reqBody)
 			if err != nil {
 				return err
 			}
@@ -445,26 +464,24 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			return nil
 		},
 	}
-	cmdCompleteTodo.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+	cmdDisableTOTP.Flags().StringP("payload", "p", "", "JSON payload for the request body")
 
-	rootCmd.AddCommand(cmdCompleteTodo)
+	rootCmd.AddCommand(cmdDisableTOTP)
 
-	cmdStartFocus := &cobra.Command{
-		Use:           "start-focus [id]",
-		Short:         "Start focus session",
+	cmdInitiateTOTP := &cobra.Command{
+		Use:           "initiate-totp",
+		Short:         "Initiate TOTP setup for the user",
 		SilenceUsage:  true,
 		SilenceErrors: true,
-		Args:          cobra.ExactArgs(1),
+
 		RunE: func(cmd *cobra.Command, args []string) error {
 			c, ctx := getClient()
 
 			if debug {
-				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing StartFocus"))
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing InitiateTOTP"))
 			}
 
-			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
-
-			resp, err := c.StartFocusWithResponse(ctx, paramid)
+			resp, err := c.InitiateTOTPWithResponse(ctx)
 			if err != nil {
 				return err
 			}
@@ -493,24 +510,35 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 		},
 	}
 
-	rootCmd.AddCommand(cmdStartFocus)
+	rootCmd.AddCommand(cmdInitiateTOTP)
 
-	cmdStopFocus := &cobra.Command{
-		Use:           "stop-focus [id]",
-		Short:         "Stop focus session",
+	cmdRecoverTOTP := &cobra.Command{
+		Use:           "recover-totp",
+		Short:         "Complete MFA with a recovery code",
 		SilenceUsage:  true,
 		SilenceErrors: true,
-		Args:          cobra.ExactArgs(1),
+
 		RunE: func(cmd *cobra.Command, args []string) error {
 			c, ctx := getClient()
 
 			if debug {
-				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing StopFocus"))
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing RecoverTOTP"))
 			}
 
-			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+			bodyStr, _ := cmd.Flags().GetString("payload")
 
-			resp, err := c.StopFocusWithResponse(ctx, paramid)
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			}
+
+			var reqBody client.RecoverTOTPJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.RecoverTOTPWithResponse(ctx, reqBody)
 			if err != nil {
 				return err
 			}
@@ -538,28 +566,21 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			return nil
 		},
 	}
+	cmdRecoverTOTP.Flags().StringP("payload", "p", "", "JSON payload for the request body")
 
-	rootCmd.AddCommand(cmdStopFocus)
+	rootCmd.AddCommand(cmdRecoverTOTP)
 
-	cmdAssignTagToTodo := &cobra.Command{
-		Use:           "assign-tag-to-todo [id]",
-		Short:         "Assign a tag to a todo",
+	cmdVerifyTOTP := &cobra.Command{
+		Use:           "verify-totp",
+		Short:         "Verify and activate TOTP",
 		SilenceUsage:  true,
 		SilenceErrors: true,
-		Args:          cobra.ExactArgs(1),
+
 		RunE: func(cmd *cobra.Command, args []string) error {
 			c, ctx := getClient()
 
 			if debug {
-				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing AssignTagToTodo"))
-			}
-
-			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
-
-			params := &client.AssignTagToTodoParams{}
-			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
-				u := uuid.MustParse(val)
-				params.IdempotencyKey = &u
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing VerifyTOTP"))
 			}
 
 			bodyStr, _ := cmd.Flags().GetString("payload")
@@ -568,14 +589,14 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
 			}
 
-			var reqBody client.AssignTagToTodoJSONRequestBody
+			var reqBody client.VerifyTOTPJSONRequestBody
 			if bodyStr != "" {
 				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
 					return fmt.Errorf("invalid json payload: %w", err)
 				}
 			}
 
-			resp, err := c.AssignTagToTodoWithResponse(ctx, paramid, params, reqBody)
+			resp, err := c.VerifyTOTPWithResponse(ctx, reqBody)
 			if err != nil {
 				return err
 			}
@@ -603,27 +624,24 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			return nil
 		},
 	}
-	cmdAssignTagToTodo.Flags().StringP("payload", "p", "", "JSON payload for the request body")
-	cmdAssignTagToTodo.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+	cmdVerifyTOTP.Flags().StringP("payload", "p", "", "JSON payload for the request body")
 
-	rootCmd.AddCommand(cmdAssignTagToTodo)
+	rootCmd.AddCommand(cmdVerifyTOTP)
 
-	cmdGetUserByID := &cobra.Command{
-		Use:           "get-user-by-id [id]",
-		Short:         "",
+	cmdHealthz := &cobra.Command{
+		Use:           "healthz",
+		Short:         "Health check",
 		SilenceUsage:  true,
 		SilenceErrors: true,
-		Args:          cobra.ExactArgs(1),
+
 		RunE: func(cmd *cobra.Command, args []string) error {
 			c, ctx := getClient()
 
 			if debug {
-				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing GetUserByID"))
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing Healthz"))
 			}
 
-			paramid := userDomain.UserID(uuid.MustParse(args[0]))
-
-			resp, err := c.GetUserByIDWithResponse(ctx, paramid)
+			resp, err := c.HealthzWithResponse(ctx)
 			if err != nil {
 				return err
 			}
@@ -652,24 +670,2402 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 		},
 	}
 
-	rootCmd.AddCommand(cmdGetUserByID)
+	rootCmd.AddCommand(cmdHealthz)
 
-	cmdGetUserWorkspaces := &cobra.Command{
-		Use:           "get-user-workspaces [id]",
-		Short:         "Get all workspaces for a user",
+	cmdCommitTask := &cobra.Command{
+		Use:           "commit-task",
+		Short:         "Commit a task to daily schedule",
 		SilenceUsage:  true,
 		SilenceErrors: true,
-		Args:          cobra.ExactArgs(1),
+
 		RunE: func(cmd *cobra.Command, args []string) error {
 			c, ctx := getClient()
 
 			if debug {
-				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing GetUserWorkspaces"))
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing CommitTask"))
 			}
 
-			paramid := userDomain.UserID(uuid.MustParse(args[0]))
+			bodyStr, _ := cmd.Flags().GetString("payload")
 
-			params := &client.GetUserWorkspacesParams{}
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			}
+
+			var reqBody client.CommitTaskJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.CommitTaskWithResponse(ctx, reqBody)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdCommitTask.Flags().StringP("payload", "p", "", "JSON payload for the request body")
+
+	rootCmd.AddCommand(cmdCommitTask)
+
+	cmdGetSchedule := &cobra.Command{
+		Use:           "get-schedule [date]",
+		Short:         "Get the caller's schedule for a day",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing GetSchedule"))
+			}
+
+			parseddate, err := time.Parse(time.DateOnly, args[0])
+			if err != nil {
+				return fmt.Errorf("invalid date: %w", err)
+			}
+			paramdate := client.ScheduleDate{Time: parseddate}
+
+			resp, err := c.GetScheduleWithResponse(ctx, paramdate)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+
+	rootCmd.AddCommand(cmdGetSchedule)
+
+	cmdUpdateScheduleCapacity := &cobra.Command{
+		Use:           "update-schedule-capacity [date]",
+		Short:         "Override the capacity of a single day",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing UpdateScheduleCapacity"))
+			}
+
+			parseddate, err := time.Parse(time.DateOnly, args[0])
+			if err != nil {
+				return fmt.Errorf("invalid date: %w", err)
+			}
+			paramdate := client.ScheduleDate{Time: parseddate}
+
+			params := &client.UpdateScheduleCapacityParams{}
+			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+				u := uuid.MustParse(val)
+				params.IdempotencyKey = &u
+			}
+
+			bodyStr, _ := cmd.Flags().GetString("payload")
+
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			}
+
+			var reqBody client.UpdateScheduleCapacityJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.UpdateScheduleCapacityWithResponse(ctx, paramdate, params, reqBody)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdUpdateScheduleCapacity.Flags().StringP("payload", "p", "", "JSON payload for the request body")
+	cmdUpdateScheduleCapacity.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+
+	rootCmd.AddCommand(cmdUpdateScheduleCapacity)
+
+	cmdAutoPlanSchedule := &cobra.Command{
+		Use:           "auto-plan-schedule [date]",
+		Short:         "Fill a day with the caller's open assignments and due todos",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing AutoPlanSchedule"))
+			}
+
+			parseddate, err := time.Parse(time.DateOnly, args[0])
+			if err != nil {
+				return fmt.Errorf("invalid date: %w", err)
+			}
+			paramdate := client.ScheduleDate{Time: parseddate}
+
+			params := &client.AutoPlanScheduleParams{}
+			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+				u := uuid.MustParse(val)
+				params.IdempotencyKey = &u
+			}
+
+			resp, err := c.AutoPlanScheduleWithResponse(ctx, paramdate, params)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdAutoPlanSchedule.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+
+	rootCmd.AddCommand(cmdAutoPlanSchedule)
+
+	cmdRemoveScheduledTask := &cobra.Command{
+		Use:           "remove-scheduled-task [date] [todoId]",
+		Short:         "Uncommit a task from a day",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing RemoveScheduledTask"))
+			}
+
+			parseddate, err := time.Parse(time.DateOnly, args[0])
+			if err != nil {
+				return fmt.Errorf("invalid date: %w", err)
+			}
+			paramdate := client.ScheduleDate{Time: parseddate}
+
+			paramtodoId := todoDomain.TodoID(uuid.MustParse(args[1]))
+
+			params := &client.RemoveScheduledTaskParams{}
+			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+				u := uuid.MustParse(val)
+				params.IdempotencyKey = &u
+			}
+
+			resp, err := c.RemoveScheduledTaskWithResponse(ctx, paramdate, paramtodoId, params)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdRemoveScheduledTask.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+
+	rootCmd.AddCommand(cmdRemoveScheduledTask)
+
+	cmdGetTodoByID := &cobra.Command{
+		Use:           "get-todo-by-id [id]",
+		Short:         "Get a todo by ID",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing GetTodoByID"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			resp, err := c.GetTodoByIDWithResponse(ctx, paramid)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+
+	rootCmd.AddCommand(cmdGetTodoByID)
+
+	cmdUpdateTodo := &cobra.Command{
+		Use:           "update-todo [id]",
+		Short:         "Update a todo",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing UpdateTodo"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			params := &client.UpdateTodoParams{}
+			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+				u := uuid.MustParse(val)
+				params.IdempotencyKey = &u
+			}
+
+			bodyStr, _ := cmd.Flags().GetString("payload")
+
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			}
+
+			var reqBody client.UpdateTodoJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.UpdateTodoWithResponse(ctx, paramid, params, reqBody)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdUpdateTodo.Flags().StringP("payload", "p", "", "JSON payload for the request body")
+	cmdUpdateTodo.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+
+	rootCmd.AddCommand(cmdUpdateTodo)
+
+	cmdArchiveTodo := &cobra.Command{
+		Use:           "archive-todo [id]",
+		Short:         "Archive a todo",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing ArchiveTodo"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			params := &client.ArchiveTodoParams{}
+			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+				u := uuid.MustParse(val)
+				params.IdempotencyKey = &u
+			}
+
+			resp, err := c.ArchiveTodoWithResponse(ctx, paramid, params)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdArchiveTodo.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+
+	rootCmd.AddCommand(cmdArchiveTodo)
+
+	cmdUnassignTodo := &cobra.Command{
+		Use:           "unassign-todo [id]",
+		Short:         "Unassign a todo",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing UnassignTodo"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			resp, err := c.UnassignTodoWithResponse(ctx, paramid)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+
+	rootCmd.AddCommand(cmdUnassignTodo)
+
+	cmdAssignTodo := &cobra.Command{
+		Use:           "assign-todo [id]",
+		Short:         "Assign a todo to a workspace member",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing AssignTodo"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			bodyStr, _ := cmd.Flags().GetString("payload")
+
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			}
+
+			var reqBody client.AssignTodoJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.AssignTodoWithResponse(ctx, paramid, reqBody)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdAssignTodo.Flags().StringP("payload", "p", "", "JSON payload for the request body")
+
+	rootCmd.AddCommand(cmdAssignTodo)
+
+	cmdAddTodoBlocker := &cobra.Command{
+		Use:           "add-todo-blocker [id]",
+		Short:         "Block a todo until another todo in the same workspace is completed",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing AddTodoBlocker"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			bodyStr, _ := cmd.Flags().GetString("payload")
+
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			}
+
+			var reqBody client.AddTodoBlockerJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.AddTodoBlockerWithResponse(ctx, paramid, reqBody)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdAddTodoBlocker.Flags().StringP("payload", "p", "", "JSON payload for the request body")
+
+	rootCmd.AddCommand(cmdAddTodoBlocker)
+
+	cmdRemoveTodoBlocker := &cobra.Command{
+		Use:           "remove-todo-blocker [id] [blockerId]",
+		Short:         "Remove a blocker from a todo",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing RemoveTodoBlocker"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			paramblockerId := todoDomain.TodoID(uuid.MustParse(args[1]))
+
+			resp, err := c.RemoveTodoBlockerWithResponse(ctx, paramid, paramblockerId)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+
+	rootCmd.AddCommand(cmdRemoveTodoBlocker)
+
+	cmdAddChecklistItem := &cobra.Command{
+		Use:           "add-checklist-item [id]",
+		Short:         "Add a checklist item to a todo",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing AddChecklistItem"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			params := &client.AddChecklistItemParams{}
+			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+				u := uuid.MustParse(val)
+				params.IdempotencyKey = &u
+			}
+
+			bodyStr, _ := cmd.Flags().GetString("payload")
+
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			}
+
+			var reqBody client.AddChecklistItemJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.AddChecklistItemWithResponse(ctx, paramid, params, reqBody)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdAddChecklistItem.Flags().StringP("payload", "p", "", "JSON payload for the request body")
+	cmdAddChecklistItem.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+
+	rootCmd.AddCommand(cmdAddChecklistItem)
+
+	cmdReorderChecklist := &cobra.Command{
+		Use:           "reorder-checklist [id]",
+		Short:         "Reorder the checklist of a todo",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing ReorderChecklist"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			bodyStr, _ := cmd.Flags().GetString("payload")
+
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			}
+
+			var reqBody client.ReorderChecklistJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.ReorderChecklistWithResponse(ctx, paramid, reqBody)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdReorderChecklist.Flags().StringP("payload", "p", "", "JSON payload for the request body")
+
+	rootCmd.AddCommand(cmdReorderChecklist)
+
+	cmdRemoveChecklistItem := &cobra.Command{
+		Use:           "remove-checklist-item [id] [itemId]",
+		Short:         "Remove a checklist item",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing RemoveChecklistItem"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			paramitemId := todoDomain.ChecklistItemID(uuid.MustParse(args[1]))
+
+			resp, err := c.RemoveChecklistItemWithResponse(ctx, paramid, paramitemId)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+
+	rootCmd.AddCommand(cmdRemoveChecklistItem)
+
+	cmdToggleChecklistItem := &cobra.Command{
+		Use:           "toggle-checklist-item [id] [itemId]",
+		Short:         "Toggle the done state of a checklist item",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing ToggleChecklistItem"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			paramitemId := todoDomain.ChecklistItemID(uuid.MustParse(args[1]))
+
+			params := &client.ToggleChecklistItemParams{}
+			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+				u := uuid.MustParse(val)
+				params.IdempotencyKey = &u
+			}
+
+			resp, err := c.ToggleChecklistItemWithResponse(ctx, paramid, paramitemId, params)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdToggleChecklistItem.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+
+	rootCmd.AddCommand(cmdToggleChecklistItem)
+
+	cmdGetTodoComments := &cobra.Command{
+		Use:           "get-todo-comments [id]",
+		Short:         "List the comments of a todo, oldest first",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing GetTodoComments"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			params := &client.GetTodoCommentsParams{}
+			if val, _ := cmd.Flags().GetInt("limit"); val != 0 {
+				params.Limit = &val
+			}
+			if val, _ := cmd.Flags().GetInt("offset"); val != 0 {
+				params.Offset = &val
+			}
+
+			resp, err := c.GetTodoCommentsWithResponse(ctx, paramid, params)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdGetTodoComments.Flags().Int("limit", 0, "Maximum number of records to return.")
+	cmdGetTodoComments.Flags().Int("offset", 0, "Number of records to skip.")
+
+	rootCmd.AddCommand(cmdGetTodoComments)
+
+	cmdCreateTodoComment := &cobra.Command{
+		Use:           "create-todo-comment [id]",
+		Short:         "Comment on a todo",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing CreateTodoComment"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			bodyStr, _ := cmd.Flags().GetString("payload")
+
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			}
+
+			var reqBody client.CreateTodoCommentJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.CreateTodoCommentWithResponse(ctx, paramid, reqBody)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdCreateTodoComment.Flags().StringP("payload", "p", "", "JSON payload for the request body")
+
+	rootCmd.AddCommand(cmdCreateTodoComment)
+
+	cmdDeleteTodoComment := &cobra.Command{
+		Use:           "delete-todo-comment [id] [commentId]",
+		Short:         "Delete a comment",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing DeleteTodoComment"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			paramcommentId := todoDomain.CommentID(uuid.MustParse(args[1]))
+
+			resp, err := c.DeleteTodoCommentWithResponse(ctx, paramid, paramcommentId)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+
+	rootCmd.AddCommand(cmdDeleteTodoComment)
+
+	cmdUpdateTodoComment := &cobra.Command{
+		Use:           "update-todo-comment [id] [commentId]",
+		Short:         "Edit a comment",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing UpdateTodoComment"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			paramcommentId := todoDomain.CommentID(uuid.MustParse(args[1]))
+
+			bodyStr, _ := cmd.Flags().GetString("payload")
+
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			}
+
+			var reqBody client.UpdateTodoCommentJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.UpdateTodoCommentWithResponse(ctx, paramid, paramcommentId, reqBody)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdUpdateTodoComment.Flags().StringP("payload", "p", "", "JSON payload for the request body")
+
+	rootCmd.AddCommand(cmdUpdateTodoComment)
+
+	cmdCompleteTodo := &cobra.Command{
+		Use:           "complete-todo [id]",
+		Short:         "Complete a todo",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing CompleteTodo"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			params := &client.CompleteTodoParams{}
+			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+				u := uuid.MustParse(val)
+				params.IdempotencyKey = &u
+			}
+
+			resp, err := c.CompleteTodoWithResponse(ctx, paramid, params)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdCompleteTodo.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+
+	rootCmd.AddCommand(cmdCompleteTodo)
+
+	cmdSetTodoDueDate := &cobra.Command{
+		Use:           "set-todo-due-date [id]",
+		Short:         "Set or clear the due date of a todo",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing SetTodoDueDate"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			params := &client.SetTodoDueDateParams{}
+			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+				u := uuid.MustParse(val)
+				params.IdempotencyKey = &u
+			}
+
+			bodyStr, _ := cmd.Flags().GetString("payload")
+
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			}
+
+			var reqBody client.SetTodoDueDateJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.SetTodoDueDateWithResponse(ctx, paramid, params, reqBody)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdSetTodoDueDate.Flags().StringP("payload", "p", "", "JSON payload for the request body")
+	cmdSetTodoDueDate.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+
+	rootCmd.AddCommand(cmdSetTodoDueDate)
+
+	cmdStartFocus := &cobra.Command{
+		Use:           "start-focus [id]",
+		Short:         "Start focus session",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing StartFocus"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			bodyStr, _ := cmd.Flags().GetString("payload")
+
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			}
+
+			var reqBody client.StartFocusJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.StartFocusWithResponse(ctx, paramid, reqBody)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdStartFocus.Flags().StringP("payload", "p", "", "JSON payload for the request body")
+
+	rootCmd.AddCommand(cmdStartFocus)
+
+	cmdStopFocus := &cobra.Command{
+		Use:           "stop-focus [id]",
+		Short:         "Stop focus session",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing StopFocus"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			resp, err := c.StopFocusWithResponse(ctx, paramid)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+
+	rootCmd.AddCommand(cmdStopFocus)
+
+	cmdSetTodoRecurrence := &cobra.Command{
+		Use:           "set-todo-recurrence [id]",
+		Short:         "Set or clear the recurrence rule of a todo",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing SetTodoRecurrence"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			params := &client.SetTodoRecurrenceParams{}
+			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+				u := uuid.MustParse(val)
+				params.IdempotencyKey = &u
+			}
+
+			bodyStr, _ := cmd.Flags().GetString("payload")
+
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			}
+
+			var reqBody client.SetTodoRecurrenceJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.SetTodoRecurrenceWithResponse(ctx, paramid, params, reqBody)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdSetTodoRecurrence.Flags().StringP("payload", "p", "", "JSON payload for the request body")
+	cmdSetTodoRecurrence.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+
+	rootCmd.AddCommand(cmdSetTodoRecurrence)
+
+	cmdReopenTodo := &cobra.Command{
+		Use:           "reopen-todo [id]",
+		Short:         "Reopen a completed todo",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing ReopenTodo"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			params := &client.ReopenTodoParams{}
+			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+				u := uuid.MustParse(val)
+				params.IdempotencyKey = &u
+			}
+
+			resp, err := c.ReopenTodoWithResponse(ctx, paramid, params)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdReopenTodo.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+
+	rootCmd.AddCommand(cmdReopenTodo)
+
+	cmdAssignTagToTodo := &cobra.Command{
+		Use:           "assign-tag-to-todo [id]",
+		Short:         "Assign a tag to a todo",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing AssignTagToTodo"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			params := &client.AssignTagToTodoParams{}
+			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+				u := uuid.MustParse(val)
+				params.IdempotencyKey = &u
+			}
+
+			bodyStr, _ := cmd.Flags().GetString("payload")
+
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			}
+
+			var reqBody client.AssignTagToTodoJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.AssignTagToTodoWithResponse(ctx, paramid, params, reqBody)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdAssignTagToTodo.Flags().StringP("payload", "p", "", "JSON payload for the request body")
+	cmdAssignTagToTodo.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+
+	rootCmd.AddCommand(cmdAssignTagToTodo)
+
+	cmdRemoveTagFromTodo := &cobra.Command{
+		Use:           "remove-tag-from-todo [id] [tagId]",
+		Short:         "Remove a tag from a todo",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing RemoveTagFromTodo"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			paramtagId := todoDomain.TagID(uuid.MustParse(args[1]))
+
+			params := &client.RemoveTagFromTodoParams{}
+			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+				u := uuid.MustParse(val)
+				params.IdempotencyKey = &u
+			}
+
+			resp, err := c.RemoveTagFromTodoWithResponse(ctx, paramid, paramtagId, params)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdRemoveTagFromTodo.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+
+	rootCmd.AddCommand(cmdRemoveTagFromTodo)
+
+	cmdUnarchiveTodo := &cobra.Command{
+		Use:           "unarchive-todo [id]",
+		Short:         "Restore an archived todo",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing UnarchiveTodo"))
+			}
+
+			paramid := todoDomain.TodoID(uuid.MustParse(args[0]))
+
+			params := &client.UnarchiveTodoParams{}
+			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+				u := uuid.MustParse(val)
+				params.IdempotencyKey = &u
+			}
+
+			resp, err := c.UnarchiveTodoWithResponse(ctx, paramid, params)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdUnarchiveTodo.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+
+	rootCmd.AddCommand(cmdUnarchiveTodo)
+
+	cmdDeleteUser := &cobra.Command{
+		Use:           "delete-user [id]",
+		Short:         "Delete a user and erase their personal data",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing DeleteUser"))
+			}
+
+			paramid := userDomain.UserID(uuid.MustParse(args[0]))
+
+			resp, err := c.DeleteUserWithResponse(ctx, paramid)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+
+	rootCmd.AddCommand(cmdDeleteUser)
+
+	cmdGetUserByID := &cobra.Command{
+		Use:           "get-user-by-id [id]",
+		Short:         "",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing GetUserByID"))
+			}
+
+			paramid := userDomain.UserID(uuid.MustParse(args[0]))
+
+			resp, err := c.GetUserByIDWithResponse(ctx, paramid)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+
+	rootCmd.AddCommand(cmdGetUserByID)
+
+	cmdGetUserAssignedTodos := &cobra.Command{
+		Use:           "get-user-assigned-todos [id]",
+		Short:         "List the todos assigned to a user, soonest due first",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing GetUserAssignedTodos"))
+			}
+
+			paramid := userDomain.UserID(uuid.MustParse(args[0]))
+
+			params := &client.GetUserAssignedTodosParams{}
+			if val, _ := cmd.Flags().GetInt("limit"); val != 0 {
+				params.Limit = &val
+			}
+			if val, _ := cmd.Flags().GetInt("offset"); val != 0 {
+				params.Offset = &val
+			}
+
+			resp, err := c.GetUserAssignedTodosWithResponse(ctx, paramid, params)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdGetUserAssignedTodos.Flags().Int("limit", 0, "Maximum number of records to return.")
+	cmdGetUserAssignedTodos.Flags().Int("offset", 0, "Number of records to skip.")
+
+	rootCmd.AddCommand(cmdGetUserAssignedTodos)
+
+	cmdSetUserDailyCapacity := &cobra.Command{
+		Use:           "set-user-daily-capacity [id]",
+		Short:         "Set the default capacity of the user's new schedules",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing SetUserDailyCapacity"))
+			}
+
+			paramid := userDomain.UserID(uuid.MustParse(args[0]))
+
+			params := &client.SetUserDailyCapacityParams{}
+			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+				u := uuid.MustParse(val)
+				params.IdempotencyKey = &u
+			}
+
+			bodyStr, _ := cmd.Flags().GetString("payload")
+
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			}
+
+			var reqBody client.SetUserDailyCapacityJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.SetUserDailyCapacityWithResponse(ctx, paramid, params, reqBody)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdSetUserDailyCapacity.Flags().StringP("payload", "p", "", "JSON payload for the request body")
+	cmdSetUserDailyCapacity.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+
+	rootCmd.AddCommand(cmdSetUserDailyCapacity)
+
+	cmdRequestUserDataExport := &cobra.Command{
+		Use:           "request-user-data-export [id]",
+		Short:         "Request an export of the user's personal data",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing RequestUserDataExport"))
+			}
+
+			paramid := userDomain.UserID(uuid.MustParse(args[0]))
+
+			params := &client.RequestUserDataExportParams{}
+			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+				u := uuid.MustParse(val)
+				params.IdempotencyKey = &u
+			}
+
+			resp, err := c.RequestUserDataExportWithResponse(ctx, paramid, params)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdRequestUserDataExport.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+
+	rootCmd.AddCommand(cmdRequestUserDataExport)
+
+	cmdGetUserDataExport := &cobra.Command{
+		Use:           "get-user-data-export [id] [exportId]",
+		Short:         "Get the status of a personal data export",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing GetUserDataExport"))
+			}
+
+			paramid := userDomain.UserID(uuid.MustParse(args[0]))
+
+			paramexportId := userDomain.DataExportID(uuid.MustParse(args[1]))
+
+			resp, err := c.GetUserDataExportWithResponse(ctx, paramid, paramexportId)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+
+	rootCmd.AddCommand(cmdGetUserDataExport)
+
+	cmdDownloadUserDataExport := &cobra.Command{
+		Use:           "download-user-data-export [id] [exportId]",
+		Short:         "Download a ready personal data export",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing DownloadUserDataExport"))
+			}
+
+			paramid := userDomain.UserID(uuid.MustParse(args[0]))
+
+			paramexportId := userDomain.DataExportID(uuid.MustParse(args[1]))
+
+			resp, err := c.DownloadUserDataExportWithResponse(ctx, paramid, paramexportId)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+
+	rootCmd.AddCommand(cmdDownloadUserDataExport)
+
+	cmdSetUserTimezone := &cobra.Command{
+		Use:           "set-user-timezone [id]",
+		Short:         "Set the IANA timezone used for the user's calendar days",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing SetUserTimezone"))
+			}
+
+			paramid := userDomain.UserID(uuid.MustParse(args[0]))
+
+			params := &client.SetUserTimezoneParams{}
+			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+				u := uuid.MustParse(val)
+				params.IdempotencyKey = &u
+			}
+
+			bodyStr, _ := cmd.Flags().GetString("payload")
+
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			}
+
+			var reqBody client.SetUserTimezoneJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.SetUserTimezoneWithResponse(ctx, paramid, params, reqBody)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdSetUserTimezone.Flags().StringP("payload", "p", "", "JSON payload for the request body")
+	cmdSetUserTimezone.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+
+	rootCmd.AddCommand(cmdSetUserTimezone)
+
+	cmdResetUserTOTP := &cobra.Command{
+		Use:           "reset-user-totp [id]",
+		Short:         "Reset the TOTP enrollment of a user",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing ResetUserTOTP"))
+			}
+
+			paramid := userDomain.UserID(uuid.MustParse(args[0]))
+
+			resp, err := c.ResetUserTOTPWithResponse(ctx, paramid)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+
+	rootCmd.AddCommand(cmdResetUserTOTP)
+
+	cmdGetUserWorkspaces := &cobra.Command{
+		Use:           "get-user-workspaces [id]",
+		Short:         "Get all workspaces for a user",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing GetUserWorkspaces"))
+			}
+
+			paramid := userDomain.UserID(uuid.MustParse(args[0]))
+
+			params := &client.GetUserWorkspacesParams{}
+			if val, _ := cmd.Flags().GetInt("limit"); val != 0 {
+				params.Limit = &val
+			}
+			if val, _ := cmd.Flags().GetInt("offset"); val != 0 {
+				params.Offset = &val
+			}
+
+			resp, err := c.GetUserWorkspacesWithResponse(ctx, paramid, params)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdGetUserWorkspaces.Flags().Int("limit", 0, "Maximum number of records to return.")
+	cmdGetUserWorkspaces.Flags().Int("offset", 0, "Number of records to skip.")
+
+	rootCmd.AddCommand(cmdGetUserWorkspaces)
+
+	cmdListWorkspaces := &cobra.Command{
+		Use:           "list-workspaces",
+		Short:         "List all workspaces",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing ListWorkspaces"))
+			}
+
+			params := &client.ListWorkspacesParams{}
+			if val, _ := cmd.Flags().GetInt("limit"); val != 0 {
+				params.Limit = &val
+			}
+			if val, _ := cmd.Flags().GetInt("offset"); val != 0 {
+				params.Offset = &val
+			}
+
+			resp, err := c.ListWorkspacesWithResponse(ctx, params)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdListWorkspaces.Flags().Int("limit", 0, "Maximum number of records to return.")
+	cmdListWorkspaces.Flags().Int("offset", 0, "Number of records to skip.")
+
+	rootCmd.AddCommand(cmdListWorkspaces)
+
+	cmdOnboardWorkspace := &cobra.Command{
+		Use:           "onboard-workspace",
+		Short:         "Onboard a new workspace with initial members",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing OnboardWorkspace"))
+			}
+
+			params := &client.OnboardWorkspaceParams{}
+			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+				u := uuid.MustParse(val)
+				params.IdempotencyKey = &u
+			}
+
+			bodyStr, _ := cmd.Flags().GetString("payload")
+
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			}
+
+			var reqBody client.OnboardWorkspaceJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.OnboardWorkspaceWithResponse(ctx, params, reqBody)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdOnboardWorkspace.Flags().StringP("payload", "p", "", "JSON payload for the request body")
+	cmdOnboardWorkspace.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+
+	rootCmd.AddCommand(cmdOnboardWorkspace)
+
+	cmdDeleteWorkspace := &cobra.Command{
+		Use:           "delete-workspace [id]",
+		Short:         "Delete a workspace",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing DeleteWorkspace"))
+			}
+
+			paramid := workspaceDomain.WorkspaceID(uuid.MustParse(args[0]))
+
+			resp, err := c.DeleteWorkspaceWithResponse(ctx, paramid)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+
+	rootCmd.AddCommand(cmdDeleteWorkspace)
+
+	cmdGetWorkspaceAuditLogs := &cobra.Command{
+		Use:           "get-workspace-audit-logs [id]",
+		Short:         "List the audit trail of a workspace",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing GetWorkspaceAuditLogs"))
+			}
+
+			paramid := workspaceDomain.WorkspaceID(uuid.MustParse(args[0]))
+
+			params := &client.GetWorkspaceAuditLogsParams{}
+			if val, _ := cmd.Flags().GetString("aggregate-type"); val != "" {
+				params.AggregateType = (*client.AuditAggregateType)(&val)
+			}
+			if val, _ := cmd.Flags().GetString("aggregate-id"); val != "" {
+				u := uuid.MustParse(val)
+				params.AggregateID = &u
+			}
+			if val, _ := cmd.Flags().GetString("actor-id"); val != "" {
+				u := uuid.MustParse(val)
+				params.ActorID = &u
+			}
+			if val, _ := cmd.Flags().GetString("operation"); val != "" {
+				params.Operation = (*client.AuditOperation)(&val)
+			}
+			if val, _ := cmd.Flags().GetString("from"); val != "" {
+				t, err := time.Parse(time.RFC3339, val)
+				if err != nil {
+					return fmt.Errorf("invalid --from: %w", err)
+				}
+				params.From = &t
+			}
+			if val, _ := cmd.Flags().GetString("to"); val != "" {
+				t, err := time.Parse(time.RFC3339, val)
+				if err != nil {
+					return fmt.Errorf("invalid --to: %w", err)
+				}
+				params.To = &t
+			}
 			if val, _ := cmd.Flags().GetInt("limit"); val != 0 {
 				params.Limit = &val
 			}
@@ -677,7 +3073,7 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 				params.Offset = &val
 			}
 
-			resp, err := c.GetUserWorkspacesWithResponse(ctx, paramid, params)
+			resp, err := c.GetWorkspaceAuditLogsWithResponse(ctx, paramid, params)
 			if err != nil {
 				return err
 			}
@@ -705,33 +3101,33 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			return nil
 		},
 	}
-	cmdGetUserWorkspaces.Flags().Int("limit", 0, "Maximum number of records to return.")
-	cmdGetUserWorkspaces.Flags().Int("offset", 0, "Number of records to skip.")
-
-	rootCmd.AddCommand(cmdGetUserWorkspaces)
-
-	cmdListWorkspaces := &cobra.Command{
-		Use:           "list-workspaces",
-		Short:         "List all workspaces",
+	cmdGetWorkspaceAuditLogs.Flags().String("aggregate-type", "", "")
+	cmdGetWorkspaceAuditLogs.Flags().String("aggregate-id", "", "")
+	cmdGetWorkspaceAuditLogs.Flags().String("actor-id", "", "Only include changes made by this user.")
+	cmdGetWorkspaceAuditLogs.Flags().String("operation", "", "")
+	cmdGetWorkspaceAuditLogs.Flags().String("from", "", "Earliest change time, inclusive.")
+	cmdGetWorkspaceAuditLogs.Flags().String("to", "", "Latest change time, exclusive.")
+	cmdGetWorkspaceAuditLogs.Flags().Int("limit", 0, "Maximum number of records to return.")
+	cmdGetWorkspaceAuditLogs.Flags().Int("offset", 0, "Number of records to skip.")
+
+	rootCmd.AddCommand(cmdGetWorkspaceAuditLogs)
+
+	cmdVerifyWorkspaceAuditChain := &cobra.Command{
+		Use:           "verify-workspace-audit-chain [id]",
+		Short:         "Verify the audit trail of a workspace has not been tampered with",
 		SilenceUsage:  true,
 		SilenceErrors: true,
-
+		Args:          cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			c, ctx := getClient()
 
 			if debug {
-				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing ListWorkspaces"))
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing VerifyWorkspaceAuditChain"))
 			}
 
-			params := &client.ListWorkspacesParams{}
-			if val, _ := cmd.Flags().GetInt("limit"); val != 0 {
-				params.Limit = &val
-			}
-			if val, _ := cmd.Flags().GetInt("offset"); val != 0 {
-				params.Offset = &val
-			}
+			paramid := workspaceDomain.WorkspaceID(uuid.MustParse(args[0]))
 
-			resp, err := c.ListWorkspacesWithResponse(ctx, params)
+			resp, err := c.VerifyWorkspaceAuditChainWithResponse(ctx, paramid)
 			if err != nil {
 				return err
 			}
@@ -759,25 +3155,25 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			return nil
 		},
 	}
-	cmdListWorkspaces.Flags().Int("limit", 0, "Maximum number of records to return.")
-	cmdListWorkspaces.Flags().Int("offset", 0, "Number of records to skip.")
 
-	rootCmd.AddCommand(cmdListWorkspaces)
+	rootCmd.AddCommand(cmdVerifyWorkspaceAuditChain)
 
-	cmdOnboardWorkspace := &cobra.Command{
-		Use:           "onboard-workspace",
-		Short:         "Onboard a new workspace with initial members",
+	cmdAddWorkspaceMember := &cobra.Command{
+		Use:           "add-workspace-member [id]",
+		Short:         "Add a member to a workspace",
 		SilenceUsage:  true,
 		SilenceErrors: true,
-
+		Args:          cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			c, ctx := getClient()
 
 			if debug {
-				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing OnboardWorkspace"))
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing AddWorkspaceMember"))
 			}
 
-			params := &client.OnboardWorkspaceParams{}
+			paramid := workspaceDomain.WorkspaceID(uuid.MustParse(args[0]))
+
+			params := &client.AddWorkspaceMemberParams{}
 			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
 				u := uuid.MustParse(val)
 				params.IdempotencyKey = &u
@@ -789,14 +3185,14 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
 			}
 
-			var reqBody client.OnboardWorkspaceJSONRequestBody
+			var reqBody client.AddWorkspaceMemberJSONRequestBody
 			if bodyStr != "" {
 				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
 					return fmt.Errorf("invalid json payload: %w", err)
 				}
 			}
 
-			resp, err := c.OnboardWorkspaceWithResponse(ctx, params, reqBody)
+			resp, err := c.AddWorkspaceMemberWithResponse(ctx, paramid, params, reqBody)
 			if err != nil {
 				return err
 			}
@@ -824,27 +3220,29 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			return nil
 		},
 	}
-	cmdOnboardWorkspace.Flags().StringP("payload", "p", "", "JSON payload for the request body")
-	cmdOnboardWorkspace.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+	cmdAddWorkspaceMember.Flags().StringP("payload", "p", "", "JSON payload for the request body")
+	cmdAddWorkspaceMember.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
 
-	rootCmd.AddCommand(cmdOnboardWorkspace)
+	rootCmd.AddCommand(cmdAddWorkspaceMember)
 
-	cmdDeleteWorkspace := &cobra.Command{
-		Use:           "delete-workspace [id]",
-		Short:         "Delete a workspace",
+	cmdRemoveWorkspaceMember := &cobra.Command{
+		Use:           "remove-workspace-member [id] [userId]",
+		Short:         "Remove a member from a workspace",
 		SilenceUsage:  true,
 		SilenceErrors: true,
-		Args:          cobra.ExactArgs(1),
+		Args:          cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			c, ctx := getClient()
 
 			if debug {
-				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing DeleteWorkspace"))
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing RemoveWorkspaceMember"))
 			}
 
 			paramid := workspaceDomain.WorkspaceID(uuid.MustParse(args[0]))
 
-			resp, err := c.DeleteWorkspaceWithResponse(ctx, paramid)
+			paramuserId := userDomain.UserID(uuid.MustParse(args[1]))
+
+			resp, err := c.RemoveWorkspaceMemberWithResponse(ctx, paramid, paramuserId)
 			if err != nil {
 				return err
 			}
@@ -873,11 +3271,11 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 		},
 	}
 
-	rootCmd.AddCommand(cmdDeleteWorkspace)
+	rootCmd.AddCommand(cmdRemoveWorkspaceMember)
 
-	cmdAddWorkspaceMember := &cobra.Command{
-		Use:           "add-workspace-member [id]",
-		Short:         "Add a member to a workspace",
+	cmdGetWorkspaceFocusReport := &cobra.Command{
+		Use:           "get-workspace-focus-report [id]",
+		Short:         "Report focused time in a workspace",
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Args:          cobra.ExactArgs(1),
@@ -885,31 +3283,85 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			c, ctx := getClient()
 
 			if debug {
-				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing AddWorkspaceMember"))
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing GetWorkspaceFocusReport"))
 			}
 
 			paramid := workspaceDomain.WorkspaceID(uuid.MustParse(args[0]))
 
-			params := &client.AddWorkspaceMemberParams{}
-			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+			params := &client.GetWorkspaceFocusReportParams{}
+			if val, _ := cmd.Flags().GetString("from"); val != "" {
+				t, err := time.Parse(time.DateOnly, val)
+				if err != nil {
+					return fmt.Errorf("invalid --from: %w", err)
+				}
+				params.From = client.ReportFrom{Time: t}
+			}
+			if val, _ := cmd.Flags().GetString("to"); val != "" {
+				t, err := time.Parse(time.DateOnly, val)
+				if err != nil {
+					return fmt.Errorf("invalid --to: %w", err)
+				}
+				params.To = client.ReportTo{Time: t}
+			}
+			if val, _ := cmd.Flags().GetString("group-by"); val != "" {
+				params.GroupBy = (*client.GetWorkspaceFocusReportParamsGroupBy)(&val)
+			}
+			if val, _ := cmd.Flags().GetString("user-id"); val != "" {
 				u := uuid.MustParse(val)
-				params.IdempotencyKey = &u
+				params.UserID = &u
 			}
 
-			bodyStr, _ := cmd.Flags().GetString("payload")
+			resp, err := c.GetWorkspaceFocusReportWithResponse(ctx, paramid, params)
+			if err != nil {
+				return err
+			}
 
-			if debug && bodyStr != "" {
-				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
 			}
 
-			var reqBody client.AddWorkspaceMemberJSONRequestBody
-			if bodyStr != "" {
-				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
-					return fmt.Errorf("invalid json payload: %w", err)
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
 				}
 			}
 
-			resp, err := c.AddWorkspaceMemberWithResponse(ctx, paramid, params, reqBody)
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdGetWorkspaceFocusReport.Flags().String("from", "", "First day of the report, inclusive.")
+	cmdGetWorkspaceFocusReport.Flags().String("to", "", "Last day of the report, inclusive. At most 366 days after from.")
+	cmdGetWorkspaceFocusReport.Flags().String("group-by", "", "")
+	cmdGetWorkspaceFocusReport.Flags().String("user-id", "", "Only include sessions by this member.")
+
+	rootCmd.AddCommand(cmdGetWorkspaceFocusReport)
+
+	cmdGetWorkspaceTags := &cobra.Command{
+		Use:           "get-workspace-tags [id]",
+		Short:         "Get all tags for a workspace",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing GetWorkspaceTags"))
+			}
+
+			paramid := workspaceDomain.WorkspaceID(uuid.MustParse(args[0]))
+
+			resp, err := c.GetWorkspaceTagsWithResponse(ctx, paramid)
 			if err != nil {
 				return err
 			}
@@ -937,29 +3389,44 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			return nil
 		},
 	}
-	cmdAddWorkspaceMember.Flags().StringP("payload", "p", "", "JSON payload for the request body")
-	cmdAddWorkspaceMember.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
 
-	rootCmd.AddCommand(cmdAddWorkspaceMember)
+	rootCmd.AddCommand(cmdGetWorkspaceTags)
 
-	cmdRemoveWorkspaceMember := &cobra.Command{
-		Use:           "remove-workspace-member [id] [userId]",
-		Short:         "Remove a member from a workspace",
+	cmdCreateTag := &cobra.Command{
+		Use:           "create-tag [id]",
+		Short:         "Create a new tag",
 		SilenceUsage:  true,
 		SilenceErrors: true,
-		Args:          cobra.ExactArgs(2),
+		Args:          cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			c, ctx := getClient()
 
 			if debug {
-				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing RemoveWorkspaceMember"))
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing CreateTag"))
 			}
 
 			paramid := workspaceDomain.WorkspaceID(uuid.MustParse(args[0]))
 
-			paramuserId := userDomain.UserID(uuid.MustParse(args[1]))
+			params := &client.CreateTagParams{}
+			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+				u := uuid.MustParse(val)
+				params.IdempotencyKey = &u
+			}
 
-			resp, err := c.RemoveWorkspaceMemberWithResponse(ctx, paramid, paramuserId)
+			bodyStr, _ := cmd.Flags().GetString("payload")
+
+			if debug && bodyStr != "" {
+				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
+			}
+
+			var reqBody client.CreateTagJSONRequestBody
+			if bodyStr != "" {
+				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
+					return fmt.Errorf("invalid json payload: %w", err)
+				}
+			}
+
+			resp, err := c.CreateTagWithResponse(ctx, paramid, params, reqBody)
 			if err != nil {
 				return err
 			}
@@ -987,25 +3454,35 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			return nil
 		},
 	}
+	cmdCreateTag.Flags().StringP("payload", "p", "", "JSON payload for the request body")
+	cmdCreateTag.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
 
-	rootCmd.AddCommand(cmdRemoveWorkspaceMember)
+	rootCmd.AddCommand(cmdCreateTag)
 
-	cmdGetWorkspaceTags := &cobra.Command{
-		Use:           "get-workspace-tags [id]",
-		Short:         "Get all tags for a workspace",
+	cmdDeleteTag := &cobra.Command{
+		Use:           "delete-tag [id] [tagId]",
+		Short:         "Delete a tag, removing it from every todo",
 		SilenceUsage:  true,
 		SilenceErrors: true,
-		Args:          cobra.ExactArgs(1),
+		Args:          cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			c, ctx := getClient()
 
 			if debug {
-				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing GetWorkspaceTags"))
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing DeleteTag"))
 			}
 
 			paramid := workspaceDomain.WorkspaceID(uuid.MustParse(args[0]))
 
-			resp, err := c.GetWorkspaceTagsWithResponse(ctx, paramid)
+			paramtagId := todoDomain.TagID(uuid.MustParse(args[1]))
+
+			params := &client.DeleteTagParams{}
+			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
+				u := uuid.MustParse(val)
+				params.IdempotencyKey = &u
+			}
+
+			resp, err := c.DeleteTagWithResponse(ctx, paramid, paramtagId, params)
 			if err != nil {
 				return err
 			}
@@ -1033,25 +3510,28 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			return nil
 		},
 	}
+	cmdDeleteTag.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
 
-	rootCmd.AddCommand(cmdGetWorkspaceTags)
+	rootCmd.AddCommand(cmdDeleteTag)
 
-	cmdCreateTag := &cobra.Command{
-		Use:           "create-tag [id]",
-		Short:         "Create a new tag",
+	cmdUpdateTag := &cobra.Command{
+		Use:           "update-tag [id] [tagId]",
+		Short:         "Rename or recolor a tag",
 		SilenceUsage:  true,
 		SilenceErrors: true,
-		Args:          cobra.ExactArgs(1),
+		Args:          cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			c, ctx := getClient()
 
 			if debug {
-				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing CreateTag"))
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing UpdateTag"))
 			}
 
 			paramid := workspaceDomain.WorkspaceID(uuid.MustParse(args[0]))
 
-			params := &client.CreateTagParams{}
+			paramtagId := todoDomain.TagID(uuid.MustParse(args[1]))
+
+			params := &client.UpdateTagParams{}
 			if val, _ := cmd.Flags().GetString("idempotency-key"); val != "" {
 				u := uuid.MustParse(val)
 				params.IdempotencyKey = &u
@@ -1063,14 +3543,14 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 				fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("DEBUG: Payload: %s", bodyStr)))
 			}
 
-			var reqBody client.CreateTagJSONRequestBody
+			var reqBody client.UpdateTagJSONRequestBody
 			if bodyStr != "" {
 				if err := json.Unmarshal([]byte(bodyStr), &reqBody); err != nil {
 					return fmt.Errorf("invalid json payload: %w", err)
 				}
 			}
 
-			resp, err := c.CreateTagWithResponse(ctx, paramid, params, reqBody)
+			resp, err := c.UpdateTagWithResponse(ctx, paramid, paramtagId, params, reqBody)
 			if err != nil {
 				return err
 			}
@@ -1098,10 +3578,10 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			return nil
 		},
 	}
-	cmdCreateTag.Flags().StringP("payload", "p", "", "JSON payload for the request body")
-	cmdCreateTag.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
+	cmdUpdateTag.Flags().StringP("payload", "p", "", "JSON payload for the request body")
+	cmdUpdateTag.Flags().String("idempotency-key", "", "Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response. ")
 
-	rootCmd.AddCommand(cmdCreateTag)
+	rootCmd.AddCommand(cmdUpdateTag)
 
 	cmdGetWorkspaceTodos := &cobra.Command{
 		Use:           "get-workspace-todos [id]",
@@ -1125,6 +3605,48 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 			if val, _ := cmd.Flags().GetInt("offset"); val != 0 {
 				params.Offset = &val
 			}
+			if vals, _ := cmd.Flags().GetStringSlice("status"); len(vals) > 0 {
+				items := make([]client.TodoStatus, len(vals))
+				for i, v := range vals {
+					items[i] = client.TodoStatus(v)
+				}
+				params.Status = &items
+			}
+			if vals, _ := cmd.Flags().GetStringSlice("tag-ids"); len(vals) > 0 {
+				items := make([]uuid.UUID, len(vals))
+				for i, v := range vals {
+					items[i] = uuid.MustParse(v)
+				}
+				params.TagIds = &items
+			}
+			if val, _ := cmd.Flags().GetString("due-before"); val != "" {
+				t, err := time.Parse(time.RFC3339, val)
+				if err != nil {
+					return fmt.Errorf("invalid --due-before: %w", err)
+				}
+				params.DueBefore = &t
+			}
+			if val, _ := cmd.Flags().GetString("due-after"); val != "" {
+				t, err := time.Parse(time.RFC3339, val)
+				if err != nil {
+					return fmt.Errorf("invalid --due-after: %w", err)
+				}
+				params.DueAfter = &t
+			}
+			if cmd.Flags().Changed("overdue") {
+				val, _ := cmd.Flags().GetBool("overdue")
+				params.Overdue = &val
+			}
+			if cmd.Flags().Changed("recurring") {
+				val, _ := cmd.Flags().GetBool("recurring")
+				params.Recurring = &val
+			}
+			if val, _ := cmd.Flags().GetString("sort"); val != "" {
+				params.Sort = (*client.GetWorkspaceTodosParamsSort)(&val)
+			}
+			if val, _ := cmd.Flags().GetString("cursor"); val != "" {
+				params.Cursor = &val
+			}
 
 			resp, err := c.GetWorkspaceTodosWithResponse(ctx, paramid, params)
 			if err != nil {
@@ -1156,6 +3678,14 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 	}
 	cmdGetWorkspaceTodos.Flags().Int("limit", 0, "Maximum number of records to return.")
 	cmdGetWorkspaceTodos.Flags().Int("offset", 0, "Number of records to skip.")
+	cmdGetWorkspaceTodos.Flags().StringSlice("status", nil, "Only return todos in any of these statuses.")
+	cmdGetWorkspaceTodos.Flags().StringSlice("tag-ids", nil, "Only return todos having any of these tags.")
+	cmdGetWorkspaceTodos.Flags().String("due-before", "", "Only return todos due strictly before this instant.")
+	cmdGetWorkspaceTodos.Flags().String("due-after", "", "Only return todos due at or after this instant.")
+	cmdGetWorkspaceTodos.Flags().Bool("overdue", false, "Filter on pending todos whose due date has passed.")
+	cmdGetWorkspaceTodos.Flags().Bool("recurring", false, "Filter on todos with a recurrence.")
+	cmdGetWorkspaceTodos.Flags().String("sort", "", "Sort key, prefixed with '-' for descending order. Todos without a due date sort last when ascending.")
+	cmdGetWorkspaceTodos.Flags().String("cursor", "", "Opaque cursor from X-Next-Cursor. Takes precedence over offset and must be used with the same sort.")
 
 	rootCmd.AddCommand(cmdGetWorkspaceTodos)
 
@@ -1226,4 +3756,64 @@ func RegisterGeneratedCommands(rootCmd *cobra.Command, getClient func() (*client
 
 	rootCmd.AddCommand(cmdCreateTodo)
 
+	cmdSearchWorkspaceTodos := &cobra.Command{
+		Use:           "search-workspace-todos [id]",
+		Short:         "Full-text search over the titles of a workspace's todos",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx := getClient()
+
+			if debug {
+				fmt.Fprintln(os.Stderr, styleHeader.Render("--> Executing SearchWorkspaceTodos"))
+			}
+
+			paramid := workspaceDomain.WorkspaceID(uuid.MustParse(args[0]))
+
+			params := &client.SearchWorkspaceTodosParams{}
+			if val, _ := cmd.Flags().GetString("q"); val != "" {
+				params.Q = val
+			}
+			if val, _ := cmd.Flags().GetInt("limit"); val != 0 {
+				params.Limit = &val
+			}
+			if val, _ := cmd.Flags().GetInt("offset"); val != 0 {
+				params.Offset = &val
+			}
+
+			resp, err := c.SearchWorkspaceTodosWithResponse(ctx, paramid, params)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() >= 400 {
+				fmt.Fprintln(os.Stderr, styleError.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			} else {
+				fmt.Fprintln(os.Stderr, styleSuccess.Render(fmt.Sprintf("Status: %d", resp.StatusCode())))
+			}
+
+			if verbose {
+				for k, v := range resp.HTTPResponse.Header {
+					fmt.Fprintln(os.Stderr, styleDebug.Render(fmt.Sprintf("%s: %s", k, strings.Join(v, ", "))))
+				}
+			}
+
+			if len(resp.Body) > 0 {
+				fmt.Printf("%s\n", string(resp.Body))
+			}
+
+			if resp.StatusCode() >= 400 {
+				return fmt.Errorf("request failed with status %d", resp.StatusCode())
+			}
+
+			return nil
+		},
+	}
+	cmdSearchWorkspaceTodos.Flags().String("q", "", "")
+	cmdSearchWorkspaceTodos.Flags().Int("limit", 0, "Maximum number of records to return.")
+	cmdSearchWorkspaceTodos.Flags().Int("offset", 0, "Number of records to skip.")
+
+	rootCmd.AddCommand(cmdSearchWorkspaceTodos)
+
 }
@@ -7,6 +7,7 @@ import (
 	"go/format"
 	"log"
 	"os"
+	"regexp"
 	"sort"
 	"strings"
 	"text/template"
@@ -15,9 +16,13 @@ import (
 	"github.com/stoewer/go-strcase"
 )
 
+// idInitialism matches a standalone "Id" word, leaving e.g. "Idempotency" or "Ids" alone.
+var idInitialism = regexp.MustCompile(`Id([A-Z]|$)`)
+
 type PathParam struct {
 	Name   string
 	GoType string
+	IsDate bool
 }
 
 type ApiParam struct {
@@ -27,7 +32,16 @@ type ApiParam struct {
 	IsString    bool
 	IsInt       bool
 	IsUUID      bool
+	IsTime      bool
+	IsBool      bool
 	Required    bool
+	// EnumType is the generated client type of an inline string enum.
+	EnumType string
+	// DateType is the generated client alias of a shared date parameter.
+	DateType string
+	// ItemType is the generated client element type of an array param.
+	ItemType   string
+	ItemIsUUID bool
 }
 
 type CommandData struct {
@@ -102,9 +116,20 @@ func main() {
 						}
 					}
 
+					isDate := param.Schema.Value.Format == "date"
+					if isDate {
+						// the client aliases openapi_types.Date under the shared parameter's name
+						if paramRef.Ref == "" {
+							log.Fatalf("date path parameter %q in %s must be a components/parameters ref", param.Name, op.OperationID)
+						}
+
+						goType = "client." + paramRef.Ref[strings.LastIndex(paramRef.Ref, "/")+1:]
+					}
+
 					cmd.PathParams = append(cmd.PathParams, PathParam{
 						Name:   param.Name,
 						GoType: goType,
+						IsDate: isDate,
 					})
 					cmd.Use += fmt.Sprintf(" [%s]", param.Name)
 				} else {
@@ -114,9 +139,7 @@ func main() {
 					goName := strcase.UpperCamelCase(param.Name)
 
 					goName = strings.ReplaceAll(goName, "Otp", "OTP")
-					if !strings.HasPrefix(goName, "Idemp") {
-						goName = strings.ReplaceAll(goName, "Id", "ID")
-					}
+					goName = idInitialism.ReplaceAllString(goName, "ID$1")
 
 					apiParam := ApiParam{
 						GoName:      goName,
@@ -129,17 +152,49 @@ func main() {
 					schemaTypeInt := false
 
 					if param.Schema != nil && param.Schema.Value != nil {
-						if param.Schema.Value.Type != nil {
-							schemaTypeString = param.Schema.Value.Type.Includes("string")
-							schemaTypeInt = param.Schema.Value.Type.Includes("integer")
+						schema := param.Schema.Value
+						if schema.Type != nil {
+							schemaTypeString = schema.Type.Includes("string")
+							schemaTypeInt = schema.Type.Includes("integer")
+							apiParam.IsBool = schema.Type.Includes("boolean")
+
+							if schema.Type.Includes("array") && schema.Items != nil {
+								switch {
+								case schema.Items.Ref != "":
+									ref := schema.Items.Ref
+									apiParam.ItemType = "client." + ref[strings.LastIndex(ref, "/")+1:]
+								case schema.Items.Value != nil && schema.Items.Value.Format == "uuid":
+									apiParam.ItemType = "uuid.UUID"
+									apiParam.ItemIsUUID = true
+								default:
+									apiParam.ItemType = "string"
+								}
+							}
 						}
 
-						if param.Schema.Value.Format == "uuid" {
+						switch schema.Format {
+						case "uuid":
 							apiParam.IsUUID = true
+						case "date-time":
+							apiParam.IsTime = true
+						case "date":
+							if paramRef.Ref == "" {
+								log.Fatalf("date query parameter %q in %s must be a components/parameters ref", param.Name, op.OperationID)
+							}
+
+							apiParam.DateType = "client." + paramRef.Ref[strings.LastIndex(paramRef.Ref, "/")+1:]
+						}
+
+						if schemaTypeString && len(schema.Enum) > 0 {
+							if ref := param.Schema.Ref; ref != "" {
+								apiParam.EnumType = "client." + ref[strings.LastIndex(ref, "/")+1:]
+							} else {
+								apiParam.EnumType = "client." + pascalOpID + "Params" + goName
+							}
 						}
 					}
 
-					apiParam.IsString = schemaTypeString && !apiParam.IsUUID
+					apiParam.IsString = schemaTypeString && !apiParam.IsUUID && !apiParam.IsTime && apiParam.DateType == ""
 					apiParam.IsInt = schemaTypeInt
 					cmd.ApiParams = append(cmd.ApiParams, apiParam)
 				}
@@ -69,7 +69,7 @@ func main() {
 			return nil
 		})
 
-		c, err := client.NewClientWithResponses(apiURL, authOption)
+		c, err := client.NewClientWithResponses(apiURL, authOption, client.WithHTTPClient(newStepUpDoer(apiURL)))
 		if err != nil {
 			log.Fatalf("Failed to create client: %v", err)
 		}
@@ -78,6 +78,7 @@ func main() {
 	}
 
 	RegisterGeneratedCommands(rootCmd, getClient)
+	rootCmd.AddCommand(newAuditCmd(getClient))
 
 	if err := rootCmd.Execute(); err != nil {
 		os.Exit(1)
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+
+	"github.com/danicc097/todo-ddd-example/internal/apperrors"
+	"github.com/danicc097/todo-ddd-example/internal/generated/client"
+)
+
+// stepUpDoer retries requests rejected with MFA_REQUIRED once the user enters a TOTP code.
+// It only prompts when stdin is a terminal, so scripts get the 403 as is.
+type stepUpDoer struct {
+	base   client.HttpRequestDoer
+	apiURL string
+}
+
+func newStepUpDoer(apiURL string) *stepUpDoer {
+	return &stepUpDoer{base: http.DefaultClient, apiURL: apiURL}
+}
+
+func (d *stepUpDoer) Do(req *http.Request) (*http.Response, error) {
+	resp, err := d.base.Do(req)
+	if err != nil || resp.StatusCode != http.StatusForbidden || !term.IsTerminal(int(os.Stdin.Fd())) {
+		return resp, err
+	}
+
+	// the request can't be sent again without its body
+	if req.Body != nil && req.GetBody == nil {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	var errResp struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+
+	if json.Unmarshal(body, &errResp) != nil || errResp.Error.Code != string(apperrors.MFARequired) {
+		return resp, nil
+	}
+
+	fmt.Fprintln(os.Stderr, styleHeader.Render(errResp.Error.Message))
+	fmt.Fprint(os.Stderr, "TOTP code: ")
+
+	code, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return resp, nil
+	}
+
+	token, err := d.verify(req.Context(), strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer "), strings.TrimSpace(code))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, styleError.Render("MFA verification failed: "+err.Error()))
+		return resp, nil
+	}
+
+	fmt.Fprintln(os.Stderr, styleSuccess.Render("MFA verified, use the new token for further privileged actions:"))
+	fmt.Fprintf(os.Stderr, "export API_TOKEN=%s\n", token)
+
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+
+	retry.Header.Set("Authorization", "Bearer "+token)
+
+	return d.base.Do(retry)
+}
+
+func (d *stepUpDoer) verify(ctx context.Context, token, code string) (string, error) {
+	c, err := client.NewClientWithResponses(d.apiURL, client.WithHTTPClient(d.base))
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.VerifyTOTPWithResponse(ctx, client.VerifyTOTPJSONRequestBody{Code: code}, func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if resp.JSON200 == nil {
+		return "", fmt.Errorf("status %d: %s", resp.StatusCode(), strings.TrimSpace(string(resp.Body)))
+	}
+
+	return resp.JSON200.AccessToken, nil
+}
@@ -5,7 +5,10 @@ import (
 	"reflect"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/google/uuid"
 	"github.com/spf13/viper"
 )
 
@@ -63,6 +66,22 @@ type AppConfig struct {
 	Env          AppEnv         `mapstructure:"ENV"`
 	Port         string         `mapstructure:"PORT"`
 	MFAMasterKey string         `mapstructure:"MFA_MASTER_KEY"`
+	// FocusMaxDuration is how long a focus session may run before it is stopped automatically.
+	FocusMaxDuration time.Duration `mapstructure:"FOCUS_MAX_DURATION"`
+	// RefreshTokenTTL is how long a session can be renewed without logging in again.
+	RefreshTokenTTL time.Duration `mapstructure:"REFRESH_TOKEN_TTL"`
+	// TokenRevocationCacheTTL is how long an instance may keep accepting an access token revoked through another one.
+	TokenRevocationCacheTTL time.Duration `mapstructure:"TOKEN_REVOCATION_CACHE_TTL"`
+	// JWTSigningKey is the PEM encoded RSA or Ed25519 private key that signs access tokens.
+	JWTSigningKey string `mapstructure:"JWT_SIGNING_KEY"`
+	// JWTPreviousKeys are the PEM encoded keys that signed access tokens before JWTSigningKey.
+	JWTPreviousKeys []string `mapstructure:"JWT_PREVIOUS_KEYS"`
+	// JWTRotatedAt is when JWTSigningKey replaced JWTPreviousKeys, which are accepted until
+	// JWTRotatedAt + JWTRotationOverlap. They are accepted indefinitely when it is unset.
+	JWTRotatedAt       time.Time     `mapstructure:"JWT_ROTATED_AT"`
+	JWTRotationOverlap time.Duration `mapstructure:"JWT_ROTATION_OVERLAP"`
+	// AdminUserIDs are the users allowed to perform administrative actions, such as resetting another user's TOTP.
+	AdminUserIDs []uuid.UUID `mapstructure:"ADMIN_USER_IDS"`
 }
 
 // NewAppConfig initializes the global Config variable.
@@ -91,10 +110,22 @@ func LoadConfig() (*AppConfig, error) {
 
 	v.SetDefault("LOG_LEVEL", "INFO")
 	v.SetDefault("ENV", "development")
+	v.SetDefault("FOCUS_MAX_DURATION", "4h")
+	v.SetDefault("REFRESH_TOKEN_TTL", "720h")
+	v.SetDefault("TOKEN_REVOCATION_CACHE_TTL", "5s")
+	v.SetDefault("JWT_SIGNING_KEY", "private.pem")
+	v.SetDefault("JWT_ROTATION_OVERLAP", "1h")
 
 	cfg := &AppConfig{}
 
-	if err := v.Unmarshal(cfg); err != nil {
+	decodeHook := mapstructure.ComposeDecodeHookFunc(
+		mapstructure.StringToTimeDurationHookFunc(),
+		mapstructure.StringToWeakSliceHookFunc(","),
+		mapstructure.StringToTimeHookFunc(time.RFC3339),
+		mapstructure.TextUnmarshallerHookFunc(),
+	)
+
+	if err := v.Unmarshal(cfg, viper.DecodeHook(decodeHook)); err != nil {
 		return nil, fmt.Errorf("viper unmarshal: %w", err)
 	}
 
@@ -119,8 +150,9 @@ func bindEnvs(v *viper.Viper, iface any, parts ...string) {
 			continue
 		}
 
-		switch vfield.Kind() {
-		case reflect.Struct:
+		switch {
+		// time.Time is a single value, not a nested config
+		case vfield.Kind() == reflect.Struct && vfield.Type() != reflect.TypeFor[time.Time]():
 			bindEnvs(v, vfield.Interface(), append(parts, tv)...)
 		default:
 			v.BindEnv(strings.Join(append(parts, tv), "."))
@@ -2,7 +2,9 @@ package internal
 
 import (
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -21,6 +23,14 @@ func TestNewAppConfig(t *testing.T) {
 		t.Setenv("ENV", "ci")
 		t.Setenv("PORT", "8080")
 		t.Setenv("MFA_MASTER_KEY", "masterkey")
+		t.Setenv("FOCUS_MAX_DURATION", "90m")
+		t.Setenv("REFRESH_TOKEN_TTL", "24h")
+		t.Setenv("TOKEN_REVOCATION_CACHE_TTL", "1s")
+		t.Setenv("JWT_SIGNING_KEY", "keys/current.pem")
+		t.Setenv("JWT_PREVIOUS_KEYS", "keys/old.pem,keys/older.pem")
+		t.Setenv("JWT_ROTATED_AT", "2026-01-02T03:04:05Z")
+		t.Setenv("JWT_ROTATION_OVERLAP", "30m")
+		t.Setenv("ADMIN_USER_IDS", "6f1c1d52-3c8e-4d0e-9a6b-1a2b3c4d5e6f,0b7e9f14-5d2a-4c3b-8e1f-2a3b4c5d6e7f")
 
 		cfg, err := LoadConfig()
 		require.NoError(t, err)
@@ -37,17 +47,44 @@ func TestNewAppConfig(t *testing.T) {
 		assert.Equal(t, AppEnvCI, cfg.Env)
 		assert.Equal(t, "8080", cfg.Port)
 		assert.Equal(t, "masterkey", cfg.MFAMasterKey)
+		assert.Equal(t, 90*time.Minute, cfg.FocusMaxDuration)
+		assert.Equal(t, 24*time.Hour, cfg.RefreshTokenTTL)
+		assert.Equal(t, time.Second, cfg.TokenRevocationCacheTTL)
+		assert.Equal(t, "keys/current.pem", cfg.JWTSigningKey)
+		assert.Equal(t, []string{"keys/old.pem", "keys/older.pem"}, cfg.JWTPreviousKeys)
+		assert.Equal(t, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), cfg.JWTRotatedAt.UTC())
+		assert.Equal(t, 30*time.Minute, cfg.JWTRotationOverlap)
+		assert.Equal(t, []uuid.UUID{
+			uuid.MustParse("6f1c1d52-3c8e-4d0e-9a6b-1a2b3c4d5e6f"),
+			uuid.MustParse("0b7e9f14-5d2a-4c3b-8e1f-2a3b4c5d6e7f"),
+		}, cfg.AdminUserIDs)
 	})
 
 	t.Run("defaults", func(t *testing.T) {
 		t.Setenv("DB_USER", "")
 		t.Setenv("LOG_LEVEL", "")
 		t.Setenv("ENV", "")
+		t.Setenv("FOCUS_MAX_DURATION", "")
+		t.Setenv("REFRESH_TOKEN_TTL", "")
+		t.Setenv("TOKEN_REVOCATION_CACHE_TTL", "")
+		t.Setenv("JWT_SIGNING_KEY", "")
+		t.Setenv("JWT_PREVIOUS_KEYS", "")
+		t.Setenv("JWT_ROTATED_AT", "")
+		t.Setenv("JWT_ROTATION_OVERLAP", "")
+		t.Setenv("ADMIN_USER_IDS", "")
 
 		cfg, err := LoadConfig()
 		require.NoError(t, err)
 
 		assert.Equal(t, "INFO", cfg.LogLevel)
 		assert.Equal(t, AppEnvDev, cfg.Env)
+		assert.Equal(t, 4*time.Hour, cfg.FocusMaxDuration)
+		assert.Equal(t, 720*time.Hour, cfg.RefreshTokenTTL)
+		assert.Equal(t, 5*time.Second, cfg.TokenRevocationCacheTTL)
+		assert.Equal(t, "private.pem", cfg.JWTSigningKey)
+		assert.Empty(t, cfg.JWTPreviousKeys)
+		assert.True(t, cfg.JWTRotatedAt.IsZero())
+		assert.Equal(t, time.Hour, cfg.JWTRotationOverlap)
+		assert.Empty(t, cfg.AdminUserIDs)
 	})
 }
@@ -22,6 +22,48 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for AuditAggregateType.
+const (
+	SCHEDULE  AuditAggregateType = "SCHEDULE"
+	TAG       AuditAggregateType = "TAG"
+	TODO      AuditAggregateType = "TODO"
+	USER      AuditAggregateType = "USER"
+	WORKSPACE AuditAggregateType = "WORKSPACE"
+)
+
+// Defines values for AuditChainBreakReason.
+const (
+	HASHMISMATCH         AuditChainBreakReason = "HASH_MISMATCH"
+	PREVIOUSHASHMISMATCH AuditChainBreakReason = "PREVIOUS_HASH_MISMATCH"
+	UNRECORDEDERASURE    AuditChainBreakReason = "UNRECORDED_ERASURE"
+)
+
+// Defines values for AuditOperation.
+const (
+	CREATE AuditOperation = "CREATE"
+	DELETE AuditOperation = "DELETE"
+	ERASE  AuditOperation = "ERASE"
+	EVENT  AuditOperation = "EVENT"
+	READ   AuditOperation = "READ"
+	UPDATE AuditOperation = "UPDATE"
+	UPSERT AuditOperation = "UPSERT"
+)
+
+// Defines values for DataExportStatus.
+const (
+	DataExportStatusFAILED  DataExportStatus = "FAILED"
+	DataExportStatusPENDING DataExportStatus = "PENDING"
+	DataExportStatusREADY   DataExportStatus = "READY"
+)
+
+// Defines values for FocusReportGroupBy.
+const (
+	FocusReportGroupByDay    FocusReportGroupBy = "day"
+	FocusReportGroupByMember FocusReportGroupBy = "member"
+	FocusReportGroupByTag    FocusReportGroupBy = "tag"
+	FocusReportGroupByTodo   FocusReportGroupBy = "todo"
+)
+
 // Defines values for RecurrenceInterval.
 const (
 	DAILY   RecurrenceInterval = "DAILY"
@@ -31,11 +73,40 @@ const (
 
 // Defines values for TodoStatus.
 const (
-	ARCHIVED  TodoStatus = "ARCHIVED"
-	COMPLETED TodoStatus = "COMPLETED"
-	PENDING   TodoStatus = "PENDING"
+	TodoStatusARCHIVED  TodoStatus = "ARCHIVED"
+	TodoStatusCOMPLETED TodoStatus = "COMPLETED"
+	TodoStatusPENDING   TodoStatus = "PENDING"
+)
+
+// Defines values for GetWorkspaceFocusReportParamsGroupBy.
+const (
+	GetWorkspaceFocusReportParamsGroupByDay    GetWorkspaceFocusReportParamsGroupBy = "day"
+	GetWorkspaceFocusReportParamsGroupByMember GetWorkspaceFocusReportParamsGroupBy = "member"
+	GetWorkspaceFocusReportParamsGroupByTag    GetWorkspaceFocusReportParamsGroupBy = "tag"
+	GetWorkspaceFocusReportParamsGroupByTodo   GetWorkspaceFocusReportParamsGroupBy = "todo"
+)
+
+// Defines values for GetWorkspaceTodosParamsSort.
+const (
+	CreatedAt      GetWorkspaceTodosParamsSort = "createdAt"
+	DueDate        GetWorkspaceTodosParamsSort = "dueDate"
+	MinusCreatedAt GetWorkspaceTodosParamsSort = "-createdAt"
+	MinusDueDate   GetWorkspaceTodosParamsSort = "-dueDate"
+	MinusTitle     GetWorkspaceTodosParamsSort = "-title"
+	Title          GetWorkspaceTodosParamsSort = "title"
 )
 
+// AddChecklistItemRequest defines model for AddChecklistItemRequest.
+type AddChecklistItemRequest struct {
+	Required *bool  `json:"required,omitempty"`
+	Title    string `json:"title"`
+}
+
+// AddTodoBlockerRequest defines model for AddTodoBlockerRequest.
+type AddTodoBlockerRequest struct {
+	BlockerId todoDomain.TodoID `json:"blockerId"`
+}
+
 // AddWorkspaceMemberRequest defines model for AddWorkspaceMemberRequest.
 type AddWorkspaceMemberRequest struct {
 	Role   WorkspaceRole      `json:"role"`
@@ -47,11 +118,108 @@ type AssignTagToTodoRequest struct {
 	TagId todoDomain.TagID `json:"tagId"`
 }
 
+// AssignTodoRequest defines model for AssignTodoRequest.
+type AssignTodoRequest struct {
+	AssigneeId userDomain.UserID `json:"assigneeId"`
+}
+
+// AuditAggregateType defines model for AuditAggregateType.
+type AuditAggregateType string
+
+// AuditChainBreak defines model for AuditChainBreak.
+type AuditChainBreak struct {
+	// AggregateId Aggregate of the broken chain. Set for global chains only.
+	AggregateId *openapi_types.UUID `json:"aggregateId,omitempty"`
+
+	// AggregateType Aggregate of the broken chain. Set for global chains only.
+	AggregateType *AuditAggregateType `json:"aggregateType,omitempty"`
+	LogId         openapi_types.UUID  `json:"logId"`
+
+	// Position 1-based position of the entry in the chain.
+	Position int `json:"position"`
+
+	// Reason PREVIOUS_HASH_MISMATCH means an earlier entry was removed, inserted or reordered. HASH_MISMATCH means this entry was edited. UNRECORDED_ERASURE means this entry looks erased but no later ERASE entry of the chain records its current content, so it may have been edited.
+	Reason AuditChainBreakReason `json:"reason"`
+}
+
+// AuditChainBreakReason PREVIOUS_HASH_MISMATCH means an earlier entry was removed, inserted or reordered. HASH_MISMATCH means this entry was edited. UNRECORDED_ERASURE means this entry looks erased but no later ERASE entry of the chain records its current content, so it may have been edited.
+type AuditChainBreakReason string
+
+// AuditChainVerification defines model for AuditChainVerification.
+type AuditChainVerification struct {
+	CheckedEntries  int              `json:"checkedEntries"`
+	FirstBrokenLink *AuditChainBreak `json:"firstBrokenLink"`
+	Valid           bool             `json:"valid"`
+}
+
+// AuditFieldChange Sensitive values are replaced by "[REDACTED]".
+type AuditFieldChange struct {
+	// After Unset for deleted entities.
+	After interface{} `json:"after"`
+
+	// Before Unset for created entities.
+	Before interface{} `json:"before"`
+	Field  string      `json:"field"`
+}
+
+// AuditLog defines model for AuditLog.
+type AuditLog struct {
+	// ActorId Unset for changes made by the system.
+	ActorId       *openapi_types.UUID `json:"actorId"`
+	AggregateId   openapi_types.UUID  `json:"aggregateId"`
+	AggregateType AuditAggregateType  `json:"aggregateType"`
+	CausationId   string              `json:"causationId"`
+
+	// Changes Fields that differ, ordered by name.
+	Changes       []AuditFieldChange `json:"changes"`
+	CorrelationId string             `json:"correlationId"`
+	Id            openapi_types.UUID `json:"id"`
+	OccurredAt    time.Time          `json:"occurredAt"`
+	Operation     AuditOperation     `json:"operation"`
+}
+
+// AuditOperation defines model for AuditOperation.
+type AuditOperation string
+
+// AutoPlanResult defines model for AutoPlanResult.
+type AutoPlanResult struct {
+	Planned []todoDomain.TodoID `json:"planned"`
+}
+
+// ChecklistItem defines model for ChecklistItem.
+type ChecklistItem struct {
+	Done     bool                       `json:"done"`
+	Id       todoDomain.ChecklistItemID `json:"id"`
+	Position int                        `json:"position"`
+
+	// Required Required items must be done before the todo can be completed.
+	Required bool   `json:"required"`
+	Title    string `json:"title"`
+}
+
+// Comment defines model for Comment.
+type Comment struct {
+	AuthorId  userDomain.UserID    `json:"authorId"`
+	Body      string               `json:"body"`
+	CreatedAt time.Time            `json:"createdAt"`
+	EditedAt  *time.Time           `json:"editedAt"`
+	Id        todoDomain.CommentID `json:"id"`
+	Mentions  []userDomain.UserID  `json:"mentions"`
+	TodoId    todoDomain.TodoID    `json:"todoId"`
+}
+
+// CommentRequest defines model for CommentRequest.
+type CommentRequest struct {
+	Body string `json:"body"`
+}
+
 // CommitTaskRequest defines model for CommitTaskRequest.
 type CommitTaskRequest struct {
-	Cost   int                `json:"cost"`
-	Date   openapi_types.Date `json:"date"`
-	TodoId openapi_types.UUID `json:"todoId"`
+	Cost int `json:"cost"`
+
+	// Date Calendar day to commit to. Defaults to today in the user's timezone.
+	Date   *openapi_types.Date `json:"date,omitempty"`
+	TodoId openapi_types.UUID  `json:"todoId"`
 }
 
 // CompletionLog defines model for CompletionLog.
@@ -62,7 +230,8 @@ type CompletionLog struct {
 
 // CreateTagRequest defines model for CreateTagRequest.
 type CreateTagRequest struct {
-	Name string `json:"name"`
+	Color *string `json:"color,omitempty"`
+	Name  string  `json:"name"`
 }
 
 // CreateTodoRequest defines model for CreateTodoRequest.
@@ -70,14 +239,72 @@ type CreateTodoRequest struct {
 	DueDate            *time.Time          `json:"dueDate"`
 	RecurrenceAmount   *int                `json:"recurrenceAmount"`
 	RecurrenceInterval *RecurrenceInterval `json:"recurrenceInterval"`
-	Title              string              `json:"title"`
+
+	// RecurrenceRule RFC 5545 RRULE subset (FREQ, INTERVAL, BYDAY, BYMONTHDAY, BYSETPOS, COUNT, UNTIL) plus a TZID extension, e.g. FREQ=MONTHLY;BYDAY=TU;BYSETPOS=2;TZID=Europe/Madrid. Mutually exclusive with recurrenceInterval and recurrenceAmount.
+	RecurrenceRule *RecurrenceRule `json:"recurrenceRule"`
+	Title          string          `json:"title"`
+}
+
+// DataExport defines model for DataExport.
+type DataExport struct {
+	CompletedAt *time.Time              `json:"completedAt,omitempty"`
+	Id          userDomain.DataExportID `json:"id"`
+	RequestedAt time.Time               `json:"requestedAt"`
+	Status      DataExportStatus        `json:"status"`
+}
+
+// DataExportStatus defines model for DataExportStatus.
+type DataExportStatus string
+
+// DisableTOTPRequestBody defines model for DisableTOTPRequestBody.
+type DisableTOTPRequestBody struct {
+	Code     string                 `json:"code"`
+	Password secrecy.Secret[string] `json:"password"`
+}
+
+// FocusReport defines model for FocusReport.
+type FocusReport struct {
+	AverageSessionSeconds int64 `json:"averageSessionSeconds"`
+
+	// CurrentStreakDays Consecutive days with focus up to the last day of the range, or the day before it.
+	CurrentStreakDays int                `json:"currentStreakDays"`
+	GroupBy           FocusReportGroupBy `json:"groupBy"`
+	Groups            []FocusReportGroup `json:"groups"`
+	LongestStreakDays int                `json:"longestStreakDays"`
+	SessionCount      int64              `json:"sessionCount"`
+	TotalSeconds      int64              `json:"totalSeconds"`
+}
+
+// FocusReportGroupBy defines model for FocusReport.GroupBy.
+type FocusReportGroupBy string
+
+// FocusReportGroup defines model for FocusReportGroup.
+type FocusReportGroup struct {
+	AverageSessionSeconds int64 `json:"averageSessionSeconds"`
+
+	// Key Todo, tag or member ID, or a YYYY-MM-DD day.
+	Key string `json:"key"`
+
+	// Label Todo title, tag name, member name or the day.
+	Label        string `json:"label"`
+	SessionCount int64  `json:"sessionCount"`
+	TotalSeconds int64  `json:"totalSeconds"`
 }
 
 // FocusSession defines model for FocusSession.
 type FocusSession struct {
-	EndTime   *time.Time         `json:"endTime"`
-	Id        openapi_types.UUID `json:"id"`
-	StartTime time.Time          `json:"startTime"`
+	EndTime                *time.Time         `json:"endTime"`
+	Id                     openapi_types.UUID `json:"id"`
+	PlannedDurationSeconds *int               `json:"plannedDurationSeconds"`
+	StartTime              time.Time          `json:"startTime"`
+}
+
+// GlobalAuditChainVerification defines model for GlobalAuditChainVerification.
+type GlobalAuditChainVerification struct {
+	CheckedChains   int              `json:"checkedChains"`
+	CheckedEntries  int              `json:"checkedEntries"`
+	FirstBrokenLink *AuditChainBreak `json:"firstBrokenLink"`
+	Valid           bool             `json:"valid"`
 }
 
 // HTTPValidationError defines model for HTTPValidationError.
@@ -104,7 +331,13 @@ type LoginRequestBody struct {
 
 // LoginResponseBody defines model for LoginResponseBody.
 type LoginResponseBody struct {
-	AccessToken string `json:"accessToken"`
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+// LogoutRequestBody defines model for LogoutRequestBody.
+type LogoutRequestBody struct {
+	RefreshToken *secrecy.Secret[string] `json:"refreshToken,omitempty"`
 }
 
 // OnboardWorkspaceRequest defines model for OnboardWorkspaceRequest.
@@ -119,9 +352,32 @@ type OnboardWorkspaceRequest struct {
 	Name string `json:"name"`
 }
 
+// ReauthenticateRequestBody defines model for ReauthenticateRequestBody.
+type ReauthenticateRequestBody struct {
+	Password secrecy.Secret[string] `json:"password"`
+}
+
+// ReauthenticateResponseBody defines model for ReauthenticateResponseBody.
+type ReauthenticateResponseBody struct {
+	AccessToken string `json:"accessToken"`
+}
+
+// RecoverTOTPRequestBody defines model for RecoverTOTPRequestBody.
+type RecoverTOTPRequestBody struct {
+	Code string `json:"code"`
+}
+
 // RecurrenceInterval defines model for RecurrenceInterval.
 type RecurrenceInterval string
 
+// RecurrenceRule RFC 5545 RRULE subset (FREQ, INTERVAL, BYDAY, BYMONTHDAY, BYSETPOS, COUNT, UNTIL) plus a TZID extension, e.g. FREQ=MONTHLY;BYDAY=TU;BYSETPOS=2;TZID=Europe/Madrid. Mutually exclusive with recurrenceInterval and recurrenceAmount.
+type RecurrenceRule = string
+
+// RefreshSessionRequestBody defines model for RefreshSessionRequestBody.
+type RefreshSessionRequestBody struct {
+	RefreshToken secrecy.Secret[string] `json:"refreshToken"`
+}
+
 // RegisterUserRequestBody defines model for RegisterUserRequestBody.
 type RegisterUserRequestBody struct {
 	Email    openapi_types.Email    `json:"email"`
@@ -129,34 +385,134 @@ type RegisterUserRequestBody struct {
 	Password secrecy.Secret[string] `json:"password"`
 }
 
+// ReorderChecklistRequest defines model for ReorderChecklistRequest.
+type ReorderChecklistRequest struct {
+	// ItemIds Every checklist item ID of the todo, in the new order.
+	ItemIds []todoDomain.ChecklistItemID `json:"itemIds"`
+}
+
+// Schedule defines model for Schedule.
+type Schedule struct {
+	// Completed Energy of committed tasks that are done.
+	Completed int                `json:"completed"`
+	Date      openapi_types.Date `json:"date"`
+
+	// Load Total energy committed to the day.
+	Load        int `json:"load"`
+	MaxCapacity int `json:"maxCapacity"`
+
+	// Remaining Energy of committed tasks still pending.
+	Remaining int             `json:"remaining"`
+	Tasks     []ScheduledTask `json:"tasks"`
+}
+
+// ScheduledTask defines model for ScheduledTask.
+type ScheduledTask struct {
+	CompletedAt *time.Time        `json:"completedAt"`
+	Cost        int               `json:"cost"`
+	TodoId      todoDomain.TodoID `json:"todoId"`
+}
+
+// SetTodoDueDateRequest defines model for SetTodoDueDateRequest.
+type SetTodoDueDateRequest struct {
+	DueDate *time.Time `json:"dueDate"`
+}
+
+// SetTodoRecurrenceRequest defines model for SetTodoRecurrenceRequest.
+type SetTodoRecurrenceRequest struct {
+	RecurrenceAmount   *int                `json:"recurrenceAmount"`
+	RecurrenceInterval *RecurrenceInterval `json:"recurrenceInterval"`
+
+	// RecurrenceRule RFC 5545 RRULE subset (FREQ, INTERVAL, BYDAY, BYMONTHDAY, BYSETPOS, COUNT, UNTIL) plus a TZID extension, e.g. FREQ=MONTHLY;BYDAY=TU;BYSETPOS=2;TZID=Europe/Madrid. Mutually exclusive with recurrenceInterval and recurrenceAmount.
+	RecurrenceRule *RecurrenceRule `json:"recurrenceRule"`
+}
+
+// SetUserDailyCapacityRequest defines model for SetUserDailyCapacityRequest.
+type SetUserDailyCapacityRequest struct {
+	Capacity int `json:"capacity"`
+}
+
+// SetUserTimezoneRequest defines model for SetUserTimezoneRequest.
+type SetUserTimezoneRequest struct {
+	Timezone string `json:"timezone"`
+}
+
+// StartFocusRequest defines model for StartFocusRequest.
+type StartFocusRequest struct {
+	// PlannedDurationSeconds Pomodoro length. The session runs until stopped when omitted.
+	PlannedDurationSeconds *int `json:"plannedDurationSeconds,omitempty"`
+}
+
 // Tag defines model for Tag.
 type Tag struct {
-	Id   todoDomain.TagID `json:"id"`
-	Name string           `json:"name"`
+	Color string           `json:"color"`
+	Id    todoDomain.TagID `json:"id"`
+	Name  string           `json:"name"`
 }
 
 // Todo defines model for Todo.
 type Todo struct {
-	CompletionLogs     *[]CompletionLog            `json:"completionLogs,omitempty"`
-	CreatedAt          time.Time                   `json:"createdAt"`
-	DueDate            *time.Time                  `json:"dueDate"`
-	FocusSessions      *[]FocusSession             `json:"focusSessions,omitempty"`
-	Id                 todoDomain.TodoID           `json:"id"`
-	RecurrenceAmount   *int                        `json:"recurrenceAmount"`
-	RecurrenceInterval *RecurrenceInterval         `json:"recurrenceInterval"`
-	Status             TodoStatus                  `json:"status"`
-	Title              string                      `json:"title"`
-	WorkspaceId        workspaceDomain.WorkspaceID `json:"workspaceId"`
+	AssigneeId *userDomain.UserID `json:"assigneeId"`
+
+	// BlockedBy Todos that must be completed before this one can be started or completed.
+	BlockedBy          *[]todoDomain.TodoID `json:"blockedBy,omitempty"`
+	ChecklistItems     *[]ChecklistItem     `json:"checklistItems,omitempty"`
+	CompletionLogs     *[]CompletionLog     `json:"completionLogs,omitempty"`
+	CreatedAt          time.Time            `json:"createdAt"`
+	DueDate            *time.Time           `json:"dueDate"`
+	FocusSessions      *[]FocusSession      `json:"focusSessions,omitempty"`
+	Id                 todoDomain.TodoID    `json:"id"`
+	LastCompletedAt    *time.Time           `json:"lastCompletedAt"`
+	RecurrenceAmount   *int                 `json:"recurrenceAmount"`
+	RecurrenceInterval *RecurrenceInterval  `json:"recurrenceInterval"`
+
+	// RecurrenceRule RFC 5545 RRULE subset (FREQ, INTERVAL, BYDAY, BYMONTHDAY, BYSETPOS, COUNT, UNTIL) plus a TZID extension, e.g. FREQ=MONTHLY;BYDAY=TU;BYSETPOS=2;TZID=Europe/Madrid. Mutually exclusive with recurrenceInterval and recurrenceAmount.
+	RecurrenceRule *RecurrenceRule             `json:"recurrenceRule"`
+	Status         TodoStatus                  `json:"status"`
+	Title          string                      `json:"title"`
+	WorkspaceId    workspaceDomain.WorkspaceID `json:"workspaceId"`
+}
+
+// TodoSearchResult defines model for TodoSearchResult.
+type TodoSearchResult struct {
+	CreatedAt time.Time  `json:"createdAt"`
+	DueDate   *time.Time `json:"dueDate"`
+
+	// Highlight HTML-escaped title with matched terms wrapped in <mark> tags.
+	Highlight   string                      `json:"highlight"`
+	Id          todoDomain.TodoID           `json:"id"`
+	Rank        float32                     `json:"rank"`
+	Status      TodoStatus                  `json:"status"`
+	Title       string                      `json:"title"`
+	WorkspaceId workspaceDomain.WorkspaceID `json:"workspaceId"`
 }
 
 // TodoStatus defines model for TodoStatus.
 type TodoStatus string
 
+// UpdateScheduleCapacityRequest defines model for UpdateScheduleCapacityRequest.
+type UpdateScheduleCapacityRequest struct {
+	Capacity int `json:"capacity"`
+}
+
+// UpdateTagRequest defines model for UpdateTagRequest.
+type UpdateTagRequest struct {
+	Color *string `json:"color,omitempty"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// UpdateTodoRequest defines model for UpdateTodoRequest.
+type UpdateTodoRequest struct {
+	Title *string `json:"title,omitempty"`
+}
+
 // User defines model for User.
 type User struct {
-	Email string            `json:"email"`
-	Id    userDomain.UserID `json:"id"`
-	Name  string            `json:"name"`
+	DailyCapacity int               `json:"dailyCapacity"`
+	Email         string            `json:"email"`
+	Id            userDomain.UserID `json:"id"`
+	Name          string            `json:"name"`
+	Timezone      string            `json:"timezone"`
 }
 
 // ValidationError defines model for ValidationError.
@@ -180,6 +536,13 @@ type VerifyTOTPRequestBody struct {
 	Code string `json:"code"`
 }
 
+// VerifyTOTPResponseBody defines model for VerifyTOTPResponseBody.
+type VerifyTOTPResponseBody struct {
+	AccessToken   string    `json:"accessToken"`
+	RecoveryCodes *[]string `json:"recoveryCodes,omitempty"`
+	RefreshToken  string    `json:"refreshToken"`
+}
+
 // Workspace defines model for Workspace.
 type Workspace struct {
 	Description string                      `json:"description"`
@@ -199,6 +562,15 @@ type Limit = int
 // Offset defines model for Offset.
 type Offset = int
 
+// ReportFrom defines model for ReportFrom.
+type ReportFrom = openapi_types.Date
+
+// ReportTo defines model for ReportTo.
+type ReportTo = openapi_types.Date
+
+// ScheduleDate defines model for ScheduleDate.
+type ScheduleDate = openapi_types.Date
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error *struct {
@@ -215,18 +587,126 @@ type RegisterParams struct {
 	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
+// UpdateScheduleCapacityParams defines parameters for UpdateScheduleCapacity.
+type UpdateScheduleCapacityParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// AutoPlanScheduleParams defines parameters for AutoPlanSchedule.
+type AutoPlanScheduleParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// RemoveScheduledTaskParams defines parameters for RemoveScheduledTask.
+type RemoveScheduledTaskParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// UpdateTodoParams defines parameters for UpdateTodo.
+type UpdateTodoParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// ArchiveTodoParams defines parameters for ArchiveTodo.
+type ArchiveTodoParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// AddChecklistItemParams defines parameters for AddChecklistItem.
+type AddChecklistItemParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// ToggleChecklistItemParams defines parameters for ToggleChecklistItem.
+type ToggleChecklistItemParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetTodoCommentsParams defines parameters for GetTodoComments.
+type GetTodoCommentsParams struct {
+	// Limit Maximum number of records to return.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of records to skip.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
 // CompleteTodoParams defines parameters for CompleteTodo.
 type CompleteTodoParams struct {
 	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
 	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
+// SetTodoDueDateParams defines parameters for SetTodoDueDate.
+type SetTodoDueDateParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// SetTodoRecurrenceParams defines parameters for SetTodoRecurrence.
+type SetTodoRecurrenceParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// ReopenTodoParams defines parameters for ReopenTodo.
+type ReopenTodoParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // AssignTagToTodoParams defines parameters for AssignTagToTodo.
 type AssignTagToTodoParams struct {
 	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
 	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
+// RemoveTagFromTodoParams defines parameters for RemoveTagFromTodo.
+type RemoveTagFromTodoParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// UnarchiveTodoParams defines parameters for UnarchiveTodo.
+type UnarchiveTodoParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetUserAssignedTodosParams defines parameters for GetUserAssignedTodos.
+type GetUserAssignedTodosParams struct {
+	// Limit Maximum number of records to return.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of records to skip.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// SetUserDailyCapacityParams defines parameters for SetUserDailyCapacity.
+type SetUserDailyCapacityParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// RequestUserDataExportParams defines parameters for RequestUserDataExport.
+type RequestUserDataExportParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// SetUserTimezoneParams defines parameters for SetUserTimezone.
+type SetUserTimezoneParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // GetUserWorkspacesParams defines parameters for GetUserWorkspaces.
 type GetUserWorkspacesParams struct {
 	// Limit Maximum number of records to return.
@@ -251,18 +731,68 @@ type OnboardWorkspaceParams struct {
 	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
+// GetWorkspaceAuditLogsParams defines parameters for GetWorkspaceAuditLogs.
+type GetWorkspaceAuditLogsParams struct {
+	AggregateType *AuditAggregateType `form:"aggregateType,omitempty" json:"aggregateType,omitempty"`
+	AggregateID   *openapi_types.UUID `form:"aggregateId,omitempty" json:"aggregateId,omitempty"`
+
+	// ActorID Only include changes made by this user.
+	ActorID   *openapi_types.UUID `form:"actorId,omitempty" json:"actorId,omitempty"`
+	Operation *AuditOperation     `form:"operation,omitempty" json:"operation,omitempty"`
+
+	// From Earliest change time, inclusive.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Latest change time, exclusive.
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit Maximum number of records to return.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of records to skip.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
 // AddWorkspaceMemberParams defines parameters for AddWorkspaceMember.
 type AddWorkspaceMemberParams struct {
 	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
 	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
+// GetWorkspaceFocusReportParams defines parameters for GetWorkspaceFocusReport.
+type GetWorkspaceFocusReportParams struct {
+	// From First day of the report, inclusive.
+	From ReportFrom `form:"from" json:"from"`
+
+	// To Last day of the report, inclusive. At most 366 days after from.
+	To      ReportTo                              `form:"to" json:"to"`
+	GroupBy *GetWorkspaceFocusReportParamsGroupBy `form:"groupBy,omitempty" json:"groupBy,omitempty"`
+
+	// UserID Only include sessions by this member.
+	UserID *openapi_types.UUID `form:"userId,omitempty" json:"userId,omitempty"`
+}
+
+// GetWorkspaceFocusReportParamsGroupBy defines parameters for GetWorkspaceFocusReport.
+type GetWorkspaceFocusReportParamsGroupBy string
+
 // CreateTagParams defines parameters for CreateTag.
 type CreateTagParams struct {
 	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
 	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
+// DeleteTagParams defines parameters for DeleteTag.
+type DeleteTagParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// UpdateTagParams defines parameters for UpdateTag.
+type UpdateTagParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // GetWorkspaceTodosParams defines parameters for GetWorkspaceTodos.
 type GetWorkspaceTodosParams struct {
 	// Limit Maximum number of records to return.
@@ -270,29 +800,121 @@ type GetWorkspaceTodosParams struct {
 
 	// Offset Number of records to skip.
 	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Status Only return todos in any of these statuses.
+	Status *[]TodoStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// TagIds Only return todos having any of these tags.
+	TagIds *[]openapi_types.UUID `form:"tagIds,omitempty" json:"tagIds,omitempty"`
+
+	// DueBefore Only return todos due strictly before this instant.
+	DueBefore *time.Time `form:"dueBefore,omitempty" json:"dueBefore,omitempty"`
+
+	// DueAfter Only return todos due at or after this instant.
+	DueAfter *time.Time `form:"dueAfter,omitempty" json:"dueAfter,omitempty"`
+
+	// Overdue Filter on pending todos whose due date has passed.
+	Overdue *bool `form:"overdue,omitempty" json:"overdue,omitempty"`
+
+	// Recurring Filter on todos with a recurrence.
+	Recurring *bool `form:"recurring,omitempty" json:"recurring,omitempty"`
+
+	// Sort Sort key, prefixed with '-' for descending order. Todos without a due date sort last when ascending.
+	Sort *GetWorkspaceTodosParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Cursor Opaque cursor from X-Next-Cursor. Takes precedence over offset and must be used with the same sort.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
+// GetWorkspaceTodosParamsSort defines parameters for GetWorkspaceTodos.
+type GetWorkspaceTodosParamsSort string
+
 // CreateTodoParams defines parameters for CreateTodo.
 type CreateTodoParams struct {
 	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
 	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
+// SearchWorkspaceTodosParams defines parameters for SearchWorkspaceTodos.
+type SearchWorkspaceTodosParams struct {
+	Q string `form:"q" json:"q"`
+
+	// Limit Maximum number of records to return.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of records to skip.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
 type LoginJSONRequestBody = LoginRequestBody
 
+// LogoutJSONRequestBody defines body for Logout for application/json ContentType.
+type LogoutJSONRequestBody = LogoutRequestBody
+
+// ReauthenticateJSONRequestBody defines body for Reauthenticate for application/json ContentType.
+type ReauthenticateJSONRequestBody = ReauthenticateRequestBody
+
+// RefreshSessionJSONRequestBody defines body for RefreshSession for application/json ContentType.
+type RefreshSessionJSONRequestBody = RefreshSessionRequestBody
+
 // RegisterJSONRequestBody defines body for Register for application/json ContentType.
 type RegisterJSONRequestBody = RegisterUserRequestBody
 
+// DisableTOTPJSONRequestBody defines body for DisableTOTP for application/json ContentType.
+type DisableTOTPJSONRequestBody = DisableTOTPRequestBody
+
+// RecoverTOTPJSONRequestBody defines body for RecoverTOTP for application/json ContentType.
+type RecoverTOTPJSONRequestBody = RecoverTOTPRequestBody
+
 // VerifyTOTPJSONRequestBody defines body for VerifyTOTP for application/json ContentType.
 type VerifyTOTPJSONRequestBody = VerifyTOTPRequestBody
 
 // CommitTaskJSONRequestBody defines body for CommitTask for application/json ContentType.
 type CommitTaskJSONRequestBody = CommitTaskRequest
 
+// UpdateScheduleCapacityJSONRequestBody defines body for UpdateScheduleCapacity for application/json ContentType.
+type UpdateScheduleCapacityJSONRequestBody = UpdateScheduleCapacityRequest
+
+// UpdateTodoJSONRequestBody defines body for UpdateTodo for application/json ContentType.
+type UpdateTodoJSONRequestBody = UpdateTodoRequest
+
+// AssignTodoJSONRequestBody defines body for AssignTodo for application/json ContentType.
+type AssignTodoJSONRequestBody = AssignTodoRequest
+
+// AddTodoBlockerJSONRequestBody defines body for AddTodoBlocker for application/json ContentType.
+type AddTodoBlockerJSONRequestBody = AddTodoBlockerRequest
+
+// AddChecklistItemJSONRequestBody defines body for AddChecklistItem for application/json ContentType.
+type AddChecklistItemJSONRequestBody = AddChecklistItemRequest
+
+// ReorderChecklistJSONRequestBody defines body for ReorderChecklist for application/json ContentType.
+type ReorderChecklistJSONRequestBody = ReorderChecklistRequest
+
+// CreateTodoCommentJSONRequestBody defines body for CreateTodoComment for application/json ContentType.
+type CreateTodoCommentJSONRequestBody = CommentRequest
+
+// UpdateTodoCommentJSONRequestBody defines body for UpdateTodoComment for application/json ContentType.
+type UpdateTodoCommentJSONRequestBody = CommentRequest
+
+// SetTodoDueDateJSONRequestBody defines body for SetTodoDueDate for application/json ContentType.
+type SetTodoDueDateJSONRequestBody = SetTodoDueDateRequest
+
+// StartFocusJSONRequestBody defines body for StartFocus for application/json ContentType.
+type StartFocusJSONRequestBody = StartFocusRequest
+
+// SetTodoRecurrenceJSONRequestBody defines body for SetTodoRecurrence for application/json ContentType.
+type SetTodoRecurrenceJSONRequestBody = SetTodoRecurrenceRequest
+
 // AssignTagToTodoJSONRequestBody defines body for AssignTagToTodo for application/json ContentType.
 type AssignTagToTodoJSONRequestBody = AssignTagToTodoRequest
 
+// SetUserDailyCapacityJSONRequestBody defines body for SetUserDailyCapacity for application/json ContentType.
+type SetUserDailyCapacityJSONRequestBody = SetUserDailyCapacityRequest
+
+// SetUserTimezoneJSONRequestBody defines body for SetUserTimezone for application/json ContentType.
+type SetUserTimezoneJSONRequestBody = SetUserTimezoneRequest
+
 // OnboardWorkspaceJSONRequestBody defines body for OnboardWorkspace for application/json ContentType.
 type OnboardWorkspaceJSONRequestBody = OnboardWorkspaceRequest
 
@@ -302,20 +924,44 @@ type AddWorkspaceMemberJSONRequestBody = AddWorkspaceMemberRequest
 // CreateTagJSONRequestBody defines body for CreateTag for application/json ContentType.
 type CreateTagJSONRequestBody = CreateTagRequest
 
+// UpdateTagJSONRequestBody defines body for UpdateTag for application/json ContentType.
+type UpdateTagJSONRequestBody = UpdateTagRequest
+
 // CreateTodoJSONRequestBody defines body for CreateTodo for application/json ContentType.
 type CreateTodoJSONRequestBody = CreateTodoRequest
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Verify the global audit trail has not been tampered with
+	// (GET /audit/verify)
+	VerifyGlobalAuditChains(c *gin.Context)
 	// Login with email and password
 	// (POST /auth/login)
 	Login(c *gin.Context)
+	// Log out of the current session
+	// (POST /auth/logout)
+	Logout(c *gin.Context)
+	// Log out of every session of the current user
+	// (POST /auth/logout-all)
+	LogoutAll(c *gin.Context)
+	// Step up with the password, for users without TOTP
+	// (POST /auth/reauthenticate)
+	Reauthenticate(c *gin.Context)
+	// Exchange a refresh token for a new access and refresh token
+	// (POST /auth/refresh)
+	RefreshSession(c *gin.Context)
 	// Register a new user with password
 	// (POST /auth/register)
 	Register(c *gin.Context, params RegisterParams)
+	// Disable TOTP for the user
+	// (POST /auth/totp/disable)
+	DisableTOTP(c *gin.Context)
 	// Initiate TOTP setup for the user
 	// (POST /auth/totp/initiate)
 	InitiateTOTP(c *gin.Context)
+	// Complete MFA with a recovery code
+	// (POST /auth/totp/recover)
+	RecoverTOTP(c *gin.Context)
 	// Verify and activate TOTP
 	// (POST /auth/totp/verify)
 	VerifyTOTP(c *gin.Context)
@@ -325,24 +971,117 @@ type ServerInterface interface {
 	// Commit a task to daily schedule
 	// (POST /schedule/commit)
 	CommitTask(c *gin.Context)
+	// Get the caller's schedule for a day
+	// (GET /schedule/{date})
+	GetSchedule(c *gin.Context, date ScheduleDate)
+	// Override the capacity of a single day
+	// (PATCH /schedule/{date})
+	UpdateScheduleCapacity(c *gin.Context, date ScheduleDate, params UpdateScheduleCapacityParams)
+	// Fill a day with the caller's open assignments and due todos
+	// (POST /schedule/{date}/auto-plan)
+	AutoPlanSchedule(c *gin.Context, date ScheduleDate, params AutoPlanScheduleParams)
+	// Uncommit a task from a day
+	// (DELETE /schedule/{date}/tasks/{todoId})
+	RemoveScheduledTask(c *gin.Context, date ScheduleDate, todoId todoDomain.TodoID, params RemoveScheduledTaskParams)
 	// Get a todo by ID
 	// (GET /todos/{id})
 	GetTodoByID(c *gin.Context, id todoDomain.TodoID)
+	// Update a todo
+	// (PATCH /todos/{id})
+	UpdateTodo(c *gin.Context, id todoDomain.TodoID, params UpdateTodoParams)
+	// Archive a todo
+	// (POST /todos/{id}/archive)
+	ArchiveTodo(c *gin.Context, id todoDomain.TodoID, params ArchiveTodoParams)
+	// Unassign a todo
+	// (DELETE /todos/{id}/assignee)
+	UnassignTodo(c *gin.Context, id todoDomain.TodoID)
+	// Assign a todo to a workspace member
+	// (PUT /todos/{id}/assignee)
+	AssignTodo(c *gin.Context, id todoDomain.TodoID)
+	// Block a todo until another todo in the same workspace is completed
+	// (POST /todos/{id}/blockers)
+	AddTodoBlocker(c *gin.Context, id todoDomain.TodoID)
+	// Remove a blocker from a todo
+	// (DELETE /todos/{id}/blockers/{blockerId})
+	RemoveTodoBlocker(c *gin.Context, id todoDomain.TodoID, blockerId todoDomain.TodoID)
+	// Add a checklist item to a todo
+	// (POST /todos/{id}/checklist)
+	AddChecklistItem(c *gin.Context, id todoDomain.TodoID, params AddChecklistItemParams)
+	// Reorder the checklist of a todo
+	// (PUT /todos/{id}/checklist/order)
+	ReorderChecklist(c *gin.Context, id todoDomain.TodoID)
+	// Remove a checklist item
+	// (DELETE /todos/{id}/checklist/{itemId})
+	RemoveChecklistItem(c *gin.Context, id todoDomain.TodoID, itemId todoDomain.ChecklistItemID)
+	// Toggle the done state of a checklist item
+	// (POST /todos/{id}/checklist/{itemId}/toggle)
+	ToggleChecklistItem(c *gin.Context, id todoDomain.TodoID, itemId todoDomain.ChecklistItemID, params ToggleChecklistItemParams)
+	// List the comments of a todo, oldest first
+	// (GET /todos/{id}/comments)
+	GetTodoComments(c *gin.Context, id todoDomain.TodoID, params GetTodoCommentsParams)
+	// Comment on a todo
+	// (POST /todos/{id}/comments)
+	CreateTodoComment(c *gin.Context, id todoDomain.TodoID)
+	// Delete a comment
+	// (DELETE /todos/{id}/comments/{commentId})
+	DeleteTodoComment(c *gin.Context, id todoDomain.TodoID, commentId todoDomain.CommentID)
+	// Edit a comment
+	// (PUT /todos/{id}/comments/{commentId})
+	UpdateTodoComment(c *gin.Context, id todoDomain.TodoID, commentId todoDomain.CommentID)
 	// Complete a todo
 	// (PATCH /todos/{id}/complete)
 	CompleteTodo(c *gin.Context, id todoDomain.TodoID, params CompleteTodoParams)
+	// Set or clear the due date of a todo
+	// (PUT /todos/{id}/due-date)
+	SetTodoDueDate(c *gin.Context, id todoDomain.TodoID, params SetTodoDueDateParams)
 	// Start focus session
 	// (POST /todos/{id}/focus/start)
 	StartFocus(c *gin.Context, id todoDomain.TodoID)
 	// Stop focus session
 	// (POST /todos/{id}/focus/stop)
 	StopFocus(c *gin.Context, id todoDomain.TodoID)
+	// Set or clear the recurrence rule of a todo
+	// (PUT /todos/{id}/recurrence)
+	SetTodoRecurrence(c *gin.Context, id todoDomain.TodoID, params SetTodoRecurrenceParams)
+	// Reopen a completed todo
+	// (POST /todos/{id}/reopen)
+	ReopenTodo(c *gin.Context, id todoDomain.TodoID, params ReopenTodoParams)
 	// Assign a tag to a todo
 	// (POST /todos/{id}/tags)
 	AssignTagToTodo(c *gin.Context, id todoDomain.TodoID, params AssignTagToTodoParams)
+	// Remove a tag from a todo
+	// (DELETE /todos/{id}/tags/{tagId})
+	RemoveTagFromTodo(c *gin.Context, id todoDomain.TodoID, tagId todoDomain.TagID, params RemoveTagFromTodoParams)
+	// Restore an archived todo
+	// (POST /todos/{id}/unarchive)
+	UnarchiveTodo(c *gin.Context, id todoDomain.TodoID, params UnarchiveTodoParams)
+	// Delete a user and erase their personal data
+	// (DELETE /users/{id})
+	DeleteUser(c *gin.Context, id userDomain.UserID)
 
 	// (GET /users/{id})
 	GetUserByID(c *gin.Context, id userDomain.UserID)
+	// List the todos assigned to a user, soonest due first
+	// (GET /users/{id}/assigned-todos)
+	GetUserAssignedTodos(c *gin.Context, id userDomain.UserID, params GetUserAssignedTodosParams)
+	// Set the default capacity of the user's new schedules
+	// (PUT /users/{id}/daily-capacity)
+	SetUserDailyCapacity(c *gin.Context, id userDomain.UserID, params SetUserDailyCapacityParams)
+	// Request an export of the user's personal data
+	// (POST /users/{id}/data-exports)
+	RequestUserDataExport(c *gin.Context, id userDomain.UserID, params RequestUserDataExportParams)
+	// Get the status of a personal data export
+	// (GET /users/{id}/data-exports/{exportId})
+	GetUserDataExport(c *gin.Context, id userDomain.UserID, exportId userDomain.DataExportID)
+	// Download a ready personal data export
+	// (GET /users/{id}/data-exports/{exportId}/archive)
+	DownloadUserDataExport(c *gin.Context, id userDomain.UserID, exportId userDomain.DataExportID)
+	// Set the IANA timezone used for the user's calendar days
+	// (PUT /users/{id}/timezone)
+	SetUserTimezone(c *gin.Context, id userDomain.UserID, params SetUserTimezoneParams)
+	// Reset the TOTP enrollment of a user
+	// (POST /users/{id}/totp/reset)
+	ResetUserTOTP(c *gin.Context, id userDomain.UserID)
 	// Get all workspaces for a user
 	// (GET /users/{id}/workspaces)
 	GetUserWorkspaces(c *gin.Context, id userDomain.UserID, params GetUserWorkspacesParams)
@@ -355,24 +1094,42 @@ type ServerInterface interface {
 	// Delete a workspace
 	// (DELETE /workspaces/{id})
 	DeleteWorkspace(c *gin.Context, id workspaceDomain.WorkspaceID)
+	// List the audit trail of a workspace
+	// (GET /workspaces/{id}/audit)
+	GetWorkspaceAuditLogs(c *gin.Context, id workspaceDomain.WorkspaceID, params GetWorkspaceAuditLogsParams)
+	// Verify the audit trail of a workspace has not been tampered with
+	// (GET /workspaces/{id}/audit/verify)
+	VerifyWorkspaceAuditChain(c *gin.Context, id workspaceDomain.WorkspaceID)
 	// Add a member to a workspace
 	// (POST /workspaces/{id}/members)
 	AddWorkspaceMember(c *gin.Context, id workspaceDomain.WorkspaceID, params AddWorkspaceMemberParams)
 	// Remove a member from a workspace
 	// (DELETE /workspaces/{id}/members/{userId})
 	RemoveWorkspaceMember(c *gin.Context, id workspaceDomain.WorkspaceID, userId userDomain.UserID)
+	// Report focused time in a workspace
+	// (GET /workspaces/{id}/reports/focus)
+	GetWorkspaceFocusReport(c *gin.Context, id workspaceDomain.WorkspaceID, params GetWorkspaceFocusReportParams)
 	// Get all tags for a workspace
 	// (GET /workspaces/{id}/tags)
 	GetWorkspaceTags(c *gin.Context, id workspaceDomain.WorkspaceID)
 	// Create a new tag
 	// (POST /workspaces/{id}/tags)
 	CreateTag(c *gin.Context, id workspaceDomain.WorkspaceID, params CreateTagParams)
+	// Delete a tag, removing it from every todo
+	// (DELETE /workspaces/{id}/tags/{tagId})
+	DeleteTag(c *gin.Context, id workspaceDomain.WorkspaceID, tagId todoDomain.TagID, params DeleteTagParams)
+	// Rename or recolor a tag
+	// (PATCH /workspaces/{id}/tags/{tagId})
+	UpdateTag(c *gin.Context, id workspaceDomain.WorkspaceID, tagId todoDomain.TagID, params UpdateTagParams)
 	// List all todos for a workspace
 	// (GET /workspaces/{id}/todos)
 	GetWorkspaceTodos(c *gin.Context, id workspaceDomain.WorkspaceID, params GetWorkspaceTodosParams)
 	// Create a new todo
 	// (POST /workspaces/{id}/todos)
 	CreateTodo(c *gin.Context, id workspaceDomain.WorkspaceID, params CreateTodoParams)
+	// Full-text search over the titles of a workspace's todos
+	// (GET /workspaces/{id}/todos/search)
+	SearchWorkspaceTodos(c *gin.Context, id workspaceDomain.WorkspaceID, params SearchWorkspaceTodosParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -384,8 +1141,23 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
-// Login operation middleware
-func (siw *ServerInterfaceWrapper) Login(c *gin.Context) {
+// VerifyGlobalAuditChains operation middleware
+func (siw *ServerInterfaceWrapper) VerifyGlobalAuditChains(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.VerifyGlobalAuditChains(c)
+}
+
+// Login operation middleware
+func (siw *ServerInterfaceWrapper) Login(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -397,6 +1169,64 @@ func (siw *ServerInterfaceWrapper) Login(c *gin.Context) {
 	siw.Handler.Login(c)
 }
 
+// Logout operation middleware
+func (siw *ServerInterfaceWrapper) Logout(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.Logout(c)
+}
+
+// LogoutAll operation middleware
+func (siw *ServerInterfaceWrapper) LogoutAll(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.LogoutAll(c)
+}
+
+// Reauthenticate operation middleware
+func (siw *ServerInterfaceWrapper) Reauthenticate(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.Reauthenticate(c)
+}
+
+// RefreshSession operation middleware
+func (siw *ServerInterfaceWrapper) RefreshSession(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RefreshSession(c)
+}
+
 // Register operation middleware
 func (siw *ServerInterfaceWrapper) Register(c *gin.Context) {
 
@@ -436,6 +1266,21 @@ func (siw *ServerInterfaceWrapper) Register(c *gin.Context) {
 	siw.Handler.Register(c, params)
 }
 
+// DisableTOTP operation middleware
+func (siw *ServerInterfaceWrapper) DisableTOTP(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DisableTOTP(c)
+}
+
 // InitiateTOTP operation middleware
 func (siw *ServerInterfaceWrapper) InitiateTOTP(c *gin.Context) {
 
@@ -451,6 +1296,21 @@ func (siw *ServerInterfaceWrapper) InitiateTOTP(c *gin.Context) {
 	siw.Handler.InitiateTOTP(c)
 }
 
+// RecoverTOTP operation middleware
+func (siw *ServerInterfaceWrapper) RecoverTOTP(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RecoverTOTP(c)
+}
+
 // VerifyTOTP operation middleware
 func (siw *ServerInterfaceWrapper) VerifyTOTP(c *gin.Context) {
 
@@ -494,20 +1354,22 @@ func (siw *ServerInterfaceWrapper) CommitTask(c *gin.Context) {
 	siw.Handler.CommitTask(c)
 }
 
-// GetTodoByID operation middleware
-func (siw *ServerInterfaceWrapper) GetTodoByID(c *gin.Context) {
+// GetSchedule operation middleware
+func (siw *ServerInterfaceWrapper) GetSchedule(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id todoDomain.TodoID
+	// ------------- Path parameter "date" -------------
+	var date ScheduleDate
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "date", c.Param("date"), &date, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter date: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(BearerAuthScopes, []string{})
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -515,25 +1377,27 @@ func (siw *ServerInterfaceWrapper) GetTodoByID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTodoByID(c, id)
+	siw.Handler.GetSchedule(c, date)
 }
 
-// CompleteTodo operation middleware
-func (siw *ServerInterfaceWrapper) CompleteTodo(c *gin.Context) {
+// UpdateScheduleCapacity operation middleware
+func (siw *ServerInterfaceWrapper) UpdateScheduleCapacity(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id todoDomain.TodoID
+	// ------------- Path parameter "date" -------------
+	var date ScheduleDate
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "date", c.Param("date"), &date, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter date: %w", err), http.StatusBadRequest)
 		return
 	}
 
+	c.Set(BearerAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params CompleteTodoParams
+	var params UpdateScheduleCapacityParams
 
 	headers := c.Request.Header
 
@@ -563,11 +1427,120 @@ func (siw *ServerInterfaceWrapper) CompleteTodo(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CompleteTodo(c, id, params)
+	siw.Handler.UpdateScheduleCapacity(c, date, params)
 }
 
-// StartFocus operation middleware
-func (siw *ServerInterfaceWrapper) StartFocus(c *gin.Context) {
+// AutoPlanSchedule operation middleware
+func (siw *ServerInterfaceWrapper) AutoPlanSchedule(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "date" -------------
+	var date ScheduleDate
+
+	err = runtime.BindStyledParameterWithOptions("simple", "date", c.Param("date"), &date, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter date: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AutoPlanScheduleParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AutoPlanSchedule(c, date, params)
+}
+
+// RemoveScheduledTask operation middleware
+func (siw *ServerInterfaceWrapper) RemoveScheduledTask(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "date" -------------
+	var date ScheduleDate
+
+	err = runtime.BindStyledParameterWithOptions("simple", "date", c.Param("date"), &date, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter date: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "todoId" -------------
+	var todoId todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "todoId", c.Param("todoId"), &todoId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter todoId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RemoveScheduledTaskParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RemoveScheduledTask(c, date, todoId, params)
+}
+
+// GetTodoByID operation middleware
+func (siw *ServerInterfaceWrapper) GetTodoByID(c *gin.Context) {
 
 	var err error
 
@@ -580,8 +1553,6 @@ func (siw *ServerInterfaceWrapper) StartFocus(c *gin.Context) {
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -589,11 +1560,11 @@ func (siw *ServerInterfaceWrapper) StartFocus(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.StartFocus(c, id)
+	siw.Handler.GetTodoByID(c, id)
 }
 
-// StopFocus operation middleware
-func (siw *ServerInterfaceWrapper) StopFocus(c *gin.Context) {
+// UpdateTodo operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTodo(c *gin.Context) {
 
 	var err error
 
@@ -606,7 +1577,29 @@ func (siw *ServerInterfaceWrapper) StopFocus(c *gin.Context) {
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateTodoParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -615,16 +1608,1469 @@ func (siw *ServerInterfaceWrapper) StopFocus(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.StopFocus(c, id)
+	siw.Handler.UpdateTodo(c, id, params)
 }
 
-// AssignTagToTodo operation middleware
-func (siw *ServerInterfaceWrapper) AssignTagToTodo(c *gin.Context) {
+// ArchiveTodo operation middleware
+func (siw *ServerInterfaceWrapper) ArchiveTodo(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ArchiveTodoParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ArchiveTodo(c, id, params)
+}
+
+// UnassignTodo operation middleware
+func (siw *ServerInterfaceWrapper) UnassignTodo(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UnassignTodo(c, id)
+}
+
+// AssignTodo operation middleware
+func (siw *ServerInterfaceWrapper) AssignTodo(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AssignTodo(c, id)
+}
+
+// AddTodoBlocker operation middleware
+func (siw *ServerInterfaceWrapper) AddTodoBlocker(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AddTodoBlocker(c, id)
+}
+
+// RemoveTodoBlocker operation middleware
+func (siw *ServerInterfaceWrapper) RemoveTodoBlocker(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "blockerId" -------------
+	var blockerId todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "blockerId", c.Param("blockerId"), &blockerId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter blockerId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RemoveTodoBlocker(c, id, blockerId)
+}
+
+// AddChecklistItem operation middleware
+func (siw *ServerInterfaceWrapper) AddChecklistItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddChecklistItemParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AddChecklistItem(c, id, params)
+}
+
+// ReorderChecklist operation middleware
+func (siw *ServerInterfaceWrapper) ReorderChecklist(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ReorderChecklist(c, id)
+}
+
+// RemoveChecklistItem operation middleware
+func (siw *ServerInterfaceWrapper) RemoveChecklistItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId todoDomain.ChecklistItemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RemoveChecklistItem(c, id, itemId)
+}
+
+// ToggleChecklistItem operation middleware
+func (siw *ServerInterfaceWrapper) ToggleChecklistItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId todoDomain.ChecklistItemID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", c.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter itemId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ToggleChecklistItemParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ToggleChecklistItem(c, id, itemId, params)
+}
+
+// GetTodoComments operation middleware
+func (siw *ServerInterfaceWrapper) GetTodoComments(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTodoCommentsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTodoComments(c, id, params)
+}
+
+// CreateTodoComment operation middleware
+func (siw *ServerInterfaceWrapper) CreateTodoComment(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateTodoComment(c, id)
+}
+
+// DeleteTodoComment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTodoComment(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId todoDomain.CommentID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", c.Param("commentId"), &commentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter commentId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteTodoComment(c, id, commentId)
+}
+
+// UpdateTodoComment operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTodoComment(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId todoDomain.CommentID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", c.Param("commentId"), &commentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter commentId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateTodoComment(c, id, commentId)
+}
+
+// CompleteTodo operation middleware
+func (siw *ServerInterfaceWrapper) CompleteTodo(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CompleteTodoParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CompleteTodo(c, id, params)
+}
+
+// SetTodoDueDate operation middleware
+func (siw *ServerInterfaceWrapper) SetTodoDueDate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetTodoDueDateParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetTodoDueDate(c, id, params)
+}
+
+// StartFocus operation middleware
+func (siw *ServerInterfaceWrapper) StartFocus(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StartFocus(c, id)
+}
+
+// StopFocus operation middleware
+func (siw *ServerInterfaceWrapper) StopFocus(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StopFocus(c, id)
+}
+
+// SetTodoRecurrence operation middleware
+func (siw *ServerInterfaceWrapper) SetTodoRecurrence(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetTodoRecurrenceParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetTodoRecurrence(c, id, params)
+}
+
+// ReopenTodo operation middleware
+func (siw *ServerInterfaceWrapper) ReopenTodo(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReopenTodoParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ReopenTodo(c, id, params)
+}
+
+// AssignTagToTodo operation middleware
+func (siw *ServerInterfaceWrapper) AssignTagToTodo(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AssignTagToTodoParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AssignTagToTodo(c, id, params)
+}
+
+// RemoveTagFromTodo operation middleware
+func (siw *ServerInterfaceWrapper) RemoveTagFromTodo(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "tagId" -------------
+	var tagId todoDomain.TagID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", c.Param("tagId"), &tagId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tagId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RemoveTagFromTodoParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RemoveTagFromTodo(c, id, tagId, params)
+}
+
+// UnarchiveTodo operation middleware
+func (siw *ServerInterfaceWrapper) UnarchiveTodo(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id todoDomain.TodoID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UnarchiveTodoParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UnarchiveTodo(c, id, params)
+}
+
+// DeleteUser operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUser(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id userDomain.UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteUser(c, id)
+}
+
+// GetUserByID operation middleware
+func (siw *ServerInterfaceWrapper) GetUserByID(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id userDomain.UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUserByID(c, id)
+}
+
+// GetUserAssignedTodos operation middleware
+func (siw *ServerInterfaceWrapper) GetUserAssignedTodos(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id userDomain.UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserAssignedTodosParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUserAssignedTodos(c, id, params)
+}
+
+// SetUserDailyCapacity operation middleware
+func (siw *ServerInterfaceWrapper) SetUserDailyCapacity(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id userDomain.UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetUserDailyCapacityParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetUserDailyCapacity(c, id, params)
+}
+
+// RequestUserDataExport operation middleware
+func (siw *ServerInterfaceWrapper) RequestUserDataExport(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id userDomain.UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RequestUserDataExportParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RequestUserDataExport(c, id, params)
+}
+
+// GetUserDataExport operation middleware
+func (siw *ServerInterfaceWrapper) GetUserDataExport(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id userDomain.UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "exportId" -------------
+	var exportId userDomain.DataExportID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "exportId", c.Param("exportId"), &exportId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exportId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUserDataExport(c, id, exportId)
+}
+
+// DownloadUserDataExport operation middleware
+func (siw *ServerInterfaceWrapper) DownloadUserDataExport(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id userDomain.UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "exportId" -------------
+	var exportId userDomain.DataExportID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "exportId", c.Param("exportId"), &exportId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter exportId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DownloadUserDataExport(c, id, exportId)
+}
+
+// SetUserTimezone operation middleware
+func (siw *ServerInterfaceWrapper) SetUserTimezone(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id userDomain.UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetUserTimezoneParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SetUserTimezone(c, id, params)
+}
+
+// ResetUserTOTP operation middleware
+func (siw *ServerInterfaceWrapper) ResetUserTOTP(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id userDomain.UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ResetUserTOTP(c, id)
+}
+
+// GetUserWorkspaces operation middleware
+func (siw *ServerInterfaceWrapper) GetUserWorkspaces(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id userDomain.UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserWorkspacesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUserWorkspaces(c, id, params)
+}
+
+// ListWorkspaces operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkspaces(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWorkspacesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListWorkspaces(c, params)
+}
+
+// OnboardWorkspace operation middleware
+func (siw *ServerInterfaceWrapper) OnboardWorkspace(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params OnboardWorkspaceParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OnboardWorkspace(c, params)
+}
+
+// DeleteWorkspace operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWorkspace(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id workspaceDomain.WorkspaceID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteWorkspace(c, id)
+}
+
+// GetWorkspaceAuditLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkspaceAuditLogs(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id workspaceDomain.WorkspaceID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWorkspaceAuditLogsParams
+
+	// ------------- Optional query parameter "aggregateType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "aggregateType", c.Request.URL.Query(), &params.AggregateType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter aggregateType: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "aggregateId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "aggregateId", c.Request.URL.Query(), &params.AggregateID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter aggregateId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "actorId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actorId", c.Request.URL.Query(), &params.ActorID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter actorId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "operation" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "operation", c.Request.URL.Query(), &params.Operation)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter operation: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetWorkspaceAuditLogs(c, id, params)
+}
+
+// VerifyWorkspaceAuditChain operation middleware
+func (siw *ServerInterfaceWrapper) VerifyWorkspaceAuditChain(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id workspaceDomain.WorkspaceID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.VerifyWorkspaceAuditChain(c, id)
+}
+
+// AddWorkspaceMember operation middleware
+func (siw *ServerInterfaceWrapper) AddWorkspaceMember(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id todoDomain.TodoID
+	var id workspaceDomain.WorkspaceID
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -633,7 +3079,7 @@ func (siw *ServerInterfaceWrapper) AssignTagToTodo(c *gin.Context) {
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params AssignTagToTodoParams
+	var params AddWorkspaceMemberParams
 
 	headers := c.Request.Header
 
@@ -663,16 +3109,16 @@ func (siw *ServerInterfaceWrapper) AssignTagToTodo(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AssignTagToTodo(c, id, params)
+	siw.Handler.AddWorkspaceMember(c, id, params)
 }
 
-// GetUserByID operation middleware
-func (siw *ServerInterfaceWrapper) GetUserByID(c *gin.Context) {
+// RemoveWorkspaceMember operation middleware
+func (siw *ServerInterfaceWrapper) RemoveWorkspaceMember(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id userDomain.UserID
+	var id workspaceDomain.WorkspaceID
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -680,6 +3126,15 @@ func (siw *ServerInterfaceWrapper) GetUserByID(c *gin.Context) {
 		return
 	}
 
+	// ------------- Path parameter "userId" -------------
+	var userId userDomain.UserID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -689,16 +3144,16 @@ func (siw *ServerInterfaceWrapper) GetUserByID(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetUserByID(c, id)
+	siw.Handler.RemoveWorkspaceMember(c, id, userId)
 }
 
-// GetUserWorkspaces operation middleware
-func (siw *ServerInterfaceWrapper) GetUserWorkspaces(c *gin.Context) {
+// GetWorkspaceFocusReport operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkspaceFocusReport(c *gin.Context) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id userDomain.UserID
+	var id workspaceDomain.WorkspaceID
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -709,97 +3164,52 @@ func (siw *ServerInterfaceWrapper) GetUserWorkspaces(c *gin.Context) {
 	c.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUserWorkspacesParams
+	var params GetWorkspaceFocusReportParams
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Required query parameter "from" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument from is required, but not found"), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, true, "from", c.Request.URL.Query(), &params.From)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.GetUserWorkspaces(c, id, params)
-}
-
-// ListWorkspaces operation middleware
-func (siw *ServerInterfaceWrapper) ListWorkspaces(c *gin.Context) {
-
-	var err error
-
-	c.Set(BearerAuthScopes, []string{})
+	// ------------- Required query parameter "to" -------------
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListWorkspacesParams
+	if paramValue := c.Query("to"); paramValue != "" {
 
-	// ------------- Optional query parameter "limit" -------------
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument to is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, true, "to", c.Request.URL.Query(), &params.To)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Optional query parameter "groupBy" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "groupBy", c.Request.URL.Query(), &params.GroupBy)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter groupBy: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.ListWorkspaces(c, params)
-}
-
-// OnboardWorkspace operation middleware
-func (siw *ServerInterfaceWrapper) OnboardWorkspace(c *gin.Context) {
-
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params OnboardWorkspaceParams
-
-	headers := c.Request.Header
-
-	// ------------- Optional header parameter "Idempotency-Key" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
-		var IdempotencyKey IdempotencyKey
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
-			return
-		}
-
-		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
-		if err != nil {
-			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
-			return
-		}
-
-		params.IdempotencyKey = &IdempotencyKey
+	// ------------- Optional query parameter "userId" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "userId", c.Request.URL.Query(), &params.UserID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -809,11 +3219,11 @@ func (siw *ServerInterfaceWrapper) OnboardWorkspace(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.OnboardWorkspace(c, params)
+	siw.Handler.GetWorkspaceFocusReport(c, id, params)
 }
 
-// DeleteWorkspace operation middleware
-func (siw *ServerInterfaceWrapper) DeleteWorkspace(c *gin.Context) {
+// GetWorkspaceTags operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkspaceTags(c *gin.Context) {
 
 	var err error
 
@@ -826,8 +3236,6 @@ func (siw *ServerInterfaceWrapper) DeleteWorkspace(c *gin.Context) {
 		return
 	}
 
-	c.Set(BearerAuthScopes, []string{})
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -835,11 +3243,11 @@ func (siw *ServerInterfaceWrapper) DeleteWorkspace(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteWorkspace(c, id)
+	siw.Handler.GetWorkspaceTags(c, id)
 }
 
-// AddWorkspaceMember operation middleware
-func (siw *ServerInterfaceWrapper) AddWorkspaceMember(c *gin.Context) {
+// CreateTag operation middleware
+func (siw *ServerInterfaceWrapper) CreateTag(c *gin.Context) {
 
 	var err error
 
@@ -853,7 +3261,7 @@ func (siw *ServerInterfaceWrapper) AddWorkspaceMember(c *gin.Context) {
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params AddWorkspaceMemberParams
+	var params CreateTagParams
 
 	headers := c.Request.Header
 
@@ -883,11 +3291,11 @@ func (siw *ServerInterfaceWrapper) AddWorkspaceMember(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AddWorkspaceMember(c, id, params)
+	siw.Handler.CreateTag(c, id, params)
 }
 
-// RemoveWorkspaceMember operation middleware
-func (siw *ServerInterfaceWrapper) RemoveWorkspaceMember(c *gin.Context) {
+// DeleteTag operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTag(c *gin.Context) {
 
 	var err error
 
@@ -900,37 +3308,37 @@ func (siw *ServerInterfaceWrapper) RemoveWorkspaceMember(c *gin.Context) {
 		return
 	}
 
-	// ------------- Path parameter "userId" -------------
-	var userId userDomain.UserID
+	// ------------- Path parameter "tagId" -------------
+	var tagId todoDomain.TagID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", c.Param("tagId"), &tagId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tagId: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteTagParams
 
-	siw.Handler.RemoveWorkspaceMember(c, id, userId)
-}
+	headers := c.Request.Header
 
-// GetWorkspaceTags operation middleware
-func (siw *ServerInterfaceWrapper) GetWorkspaceTags(c *gin.Context) {
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
 
-	var err error
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
 
-	// ------------- Path parameter "id" -------------
-	var id workspaceDomain.WorkspaceID
+		params.IdempotencyKey = &IdempotencyKey
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
-		return
 	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -940,11 +3348,11 @@ func (siw *ServerInterfaceWrapper) GetWorkspaceTags(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetWorkspaceTags(c, id)
+	siw.Handler.DeleteTag(c, id, tagId, params)
 }
 
-// CreateTag operation middleware
-func (siw *ServerInterfaceWrapper) CreateTag(c *gin.Context) {
+// UpdateTag operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTag(c *gin.Context) {
 
 	var err error
 
@@ -957,8 +3365,17 @@ func (siw *ServerInterfaceWrapper) CreateTag(c *gin.Context) {
 		return
 	}
 
+	// ------------- Path parameter "tagId" -------------
+	var tagId todoDomain.TagID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", c.Param("tagId"), &tagId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tagId: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params CreateTagParams
+	var params UpdateTagParams
 
 	headers := c.Request.Header
 
@@ -988,7 +3405,7 @@ func (siw *ServerInterfaceWrapper) CreateTag(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateTag(c, id, params)
+	siw.Handler.UpdateTag(c, id, tagId, params)
 }
 
 // GetWorkspaceTodos operation middleware
@@ -1024,6 +3441,70 @@ func (siw *ServerInterfaceWrapper) GetWorkspaceTodos(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tagIds" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tagIds", c.Request.URL.Query(), &params.TagIds)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tagIds: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "dueBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dueBefore", c.Request.URL.Query(), &params.DueBefore)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dueBefore: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "dueAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dueAfter", c.Request.URL.Query(), &params.DueAfter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dueAfter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "overdue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "overdue", c.Request.URL.Query(), &params.Overdue)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter overdue: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "recurring" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "recurring", c.Request.URL.Query(), &params.Recurring)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter recurring: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1082,6 +3563,64 @@ func (siw *ServerInterfaceWrapper) CreateTodo(c *gin.Context) {
 	siw.Handler.CreateTodo(c, id, params)
 }
 
+// SearchWorkspaceTodos operation middleware
+func (siw *ServerInterfaceWrapper) SearchWorkspaceTodos(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id workspaceDomain.WorkspaceID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchWorkspaceTodosParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := c.Query("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument q is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", c.Request.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter offset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SearchWorkspaceTodos(c, id, params)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL      string
@@ -1109,26 +3648,70 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/audit/verify", wrapper.VerifyGlobalAuditChains)
 	router.POST(options.BaseURL+"/auth/login", wrapper.Login)
+	router.POST(options.BaseURL+"/auth/logout", wrapper.Logout)
+	router.POST(options.BaseURL+"/auth/logout-all", wrapper.LogoutAll)
+	router.POST(options.BaseURL+"/auth/reauthenticate", wrapper.Reauthenticate)
+	router.POST(options.BaseURL+"/auth/refresh", wrapper.RefreshSession)
 	router.POST(options.BaseURL+"/auth/register", wrapper.Register)
+	router.POST(options.BaseURL+"/auth/totp/disable", wrapper.DisableTOTP)
 	router.POST(options.BaseURL+"/auth/totp/initiate", wrapper.InitiateTOTP)
+	router.POST(options.BaseURL+"/auth/totp/recover", wrapper.RecoverTOTP)
 	router.POST(options.BaseURL+"/auth/totp/verify", wrapper.VerifyTOTP)
 	router.GET(options.BaseURL+"/healthz", wrapper.Healthz)
 	router.POST(options.BaseURL+"/schedule/commit", wrapper.CommitTask)
+	router.GET(options.BaseURL+"/schedule/:date", wrapper.GetSchedule)
+	router.PATCH(options.BaseURL+"/schedule/:date", wrapper.UpdateScheduleCapacity)
+	router.POST(options.BaseURL+"/schedule/:date/auto-plan", wrapper.AutoPlanSchedule)
+	router.DELETE(options.BaseURL+"/schedule/:date/tasks/:todoId", wrapper.RemoveScheduledTask)
 	router.GET(options.BaseURL+"/todos/:id", wrapper.GetTodoByID)
+	router.PATCH(options.BaseURL+"/todos/:id", wrapper.UpdateTodo)
+	router.POST(options.BaseURL+"/todos/:id/archive", wrapper.ArchiveTodo)
+	router.DELETE(options.BaseURL+"/todos/:id/assignee", wrapper.UnassignTodo)
+	router.PUT(options.BaseURL+"/todos/:id/assignee", wrapper.AssignTodo)
+	router.POST(options.BaseURL+"/todos/:id/blockers", wrapper.AddTodoBlocker)
+	router.DELETE(options.BaseURL+"/todos/:id/blockers/:blockerId", wrapper.RemoveTodoBlocker)
+	router.POST(options.BaseURL+"/todos/:id/checklist", wrapper.AddChecklistItem)
+	router.PUT(options.BaseURL+"/todos/:id/checklist/order", wrapper.ReorderChecklist)
+	router.DELETE(options.BaseURL+"/todos/:id/checklist/:itemId", wrapper.RemoveChecklistItem)
+	router.POST(options.BaseURL+"/todos/:id/checklist/:itemId/toggle", wrapper.ToggleChecklistItem)
+	router.GET(options.BaseURL+"/todos/:id/comments", wrapper.GetTodoComments)
+	router.POST(options.BaseURL+"/todos/:id/comments", wrapper.CreateTodoComment)
+	router.DELETE(options.BaseURL+"/todos/:id/comments/:commentId", wrapper.DeleteTodoComment)
+	router.PUT(options.BaseURL+"/todos/:id/comments/:commentId", wrapper.UpdateTodoComment)
 	router.PATCH(options.BaseURL+"/todos/:id/complete", wrapper.CompleteTodo)
+	router.PUT(options.BaseURL+"/todos/:id/due-date", wrapper.SetTodoDueDate)
 	router.POST(options.BaseURL+"/todos/:id/focus/start", wrapper.StartFocus)
 	router.POST(options.BaseURL+"/todos/:id/focus/stop", wrapper.StopFocus)
+	router.PUT(options.BaseURL+"/todos/:id/recurrence", wrapper.SetTodoRecurrence)
+	router.POST(options.BaseURL+"/todos/:id/reopen", wrapper.ReopenTodo)
 	router.POST(options.BaseURL+"/todos/:id/tags", wrapper.AssignTagToTodo)
+	router.DELETE(options.BaseURL+"/todos/:id/tags/:tagId", wrapper.RemoveTagFromTodo)
+	router.POST(options.BaseURL+"/todos/:id/unarchive", wrapper.UnarchiveTodo)
+	router.DELETE(options.BaseURL+"/users/:id", wrapper.DeleteUser)
 	router.GET(options.BaseURL+"/users/:id", wrapper.GetUserByID)
+	router.GET(options.BaseURL+"/users/:id/assigned-todos", wrapper.GetUserAssignedTodos)
+	router.PUT(options.BaseURL+"/users/:id/daily-capacity", wrapper.SetUserDailyCapacity)
+	router.POST(options.BaseURL+"/users/:id/data-exports", wrapper.RequestUserDataExport)
+	router.GET(options.BaseURL+"/users/:id/data-exports/:exportId", wrapper.GetUserDataExport)
+	router.GET(options.BaseURL+"/users/:id/data-exports/:exportId/archive", wrapper.DownloadUserDataExport)
+	router.PUT(options.BaseURL+"/users/:id/timezone", wrapper.SetUserTimezone)
+	router.POST(options.BaseURL+"/users/:id/totp/reset", wrapper.ResetUserTOTP)
 	router.GET(options.BaseURL+"/users/:id/workspaces", wrapper.GetUserWorkspaces)
 	router.GET(options.BaseURL+"/workspaces", wrapper.ListWorkspaces)
 	router.POST(options.BaseURL+"/workspaces", wrapper.OnboardWorkspace)
 	router.DELETE(options.BaseURL+"/workspaces/:id", wrapper.DeleteWorkspace)
+	router.GET(options.BaseURL+"/workspaces/:id/audit", wrapper.GetWorkspaceAuditLogs)
+	router.GET(options.BaseURL+"/workspaces/:id/audit/verify", wrapper.VerifyWorkspaceAuditChain)
 	router.POST(options.BaseURL+"/workspaces/:id/members", wrapper.AddWorkspaceMember)
 	router.DELETE(options.BaseURL+"/workspaces/:id/members/:userId", wrapper.RemoveWorkspaceMember)
+	router.GET(options.BaseURL+"/workspaces/:id/reports/focus", wrapper.GetWorkspaceFocusReport)
 	router.GET(options.BaseURL+"/workspaces/:id/tags", wrapper.GetWorkspaceTags)
 	router.POST(options.BaseURL+"/workspaces/:id/tags", wrapper.CreateTag)
+	router.DELETE(options.BaseURL+"/workspaces/:id/tags/:tagId", wrapper.DeleteTag)
+	router.PATCH(options.BaseURL+"/workspaces/:id/tags/:tagId", wrapper.UpdateTag)
 	router.GET(options.BaseURL+"/workspaces/:id/todos", wrapper.GetWorkspaceTodos)
 	router.POST(options.BaseURL+"/workspaces/:id/todos", wrapper.CreateTodo)
+	router.GET(options.BaseURL+"/workspaces/:id/todos/search", wrapper.SearchWorkspaceTodos)
 }
@@ -27,6 +27,48 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for AuditAggregateType.
+const (
+	SCHEDULE  AuditAggregateType = "SCHEDULE"
+	TAG       AuditAggregateType = "TAG"
+	TODO      AuditAggregateType = "TODO"
+	USER      AuditAggregateType = "USER"
+	WORKSPACE AuditAggregateType = "WORKSPACE"
+)
+
+// Defines values for AuditChainBreakReason.
+const (
+	HASHMISMATCH         AuditChainBreakReason = "HASH_MISMATCH"
+	PREVIOUSHASHMISMATCH AuditChainBreakReason = "PREVIOUS_HASH_MISMATCH"
+	UNRECORDEDERASURE    AuditChainBreakReason = "UNRECORDED_ERASURE"
+)
+
+// Defines values for AuditOperation.
+const (
+	CREATE AuditOperation = "CREATE"
+	DELETE AuditOperation = "DELETE"
+	ERASE  AuditOperation = "ERASE"
+	EVENT  AuditOperation = "EVENT"
+	READ   AuditOperation = "READ"
+	UPDATE AuditOperation = "UPDATE"
+	UPSERT AuditOperation = "UPSERT"
+)
+
+// Defines values for DataExportStatus.
+const (
+	DataExportStatusFAILED  DataExportStatus = "FAILED"
+	DataExportStatusPENDING DataExportStatus = "PENDING"
+	DataExportStatusREADY   DataExportStatus = "READY"
+)
+
+// Defines values for FocusReportGroupBy.
+const (
+	FocusReportGroupByDay    FocusReportGroupBy = "day"
+	FocusReportGroupByMember FocusReportGroupBy = "member"
+	FocusReportGroupByTag    FocusReportGroupBy = "tag"
+	FocusReportGroupByTodo   FocusReportGroupBy = "todo"
+)
+
 // Defines values for RecurrenceInterval.
 const (
 	DAILY   RecurrenceInterval = "DAILY"
@@ -36,11 +78,40 @@ const (
 
 // Defines values for TodoStatus.
 const (
-	ARCHIVED  TodoStatus = "ARCHIVED"
-	COMPLETED TodoStatus = "COMPLETED"
-	PENDING   TodoStatus = "PENDING"
+	TodoStatusARCHIVED  TodoStatus = "ARCHIVED"
+	TodoStatusCOMPLETED TodoStatus = "COMPLETED"
+	TodoStatusPENDING   TodoStatus = "PENDING"
+)
+
+// Defines values for GetWorkspaceFocusReportParamsGroupBy.
+const (
+	GetWorkspaceFocusReportParamsGroupByDay    GetWorkspaceFocusReportParamsGroupBy = "day"
+	GetWorkspaceFocusReportParamsGroupByMember GetWorkspaceFocusReportParamsGroupBy = "member"
+	GetWorkspaceFocusReportParamsGroupByTag    GetWorkspaceFocusReportParamsGroupBy = "tag"
+	GetWorkspaceFocusReportParamsGroupByTodo   GetWorkspaceFocusReportParamsGroupBy = "todo"
+)
+
+// Defines values for GetWorkspaceTodosParamsSort.
+const (
+	CreatedAt      GetWorkspaceTodosParamsSort = "createdAt"
+	DueDate        GetWorkspaceTodosParamsSort = "dueDate"
+	MinusCreatedAt GetWorkspaceTodosParamsSort = "-createdAt"
+	MinusDueDate   GetWorkspaceTodosParamsSort = "-dueDate"
+	MinusTitle     GetWorkspaceTodosParamsSort = "-title"
+	Title          GetWorkspaceTodosParamsSort = "title"
 )
 
+// AddChecklistItemRequest defines model for AddChecklistItemRequest.
+type AddChecklistItemRequest struct {
+	Required *bool  `json:"required,omitempty"`
+	Title    string `json:"title"`
+}
+
+// AddTodoBlockerRequest defines model for AddTodoBlockerRequest.
+type AddTodoBlockerRequest struct {
+	BlockerId todoDomain.TodoID `json:"blockerId"`
+}
+
 // AddWorkspaceMemberRequest defines model for AddWorkspaceMemberRequest.
 type AddWorkspaceMemberRequest struct {
 	Role   WorkspaceRole      `json:"role"`
@@ -52,11 +123,108 @@ type AssignTagToTodoRequest struct {
 	TagId todoDomain.TagID `json:"tagId"`
 }
 
+// AssignTodoRequest defines model for AssignTodoRequest.
+type AssignTodoRequest struct {
+	AssigneeId userDomain.UserID `json:"assigneeId"`
+}
+
+// AuditAggregateType defines model for AuditAggregateType.
+type AuditAggregateType string
+
+// AuditChainBreak defines model for AuditChainBreak.
+type AuditChainBreak struct {
+	// AggregateId Aggregate of the broken chain. Set for global chains only.
+	AggregateId *openapi_types.UUID `json:"aggregateId,omitempty"`
+
+	// AggregateType Aggregate of the broken chain. Set for global chains only.
+	AggregateType *AuditAggregateType `json:"aggregateType,omitempty"`
+	LogId         openapi_types.UUID  `json:"logId"`
+
+	// Position 1-based position of the entry in the chain.
+	Position int `json:"position"`
+
+	// Reason PREVIOUS_HASH_MISMATCH means an earlier entry was removed, inserted or reordered. HASH_MISMATCH means this entry was edited. UNRECORDED_ERASURE means this entry looks erased but no later ERASE entry of the chain records its current content, so it may have been edited.
+	Reason AuditChainBreakReason `json:"reason"`
+}
+
+// AuditChainBreakReason PREVIOUS_HASH_MISMATCH means an earlier entry was removed, inserted or reordered. HASH_MISMATCH means this entry was edited. UNRECORDED_ERASURE means this entry looks erased but no later ERASE entry of the chain records its current content, so it may have been edited.
+type AuditChainBreakReason string
+
+// AuditChainVerification defines model for AuditChainVerification.
+type AuditChainVerification struct {
+	CheckedEntries  int              `json:"checkedEntries"`
+	FirstBrokenLink *AuditChainBreak `json:"firstBrokenLink"`
+	Valid           bool             `json:"valid"`
+}
+
+// AuditFieldChange Sensitive values are replaced by "[REDACTED]".
+type AuditFieldChange struct {
+	// After Unset for deleted entities.
+	After interface{} `json:"after"`
+
+	// Before Unset for created entities.
+	Before interface{} `json:"before"`
+	Field  string      `json:"field"`
+}
+
+// AuditLog defines model for AuditLog.
+type AuditLog struct {
+	// ActorId Unset for changes made by the system.
+	ActorId       *openapi_types.UUID `json:"actorId"`
+	AggregateId   openapi_types.UUID  `json:"aggregateId"`
+	AggregateType AuditAggregateType  `json:"aggregateType"`
+	CausationId   string              `json:"causationId"`
+
+	// Changes Fields that differ, ordered by name.
+	Changes       []AuditFieldChange `json:"changes"`
+	CorrelationId string             `json:"correlationId"`
+	Id            openapi_types.UUID `json:"id"`
+	OccurredAt    time.Time          `json:"occurredAt"`
+	Operation     AuditOperation     `json:"operation"`
+}
+
+// AuditOperation defines model for AuditOperation.
+type AuditOperation string
+
+// AutoPlanResult defines model for AutoPlanResult.
+type AutoPlanResult struct {
+	Planned []todoDomain.TodoID `json:"planned"`
+}
+
+// ChecklistItem defines model for ChecklistItem.
+type ChecklistItem struct {
+	Done     bool                       `json:"done"`
+	Id       todoDomain.ChecklistItemID `json:"id"`
+	Position int                        `json:"position"`
+
+	// Required Required items must be done before the todo can be completed.
+	Required bool   `json:"required"`
+	Title    string `json:"title"`
+}
+
+// Comment defines model for Comment.
+type Comment struct {
+	AuthorId  userDomain.UserID    `json:"authorId"`
+	Body      string               `json:"body"`
+	CreatedAt time.Time            `json:"createdAt"`
+	EditedAt  *time.Time           `json:"editedAt"`
+	Id        todoDomain.CommentID `json:"id"`
+	Mentions  []userDomain.UserID  `json:"mentions"`
+	TodoId    todoDomain.TodoID    `json:"todoId"`
+}
+
+// CommentRequest defines model for CommentRequest.
+type CommentRequest struct {
+	Body string `json:"body"`
+}
+
 // CommitTaskRequest defines model for CommitTaskRequest.
 type CommitTaskRequest struct {
-	Cost   int                `json:"cost"`
-	Date   openapi_types.Date `json:"date"`
-	TodoId openapi_types.UUID `json:"todoId"`
+	Cost int `json:"cost"`
+
+	// Date Calendar day to commit to. Defaults to today in the user's timezone.
+	Date   *openapi_types.Date `json:"date,omitempty"`
+	TodoId openapi_types.UUID  `json:"todoId"`
 }
 
 // CompletionLog defines model for CompletionLog.
@@ -67,7 +235,8 @@ type CompletionLog struct {
 
 // CreateTagRequest defines model for CreateTagRequest.
 type CreateTagRequest struct {
-	Name string `json:"name"`
+	Color *string `json:"color,omitempty"`
+	Name  string  `json:"name"`
 }
 
 // CreateTodoRequest defines model for CreateTodoRequest.
@@ -75,14 +244,72 @@ type CreateTodoRequest struct {
 	DueDate            *time.Time          `json:"dueDate"`
 	RecurrenceAmount   *int                `json:"recurrenceAmount"`
 	RecurrenceInterval *RecurrenceInterval `json:"recurrenceInterval"`
-	Title              string              `json:"title"`
+
+	// RecurrenceRule RFC 5545 RRULE subset (FREQ, INTERVAL, BYDAY, BYMONTHDAY, BYSETPOS, COUNT, UNTIL) plus a TZID extension, e.g. FREQ=MONTHLY;BYDAY=TU;BYSETPOS=2;TZID=Europe/Madrid. Mutually exclusive with recurrenceInterval and recurrenceAmount.
+	RecurrenceRule *RecurrenceRule `json:"recurrenceRule"`
+	Title          string          `json:"title"`
+}
+
+// DataExport defines model for DataExport.
+type DataExport struct {
+	CompletedAt *time.Time              `json:"completedAt,omitempty"`
+	Id          userDomain.DataExportID `json:"id"`
+	RequestedAt time.Time               `json:"requestedAt"`
+	Status      DataExportStatus        `json:"status"`
+}
+
+// DataExportStatus defines model for DataExportStatus.
+type DataExportStatus string
+
+// DisableTOTPRequestBody defines model for DisableTOTPRequestBody.
+type DisableTOTPRequestBody struct {
+	Code     string                 `json:"code"`
+	Password secrecy.Secret[string] `json:"password"`
+}
+
+// FocusReport defines model for FocusReport.
+type FocusReport struct {
+	AverageSessionSeconds int64 `json:"averageSessionSeconds"`
+
+	// CurrentStreakDays Consecutive days with focus up to the last day of the range, or the day before it.
+	CurrentStreakDays int                `json:"currentStreakDays"`
+	GroupBy           FocusReportGroupBy `json:"groupBy"`
+	Groups            []FocusReportGroup `json:"groups"`
+	LongestStreakDays int                `json:"longestStreakDays"`
+	SessionCount      int64              `json:"sessionCount"`
+	TotalSeconds      int64              `json:"totalSeconds"`
+}
+
+// FocusReportGroupBy defines model for FocusReport.GroupBy.
+type FocusReportGroupBy string
+
+// FocusReportGroup defines model for FocusReportGroup.
+type FocusReportGroup struct {
+	AverageSessionSeconds int64 `json:"averageSessionSeconds"`
+
+	// Key Todo, tag or member ID, or a YYYY-MM-DD day.
+	Key string `json:"key"`
+
+	// Label Todo title, tag name, member name or the day.
+	Label        string `json:"label"`
+	SessionCount int64  `json:"sessionCount"`
+	TotalSeconds int64  `json:"totalSeconds"`
 }
 
 // FocusSession defines model for FocusSession.
 type FocusSession struct {
-	EndTime   *time.Time         `json:"endTime"`
-	Id        openapi_types.UUID `json:"id"`
-	StartTime time.Time          `json:"startTime"`
+	EndTime                *time.Time         `json:"endTime"`
+	Id                     openapi_types.UUID `json:"id"`
+	PlannedDurationSeconds *int               `json:"plannedDurationSeconds"`
+	StartTime              time.Time          `json:"startTime"`
+}
+
+// GlobalAuditChainVerification defines model for GlobalAuditChainVerification.
+type GlobalAuditChainVerification struct {
+	CheckedChains   int              `json:"checkedChains"`
+	CheckedEntries  int              `json:"checkedEntries"`
+	FirstBrokenLink *AuditChainBreak `json:"firstBrokenLink"`
+	Valid           bool             `json:"valid"`
 }
 
 // HTTPValidationError defines model for HTTPValidationError.
@@ -109,7 +336,13 @@ type LoginRequestBody struct {
 
 // LoginResponseBody defines model for LoginResponseBody.
 type LoginResponseBody struct {
-	AccessToken string `json:"accessToken"`
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+// LogoutRequestBody defines model for LogoutRequestBody.
+type LogoutRequestBody struct {
+	RefreshToken *secrecy.Secret[string] `json:"refreshToken,omitempty"`
 }
 
 // OnboardWorkspaceRequest defines model for OnboardWorkspaceRequest.
@@ -124,9 +357,32 @@ type OnboardWorkspaceRequest struct {
 	Name string `json:"name"`
 }
 
+// ReauthenticateRequestBody defines model for ReauthenticateRequestBody.
+type ReauthenticateRequestBody struct {
+	Password secrecy.Secret[string] `json:"password"`
+}
+
+// ReauthenticateResponseBody defines model for ReauthenticateResponseBody.
+type ReauthenticateResponseBody struct {
+	AccessToken string `json:"accessToken"`
+}
+
+// RecoverTOTPRequestBody defines model for RecoverTOTPRequestBody.
+type RecoverTOTPRequestBody struct {
+	Code string `json:"code"`
+}
+
 // RecurrenceInterval defines model for RecurrenceInterval.
 type RecurrenceInterval string
 
+// RecurrenceRule RFC 5545 RRULE subset (FREQ, INTERVAL, BYDAY, BYMONTHDAY, BYSETPOS, COUNT, UNTIL) plus a TZID extension, e.g. FREQ=MONTHLY;BYDAY=TU;BYSETPOS=2;TZID=Europe/Madrid. Mutually exclusive with recurrenceInterval and recurrenceAmount.
+type RecurrenceRule = string
+
+// RefreshSessionRequestBody defines model for RefreshSessionRequestBody.
+type RefreshSessionRequestBody struct {
+	RefreshToken secrecy.Secret[string] `json:"refreshToken"`
+}
+
 // RegisterUserRequestBody defines model for RegisterUserRequestBody.
 type RegisterUserRequestBody struct {
 	Email    openapi_types.Email    `json:"email"`
@@ -134,34 +390,134 @@ type RegisterUserRequestBody struct {
 	Password secrecy.Secret[string] `json:"password"`
 }
 
+// ReorderChecklistRequest defines model for ReorderChecklistRequest.
+type ReorderChecklistRequest struct {
+	// ItemIds Every checklist item ID of the todo, in the new order.
+	ItemIds []todoDomain.ChecklistItemID `json:"itemIds"`
+}
+
+// Schedule defines model for Schedule.
+type Schedule struct {
+	// Completed Energy of committed tasks that are done.
+	Completed int                `json:"completed"`
+	Date      openapi_types.Date `json:"date"`
+
+	// Load Total energy committed to the day.
+	Load        int `json:"load"`
+	MaxCapacity int `json:"maxCapacity"`
+
+	// Remaining Energy of committed tasks still pending.
+	Remaining int             `json:"remaining"`
+	Tasks     []ScheduledTask `json:"tasks"`
+}
+
+// ScheduledTask defines model for ScheduledTask.
+type ScheduledTask struct {
+	CompletedAt *time.Time        `json:"completedAt"`
+	Cost        int               `json:"cost"`
+	TodoId      todoDomain.TodoID `json:"todoId"`
+}
+
+// SetTodoDueDateRequest defines model for SetTodoDueDateRequest.
+type SetTodoDueDateRequest struct {
+	DueDate *time.Time `json:"dueDate"`
+}
+
+// SetTodoRecurrenceRequest defines model for SetTodoRecurrenceRequest.
+type SetTodoRecurrenceRequest struct {
+	RecurrenceAmount   *int                `json:"recurrenceAmount"`
+	RecurrenceInterval *RecurrenceInterval `json:"recurrenceInterval"`
+
+	// RecurrenceRule RFC 5545 RRULE subset (FREQ, INTERVAL, BYDAY, BYMONTHDAY, BYSETPOS, COUNT, UNTIL) plus a TZID extension, e.g. FREQ=MONTHLY;BYDAY=TU;BYSETPOS=2;TZID=Europe/Madrid. Mutually exclusive with recurrenceInterval and recurrenceAmount.
+	RecurrenceRule *RecurrenceRule `json:"recurrenceRule"`
+}
+
+// SetUserDailyCapacityRequest defines model for SetUserDailyCapacityRequest.
+type SetUserDailyCapacityRequest struct {
+	Capacity int `json:"capacity"`
+}
+
+// SetUserTimezoneRequest defines model for SetUserTimezoneRequest.
+type SetUserTimezoneRequest struct {
+	Timezone string `json:"timezone"`
+}
+
+// StartFocusRequest defines model for StartFocusRequest.
+type StartFocusRequest struct {
+	// PlannedDurationSeconds Pomodoro length. The session runs until stopped when omitted.
+	PlannedDurationSeconds *int `json:"plannedDurationSeconds,omitempty"`
+}
+
 // Tag defines model for Tag.
 type Tag struct {
-	Id   todoDomain.TagID `json:"id"`
-	Name string           `json:"name"`
+	Color string           `json:"color"`
+	Id    todoDomain.TagID `json:"id"`
+	Name  string           `json:"name"`
 }
 
 // Todo defines model for Todo.
 type Todo struct {
-	CompletionLogs     *[]CompletionLog            `json:"completionLogs,omitempty"`
-	CreatedAt          time.Time                   `json:"createdAt"`
-	DueDate            *time.Time                  `json:"dueDate"`
-	FocusSessions      *[]FocusSession             `json:"focusSessions,omitempty"`
-	Id                 todoDomain.TodoID           `json:"id"`
-	RecurrenceAmount   *int                        `json:"recurrenceAmount"`
-	RecurrenceInterval *RecurrenceInterval         `json:"recurrenceInterval"`
-	Status             TodoStatus                  `json:"status"`
-	Title              string                      `json:"title"`
-	WorkspaceId        workspaceDomain.WorkspaceID `json:"workspaceId"`
+	AssigneeId *userDomain.UserID `json:"assigneeId"`
+
+	// BlockedBy Todos that must be completed before this one can be started or completed.
+	BlockedBy          *[]todoDomain.TodoID `json:"blockedBy,omitempty"`
+	ChecklistItems     *[]ChecklistItem     `json:"checklistItems,omitempty"`
+	CompletionLogs     *[]CompletionLog     `json:"completionLogs,omitempty"`
+	CreatedAt          time.Time            `json:"createdAt"`
+	DueDate            *time.Time           `json:"dueDate"`
+	FocusSessions      *[]FocusSession      `json:"focusSessions,omitempty"`
+	Id                 todoDomain.TodoID    `json:"id"`
+	LastCompletedAt    *time.Time           `json:"lastCompletedAt"`
+	RecurrenceAmount   *int                 `json:"recurrenceAmount"`
+	RecurrenceInterval *RecurrenceInterval  `json:"recurrenceInterval"`
+
+	// RecurrenceRule RFC 5545 RRULE subset (FREQ, INTERVAL, BYDAY, BYMONTHDAY, BYSETPOS, COUNT, UNTIL) plus a TZID extension, e.g. FREQ=MONTHLY;BYDAY=TU;BYSETPOS=2;TZID=Europe/Madrid. Mutually exclusive with recurrenceInterval and recurrenceAmount.
+	RecurrenceRule *RecurrenceRule             `json:"recurrenceRule"`
+	Status         TodoStatus                  `json:"status"`
+	Title          string                      `json:"title"`
+	WorkspaceId    workspaceDomain.WorkspaceID `json:"workspaceId"`
+}
+
+// TodoSearchResult defines model for TodoSearchResult.
+type TodoSearchResult struct {
+	CreatedAt time.Time  `json:"createdAt"`
+	DueDate   *time.Time `json:"dueDate"`
+
+	// Highlight HTML-escaped title with matched terms wrapped in <mark> tags.
+	Highlight   string                      `json:"highlight"`
+	Id          todoDomain.TodoID           `json:"id"`
+	Rank        float32                     `json:"rank"`
+	Status      TodoStatus                  `json:"status"`
+	Title       string                      `json:"title"`
+	WorkspaceId workspaceDomain.WorkspaceID `json:"workspaceId"`
 }
 
 // TodoStatus defines model for TodoStatus.
 type TodoStatus string
 
+// UpdateScheduleCapacityRequest defines model for UpdateScheduleCapacityRequest.
+type UpdateScheduleCapacityRequest struct {
+	Capacity int `json:"capacity"`
+}
+
+// UpdateTagRequest defines model for UpdateTagRequest.
+type UpdateTagRequest struct {
+	Color *string `json:"color,omitempty"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// UpdateTodoRequest defines model for UpdateTodoRequest.
+type UpdateTodoRequest struct {
+	Title *string `json:"title,omitempty"`
+}
+
 // User defines model for User.
 type User struct {
-	Email string            `json:"email"`
-	Id    userDomain.UserID `json:"id"`
-	Name  string            `json:"name"`
+	DailyCapacity int               `json:"dailyCapacity"`
+	Email         string            `json:"email"`
+	Id            userDomain.UserID `json:"id"`
+	Name          string            `json:"name"`
+	Timezone      string            `json:"timezone"`
 }
 
 // ValidationError defines model for ValidationError.
@@ -185,6 +541,13 @@ type VerifyTOTPRequestBody struct {
 	Code string `json:"code"`
 }
 
+// VerifyTOTPResponseBody defines model for VerifyTOTPResponseBody.
+type VerifyTOTPResponseBody struct {
+	AccessToken   string    `json:"accessToken"`
+	RecoveryCodes *[]string `json:"recoveryCodes,omitempty"`
+	RefreshToken  string    `json:"refreshToken"`
+}
+
 // Workspace defines model for Workspace.
 type Workspace struct {
 	Description string                      `json:"description"`
@@ -204,6 +567,15 @@ type Limit = int
 // Offset defines model for Offset.
 type Offset = int
 
+// ReportFrom defines model for ReportFrom.
+type ReportFrom = openapi_types.Date
+
+// ReportTo defines model for ReportTo.
+type ReportTo = openapi_types.Date
+
+// ScheduleDate defines model for ScheduleDate.
+type ScheduleDate = openapi_types.Date
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error *struct {
@@ -220,18 +592,126 @@ type RegisterParams struct {
 	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
+// UpdateScheduleCapacityParams defines parameters for UpdateScheduleCapacity.
+type UpdateScheduleCapacityParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// AutoPlanScheduleParams defines parameters for AutoPlanSchedule.
+type AutoPlanScheduleParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// RemoveScheduledTaskParams defines parameters for RemoveScheduledTask.
+type RemoveScheduledTaskParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// UpdateTodoParams defines parameters for UpdateTodo.
+type UpdateTodoParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// ArchiveTodoParams defines parameters for ArchiveTodo.
+type ArchiveTodoParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// AddChecklistItemParams defines parameters for AddChecklistItem.
+type AddChecklistItemParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// ToggleChecklistItemParams defines parameters for ToggleChecklistItem.
+type ToggleChecklistItemParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetTodoCommentsParams defines parameters for GetTodoComments.
+type GetTodoCommentsParams struct {
+	// Limit Maximum number of records to return.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of records to skip.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
 // CompleteTodoParams defines parameters for CompleteTodo.
 type CompleteTodoParams struct {
 	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
 	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
+// SetTodoDueDateParams defines parameters for SetTodoDueDate.
+type SetTodoDueDateParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// SetTodoRecurrenceParams defines parameters for SetTodoRecurrence.
+type SetTodoRecurrenceParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// ReopenTodoParams defines parameters for ReopenTodo.
+type ReopenTodoParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // AssignTagToTodoParams defines parameters for AssignTagToTodo.
 type AssignTagToTodoParams struct {
 	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
 	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
+// RemoveTagFromTodoParams defines parameters for RemoveTagFromTodo.
+type RemoveTagFromTodoParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// UnarchiveTodoParams defines parameters for UnarchiveTodo.
+type UnarchiveTodoParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetUserAssignedTodosParams defines parameters for GetUserAssignedTodos.
+type GetUserAssignedTodosParams struct {
+	// Limit Maximum number of records to return.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of records to skip.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// SetUserDailyCapacityParams defines parameters for SetUserDailyCapacity.
+type SetUserDailyCapacityParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// RequestUserDataExportParams defines parameters for RequestUserDataExport.
+type RequestUserDataExportParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// SetUserTimezoneParams defines parameters for SetUserTimezone.
+type SetUserTimezoneParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // GetUserWorkspacesParams defines parameters for GetUserWorkspaces.
 type GetUserWorkspacesParams struct {
 	// Limit Maximum number of records to return.
@@ -256,18 +736,68 @@ type OnboardWorkspaceParams struct {
 	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
+// GetWorkspaceAuditLogsParams defines parameters for GetWorkspaceAuditLogs.
+type GetWorkspaceAuditLogsParams struct {
+	AggregateType *AuditAggregateType `form:"aggregateType,omitempty" json:"aggregateType,omitempty"`
+	AggregateID   *openapi_types.UUID `form:"aggregateId,omitempty" json:"aggregateId,omitempty"`
+
+	// ActorID Only include changes made by this user.
+	ActorID   *openapi_types.UUID `form:"actorId,omitempty" json:"actorId,omitempty"`
+	Operation *AuditOperation     `form:"operation,omitempty" json:"operation,omitempty"`
+
+	// From Earliest change time, inclusive.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Latest change time, exclusive.
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit Maximum number of records to return.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of records to skip.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
 // AddWorkspaceMemberParams defines parameters for AddWorkspaceMember.
 type AddWorkspaceMemberParams struct {
 	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
 	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
+// GetWorkspaceFocusReportParams defines parameters for GetWorkspaceFocusReport.
+type GetWorkspaceFocusReportParams struct {
+	// From First day of the report, inclusive.
+	From ReportFrom `form:"from" json:"from"`
+
+	// To Last day of the report, inclusive. At most 366 days after from.
+	To      ReportTo                              `form:"to" json:"to"`
+	GroupBy *GetWorkspaceFocusReportParamsGroupBy `form:"groupBy,omitempty" json:"groupBy,omitempty"`
+
+	// UserID Only include sessions by this member.
+	UserID *openapi_types.UUID `form:"userId,omitempty" json:"userId,omitempty"`
+}
+
+// GetWorkspaceFocusReportParamsGroupBy defines parameters for GetWorkspaceFocusReport.
+type GetWorkspaceFocusReportParamsGroupBy string
+
 // CreateTagParams defines parameters for CreateTag.
 type CreateTagParams struct {
 	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
 	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
+// DeleteTagParams defines parameters for DeleteTag.
+type DeleteTagParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// UpdateTagParams defines parameters for UpdateTag.
+type UpdateTagParams struct {
+	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // GetWorkspaceTodosParams defines parameters for GetWorkspaceTodos.
 type GetWorkspaceTodosParams struct {
 	// Limit Maximum number of records to return.
@@ -275,29 +805,121 @@ type GetWorkspaceTodosParams struct {
 
 	// Offset Number of records to skip.
 	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Status Only return todos in any of these statuses.
+	Status *[]TodoStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// TagIds Only return todos having any of these tags.
+	TagIds *[]openapi_types.UUID `form:"tagIds,omitempty" json:"tagIds,omitempty"`
+
+	// DueBefore Only return todos due strictly before this instant.
+	DueBefore *time.Time `form:"dueBefore,omitempty" json:"dueBefore,omitempty"`
+
+	// DueAfter Only return todos due at or after this instant.
+	DueAfter *time.Time `form:"dueAfter,omitempty" json:"dueAfter,omitempty"`
+
+	// Overdue Filter on pending todos whose due date has passed.
+	Overdue *bool `form:"overdue,omitempty" json:"overdue,omitempty"`
+
+	// Recurring Filter on todos with a recurrence.
+	Recurring *bool `form:"recurring,omitempty" json:"recurring,omitempty"`
+
+	// Sort Sort key, prefixed with '-' for descending order. Todos without a due date sort last when ascending.
+	Sort *GetWorkspaceTodosParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Cursor Opaque cursor from X-Next-Cursor. Takes precedence over offset and must be used with the same sort.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
+// GetWorkspaceTodosParamsSort defines parameters for GetWorkspaceTodos.
+type GetWorkspaceTodosParamsSort string
+
 // CreateTodoParams defines parameters for CreateTodo.
 type CreateTodoParams struct {
 	// IdempotencyKey Unique key to allow safe retries of non-idempotent requests. If a request with the same key is received, the server returns the cached response.
 	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
+// SearchWorkspaceTodosParams defines parameters for SearchWorkspaceTodos.
+type SearchWorkspaceTodosParams struct {
+	Q string `form:"q" json:"q"`
+
+	// Limit Maximum number of records to return.
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of records to skip.
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
 type LoginJSONRequestBody = LoginRequestBody
 
+// LogoutJSONRequestBody defines body for Logout for application/json ContentType.
+type LogoutJSONRequestBody = LogoutRequestBody
+
+// ReauthenticateJSONRequestBody defines body for Reauthenticate for application/json ContentType.
+type ReauthenticateJSONRequestBody = ReauthenticateRequestBody
+
+// RefreshSessionJSONRequestBody defines body for RefreshSession for application/json ContentType.
+type RefreshSessionJSONRequestBody = RefreshSessionRequestBody
+
 // RegisterJSONRequestBody defines body for Register for application/json ContentType.
 type RegisterJSONRequestBody = RegisterUserRequestBody
 
+// DisableTOTPJSONRequestBody defines body for DisableTOTP for application/json ContentType.
+type DisableTOTPJSONRequestBody = DisableTOTPRequestBody
+
+// RecoverTOTPJSONRequestBody defines body for RecoverTOTP for application/json ContentType.
+type RecoverTOTPJSONRequestBody = RecoverTOTPRequestBody
+
 // VerifyTOTPJSONRequestBody defines body for VerifyTOTP for application/json ContentType.
 type VerifyTOTPJSONRequestBody = VerifyTOTPRequestBody
 
 // CommitTaskJSONRequestBody defines body for CommitTask for application/json ContentType.
 type CommitTaskJSONRequestBody = CommitTaskRequest
 
+// UpdateScheduleCapacityJSONRequestBody defines body for UpdateScheduleCapacity for application/json ContentType.
+type UpdateScheduleCapacityJSONRequestBody = UpdateScheduleCapacityRequest
+
+// UpdateTodoJSONRequestBody defines body for UpdateTodo for application/json ContentType.
+type UpdateTodoJSONRequestBody = UpdateTodoRequest
+
+// AssignTodoJSONRequestBody defines body for AssignTodo for application/json ContentType.
+type AssignTodoJSONRequestBody = AssignTodoRequest
+
+// AddTodoBlockerJSONRequestBody defines body for AddTodoBlocker for application/json ContentType.
+type AddTodoBlockerJSONRequestBody = AddTodoBlockerRequest
+
+// AddChecklistItemJSONRequestBody defines body for AddChecklistItem for application/json ContentType.
+type AddChecklistItemJSONRequestBody = AddChecklistItemRequest
+
+// ReorderChecklistJSONRequestBody defines body for ReorderChecklist for application/json ContentType.
+type ReorderChecklistJSONRequestBody = ReorderChecklistRequest
+
+// CreateTodoCommentJSONRequestBody defines body for CreateTodoComment for application/json ContentType.
+type CreateTodoCommentJSONRequestBody = CommentRequest
+
+// UpdateTodoCommentJSONRequestBody defines body for UpdateTodoComment for application/json ContentType.
+type UpdateTodoCommentJSONRequestBody = CommentRequest
+
+// SetTodoDueDateJSONRequestBody defines body for SetTodoDueDate for application/json ContentType.
+type SetTodoDueDateJSONRequestBody = SetTodoDueDateRequest
+
+// StartFocusJSONRequestBody defines body for StartFocus for application/json ContentType.
+type StartFocusJSONRequestBody = StartFocusRequest
+
+// SetTodoRecurrenceJSONRequestBody defines body for SetTodoRecurrence for application/json ContentType.
+type SetTodoRecurrenceJSONRequestBody = SetTodoRecurrenceRequest
+
 // AssignTagToTodoJSONRequestBody defines body for AssignTagToTodo for application/json ContentType.
 type AssignTagToTodoJSONRequestBody = AssignTagToTodoRequest
 
+// SetUserDailyCapacityJSONRequestBody defines body for SetUserDailyCapacity for application/json ContentType.
+type SetUserDailyCapacityJSONRequestBody = SetUserDailyCapacityRequest
+
+// SetUserTimezoneJSONRequestBody defines body for SetUserTimezone for application/json ContentType.
+type SetUserTimezoneJSONRequestBody = SetUserTimezoneRequest
+
 // OnboardWorkspaceJSONRequestBody defines body for OnboardWorkspace for application/json ContentType.
 type OnboardWorkspaceJSONRequestBody = OnboardWorkspaceRequest
 
@@ -307,6 +929,9 @@ type AddWorkspaceMemberJSONRequestBody = AddWorkspaceMemberRequest
 // CreateTagJSONRequestBody defines body for CreateTag for application/json ContentType.
 type CreateTagJSONRequestBody = CreateTagRequest
 
+// UpdateTagJSONRequestBody defines body for UpdateTag for application/json ContentType.
+type UpdateTagJSONRequestBody = UpdateTagRequest
+
 // CreateTodoJSONRequestBody defines body for CreateTodo for application/json ContentType.
 type CreateTodoJSONRequestBody = CreateTodoRequest
 
@@ -383,19 +1008,50 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// VerifyGlobalAuditChains request
+	VerifyGlobalAuditChains(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// LoginWithBody request with any body
 	LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	Login(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// RegisterWithBody request with any body
-	RegisterWithBody(ctx context.Context, params *RegisterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// LogoutWithBody request with any body
+	LogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	Register(ctx context.Context, params *RegisterParams, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	Logout(ctx context.Context, body LogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// LogoutAll request
+	LogoutAll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReauthenticateWithBody request with any body
+	ReauthenticateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Reauthenticate(ctx context.Context, body ReauthenticateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RefreshSessionWithBody request with any body
+	RefreshSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RefreshSession(ctx context.Context, body RefreshSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RegisterWithBody request with any body
+	RegisterWithBody(ctx context.Context, params *RegisterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Register(ctx context.Context, params *RegisterParams, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DisableTOTPWithBody request with any body
+	DisableTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DisableTOTP(ctx context.Context, body DisableTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// InitiateTOTP request
 	InitiateTOTP(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// RecoverTOTPWithBody request with any body
+	RecoverTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RecoverTOTP(ctx context.Context, body RecoverTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// VerifyTOTPWithBody request with any body
 	VerifyTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -409,26 +1065,145 @@ type ClientInterface interface {
 
 	CommitTask(ctx context.Context, body CommitTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetSchedule request
+	GetSchedule(ctx context.Context, date ScheduleDate, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateScheduleCapacityWithBody request with any body
+	UpdateScheduleCapacityWithBody(ctx context.Context, date ScheduleDate, params *UpdateScheduleCapacityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateScheduleCapacity(ctx context.Context, date ScheduleDate, params *UpdateScheduleCapacityParams, body UpdateScheduleCapacityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AutoPlanSchedule request
+	AutoPlanSchedule(ctx context.Context, date ScheduleDate, params *AutoPlanScheduleParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveScheduledTask request
+	RemoveScheduledTask(ctx context.Context, date ScheduleDate, todoId todoDomain.TodoID, params *RemoveScheduledTaskParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetTodoByID request
 	GetTodoByID(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// UpdateTodoWithBody request with any body
+	UpdateTodoWithBody(ctx context.Context, id todoDomain.TodoID, params *UpdateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTodo(ctx context.Context, id todoDomain.TodoID, params *UpdateTodoParams, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ArchiveTodo request
+	ArchiveTodo(ctx context.Context, id todoDomain.TodoID, params *ArchiveTodoParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnassignTodo request
+	UnassignTodo(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AssignTodoWithBody request with any body
+	AssignTodoWithBody(ctx context.Context, id todoDomain.TodoID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AssignTodo(ctx context.Context, id todoDomain.TodoID, body AssignTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddTodoBlockerWithBody request with any body
+	AddTodoBlockerWithBody(ctx context.Context, id todoDomain.TodoID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddTodoBlocker(ctx context.Context, id todoDomain.TodoID, body AddTodoBlockerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveTodoBlocker request
+	RemoveTodoBlocker(ctx context.Context, id todoDomain.TodoID, blockerId todoDomain.TodoID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddChecklistItemWithBody request with any body
+	AddChecklistItemWithBody(ctx context.Context, id todoDomain.TodoID, params *AddChecklistItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddChecklistItem(ctx context.Context, id todoDomain.TodoID, params *AddChecklistItemParams, body AddChecklistItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReorderChecklistWithBody request with any body
+	ReorderChecklistWithBody(ctx context.Context, id todoDomain.TodoID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReorderChecklist(ctx context.Context, id todoDomain.TodoID, body ReorderChecklistJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveChecklistItem request
+	RemoveChecklistItem(ctx context.Context, id todoDomain.TodoID, itemId todoDomain.ChecklistItemID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ToggleChecklistItem request
+	ToggleChecklistItem(ctx context.Context, id todoDomain.TodoID, itemId todoDomain.ChecklistItemID, params *ToggleChecklistItemParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTodoComments request
+	GetTodoComments(ctx context.Context, id todoDomain.TodoID, params *GetTodoCommentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTodoCommentWithBody request with any body
+	CreateTodoCommentWithBody(ctx context.Context, id todoDomain.TodoID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTodoComment(ctx context.Context, id todoDomain.TodoID, body CreateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTodoComment request
+	DeleteTodoComment(ctx context.Context, id todoDomain.TodoID, commentId todoDomain.CommentID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTodoCommentWithBody request with any body
+	UpdateTodoCommentWithBody(ctx context.Context, id todoDomain.TodoID, commentId todoDomain.CommentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTodoComment(ctx context.Context, id todoDomain.TodoID, commentId todoDomain.CommentID, body UpdateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// CompleteTodo request
 	CompleteTodo(ctx context.Context, id todoDomain.TodoID, params *CompleteTodoParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// StartFocus request
-	StartFocus(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// SetTodoDueDateWithBody request with any body
+	SetTodoDueDateWithBody(ctx context.Context, id todoDomain.TodoID, params *SetTodoDueDateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetTodoDueDate(ctx context.Context, id todoDomain.TodoID, params *SetTodoDueDateParams, body SetTodoDueDateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StartFocusWithBody request with any body
+	StartFocusWithBody(ctx context.Context, id todoDomain.TodoID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	StartFocus(ctx context.Context, id todoDomain.TodoID, body StartFocusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// StopFocus request
 	StopFocus(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// SetTodoRecurrenceWithBody request with any body
+	SetTodoRecurrenceWithBody(ctx context.Context, id todoDomain.TodoID, params *SetTodoRecurrenceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetTodoRecurrence(ctx context.Context, id todoDomain.TodoID, params *SetTodoRecurrenceParams, body SetTodoRecurrenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReopenTodo request
+	ReopenTodo(ctx context.Context, id todoDomain.TodoID, params *ReopenTodoParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AssignTagToTodoWithBody request with any body
 	AssignTagToTodoWithBody(ctx context.Context, id todoDomain.TodoID, params *AssignTagToTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	AssignTagToTodo(ctx context.Context, id todoDomain.TodoID, params *AssignTagToTodoParams, body AssignTagToTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// RemoveTagFromTodo request
+	RemoveTagFromTodo(ctx context.Context, id todoDomain.TodoID, tagId todoDomain.TagID, params *RemoveTagFromTodoParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnarchiveTodo request
+	UnarchiveTodo(ctx context.Context, id todoDomain.TodoID, params *UnarchiveTodoParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUser request
+	DeleteUser(ctx context.Context, id userDomain.UserID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetUserByID request
 	GetUserByID(ctx context.Context, id userDomain.UserID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetUserAssignedTodos request
+	GetUserAssignedTodos(ctx context.Context, id userDomain.UserID, params *GetUserAssignedTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetUserDailyCapacityWithBody request with any body
+	SetUserDailyCapacityWithBody(ctx context.Context, id userDomain.UserID, params *SetUserDailyCapacityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetUserDailyCapacity(ctx context.Context, id userDomain.UserID, params *SetUserDailyCapacityParams, body SetUserDailyCapacityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RequestUserDataExport request
+	RequestUserDataExport(ctx context.Context, id userDomain.UserID, params *RequestUserDataExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserDataExport request
+	GetUserDataExport(ctx context.Context, id userDomain.UserID, exportId userDomain.DataExportID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DownloadUserDataExport request
+	DownloadUserDataExport(ctx context.Context, id userDomain.UserID, exportId userDomain.DataExportID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetUserTimezoneWithBody request with any body
+	SetUserTimezoneWithBody(ctx context.Context, id userDomain.UserID, params *SetUserTimezoneParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetUserTimezone(ctx context.Context, id userDomain.UserID, params *SetUserTimezoneParams, body SetUserTimezoneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ResetUserTOTP request
+	ResetUserTOTP(ctx context.Context, id userDomain.UserID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetUserWorkspaces request
 	GetUserWorkspaces(ctx context.Context, id userDomain.UserID, params *GetUserWorkspacesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -443,6 +1218,12 @@ type ClientInterface interface {
 	// DeleteWorkspace request
 	DeleteWorkspace(ctx context.Context, id workspaceDomain.WorkspaceID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetWorkspaceAuditLogs request
+	GetWorkspaceAuditLogs(ctx context.Context, id workspaceDomain.WorkspaceID, params *GetWorkspaceAuditLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerifyWorkspaceAuditChain request
+	VerifyWorkspaceAuditChain(ctx context.Context, id workspaceDomain.WorkspaceID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// AddWorkspaceMemberWithBody request with any body
 	AddWorkspaceMemberWithBody(ctx context.Context, id workspaceDomain.WorkspaceID, params *AddWorkspaceMemberParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -451,6 +1232,9 @@ type ClientInterface interface {
 	// RemoveWorkspaceMember request
 	RemoveWorkspaceMember(ctx context.Context, id workspaceDomain.WorkspaceID, userId userDomain.UserID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetWorkspaceFocusReport request
+	GetWorkspaceFocusReport(ctx context.Context, id workspaceDomain.WorkspaceID, params *GetWorkspaceFocusReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetWorkspaceTags request
 	GetWorkspaceTags(ctx context.Context, id workspaceDomain.WorkspaceID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -459,6 +1243,14 @@ type ClientInterface interface {
 
 	CreateTag(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTagParams, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteTag request
+	DeleteTag(ctx context.Context, id workspaceDomain.WorkspaceID, tagId todoDomain.TagID, params *DeleteTagParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTagWithBody request with any body
+	UpdateTagWithBody(ctx context.Context, id workspaceDomain.WorkspaceID, tagId todoDomain.TagID, params *UpdateTagParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTag(ctx context.Context, id workspaceDomain.WorkspaceID, tagId todoDomain.TagID, params *UpdateTagParams, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetWorkspaceTodos request
 	GetWorkspaceTodos(ctx context.Context, id workspaceDomain.WorkspaceID, params *GetWorkspaceTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -466,6 +1258,21 @@ type ClientInterface interface {
 	CreateTodoWithBody(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	CreateTodo(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTodoParams, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchWorkspaceTodos request
+	SearchWorkspaceTodos(ctx context.Context, id workspaceDomain.WorkspaceID, params *SearchWorkspaceTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) VerifyGlobalAuditChains(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyGlobalAuditChainsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
 func (c *Client) LoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -492,8 +1299,8 @@ func (c *Client) Login(ctx context.Context, body LoginJSONRequestBody, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) RegisterWithBody(ctx context.Context, params *RegisterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRegisterRequestWithBody(c.Server, params, contentType, body)
+func (c *Client) LogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogoutRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -504,8 +1311,8 @@ func (c *Client) RegisterWithBody(ctx context.Context, params *RegisterParams, c
 	return c.Client.Do(req)
 }
 
-func (c *Client) Register(ctx context.Context, params *RegisterParams, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRegisterRequest(c.Server, params, body)
+func (c *Client) Logout(ctx context.Context, body LogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogoutRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -516,8 +1323,8 @@ func (c *Client) Register(ctx context.Context, params *RegisterParams, body Regi
 	return c.Client.Do(req)
 }
 
-func (c *Client) InitiateTOTP(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewInitiateTOTPRequest(c.Server)
+func (c *Client) LogoutAll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewLogoutAllRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -528,8 +1335,8 @@ func (c *Client) InitiateTOTP(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
-func (c *Client) VerifyTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewVerifyTOTPRequestWithBody(c.Server, contentType, body)
+func (c *Client) ReauthenticateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReauthenticateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -540,8 +1347,8 @@ func (c *Client) VerifyTOTPWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) VerifyTOTP(ctx context.Context, body VerifyTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewVerifyTOTPRequest(c.Server, body)
+func (c *Client) Reauthenticate(ctx context.Context, body ReauthenticateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReauthenticateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -552,8 +1359,8 @@ func (c *Client) VerifyTOTP(ctx context.Context, body VerifyTOTPJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) Healthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewHealthzRequest(c.Server)
+func (c *Client) RefreshSessionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshSessionRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -564,8 +1371,8 @@ func (c *Client) Healthz(ctx context.Context, reqEditors ...RequestEditorFn) (*h
 	return c.Client.Do(req)
 }
 
-func (c *Client) CommitTaskWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCommitTaskRequestWithBody(c.Server, contentType, body)
+func (c *Client) RefreshSession(ctx context.Context, body RefreshSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRefreshSessionRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -576,8 +1383,8 @@ func (c *Client) CommitTaskWithBody(ctx context.Context, contentType string, bod
 	return c.Client.Do(req)
 }
 
-func (c *Client) CommitTask(ctx context.Context, body CommitTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCommitTaskRequest(c.Server, body)
+func (c *Client) RegisterWithBody(ctx context.Context, params *RegisterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -588,8 +1395,8 @@ func (c *Client) CommitTask(ctx context.Context, body CommitTaskJSONRequestBody,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetTodoByID(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetTodoByIDRequest(c.Server, id)
+func (c *Client) Register(ctx context.Context, params *RegisterParams, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRegisterRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -600,8 +1407,8 @@ func (c *Client) GetTodoByID(ctx context.Context, id todoDomain.TodoID, reqEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) CompleteTodo(ctx context.Context, id todoDomain.TodoID, params *CompleteTodoParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCompleteTodoRequest(c.Server, id, params)
+func (c *Client) DisableTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableTOTPRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -612,8 +1419,8 @@ func (c *Client) CompleteTodo(ctx context.Context, id todoDomain.TodoID, params
 	return c.Client.Do(req)
 }
 
-func (c *Client) StartFocus(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStartFocusRequest(c.Server, id)
+func (c *Client) DisableTOTP(ctx context.Context, body DisableTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDisableTOTPRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -624,8 +1431,8 @@ func (c *Client) StartFocus(ctx context.Context, id todoDomain.TodoID, reqEditor
 	return c.Client.Do(req)
 }
 
-func (c *Client) StopFocus(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewStopFocusRequest(c.Server, id)
+func (c *Client) InitiateTOTP(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewInitiateTOTPRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -636,8 +1443,8 @@ func (c *Client) StopFocus(ctx context.Context, id todoDomain.TodoID, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) AssignTagToTodoWithBody(ctx context.Context, id todoDomain.TodoID, params *AssignTagToTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAssignTagToTodoRequestWithBody(c.Server, id, params, contentType, body)
+func (c *Client) RecoverTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecoverTOTPRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -648,8 +1455,8 @@ func (c *Client) AssignTagToTodoWithBody(ctx context.Context, id todoDomain.Todo
 	return c.Client.Do(req)
 }
 
-func (c *Client) AssignTagToTodo(ctx context.Context, id todoDomain.TodoID, params *AssignTagToTodoParams, body AssignTagToTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAssignTagToTodoRequest(c.Server, id, params, body)
+func (c *Client) RecoverTOTP(ctx context.Context, body RecoverTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecoverTOTPRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -660,8 +1467,8 @@ func (c *Client) AssignTagToTodo(ctx context.Context, id todoDomain.TodoID, para
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUserByID(ctx context.Context, id userDomain.UserID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUserByIDRequest(c.Server, id)
+func (c *Client) VerifyTOTPWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyTOTPRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -672,8 +1479,8 @@ func (c *Client) GetUserByID(ctx context.Context, id userDomain.UserID, reqEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetUserWorkspaces(ctx context.Context, id userDomain.UserID, params *GetUserWorkspacesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUserWorkspacesRequest(c.Server, id, params)
+func (c *Client) VerifyTOTP(ctx context.Context, body VerifyTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyTOTPRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -684,8 +1491,8 @@ func (c *Client) GetUserWorkspaces(ctx context.Context, id userDomain.UserID, pa
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListWorkspaces(ctx context.Context, params *ListWorkspacesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListWorkspacesRequest(c.Server, params)
+func (c *Client) Healthz(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthzRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -696,8 +1503,8 @@ func (c *Client) ListWorkspaces(ctx context.Context, params *ListWorkspacesParam
 	return c.Client.Do(req)
 }
 
-func (c *Client) OnboardWorkspaceWithBody(ctx context.Context, params *OnboardWorkspaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewOnboardWorkspaceRequestWithBody(c.Server, params, contentType, body)
+func (c *Client) CommitTaskWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCommitTaskRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -708,8 +1515,8 @@ func (c *Client) OnboardWorkspaceWithBody(ctx context.Context, params *OnboardWo
 	return c.Client.Do(req)
 }
 
-func (c *Client) OnboardWorkspace(ctx context.Context, params *OnboardWorkspaceParams, body OnboardWorkspaceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewOnboardWorkspaceRequest(c.Server, params, body)
+func (c *Client) CommitTask(ctx context.Context, body CommitTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCommitTaskRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -720,8 +1527,8 @@ func (c *Client) OnboardWorkspace(ctx context.Context, params *OnboardWorkspaceP
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteWorkspace(ctx context.Context, id workspaceDomain.WorkspaceID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteWorkspaceRequest(c.Server, id)
+func (c *Client) GetSchedule(ctx context.Context, date ScheduleDate, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetScheduleRequest(c.Server, date)
 	if err != nil {
 		return nil, err
 	}
@@ -732,8 +1539,8 @@ func (c *Client) DeleteWorkspace(ctx context.Context, id workspaceDomain.Workspa
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddWorkspaceMemberWithBody(ctx context.Context, id workspaceDomain.WorkspaceID, params *AddWorkspaceMemberParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddWorkspaceMemberRequestWithBody(c.Server, id, params, contentType, body)
+func (c *Client) UpdateScheduleCapacityWithBody(ctx context.Context, date ScheduleDate, params *UpdateScheduleCapacityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateScheduleCapacityRequestWithBody(c.Server, date, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -744,8 +1551,8 @@ func (c *Client) AddWorkspaceMemberWithBody(ctx context.Context, id workspaceDom
 	return c.Client.Do(req)
 }
 
-func (c *Client) AddWorkspaceMember(ctx context.Context, id workspaceDomain.WorkspaceID, params *AddWorkspaceMemberParams, body AddWorkspaceMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewAddWorkspaceMemberRequest(c.Server, id, params, body)
+func (c *Client) UpdateScheduleCapacity(ctx context.Context, date ScheduleDate, params *UpdateScheduleCapacityParams, body UpdateScheduleCapacityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateScheduleCapacityRequest(c.Server, date, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -756,8 +1563,8 @@ func (c *Client) AddWorkspaceMember(ctx context.Context, id workspaceDomain.Work
 	return c.Client.Do(req)
 }
 
-func (c *Client) RemoveWorkspaceMember(ctx context.Context, id workspaceDomain.WorkspaceID, userId userDomain.UserID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRemoveWorkspaceMemberRequest(c.Server, id, userId)
+func (c *Client) AutoPlanSchedule(ctx context.Context, date ScheduleDate, params *AutoPlanScheduleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAutoPlanScheduleRequest(c.Server, date, params)
 	if err != nil {
 		return nil, err
 	}
@@ -768,8 +1575,8 @@ func (c *Client) RemoveWorkspaceMember(ctx context.Context, id workspaceDomain.W
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetWorkspaceTags(ctx context.Context, id workspaceDomain.WorkspaceID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetWorkspaceTagsRequest(c.Server, id)
+func (c *Client) RemoveScheduledTask(ctx context.Context, date ScheduleDate, todoId todoDomain.TodoID, params *RemoveScheduledTaskParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveScheduledTaskRequest(c.Server, date, todoId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -780,8 +1587,8 @@ func (c *Client) GetWorkspaceTags(ctx context.Context, id workspaceDomain.Worksp
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTagWithBody(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTagParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTagRequestWithBody(c.Server, id, params, contentType, body)
+func (c *Client) GetTodoByID(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTodoByIDRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -792,8 +1599,8 @@ func (c *Client) CreateTagWithBody(ctx context.Context, id workspaceDomain.Works
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTag(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTagParams, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTagRequest(c.Server, id, params, body)
+func (c *Client) UpdateTodoWithBody(ctx context.Context, id todoDomain.TodoID, params *UpdateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTodoRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -804,8 +1611,8 @@ func (c *Client) CreateTag(ctx context.Context, id workspaceDomain.WorkspaceID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetWorkspaceTodos(ctx context.Context, id workspaceDomain.WorkspaceID, params *GetWorkspaceTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetWorkspaceTodosRequest(c.Server, id, params)
+func (c *Client) UpdateTodo(ctx context.Context, id todoDomain.TodoID, params *UpdateTodoParams, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTodoRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -816,8 +1623,8 @@ func (c *Client) GetWorkspaceTodos(ctx context.Context, id workspaceDomain.Works
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTodoWithBody(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTodoRequestWithBody(c.Server, id, params, contentType, body)
+func (c *Client) ArchiveTodo(ctx context.Context, id todoDomain.TodoID, params *ArchiveTodoParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewArchiveTodoRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -828,8 +1635,8 @@ func (c *Client) CreateTodoWithBody(ctx context.Context, id workspaceDomain.Work
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateTodo(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTodoParams, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateTodoRequest(c.Server, id, params, body)
+func (c *Client) UnassignTodo(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnassignTodoRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -840,828 +1647,760 @@ func (c *Client) CreateTodo(ctx context.Context, id workspaceDomain.WorkspaceID,
 	return c.Client.Do(req)
 }
 
-// NewLoginRequest calls the generic Login builder with application/json body
-func NewLoginRequest(server string, body LoginJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) AssignTodoWithBody(ctx context.Context, id todoDomain.TodoID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAssignTodoRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewLoginRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewLoginRequestWithBody generates requests for Login with any type of body
-func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) AssignTodo(ctx context.Context, id todoDomain.TodoID, body AssignTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAssignTodoRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/login")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddTodoBlockerWithBody(ctx context.Context, id todoDomain.TodoID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTodoBlockerRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewRegisterRequest calls the generic Register builder with application/json body
-func NewRegisterRequest(server string, params *RegisterParams, body RegisterJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) AddTodoBlocker(ctx context.Context, id todoDomain.TodoID, body AddTodoBlockerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddTodoBlockerRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewRegisterRequestWithBody(server, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewRegisterRequestWithBody generates requests for Register with any type of body
-func NewRegisterRequestWithBody(server string, params *RegisterParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) RemoveTodoBlocker(ctx context.Context, id todoDomain.TodoID, blockerId todoDomain.TodoID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveTodoBlockerRequest(c.Server, id, blockerId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/register")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) AddChecklistItemWithBody(ctx context.Context, id todoDomain.TodoID, params *AddChecklistItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddChecklistItemRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) AddChecklistItem(ctx context.Context, id todoDomain.TodoID, params *AddChecklistItemParams, body AddChecklistItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddChecklistItemRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	if params != nil {
-
-		if params.IdempotencyKey != nil {
-			var headerParam0 string
-
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
-			if err != nil {
-				return nil, err
-			}
-
-			req.Header.Set("Idempotency-Key", headerParam0)
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewInitiateTOTPRequest generates requests for InitiateTOTP
-func NewInitiateTOTPRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ReorderChecklistWithBody(ctx context.Context, id todoDomain.TodoID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReorderChecklistRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/totp/initiate")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ReorderChecklist(ctx context.Context, id todoDomain.TodoID, body ReorderChecklistJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReorderChecklistRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewVerifyTOTPRequest calls the generic VerifyTOTP builder with application/json body
-func NewVerifyTOTPRequest(server string, body VerifyTOTPJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) RemoveChecklistItem(ctx context.Context, id todoDomain.TodoID, itemId todoDomain.ChecklistItemID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveChecklistItemRequest(c.Server, id, itemId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewVerifyTOTPRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewVerifyTOTPRequestWithBody generates requests for VerifyTOTP with any type of body
-func NewVerifyTOTPRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ToggleChecklistItem(ctx context.Context, id todoDomain.TodoID, itemId todoDomain.ChecklistItemID, params *ToggleChecklistItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewToggleChecklistItemRequest(c.Server, id, itemId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/auth/totp/verify")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetTodoComments(ctx context.Context, id todoDomain.TodoID, params *GetTodoCommentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTodoCommentsRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewHealthzRequest generates requests for Healthz
-func NewHealthzRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateTodoCommentWithBody(ctx context.Context, id todoDomain.TodoID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTodoCommentRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/healthz")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateTodoComment(ctx context.Context, id todoDomain.TodoID, body CreateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTodoCommentRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCommitTaskRequest calls the generic CommitTask builder with application/json body
-func NewCommitTaskRequest(server string, body CommitTaskJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeleteTodoComment(ctx context.Context, id todoDomain.TodoID, commentId todoDomain.CommentID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTodoCommentRequest(c.Server, id, commentId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCommitTaskRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCommitTaskRequestWithBody generates requests for CommitTask with any type of body
-func NewCommitTaskRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateTodoCommentWithBody(ctx context.Context, id todoDomain.TodoID, commentId todoDomain.CommentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTodoCommentRequestWithBody(c.Server, id, commentId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/schedule/commit")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateTodoComment(ctx context.Context, id todoDomain.TodoID, commentId todoDomain.CommentID, body UpdateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTodoCommentRequest(c.Server, id, commentId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetTodoByIDRequest generates requests for GetTodoByID
-func NewGetTodoByIDRequest(server string, id todoDomain.TodoID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) CompleteTodo(ctx context.Context, id todoDomain.TodoID, params *CompleteTodoParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCompleteTodoRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/todos/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SetTodoDueDateWithBody(ctx context.Context, id todoDomain.TodoID, params *SetTodoDueDateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTodoDueDateRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCompleteTodoRequest generates requests for CompleteTodo
-func NewCompleteTodoRequest(server string, id todoDomain.TodoID, params *CompleteTodoParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) SetTodoDueDate(ctx context.Context, id todoDomain.TodoID, params *SetTodoDueDateParams, body SetTodoDueDateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTodoDueDateRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) StartFocusWithBody(ctx context.Context, id todoDomain.TodoID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartFocusRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/todos/%s/complete", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) StartFocus(ctx context.Context, id todoDomain.TodoID, body StartFocusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartFocusRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), nil)
+func (c *Client) StopFocus(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStopFocusRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-
-		if params.IdempotencyKey != nil {
-			var headerParam0 string
-
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
-			if err != nil {
-				return nil, err
-			}
-
-			req.Header.Set("Idempotency-Key", headerParam0)
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewStartFocusRequest generates requests for StartFocus
-func NewStartFocusRequest(server string, id todoDomain.TodoID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) SetTodoRecurrenceWithBody(ctx context.Context, id todoDomain.TodoID, params *SetTodoRecurrenceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTodoRecurrenceRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) SetTodoRecurrence(ctx context.Context, id todoDomain.TodoID, params *SetTodoRecurrenceParams, body SetTodoRecurrenceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetTodoRecurrenceRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/todos/%s/focus/start", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ReopenTodo(ctx context.Context, id todoDomain.TodoID, params *ReopenTodoParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReopenTodoRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewStopFocusRequest generates requests for StopFocus
-func NewStopFocusRequest(server string, id todoDomain.TodoID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) AssignTagToTodoWithBody(ctx context.Context, id todoDomain.TodoID, params *AssignTagToTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAssignTagToTodoRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) AssignTagToTodo(ctx context.Context, id todoDomain.TodoID, params *AssignTagToTodoParams, body AssignTagToTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAssignTagToTodoRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/todos/%s/focus/stop", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RemoveTagFromTodo(ctx context.Context, id todoDomain.TodoID, tagId todoDomain.TagID, params *RemoveTagFromTodoParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveTagFromTodoRequest(c.Server, id, tagId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewAssignTagToTodoRequest calls the generic AssignTagToTodo builder with application/json body
-func NewAssignTagToTodoRequest(server string, id todoDomain.TodoID, params *AssignTagToTodoParams, body AssignTagToTodoJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UnarchiveTodo(ctx context.Context, id todoDomain.TodoID, params *UnarchiveTodoParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnarchiveTodoRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAssignTagToTodoRequestWithBody(server, id, params, "application/json", bodyReader)
-}
-
-// NewAssignTagToTodoRequestWithBody generates requests for AssignTagToTodo with any type of body
-func NewAssignTagToTodoRequestWithBody(server string, id todoDomain.TodoID, params *AssignTagToTodoParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteUser(ctx context.Context, id userDomain.UserID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUserRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/todos/%s/tags", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) GetUserByID(ctx context.Context, id userDomain.UserID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserByIDRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	if params != nil {
-
-		if params.IdempotencyKey != nil {
-			var headerParam0 string
-
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
-			if err != nil {
-				return nil, err
-			}
-
-			req.Header.Set("Idempotency-Key", headerParam0)
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetUserByIDRequest generates requests for GetUserByID
-func NewGetUserByIDRequest(server string, id userDomain.UserID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) GetUserAssignedTodos(ctx context.Context, id userDomain.UserID, params *GetUserAssignedTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserAssignedTodosRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/users/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SetUserDailyCapacityWithBody(ctx context.Context, id userDomain.UserID, params *SetUserDailyCapacityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetUserDailyCapacityRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetUserWorkspacesRequest generates requests for GetUserWorkspaces
-func NewGetUserWorkspacesRequest(server string, id userDomain.UserID, params *GetUserWorkspacesParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) SetUserDailyCapacity(ctx context.Context, id userDomain.UserID, params *SetUserDailyCapacityParams, body SetUserDailyCapacityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetUserDailyCapacityRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/users/%s/workspaces", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) RequestUserDataExport(ctx context.Context, id userDomain.UserID, params *RequestUserDataExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRequestUserDataExportRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetUserDataExport(ctx context.Context, id userDomain.UserID, exportId userDomain.DataExportID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserDataExportRequest(c.Server, id, exportId)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListWorkspacesRequest generates requests for ListWorkspaces
-func NewListWorkspacesRequest(server string, params *ListWorkspacesParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DownloadUserDataExport(ctx context.Context, id userDomain.UserID, exportId userDomain.DataExportID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDownloadUserDataExportRequest(c.Server, id, exportId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/workspaces")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) SetUserTimezoneWithBody(ctx context.Context, id userDomain.UserID, params *SetUserTimezoneParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetUserTimezoneRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) SetUserTimezone(ctx context.Context, id userDomain.UserID, params *SetUserTimezoneParams, body SetUserTimezoneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetUserTimezoneRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewOnboardWorkspaceRequest calls the generic OnboardWorkspace builder with application/json body
-func NewOnboardWorkspaceRequest(server string, params *OnboardWorkspaceParams, body OnboardWorkspaceJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ResetUserTOTP(ctx context.Context, id userDomain.UserID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewResetUserTOTPRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewOnboardWorkspaceRequestWithBody(server, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewOnboardWorkspaceRequestWithBody generates requests for OnboardWorkspace with any type of body
-func NewOnboardWorkspaceRequestWithBody(server string, params *OnboardWorkspaceParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetUserWorkspaces(ctx context.Context, id userDomain.UserID, params *GetUserWorkspacesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserWorkspacesRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/workspaces")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListWorkspaces(ctx context.Context, params *ListWorkspacesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListWorkspacesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) OnboardWorkspaceWithBody(ctx context.Context, params *OnboardWorkspaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOnboardWorkspaceRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	if params != nil {
-
-		if params.IdempotencyKey != nil {
-			var headerParam0 string
-
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
-			if err != nil {
-				return nil, err
-			}
-
-			req.Header.Set("Idempotency-Key", headerParam0)
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteWorkspaceRequest generates requests for DeleteWorkspace
-func NewDeleteWorkspaceRequest(server string, id workspaceDomain.WorkspaceID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) OnboardWorkspace(ctx context.Context, params *OnboardWorkspaceParams, body OnboardWorkspaceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOnboardWorkspaceRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteWorkspace(ctx context.Context, id workspaceDomain.WorkspaceID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWorkspaceRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/workspaces/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetWorkspaceAuditLogs(ctx context.Context, id workspaceDomain.WorkspaceID, params *GetWorkspaceAuditLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWorkspaceAuditLogsRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) VerifyWorkspaceAuditChain(ctx context.Context, id workspaceDomain.WorkspaceID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyWorkspaceAuditChainRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAddWorkspaceMemberRequest calls the generic AddWorkspaceMember builder with application/json body
-func NewAddWorkspaceMemberRequest(server string, id workspaceDomain.WorkspaceID, params *AddWorkspaceMemberParams, body AddWorkspaceMemberJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) AddWorkspaceMemberWithBody(ctx context.Context, id workspaceDomain.WorkspaceID, params *AddWorkspaceMemberParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddWorkspaceMemberRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewAddWorkspaceMemberRequestWithBody(server, id, params, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewAddWorkspaceMemberRequestWithBody generates requests for AddWorkspaceMember with any type of body
-func NewAddWorkspaceMemberRequestWithBody(server string, id workspaceDomain.WorkspaceID, params *AddWorkspaceMemberParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) AddWorkspaceMember(ctx context.Context, id workspaceDomain.WorkspaceID, params *AddWorkspaceMemberParams, body AddWorkspaceMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddWorkspaceMemberRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) RemoveWorkspaceMember(ctx context.Context, id workspaceDomain.WorkspaceID, userId userDomain.UserID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveWorkspaceMemberRequest(c.Server, id, userId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/workspaces/%s/members", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetWorkspaceFocusReport(ctx context.Context, id workspaceDomain.WorkspaceID, params *GetWorkspaceFocusReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWorkspaceFocusReportRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) GetWorkspaceTags(ctx context.Context, id workspaceDomain.WorkspaceID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWorkspaceTagsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	if params != nil {
-
-		if params.IdempotencyKey != nil {
-			var headerParam0 string
-
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
-			if err != nil {
-				return nil, err
-			}
-
-			req.Header.Set("Idempotency-Key", headerParam0)
-		}
-
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewRemoveWorkspaceMemberRequest generates requests for RemoveWorkspaceMember
-func NewRemoveWorkspaceMemberRequest(server string, id workspaceDomain.WorkspaceID, userId userDomain.UserID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) CreateTagWithBody(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTagParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTagRequestWithBody(c.Server, id, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+func (c *Client) CreateTag(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTagParams, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTagRequest(c.Server, id, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteTag(ctx context.Context, id workspaceDomain.WorkspaceID, tagId todoDomain.TagID, params *DeleteTagParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTagRequest(c.Server, id, tagId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/workspaces/%s/members/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateTagWithBody(ctx context.Context, id workspaceDomain.WorkspaceID, tagId todoDomain.TagID, params *UpdateTagParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTagRequestWithBody(c.Server, id, tagId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) UpdateTag(ctx context.Context, id workspaceDomain.WorkspaceID, tagId todoDomain.TagID, params *UpdateTagParams, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTagRequest(c.Server, id, tagId, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) GetWorkspaceTodos(ctx context.Context, id workspaceDomain.WorkspaceID, params *GetWorkspaceTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWorkspaceTodosRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetWorkspaceTagsRequest generates requests for GetWorkspaceTags
-func NewGetWorkspaceTagsRequest(server string, id workspaceDomain.WorkspaceID) (*http.Request, error) {
-	var err error
+func (c *Client) CreateTodoWithBody(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTodoRequestWithBody(c.Server, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) CreateTodo(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTodoParams, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTodoRequest(c.Server, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+func (c *Client) SearchWorkspaceTodos(ctx context.Context, id workspaceDomain.WorkspaceID, params *SearchWorkspaceTodosParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchWorkspaceTodosRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewVerifyGlobalAuditChainsRequest generates requests for VerifyGlobalAuditChains
+func NewVerifyGlobalAuditChainsRequest(server string) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/workspaces/%s/tags", pathParam0)
+	operationPath := fmt.Sprintf("/audit/verify")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1679,34 +2418,27 @@ func NewGetWorkspaceTagsRequest(server string, id workspaceDomain.WorkspaceID) (
 	return req, nil
 }
 
-// NewCreateTagRequest calls the generic CreateTag builder with application/json body
-func NewCreateTagRequest(server string, id workspaceDomain.WorkspaceID, params *CreateTagParams, body CreateTagJSONRequestBody) (*http.Request, error) {
+// NewLoginRequest calls the generic Login builder with application/json body
+func NewLoginRequest(server string, body LoginJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateTagRequestWithBody(server, id, params, "application/json", bodyReader)
+	return NewLoginRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateTagRequestWithBody generates requests for CreateTag with any type of body
-func NewCreateTagRequestWithBody(server string, id workspaceDomain.WorkspaceID, params *CreateTagParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewLoginRequestWithBody generates requests for Login with any type of body
+func NewLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/workspaces/%s/tags", pathParam0)
+	operationPath := fmt.Sprintf("/auth/login")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1723,41 +2455,97 @@ func NewCreateTagRequestWithBody(server string, id workspaceDomain.WorkspaceID,
 
 	req.Header.Add("Content-Type", contentType)
 
-	if params != nil {
+	return req, nil
+}
 
-		if params.IdempotencyKey != nil {
-			var headerParam0 string
+// NewLogoutRequest calls the generic Logout builder with application/json body
+func NewLogoutRequest(server string, body LogoutJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewLogoutRequestWithBody(server, "application/json", bodyReader)
+}
 
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
-			if err != nil {
-				return nil, err
-			}
+// NewLogoutRequestWithBody generates requests for Logout with any type of body
+func NewLogoutRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-			req.Header.Set("Idempotency-Key", headerParam0)
-		}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetWorkspaceTodosRequest generates requests for GetWorkspaceTodos
-func NewGetWorkspaceTodosRequest(server string, id workspaceDomain.WorkspaceID, params *GetWorkspaceTodosParams) (*http.Request, error) {
+// NewLogoutAllRequest generates requests for LogoutAll
+func NewLogoutAllRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	operationPath := fmt.Sprintf("/auth/logout-all")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReauthenticateRequest calls the generic Reauthenticate builder with application/json body
+func NewReauthenticateRequest(server string, body ReauthenticateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReauthenticateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewReauthenticateRequestWithBody generates requests for Reauthenticate with any type of body
+func NewReauthenticateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/workspaces/%s/todos", pathParam0)
+	operationPath := fmt.Sprintf("/auth/reauthenticate")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1767,80 +2555,77 @@ func NewGetWorkspaceTodosRequest(server string, id workspaceDomain.WorkspaceID,
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Limit != nil {
+	req.Header.Add("Content-Type", contentType)
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	return req, nil
+}
 
-		}
+// NewRefreshSessionRequest calls the generic RefreshSession builder with application/json body
+func NewRefreshSessionRequest(server string, body RefreshSessionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRefreshSessionRequestWithBody(server, "application/json", bodyReader)
+}
 
-		if params.Offset != nil {
+// NewRefreshSessionRequestWithBody generates requests for RefreshSession with any type of body
+func NewRefreshSessionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		}
+	operationPath := fmt.Sprintf("/auth/refresh")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-		queryURL.RawQuery = queryValues.Encode()
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewCreateTodoRequest calls the generic CreateTodo builder with application/json body
-func NewCreateTodoRequest(server string, id workspaceDomain.WorkspaceID, params *CreateTodoParams, body CreateTodoJSONRequestBody) (*http.Request, error) {
+// NewRegisterRequest calls the generic Register builder with application/json body
+func NewRegisterRequest(server string, params *RegisterParams, body RegisterJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateTodoRequestWithBody(server, id, params, "application/json", bodyReader)
+	return NewRegisterRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// NewCreateTodoRequestWithBody generates requests for CreateTodo with any type of body
-func NewCreateTodoRequestWithBody(server string, id workspaceDomain.WorkspaceID, params *CreateTodoParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewRegisterRequestWithBody generates requests for Register with any type of body
+func NewRegisterRequestWithBody(server string, params *RegisterParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/workspaces/%s/todos", pathParam0)
+	operationPath := fmt.Sprintf("/auth/register")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1875,1026 +2660,7035 @@ func NewCreateTodoRequestWithBody(server string, id workspaceDomain.WorkspaceID,
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewDisableTOTPRequest calls the generic DisableTOTP builder with application/json body
+func NewDisableTOTPRequest(server string, body DisableTOTPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewDisableTOTPRequestWithBody(server, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewDisableTOTPRequestWithBody generates requests for DisableTOTP with any type of body
+func NewDisableTOTPRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	operationPath := fmt.Sprintf("/auth/totp/disable")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// LoginWithBodyWithResponse request with any body
-	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error)
 
-	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// RegisterWithBodyWithResponse request with any body
-	RegisterWithBodyWithResponse(ctx context.Context, params *RegisterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	RegisterWithResponse(ctx context.Context, params *RegisterParams, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// InitiateTOTPWithResponse request
-	InitiateTOTPWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*InitiateTOTPResponse, error)
+	return req, nil
+}
 
-	// VerifyTOTPWithBodyWithResponse request with any body
-	VerifyTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyTOTPResponse, error)
+// NewInitiateTOTPRequest generates requests for InitiateTOTP
+func NewInitiateTOTPRequest(server string) (*http.Request, error) {
+	var err error
 
-	VerifyTOTPWithResponse(ctx context.Context, body VerifyTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyTOTPResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// HealthzWithResponse request
-	HealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthzResponse, error)
+	operationPath := fmt.Sprintf("/auth/totp/initiate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// CommitTaskWithBodyWithResponse request with any body
-	CommitTaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CommitTaskResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	CommitTaskWithResponse(ctx context.Context, body CommitTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*CommitTaskResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetTodoByIDWithResponse request
-	GetTodoByIDWithResponse(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*GetTodoByIDResponse, error)
+	return req, nil
+}
 
-	// CompleteTodoWithResponse request
-	CompleteTodoWithResponse(ctx context.Context, id todoDomain.TodoID, params *CompleteTodoParams, reqEditors ...RequestEditorFn) (*CompleteTodoResponse, error)
+// NewRecoverTOTPRequest calls the generic RecoverTOTP builder with application/json body
+func NewRecoverTOTPRequest(server string, body RecoverTOTPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRecoverTOTPRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// StartFocusWithResponse request
-	StartFocusWithResponse(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*StartFocusResponse, error)
+// NewRecoverTOTPRequestWithBody generates requests for RecoverTOTP with any type of body
+func NewRecoverTOTPRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// StopFocusWithResponse request
-	StopFocusWithResponse(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*StopFocusResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// AssignTagToTodoWithBodyWithResponse request with any body
-	AssignTagToTodoWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, params *AssignTagToTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignTagToTodoResponse, error)
+	operationPath := fmt.Sprintf("/auth/totp/recover")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	AssignTagToTodoWithResponse(ctx context.Context, id todoDomain.TodoID, params *AssignTagToTodoParams, body AssignTagToTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignTagToTodoResponse, error)
-
-	// GetUserByIDWithResponse request
-	GetUserByIDWithResponse(ctx context.Context, id userDomain.UserID, reqEditors ...RequestEditorFn) (*GetUserByIDResponse, error)
-
-	// GetUserWorkspacesWithResponse request
-	GetUserWorkspacesWithResponse(ctx context.Context, id userDomain.UserID, params *GetUserWorkspacesParams, reqEditors ...RequestEditorFn) (*GetUserWorkspacesResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListWorkspacesWithResponse request
-	ListWorkspacesWithResponse(ctx context.Context, params *ListWorkspacesParams, reqEditors ...RequestEditorFn) (*ListWorkspacesResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// OnboardWorkspaceWithBodyWithResponse request with any body
-	OnboardWorkspaceWithBodyWithResponse(ctx context.Context, params *OnboardWorkspaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OnboardWorkspaceResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	OnboardWorkspaceWithResponse(ctx context.Context, params *OnboardWorkspaceParams, body OnboardWorkspaceJSONRequestBody, reqEditors ...RequestEditorFn) (*OnboardWorkspaceResponse, error)
+	return req, nil
+}
 
-	// DeleteWorkspaceWithResponse request
-	DeleteWorkspaceWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, reqEditors ...RequestEditorFn) (*DeleteWorkspaceResponse, error)
+// NewVerifyTOTPRequest calls the generic VerifyTOTP builder with application/json body
+func NewVerifyTOTPRequest(server string, body VerifyTOTPJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewVerifyTOTPRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// AddWorkspaceMemberWithBodyWithResponse request with any body
-	AddWorkspaceMemberWithBodyWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *AddWorkspaceMemberParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddWorkspaceMemberResponse, error)
+// NewVerifyTOTPRequestWithBody generates requests for VerifyTOTP with any type of body
+func NewVerifyTOTPRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	AddWorkspaceMemberWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *AddWorkspaceMemberParams, body AddWorkspaceMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*AddWorkspaceMemberResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// RemoveWorkspaceMemberWithResponse request
-	RemoveWorkspaceMemberWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, userId userDomain.UserID, reqEditors ...RequestEditorFn) (*RemoveWorkspaceMemberResponse, error)
+	operationPath := fmt.Sprintf("/auth/totp/verify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetWorkspaceTagsWithResponse request
-	GetWorkspaceTagsWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, reqEditors ...RequestEditorFn) (*GetWorkspaceTagsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateTagWithBodyWithResponse request with any body
-	CreateTagWithBodyWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTagParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateTagWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTagParams, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// GetWorkspaceTodosWithResponse request
-	GetWorkspaceTodosWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *GetWorkspaceTodosParams, reqEditors ...RequestEditorFn) (*GetWorkspaceTodosResponse, error)
+	return req, nil
+}
 
-	// CreateTodoWithBodyWithResponse request with any body
-	CreateTodoWithBodyWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error)
+// NewHealthzRequest generates requests for Healthz
+func NewHealthzRequest(server string) (*http.Request, error) {
+	var err error
 
-	CreateTodoWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTodoParams, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error)
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-type LoginResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *LoginResponseBody
-}
+	operationPath := fmt.Sprintf("/healthz")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r LoginResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r LoginResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type RegisterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *IdResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r RegisterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCommitTaskRequest calls the generic CommitTask builder with application/json body
+func NewCommitTaskRequest(server string, body CommitTaskJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewCommitTaskRequestWithBody(server, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RegisterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewCommitTaskRequestWithBody generates requests for CommitTask with any type of body
+func NewCommitTaskRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type InitiateTOTPResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *InitiateTOTPResponseBody
-}
+	operationPath := fmt.Sprintf("/schedule/commit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r InitiateTOTPResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r InitiateTOTPResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type VerifyTOTPResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *LoginResponseBody
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r VerifyTOTPResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetScheduleRequest generates requests for GetSchedule
+func NewGetScheduleRequest(server string, date ScheduleDate) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "date", runtime.ParamLocationPath, date)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r VerifyTOTPResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type HealthzResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	operationPath := fmt.Sprintf("/schedule/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r HealthzResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r HealthzResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CommitTaskResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON4XX      *ErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CommitTaskResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpdateScheduleCapacityRequest calls the generic UpdateScheduleCapacity builder with application/json body
+func NewUpdateScheduleCapacityRequest(server string, date ScheduleDate, params *UpdateScheduleCapacityParams, body UpdateScheduleCapacityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateScheduleCapacityRequestWithBody(server, date, params, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CommitTaskResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewUpdateScheduleCapacityRequestWithBody generates requests for UpdateScheduleCapacity with any type of body
+func NewUpdateScheduleCapacityRequestWithBody(server string, date ScheduleDate, params *UpdateScheduleCapacityParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type GetTodoByIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Todo
-	JSON4XX      *ErrorResponse
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetTodoByIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "date", runtime.ParamLocationPath, date)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schedule/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewAutoPlanScheduleRequest generates requests for AutoPlanSchedule
+func NewAutoPlanScheduleRequest(server string, date ScheduleDate, params *AutoPlanScheduleParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "date", runtime.ParamLocationPath, date)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schedule/%s/auto-plan", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewRemoveScheduledTaskRequest generates requests for RemoveScheduledTask
+func NewRemoveScheduledTaskRequest(server string, date ScheduleDate, todoId todoDomain.TodoID, params *RemoveScheduledTaskParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "date", runtime.ParamLocationPath, date)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "todoId", runtime.ParamLocationPath, todoId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schedule/%s/tasks/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetTodoByIDRequest generates requests for GetTodoByID
+func NewGetTodoByIDRequest(server string, id todoDomain.TodoID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTodoRequest calls the generic UpdateTodo builder with application/json body
+func NewUpdateTodoRequest(server string, id todoDomain.TodoID, params *UpdateTodoParams, body UpdateTodoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTodoRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewUpdateTodoRequestWithBody generates requests for UpdateTodo with any type of body
+func NewUpdateTodoRequestWithBody(server string, id todoDomain.TodoID, params *UpdateTodoParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewArchiveTodoRequest generates requests for ArchiveTodo
+func NewArchiveTodoRequest(server string, id todoDomain.TodoID, params *ArchiveTodoParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/archive", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewUnassignTodoRequest generates requests for UnassignTodo
+func NewUnassignTodoRequest(server string, id todoDomain.TodoID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/assignee", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAssignTodoRequest calls the generic AssignTodo builder with application/json body
+func NewAssignTodoRequest(server string, id todoDomain.TodoID, body AssignTodoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAssignTodoRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAssignTodoRequestWithBody generates requests for AssignTodo with any type of body
+func NewAssignTodoRequestWithBody(server string, id todoDomain.TodoID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/assignee", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAddTodoBlockerRequest calls the generic AddTodoBlocker builder with application/json body
+func NewAddTodoBlockerRequest(server string, id todoDomain.TodoID, body AddTodoBlockerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddTodoBlockerRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAddTodoBlockerRequestWithBody generates requests for AddTodoBlocker with any type of body
+func NewAddTodoBlockerRequestWithBody(server string, id todoDomain.TodoID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/blockers", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveTodoBlockerRequest generates requests for RemoveTodoBlocker
+func NewRemoveTodoBlockerRequest(server string, id todoDomain.TodoID, blockerId todoDomain.TodoID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "blockerId", runtime.ParamLocationPath, blockerId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/blockers/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddChecklistItemRequest calls the generic AddChecklistItem builder with application/json body
+func NewAddChecklistItemRequest(server string, id todoDomain.TodoID, params *AddChecklistItemParams, body AddChecklistItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddChecklistItemRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewAddChecklistItemRequestWithBody generates requests for AddChecklistItem with any type of body
+func NewAddChecklistItemRequestWithBody(server string, id todoDomain.TodoID, params *AddChecklistItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/checklist", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewReorderChecklistRequest calls the generic ReorderChecklist builder with application/json body
+func NewReorderChecklistRequest(server string, id todoDomain.TodoID, body ReorderChecklistJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReorderChecklistRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewReorderChecklistRequestWithBody generates requests for ReorderChecklist with any type of body
+func NewReorderChecklistRequestWithBody(server string, id todoDomain.TodoID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/checklist/order", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewRemoveChecklistItemRequest generates requests for RemoveChecklistItem
+func NewRemoveChecklistItemRequest(server string, id todoDomain.TodoID, itemId todoDomain.ChecklistItemID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "itemId", runtime.ParamLocationPath, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/checklist/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewToggleChecklistItemRequest generates requests for ToggleChecklistItem
+func NewToggleChecklistItemRequest(server string, id todoDomain.TodoID, itemId todoDomain.ChecklistItemID, params *ToggleChecklistItemParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "itemId", runtime.ParamLocationPath, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/checklist/%s/toggle", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetTodoCommentsRequest generates requests for GetTodoComments
+func NewGetTodoCommentsRequest(server string, id todoDomain.TodoID, params *GetTodoCommentsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/comments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTodoCommentRequest calls the generic CreateTodoComment builder with application/json body
+func NewCreateTodoCommentRequest(server string, id todoDomain.TodoID, body CreateTodoCommentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTodoCommentRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewCreateTodoCommentRequestWithBody generates requests for CreateTodoComment with any type of body
+func NewCreateTodoCommentRequestWithBody(server string, id todoDomain.TodoID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/comments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteTodoCommentRequest generates requests for DeleteTodoComment
+func NewDeleteTodoCommentRequest(server string, id todoDomain.TodoID, commentId todoDomain.CommentID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "commentId", runtime.ParamLocationPath, commentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/comments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTodoCommentRequest calls the generic UpdateTodoComment builder with application/json body
+func NewUpdateTodoCommentRequest(server string, id todoDomain.TodoID, commentId todoDomain.CommentID, body UpdateTodoCommentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTodoCommentRequestWithBody(server, id, commentId, "application/json", bodyReader)
+}
+
+// NewUpdateTodoCommentRequestWithBody generates requests for UpdateTodoComment with any type of body
+func NewUpdateTodoCommentRequestWithBody(server string, id todoDomain.TodoID, commentId todoDomain.CommentID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "commentId", runtime.ParamLocationPath, commentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/comments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCompleteTodoRequest generates requests for CompleteTodo
+func NewCompleteTodoRequest(server string, id todoDomain.TodoID, params *CompleteTodoParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/complete", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewSetTodoDueDateRequest calls the generic SetTodoDueDate builder with application/json body
+func NewSetTodoDueDateRequest(server string, id todoDomain.TodoID, params *SetTodoDueDateParams, body SetTodoDueDateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetTodoDueDateRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewSetTodoDueDateRequestWithBody generates requests for SetTodoDueDate with any type of body
+func NewSetTodoDueDateRequestWithBody(server string, id todoDomain.TodoID, params *SetTodoDueDateParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/due-date", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewStartFocusRequest calls the generic StartFocus builder with application/json body
+func NewStartFocusRequest(server string, id todoDomain.TodoID, body StartFocusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewStartFocusRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewStartFocusRequestWithBody generates requests for StartFocus with any type of body
+func NewStartFocusRequestWithBody(server string, id todoDomain.TodoID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/focus/start", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewStopFocusRequest generates requests for StopFocus
+func NewStopFocusRequest(server string, id todoDomain.TodoID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/focus/stop", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetTodoRecurrenceRequest calls the generic SetTodoRecurrence builder with application/json body
+func NewSetTodoRecurrenceRequest(server string, id todoDomain.TodoID, params *SetTodoRecurrenceParams, body SetTodoRecurrenceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetTodoRecurrenceRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewSetTodoRecurrenceRequestWithBody generates requests for SetTodoRecurrence with any type of body
+func NewSetTodoRecurrenceRequestWithBody(server string, id todoDomain.TodoID, params *SetTodoRecurrenceParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/recurrence", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewReopenTodoRequest generates requests for ReopenTodo
+func NewReopenTodoRequest(server string, id todoDomain.TodoID, params *ReopenTodoParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/reopen", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewAssignTagToTodoRequest calls the generic AssignTagToTodo builder with application/json body
+func NewAssignTagToTodoRequest(server string, id todoDomain.TodoID, params *AssignTagToTodoParams, body AssignTagToTodoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAssignTagToTodoRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewAssignTagToTodoRequestWithBody generates requests for AssignTagToTodo with any type of body
+func NewAssignTagToTodoRequestWithBody(server string, id todoDomain.TodoID, params *AssignTagToTodoParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/tags", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewRemoveTagFromTodoRequest generates requests for RemoveTagFromTodo
+func NewRemoveTagFromTodoRequest(server string, id todoDomain.TodoID, tagId todoDomain.TagID, params *RemoveTagFromTodoParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tagId", runtime.ParamLocationPath, tagId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/tags/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewUnarchiveTodoRequest generates requests for UnarchiveTodo
+func NewUnarchiveTodoRequest(server string, id todoDomain.TodoID, params *UnarchiveTodoParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/todos/%s/unarchive", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteUserRequest generates requests for DeleteUser
+func NewDeleteUserRequest(server string, id userDomain.UserID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUserByIDRequest generates requests for GetUserByID
+func NewGetUserByIDRequest(server string, id userDomain.UserID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUserAssignedTodosRequest generates requests for GetUserAssignedTodos
+func NewGetUserAssignedTodosRequest(server string, id userDomain.UserID, params *GetUserAssignedTodosParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/assigned-todos", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetUserDailyCapacityRequest calls the generic SetUserDailyCapacity builder with application/json body
+func NewSetUserDailyCapacityRequest(server string, id userDomain.UserID, params *SetUserDailyCapacityParams, body SetUserDailyCapacityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetUserDailyCapacityRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewSetUserDailyCapacityRequestWithBody generates requests for SetUserDailyCapacity with any type of body
+func NewSetUserDailyCapacityRequestWithBody(server string, id userDomain.UserID, params *SetUserDailyCapacityParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/daily-capacity", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewRequestUserDataExportRequest generates requests for RequestUserDataExport
+func NewRequestUserDataExportRequest(server string, id userDomain.UserID, params *RequestUserDataExportParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/data-exports", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetUserDataExportRequest generates requests for GetUserDataExport
+func NewGetUserDataExportRequest(server string, id userDomain.UserID, exportId userDomain.DataExportID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "exportId", runtime.ParamLocationPath, exportId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/data-exports/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDownloadUserDataExportRequest generates requests for DownloadUserDataExport
+func NewDownloadUserDataExportRequest(server string, id userDomain.UserID, exportId userDomain.DataExportID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "exportId", runtime.ParamLocationPath, exportId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/data-exports/%s/archive", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetUserTimezoneRequest calls the generic SetUserTimezone builder with application/json body
+func NewSetUserTimezoneRequest(server string, id userDomain.UserID, params *SetUserTimezoneParams, body SetUserTimezoneJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetUserTimezoneRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewSetUserTimezoneRequestWithBody generates requests for SetUserTimezone with any type of body
+func NewSetUserTimezoneRequestWithBody(server string, id userDomain.UserID, params *SetUserTimezoneParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/timezone", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewResetUserTOTPRequest generates requests for ResetUserTOTP
+func NewResetUserTOTPRequest(server string, id userDomain.UserID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/totp/reset", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUserWorkspacesRequest generates requests for GetUserWorkspaces
+func NewGetUserWorkspacesRequest(server string, id userDomain.UserID, params *GetUserWorkspacesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/workspaces", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListWorkspacesRequest generates requests for ListWorkspaces
+func NewListWorkspacesRequest(server string, params *ListWorkspacesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workspaces")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewOnboardWorkspaceRequest calls the generic OnboardWorkspace builder with application/json body
+func NewOnboardWorkspaceRequest(server string, params *OnboardWorkspaceParams, body OnboardWorkspaceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewOnboardWorkspaceRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewOnboardWorkspaceRequestWithBody generates requests for OnboardWorkspace with any type of body
+func NewOnboardWorkspaceRequestWithBody(server string, params *OnboardWorkspaceParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workspaces")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteWorkspaceRequest generates requests for DeleteWorkspace
+func NewDeleteWorkspaceRequest(server string, id workspaceDomain.WorkspaceID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workspaces/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWorkspaceAuditLogsRequest generates requests for GetWorkspaceAuditLogs
+func NewGetWorkspaceAuditLogsRequest(server string, id workspaceDomain.WorkspaceID, params *GetWorkspaceAuditLogsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workspaces/%s/audit", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.AggregateType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "aggregateType", runtime.ParamLocationQuery, *params.AggregateType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AggregateID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "aggregateId", runtime.ParamLocationQuery, *params.AggregateID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ActorID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actorId", runtime.ParamLocationQuery, *params.ActorID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Operation != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "operation", runtime.ParamLocationQuery, *params.Operation); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewVerifyWorkspaceAuditChainRequest generates requests for VerifyWorkspaceAuditChain
+func NewVerifyWorkspaceAuditChainRequest(server string, id workspaceDomain.WorkspaceID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workspaces/%s/audit/verify", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddWorkspaceMemberRequest calls the generic AddWorkspaceMember builder with application/json body
+func NewAddWorkspaceMemberRequest(server string, id workspaceDomain.WorkspaceID, params *AddWorkspaceMemberParams, body AddWorkspaceMemberJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddWorkspaceMemberRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewAddWorkspaceMemberRequestWithBody generates requests for AddWorkspaceMember with any type of body
+func NewAddWorkspaceMemberRequestWithBody(server string, id workspaceDomain.WorkspaceID, params *AddWorkspaceMemberParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workspaces/%s/members", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewRemoveWorkspaceMemberRequest generates requests for RemoveWorkspaceMember
+func NewRemoveWorkspaceMemberRequest(server string, id workspaceDomain.WorkspaceID, userId userDomain.UserID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workspaces/%s/members/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWorkspaceFocusReportRequest generates requests for GetWorkspaceFocusReport
+func NewGetWorkspaceFocusReportRequest(server string, id workspaceDomain.WorkspaceID, params *GetWorkspaceFocusReportParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workspaces/%s/reports/focus", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.GroupBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "groupBy", runtime.ParamLocationQuery, *params.GroupBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.UserID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "userId", runtime.ParamLocationQuery, *params.UserID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWorkspaceTagsRequest generates requests for GetWorkspaceTags
+func NewGetWorkspaceTagsRequest(server string, id workspaceDomain.WorkspaceID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workspaces/%s/tags", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTagRequest calls the generic CreateTag builder with application/json body
+func NewCreateTagRequest(server string, id workspaceDomain.WorkspaceID, params *CreateTagParams, body CreateTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTagRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewCreateTagRequestWithBody generates requests for CreateTag with any type of body
+func NewCreateTagRequestWithBody(server string, id workspaceDomain.WorkspaceID, params *CreateTagParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workspaces/%s/tags", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteTagRequest generates requests for DeleteTag
+func NewDeleteTagRequest(server string, id workspaceDomain.WorkspaceID, tagId todoDomain.TagID, params *DeleteTagParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tagId", runtime.ParamLocationPath, tagId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workspaces/%s/tags/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewUpdateTagRequest calls the generic UpdateTag builder with application/json body
+func NewUpdateTagRequest(server string, id workspaceDomain.WorkspaceID, tagId todoDomain.TagID, params *UpdateTagParams, body UpdateTagJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateTagRequestWithBody(server, id, tagId, params, "application/json", bodyReader)
+}
+
+// NewUpdateTagRequestWithBody generates requests for UpdateTag with any type of body
+func NewUpdateTagRequestWithBody(server string, id workspaceDomain.WorkspaceID, tagId todoDomain.TagID, params *UpdateTagParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "tagId", runtime.ParamLocationPath, tagId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workspaces/%s/tags/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetWorkspaceTodosRequest generates requests for GetWorkspaceTodos
+func NewGetWorkspaceTodosRequest(server string, id workspaceDomain.WorkspaceID, params *GetWorkspaceTodosParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workspaces/%s/todos", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TagIds != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tagIds", runtime.ParamLocationQuery, *params.TagIds); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DueBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dueBefore", runtime.ParamLocationQuery, *params.DueBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DueAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dueAfter", runtime.ParamLocationQuery, *params.DueAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Overdue != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "overdue", runtime.ParamLocationQuery, *params.Overdue); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Recurring != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "recurring", runtime.ParamLocationQuery, *params.Recurring); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTodoRequest calls the generic CreateTodo builder with application/json body
+func NewCreateTodoRequest(server string, id workspaceDomain.WorkspaceID, params *CreateTodoParams, body CreateTodoJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTodoRequestWithBody(server, id, params, "application/json", bodyReader)
+}
+
+// NewCreateTodoRequestWithBody generates requests for CreateTodo with any type of body
+func NewCreateTodoRequestWithBody(server string, id workspaceDomain.WorkspaceID, params *CreateTodoParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workspaces/%s/todos", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewSearchWorkspaceTodosRequest generates requests for SearchWorkspaceTodos
+func NewSearchWorkspaceTodosRequest(server string, id workspaceDomain.WorkspaceID, params *SearchWorkspaceTodosParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/workspaces/%s/todos/search", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, params.Q); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// VerifyGlobalAuditChainsWithResponse request
+	VerifyGlobalAuditChainsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VerifyGlobalAuditChainsResponse, error)
+
+	// LoginWithBodyWithResponse request with any body
+	LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+
+	LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error)
+
+	// LogoutWithBodyWithResponse request with any body
+	LogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LogoutResponse, error)
+
+	LogoutWithResponse(ctx context.Context, body LogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*LogoutResponse, error)
+
+	// LogoutAllWithResponse request
+	LogoutAllWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutAllResponse, error)
+
+	// ReauthenticateWithBodyWithResponse request with any body
+	ReauthenticateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReauthenticateResponse, error)
+
+	ReauthenticateWithResponse(ctx context.Context, body ReauthenticateJSONRequestBody, reqEditors ...RequestEditorFn) (*ReauthenticateResponse, error)
+
+	// RefreshSessionWithBodyWithResponse request with any body
+	RefreshSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshSessionResponse, error)
+
+	RefreshSessionWithResponse(ctx context.Context, body RefreshSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshSessionResponse, error)
+
+	// RegisterWithBodyWithResponse request with any body
+	RegisterWithBodyWithResponse(ctx context.Context, params *RegisterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+
+	RegisterWithResponse(ctx context.Context, params *RegisterParams, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error)
+
+	// DisableTOTPWithBodyWithResponse request with any body
+	DisableTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableTOTPResponse, error)
+
+	DisableTOTPWithResponse(ctx context.Context, body DisableTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*DisableTOTPResponse, error)
+
+	// InitiateTOTPWithResponse request
+	InitiateTOTPWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*InitiateTOTPResponse, error)
+
+	// RecoverTOTPWithBodyWithResponse request with any body
+	RecoverTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecoverTOTPResponse, error)
+
+	RecoverTOTPWithResponse(ctx context.Context, body RecoverTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*RecoverTOTPResponse, error)
+
+	// VerifyTOTPWithBodyWithResponse request with any body
+	VerifyTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyTOTPResponse, error)
+
+	VerifyTOTPWithResponse(ctx context.Context, body VerifyTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyTOTPResponse, error)
+
+	// HealthzWithResponse request
+	HealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthzResponse, error)
+
+	// CommitTaskWithBodyWithResponse request with any body
+	CommitTaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CommitTaskResponse, error)
+
+	CommitTaskWithResponse(ctx context.Context, body CommitTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*CommitTaskResponse, error)
+
+	// GetScheduleWithResponse request
+	GetScheduleWithResponse(ctx context.Context, date ScheduleDate, reqEditors ...RequestEditorFn) (*GetScheduleResponse, error)
+
+	// UpdateScheduleCapacityWithBodyWithResponse request with any body
+	UpdateScheduleCapacityWithBodyWithResponse(ctx context.Context, date ScheduleDate, params *UpdateScheduleCapacityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateScheduleCapacityResponse, error)
+
+	UpdateScheduleCapacityWithResponse(ctx context.Context, date ScheduleDate, params *UpdateScheduleCapacityParams, body UpdateScheduleCapacityJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateScheduleCapacityResponse, error)
+
+	// AutoPlanScheduleWithResponse request
+	AutoPlanScheduleWithResponse(ctx context.Context, date ScheduleDate, params *AutoPlanScheduleParams, reqEditors ...RequestEditorFn) (*AutoPlanScheduleResponse, error)
+
+	// RemoveScheduledTaskWithResponse request
+	RemoveScheduledTaskWithResponse(ctx context.Context, date ScheduleDate, todoId todoDomain.TodoID, params *RemoveScheduledTaskParams, reqEditors ...RequestEditorFn) (*RemoveScheduledTaskResponse, error)
+
+	// GetTodoByIDWithResponse request
+	GetTodoByIDWithResponse(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*GetTodoByIDResponse, error)
+
+	// UpdateTodoWithBodyWithResponse request with any body
+	UpdateTodoWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, params *UpdateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
+
+	UpdateTodoWithResponse(ctx context.Context, id todoDomain.TodoID, params *UpdateTodoParams, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error)
+
+	// ArchiveTodoWithResponse request
+	ArchiveTodoWithResponse(ctx context.Context, id todoDomain.TodoID, params *ArchiveTodoParams, reqEditors ...RequestEditorFn) (*ArchiveTodoResponse, error)
+
+	// UnassignTodoWithResponse request
+	UnassignTodoWithResponse(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*UnassignTodoResponse, error)
+
+	// AssignTodoWithBodyWithResponse request with any body
+	AssignTodoWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignTodoResponse, error)
+
+	AssignTodoWithResponse(ctx context.Context, id todoDomain.TodoID, body AssignTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignTodoResponse, error)
+
+	// AddTodoBlockerWithBodyWithResponse request with any body
+	AddTodoBlockerWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTodoBlockerResponse, error)
+
+	AddTodoBlockerWithResponse(ctx context.Context, id todoDomain.TodoID, body AddTodoBlockerJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTodoBlockerResponse, error)
+
+	// RemoveTodoBlockerWithResponse request
+	RemoveTodoBlockerWithResponse(ctx context.Context, id todoDomain.TodoID, blockerId todoDomain.TodoID, reqEditors ...RequestEditorFn) (*RemoveTodoBlockerResponse, error)
+
+	// AddChecklistItemWithBodyWithResponse request with any body
+	AddChecklistItemWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, params *AddChecklistItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddChecklistItemResponse, error)
+
+	AddChecklistItemWithResponse(ctx context.Context, id todoDomain.TodoID, params *AddChecklistItemParams, body AddChecklistItemJSONRequestBody, reqEditors ...RequestEditorFn) (*AddChecklistItemResponse, error)
+
+	// ReorderChecklistWithBodyWithResponse request with any body
+	ReorderChecklistWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReorderChecklistResponse, error)
+
+	ReorderChecklistWithResponse(ctx context.Context, id todoDomain.TodoID, body ReorderChecklistJSONRequestBody, reqEditors ...RequestEditorFn) (*ReorderChecklistResponse, error)
+
+	// RemoveChecklistItemWithResponse request
+	RemoveChecklistItemWithResponse(ctx context.Context, id todoDomain.TodoID, itemId todoDomain.ChecklistItemID, reqEditors ...RequestEditorFn) (*RemoveChecklistItemResponse, error)
+
+	// ToggleChecklistItemWithResponse request
+	ToggleChecklistItemWithResponse(ctx context.Context, id todoDomain.TodoID, itemId todoDomain.ChecklistItemID, params *ToggleChecklistItemParams, reqEditors ...RequestEditorFn) (*ToggleChecklistItemResponse, error)
+
+	// GetTodoCommentsWithResponse request
+	GetTodoCommentsWithResponse(ctx context.Context, id todoDomain.TodoID, params *GetTodoCommentsParams, reqEditors ...RequestEditorFn) (*GetTodoCommentsResponse, error)
+
+	// CreateTodoCommentWithBodyWithResponse request with any body
+	CreateTodoCommentWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoCommentResponse, error)
+
+	CreateTodoCommentWithResponse(ctx context.Context, id todoDomain.TodoID, body CreateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoCommentResponse, error)
+
+	// DeleteTodoCommentWithResponse request
+	DeleteTodoCommentWithResponse(ctx context.Context, id todoDomain.TodoID, commentId todoDomain.CommentID, reqEditors ...RequestEditorFn) (*DeleteTodoCommentResponse, error)
+
+	// UpdateTodoCommentWithBodyWithResponse request with any body
+	UpdateTodoCommentWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, commentId todoDomain.CommentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoCommentResponse, error)
+
+	UpdateTodoCommentWithResponse(ctx context.Context, id todoDomain.TodoID, commentId todoDomain.CommentID, body UpdateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoCommentResponse, error)
+
+	// CompleteTodoWithResponse request
+	CompleteTodoWithResponse(ctx context.Context, id todoDomain.TodoID, params *CompleteTodoParams, reqEditors ...RequestEditorFn) (*CompleteTodoResponse, error)
+
+	// SetTodoDueDateWithBodyWithResponse request with any body
+	SetTodoDueDateWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, params *SetTodoDueDateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTodoDueDateResponse, error)
+
+	SetTodoDueDateWithResponse(ctx context.Context, id todoDomain.TodoID, params *SetTodoDueDateParams, body SetTodoDueDateJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTodoDueDateResponse, error)
+
+	// StartFocusWithBodyWithResponse request with any body
+	StartFocusWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartFocusResponse, error)
+
+	StartFocusWithResponse(ctx context.Context, id todoDomain.TodoID, body StartFocusJSONRequestBody, reqEditors ...RequestEditorFn) (*StartFocusResponse, error)
+
+	// StopFocusWithResponse request
+	StopFocusWithResponse(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*StopFocusResponse, error)
+
+	// SetTodoRecurrenceWithBodyWithResponse request with any body
+	SetTodoRecurrenceWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, params *SetTodoRecurrenceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTodoRecurrenceResponse, error)
+
+	SetTodoRecurrenceWithResponse(ctx context.Context, id todoDomain.TodoID, params *SetTodoRecurrenceParams, body SetTodoRecurrenceJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTodoRecurrenceResponse, error)
+
+	// ReopenTodoWithResponse request
+	ReopenTodoWithResponse(ctx context.Context, id todoDomain.TodoID, params *ReopenTodoParams, reqEditors ...RequestEditorFn) (*ReopenTodoResponse, error)
+
+	// AssignTagToTodoWithBodyWithResponse request with any body
+	AssignTagToTodoWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, params *AssignTagToTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignTagToTodoResponse, error)
+
+	AssignTagToTodoWithResponse(ctx context.Context, id todoDomain.TodoID, params *AssignTagToTodoParams, body AssignTagToTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignTagToTodoResponse, error)
+
+	// RemoveTagFromTodoWithResponse request
+	RemoveTagFromTodoWithResponse(ctx context.Context, id todoDomain.TodoID, tagId todoDomain.TagID, params *RemoveTagFromTodoParams, reqEditors ...RequestEditorFn) (*RemoveTagFromTodoResponse, error)
+
+	// UnarchiveTodoWithResponse request
+	UnarchiveTodoWithResponse(ctx context.Context, id todoDomain.TodoID, params *UnarchiveTodoParams, reqEditors ...RequestEditorFn) (*UnarchiveTodoResponse, error)
+
+	// DeleteUserWithResponse request
+	DeleteUserWithResponse(ctx context.Context, id userDomain.UserID, reqEditors ...RequestEditorFn) (*DeleteUserResponse, error)
+
+	// GetUserByIDWithResponse request
+	GetUserByIDWithResponse(ctx context.Context, id userDomain.UserID, reqEditors ...RequestEditorFn) (*GetUserByIDResponse, error)
+
+	// GetUserAssignedTodosWithResponse request
+	GetUserAssignedTodosWithResponse(ctx context.Context, id userDomain.UserID, params *GetUserAssignedTodosParams, reqEditors ...RequestEditorFn) (*GetUserAssignedTodosResponse, error)
+
+	// SetUserDailyCapacityWithBodyWithResponse request with any body
+	SetUserDailyCapacityWithBodyWithResponse(ctx context.Context, id userDomain.UserID, params *SetUserDailyCapacityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetUserDailyCapacityResponse, error)
+
+	SetUserDailyCapacityWithResponse(ctx context.Context, id userDomain.UserID, params *SetUserDailyCapacityParams, body SetUserDailyCapacityJSONRequestBody, reqEditors ...RequestEditorFn) (*SetUserDailyCapacityResponse, error)
+
+	// RequestUserDataExportWithResponse request
+	RequestUserDataExportWithResponse(ctx context.Context, id userDomain.UserID, params *RequestUserDataExportParams, reqEditors ...RequestEditorFn) (*RequestUserDataExportResponse, error)
+
+	// GetUserDataExportWithResponse request
+	GetUserDataExportWithResponse(ctx context.Context, id userDomain.UserID, exportId userDomain.DataExportID, reqEditors ...RequestEditorFn) (*GetUserDataExportResponse, error)
+
+	// DownloadUserDataExportWithResponse request
+	DownloadUserDataExportWithResponse(ctx context.Context, id userDomain.UserID, exportId userDomain.DataExportID, reqEditors ...RequestEditorFn) (*DownloadUserDataExportResponse, error)
+
+	// SetUserTimezoneWithBodyWithResponse request with any body
+	SetUserTimezoneWithBodyWithResponse(ctx context.Context, id userDomain.UserID, params *SetUserTimezoneParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetUserTimezoneResponse, error)
+
+	SetUserTimezoneWithResponse(ctx context.Context, id userDomain.UserID, params *SetUserTimezoneParams, body SetUserTimezoneJSONRequestBody, reqEditors ...RequestEditorFn) (*SetUserTimezoneResponse, error)
+
+	// ResetUserTOTPWithResponse request
+	ResetUserTOTPWithResponse(ctx context.Context, id userDomain.UserID, reqEditors ...RequestEditorFn) (*ResetUserTOTPResponse, error)
+
+	// GetUserWorkspacesWithResponse request
+	GetUserWorkspacesWithResponse(ctx context.Context, id userDomain.UserID, params *GetUserWorkspacesParams, reqEditors ...RequestEditorFn) (*GetUserWorkspacesResponse, error)
+
+	// ListWorkspacesWithResponse request
+	ListWorkspacesWithResponse(ctx context.Context, params *ListWorkspacesParams, reqEditors ...RequestEditorFn) (*ListWorkspacesResponse, error)
+
+	// OnboardWorkspaceWithBodyWithResponse request with any body
+	OnboardWorkspaceWithBodyWithResponse(ctx context.Context, params *OnboardWorkspaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OnboardWorkspaceResponse, error)
+
+	OnboardWorkspaceWithResponse(ctx context.Context, params *OnboardWorkspaceParams, body OnboardWorkspaceJSONRequestBody, reqEditors ...RequestEditorFn) (*OnboardWorkspaceResponse, error)
+
+	// DeleteWorkspaceWithResponse request
+	DeleteWorkspaceWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, reqEditors ...RequestEditorFn) (*DeleteWorkspaceResponse, error)
+
+	// GetWorkspaceAuditLogsWithResponse request
+	GetWorkspaceAuditLogsWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *GetWorkspaceAuditLogsParams, reqEditors ...RequestEditorFn) (*GetWorkspaceAuditLogsResponse, error)
+
+	// VerifyWorkspaceAuditChainWithResponse request
+	VerifyWorkspaceAuditChainWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, reqEditors ...RequestEditorFn) (*VerifyWorkspaceAuditChainResponse, error)
+
+	// AddWorkspaceMemberWithBodyWithResponse request with any body
+	AddWorkspaceMemberWithBodyWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *AddWorkspaceMemberParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddWorkspaceMemberResponse, error)
+
+	AddWorkspaceMemberWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *AddWorkspaceMemberParams, body AddWorkspaceMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*AddWorkspaceMemberResponse, error)
+
+	// RemoveWorkspaceMemberWithResponse request
+	RemoveWorkspaceMemberWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, userId userDomain.UserID, reqEditors ...RequestEditorFn) (*RemoveWorkspaceMemberResponse, error)
+
+	// GetWorkspaceFocusReportWithResponse request
+	GetWorkspaceFocusReportWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *GetWorkspaceFocusReportParams, reqEditors ...RequestEditorFn) (*GetWorkspaceFocusReportResponse, error)
+
+	// GetWorkspaceTagsWithResponse request
+	GetWorkspaceTagsWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, reqEditors ...RequestEditorFn) (*GetWorkspaceTagsResponse, error)
+
+	// CreateTagWithBodyWithResponse request with any body
+	CreateTagWithBodyWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTagParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResponse, error)
+
+	CreateTagWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTagParams, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResponse, error)
+
+	// DeleteTagWithResponse request
+	DeleteTagWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, tagId todoDomain.TagID, params *DeleteTagParams, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error)
+
+	// UpdateTagWithBodyWithResponse request with any body
+	UpdateTagWithBodyWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, tagId todoDomain.TagID, params *UpdateTagParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error)
+
+	UpdateTagWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, tagId todoDomain.TagID, params *UpdateTagParams, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error)
+
+	// GetWorkspaceTodosWithResponse request
+	GetWorkspaceTodosWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *GetWorkspaceTodosParams, reqEditors ...RequestEditorFn) (*GetWorkspaceTodosResponse, error)
+
+	// CreateTodoWithBodyWithResponse request with any body
+	CreateTodoWithBodyWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error)
+
+	CreateTodoWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTodoParams, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error)
+
+	// SearchWorkspaceTodosWithResponse request
+	SearchWorkspaceTodosWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *SearchWorkspaceTodosParams, reqEditors ...RequestEditorFn) (*SearchWorkspaceTodosResponse, error)
+}
+
+type VerifyGlobalAuditChainsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GlobalAuditChainVerification
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyGlobalAuditChainsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyGlobalAuditChainsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResponseBody
+}
+
+// Status returns HTTPResponse.Status
+func (r LoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LogoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r LogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type LogoutAllResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r LogoutAllResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r LogoutAllResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReauthenticateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReauthenticateResponseBody
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ReauthenticateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReauthenticateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RefreshSessionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResponseBody
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RefreshSessionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RefreshSessionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RegisterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *IdResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RegisterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RegisterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DisableTOTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DisableTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DisableTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type InitiateTOTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InitiateTOTPResponseBody
+}
+
+// Status returns HTTPResponse.Status
+func (r InitiateTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r InitiateTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RecoverTOTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LoginResponseBody
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RecoverTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RecoverTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyTOTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *VerifyTOTPResponseBody
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HealthzResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthzResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthzResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CommitTaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CommitTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CommitTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScheduleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Schedule
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateScheduleCapacityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateScheduleCapacityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateScheduleCapacityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AutoPlanScheduleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AutoPlanResult
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AutoPlanScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AutoPlanScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveScheduledTaskResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveScheduledTaskResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveScheduledTaskResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTodoByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Todo
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTodoByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
 func (r GetTodoByIDResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
+	return 0
+}
+
+type UpdateTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ArchiveTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ArchiveTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ArchiveTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnassignTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UnassignTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnassignTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AssignTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AssignTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AssignTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddTodoBlockerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AddTodoBlockerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddTodoBlockerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveTodoBlockerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveTodoBlockerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveTodoBlockerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddChecklistItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *IdResponse
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AddChecklistItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddChecklistItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReorderChecklistResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ReorderChecklistResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReorderChecklistResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveChecklistItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveChecklistItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveChecklistItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ToggleChecklistItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ToggleChecklistItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ToggleChecklistItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTodoCommentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Comment
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTodoCommentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTodoCommentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTodoCommentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *IdResponse
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTodoCommentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTodoCommentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTodoCommentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTodoCommentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTodoCommentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTodoCommentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTodoCommentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTodoCommentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CompleteTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CompleteTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CompleteTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetTodoDueDateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetTodoDueDateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetTodoDueDateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StartFocusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r StartFocusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartFocusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StopFocusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r StopFocusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StopFocusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetTodoRecurrenceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetTodoRecurrenceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetTodoRecurrenceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReopenTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ReopenTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReopenTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AssignTagToTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AssignTagToTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AssignTagToTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveTagFromTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveTagFromTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveTagFromTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnarchiveTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UnarchiveTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnarchiveTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserByIDResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserByIDResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserByIDResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserAssignedTodosResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Todo
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserAssignedTodosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserAssignedTodosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetUserDailyCapacityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetUserDailyCapacityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetUserDailyCapacityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RequestUserDataExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *DataExport
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RequestUserDataExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RequestUserDataExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserDataExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DataExport
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserDataExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserDataExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DownloadUserDataExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DownloadUserDataExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DownloadUserDataExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetUserTimezoneResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetUserTimezoneResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetUserTimezoneResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ResetUserTOTPResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ResetUserTOTPResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ResetUserTOTPResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserWorkspacesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Workspace
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserWorkspacesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserWorkspacesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListWorkspacesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Workspace
+}
+
+// Status returns HTTPResponse.Status
+func (r ListWorkspacesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListWorkspacesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OnboardWorkspaceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *IdResponse
+	JSON400      *ErrorResponse
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r OnboardWorkspaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OnboardWorkspaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWorkspaceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWorkspaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWorkspaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkspaceAuditLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AuditLog
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWorkspaceAuditLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkspaceAuditLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyWorkspaceAuditChainResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditChainVerification
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyWorkspaceAuditChainResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyWorkspaceAuditChainResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddWorkspaceMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AddWorkspaceMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddWorkspaceMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveWorkspaceMemberResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveWorkspaceMemberResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveWorkspaceMemberResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkspaceFocusReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FocusReport
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWorkspaceFocusReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkspaceFocusReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkspaceTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Tag
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWorkspaceTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkspaceTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *IdResponse
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTagResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTagResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTagResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkspaceTodosResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Todo
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWorkspaceTodosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkspaceTodosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTodoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *IdResponse
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTodoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTodoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchWorkspaceTodosResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TodoSearchResult
+	JSON4XX      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchWorkspaceTodosResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchWorkspaceTodosResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// VerifyGlobalAuditChainsWithResponse request returning *VerifyGlobalAuditChainsResponse
+func (c *ClientWithResponses) VerifyGlobalAuditChainsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*VerifyGlobalAuditChainsResponse, error) {
+	rsp, err := c.VerifyGlobalAuditChains(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyGlobalAuditChainsResponse(rsp)
+}
+
+// LoginWithBodyWithResponse request with arbitrary body returning *LoginResponse
+func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
+	rsp, err := c.LoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
+	rsp, err := c.Login(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLoginResponse(rsp)
+}
+
+// LogoutWithBodyWithResponse request with arbitrary body returning *LogoutResponse
+func (c *ClientWithResponses) LogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LogoutResponse, error) {
+	rsp, err := c.LogoutWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) LogoutWithResponse(ctx context.Context, body LogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*LogoutResponse, error) {
+	rsp, err := c.Logout(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogoutResponse(rsp)
+}
+
+// LogoutAllWithResponse request returning *LogoutAllResponse
+func (c *ClientWithResponses) LogoutAllWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*LogoutAllResponse, error) {
+	rsp, err := c.LogoutAll(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogoutAllResponse(rsp)
+}
+
+// ReauthenticateWithBodyWithResponse request with arbitrary body returning *ReauthenticateResponse
+func (c *ClientWithResponses) ReauthenticateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReauthenticateResponse, error) {
+	rsp, err := c.ReauthenticateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReauthenticateResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReauthenticateWithResponse(ctx context.Context, body ReauthenticateJSONRequestBody, reqEditors ...RequestEditorFn) (*ReauthenticateResponse, error) {
+	rsp, err := c.Reauthenticate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReauthenticateResponse(rsp)
+}
+
+// RefreshSessionWithBodyWithResponse request with arbitrary body returning *RefreshSessionResponse
+func (c *ClientWithResponses) RefreshSessionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RefreshSessionResponse, error) {
+	rsp, err := c.RefreshSessionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshSessionResponse(rsp)
+}
+
+func (c *ClientWithResponses) RefreshSessionWithResponse(ctx context.Context, body RefreshSessionJSONRequestBody, reqEditors ...RequestEditorFn) (*RefreshSessionResponse, error) {
+	rsp, err := c.RefreshSession(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRefreshSessionResponse(rsp)
+}
+
+// RegisterWithBodyWithResponse request with arbitrary body returning *RegisterResponse
+func (c *ClientWithResponses) RegisterWithBodyWithResponse(ctx context.Context, params *RegisterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error) {
+	rsp, err := c.RegisterWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterResponse(rsp)
+}
+
+func (c *ClientWithResponses) RegisterWithResponse(ctx context.Context, params *RegisterParams, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error) {
+	rsp, err := c.Register(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRegisterResponse(rsp)
+}
+
+// DisableTOTPWithBodyWithResponse request with arbitrary body returning *DisableTOTPResponse
+func (c *ClientWithResponses) DisableTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DisableTOTPResponse, error) {
+	rsp, err := c.DisableTOTPWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableTOTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) DisableTOTPWithResponse(ctx context.Context, body DisableTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*DisableTOTPResponse, error) {
+	rsp, err := c.DisableTOTP(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDisableTOTPResponse(rsp)
+}
+
+// InitiateTOTPWithResponse request returning *InitiateTOTPResponse
+func (c *ClientWithResponses) InitiateTOTPWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*InitiateTOTPResponse, error) {
+	rsp, err := c.InitiateTOTP(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseInitiateTOTPResponse(rsp)
+}
+
+// RecoverTOTPWithBodyWithResponse request with arbitrary body returning *RecoverTOTPResponse
+func (c *ClientWithResponses) RecoverTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecoverTOTPResponse, error) {
+	rsp, err := c.RecoverTOTPWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecoverTOTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) RecoverTOTPWithResponse(ctx context.Context, body RecoverTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*RecoverTOTPResponse, error) {
+	rsp, err := c.RecoverTOTP(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecoverTOTPResponse(rsp)
+}
+
+// VerifyTOTPWithBodyWithResponse request with arbitrary body returning *VerifyTOTPResponse
+func (c *ClientWithResponses) VerifyTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyTOTPResponse, error) {
+	rsp, err := c.VerifyTOTPWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyTOTPResponse(rsp)
+}
+
+func (c *ClientWithResponses) VerifyTOTPWithResponse(ctx context.Context, body VerifyTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyTOTPResponse, error) {
+	rsp, err := c.VerifyTOTP(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyTOTPResponse(rsp)
+}
+
+// HealthzWithResponse request returning *HealthzResponse
+func (c *ClientWithResponses) HealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthzResponse, error) {
+	rsp, err := c.Healthz(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthzResponse(rsp)
+}
+
+// CommitTaskWithBodyWithResponse request with arbitrary body returning *CommitTaskResponse
+func (c *ClientWithResponses) CommitTaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CommitTaskResponse, error) {
+	rsp, err := c.CommitTaskWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCommitTaskResponse(rsp)
+}
+
+func (c *ClientWithResponses) CommitTaskWithResponse(ctx context.Context, body CommitTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*CommitTaskResponse, error) {
+	rsp, err := c.CommitTask(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCommitTaskResponse(rsp)
+}
+
+// GetScheduleWithResponse request returning *GetScheduleResponse
+func (c *ClientWithResponses) GetScheduleWithResponse(ctx context.Context, date ScheduleDate, reqEditors ...RequestEditorFn) (*GetScheduleResponse, error) {
+	rsp, err := c.GetSchedule(ctx, date, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScheduleResponse(rsp)
+}
+
+// UpdateScheduleCapacityWithBodyWithResponse request with arbitrary body returning *UpdateScheduleCapacityResponse
+func (c *ClientWithResponses) UpdateScheduleCapacityWithBodyWithResponse(ctx context.Context, date ScheduleDate, params *UpdateScheduleCapacityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateScheduleCapacityResponse, error) {
+	rsp, err := c.UpdateScheduleCapacityWithBody(ctx, date, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateScheduleCapacityResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateScheduleCapacityWithResponse(ctx context.Context, date ScheduleDate, params *UpdateScheduleCapacityParams, body UpdateScheduleCapacityJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateScheduleCapacityResponse, error) {
+	rsp, err := c.UpdateScheduleCapacity(ctx, date, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateScheduleCapacityResponse(rsp)
+}
+
+// AutoPlanScheduleWithResponse request returning *AutoPlanScheduleResponse
+func (c *ClientWithResponses) AutoPlanScheduleWithResponse(ctx context.Context, date ScheduleDate, params *AutoPlanScheduleParams, reqEditors ...RequestEditorFn) (*AutoPlanScheduleResponse, error) {
+	rsp, err := c.AutoPlanSchedule(ctx, date, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAutoPlanScheduleResponse(rsp)
+}
+
+// RemoveScheduledTaskWithResponse request returning *RemoveScheduledTaskResponse
+func (c *ClientWithResponses) RemoveScheduledTaskWithResponse(ctx context.Context, date ScheduleDate, todoId todoDomain.TodoID, params *RemoveScheduledTaskParams, reqEditors ...RequestEditorFn) (*RemoveScheduledTaskResponse, error) {
+	rsp, err := c.RemoveScheduledTask(ctx, date, todoId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveScheduledTaskResponse(rsp)
+}
+
+// GetTodoByIDWithResponse request returning *GetTodoByIDResponse
+func (c *ClientWithResponses) GetTodoByIDWithResponse(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*GetTodoByIDResponse, error) {
+	rsp, err := c.GetTodoByID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTodoByIDResponse(rsp)
+}
+
+// UpdateTodoWithBodyWithResponse request with arbitrary body returning *UpdateTodoResponse
+func (c *ClientWithResponses) UpdateTodoWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, params *UpdateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
+	rsp, err := c.UpdateTodoWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTodoWithResponse(ctx context.Context, id todoDomain.TodoID, params *UpdateTodoParams, body UpdateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoResponse, error) {
+	rsp, err := c.UpdateTodo(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTodoResponse(rsp)
+}
+
+// ArchiveTodoWithResponse request returning *ArchiveTodoResponse
+func (c *ClientWithResponses) ArchiveTodoWithResponse(ctx context.Context, id todoDomain.TodoID, params *ArchiveTodoParams, reqEditors ...RequestEditorFn) (*ArchiveTodoResponse, error) {
+	rsp, err := c.ArchiveTodo(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseArchiveTodoResponse(rsp)
+}
+
+// UnassignTodoWithResponse request returning *UnassignTodoResponse
+func (c *ClientWithResponses) UnassignTodoWithResponse(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*UnassignTodoResponse, error) {
+	rsp, err := c.UnassignTodo(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnassignTodoResponse(rsp)
+}
+
+// AssignTodoWithBodyWithResponse request with arbitrary body returning *AssignTodoResponse
+func (c *ClientWithResponses) AssignTodoWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignTodoResponse, error) {
+	rsp, err := c.AssignTodoWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAssignTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) AssignTodoWithResponse(ctx context.Context, id todoDomain.TodoID, body AssignTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignTodoResponse, error) {
+	rsp, err := c.AssignTodo(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAssignTodoResponse(rsp)
+}
+
+// AddTodoBlockerWithBodyWithResponse request with arbitrary body returning *AddTodoBlockerResponse
+func (c *ClientWithResponses) AddTodoBlockerWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddTodoBlockerResponse, error) {
+	rsp, err := c.AddTodoBlockerWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTodoBlockerResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddTodoBlockerWithResponse(ctx context.Context, id todoDomain.TodoID, body AddTodoBlockerJSONRequestBody, reqEditors ...RequestEditorFn) (*AddTodoBlockerResponse, error) {
+	rsp, err := c.AddTodoBlocker(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddTodoBlockerResponse(rsp)
+}
+
+// RemoveTodoBlockerWithResponse request returning *RemoveTodoBlockerResponse
+func (c *ClientWithResponses) RemoveTodoBlockerWithResponse(ctx context.Context, id todoDomain.TodoID, blockerId todoDomain.TodoID, reqEditors ...RequestEditorFn) (*RemoveTodoBlockerResponse, error) {
+	rsp, err := c.RemoveTodoBlocker(ctx, id, blockerId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveTodoBlockerResponse(rsp)
+}
+
+// AddChecklistItemWithBodyWithResponse request with arbitrary body returning *AddChecklistItemResponse
+func (c *ClientWithResponses) AddChecklistItemWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, params *AddChecklistItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddChecklistItemResponse, error) {
+	rsp, err := c.AddChecklistItemWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddChecklistItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddChecklistItemWithResponse(ctx context.Context, id todoDomain.TodoID, params *AddChecklistItemParams, body AddChecklistItemJSONRequestBody, reqEditors ...RequestEditorFn) (*AddChecklistItemResponse, error) {
+	rsp, err := c.AddChecklistItem(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddChecklistItemResponse(rsp)
+}
+
+// ReorderChecklistWithBodyWithResponse request with arbitrary body returning *ReorderChecklistResponse
+func (c *ClientWithResponses) ReorderChecklistWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReorderChecklistResponse, error) {
+	rsp, err := c.ReorderChecklistWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReorderChecklistResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReorderChecklistWithResponse(ctx context.Context, id todoDomain.TodoID, body ReorderChecklistJSONRequestBody, reqEditors ...RequestEditorFn) (*ReorderChecklistResponse, error) {
+	rsp, err := c.ReorderChecklist(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReorderChecklistResponse(rsp)
+}
+
+// RemoveChecklistItemWithResponse request returning *RemoveChecklistItemResponse
+func (c *ClientWithResponses) RemoveChecklistItemWithResponse(ctx context.Context, id todoDomain.TodoID, itemId todoDomain.ChecklistItemID, reqEditors ...RequestEditorFn) (*RemoveChecklistItemResponse, error) {
+	rsp, err := c.RemoveChecklistItem(ctx, id, itemId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveChecklistItemResponse(rsp)
+}
+
+// ToggleChecklistItemWithResponse request returning *ToggleChecklistItemResponse
+func (c *ClientWithResponses) ToggleChecklistItemWithResponse(ctx context.Context, id todoDomain.TodoID, itemId todoDomain.ChecklistItemID, params *ToggleChecklistItemParams, reqEditors ...RequestEditorFn) (*ToggleChecklistItemResponse, error) {
+	rsp, err := c.ToggleChecklistItem(ctx, id, itemId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseToggleChecklistItemResponse(rsp)
+}
+
+// GetTodoCommentsWithResponse request returning *GetTodoCommentsResponse
+func (c *ClientWithResponses) GetTodoCommentsWithResponse(ctx context.Context, id todoDomain.TodoID, params *GetTodoCommentsParams, reqEditors ...RequestEditorFn) (*GetTodoCommentsResponse, error) {
+	rsp, err := c.GetTodoComments(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTodoCommentsResponse(rsp)
+}
+
+// CreateTodoCommentWithBodyWithResponse request with arbitrary body returning *CreateTodoCommentResponse
+func (c *ClientWithResponses) CreateTodoCommentWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoCommentResponse, error) {
+	rsp, err := c.CreateTodoCommentWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTodoCommentResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTodoCommentWithResponse(ctx context.Context, id todoDomain.TodoID, body CreateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoCommentResponse, error) {
+	rsp, err := c.CreateTodoComment(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTodoCommentResponse(rsp)
+}
+
+// DeleteTodoCommentWithResponse request returning *DeleteTodoCommentResponse
+func (c *ClientWithResponses) DeleteTodoCommentWithResponse(ctx context.Context, id todoDomain.TodoID, commentId todoDomain.CommentID, reqEditors ...RequestEditorFn) (*DeleteTodoCommentResponse, error) {
+	rsp, err := c.DeleteTodoComment(ctx, id, commentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTodoCommentResponse(rsp)
+}
+
+// UpdateTodoCommentWithBodyWithResponse request with arbitrary body returning *UpdateTodoCommentResponse
+func (c *ClientWithResponses) UpdateTodoCommentWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, commentId todoDomain.CommentID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTodoCommentResponse, error) {
+	rsp, err := c.UpdateTodoCommentWithBody(ctx, id, commentId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTodoCommentResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTodoCommentWithResponse(ctx context.Context, id todoDomain.TodoID, commentId todoDomain.CommentID, body UpdateTodoCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTodoCommentResponse, error) {
+	rsp, err := c.UpdateTodoComment(ctx, id, commentId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTodoCommentResponse(rsp)
+}
+
+// CompleteTodoWithResponse request returning *CompleteTodoResponse
+func (c *ClientWithResponses) CompleteTodoWithResponse(ctx context.Context, id todoDomain.TodoID, params *CompleteTodoParams, reqEditors ...RequestEditorFn) (*CompleteTodoResponse, error) {
+	rsp, err := c.CompleteTodo(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCompleteTodoResponse(rsp)
+}
+
+// SetTodoDueDateWithBodyWithResponse request with arbitrary body returning *SetTodoDueDateResponse
+func (c *ClientWithResponses) SetTodoDueDateWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, params *SetTodoDueDateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTodoDueDateResponse, error) {
+	rsp, err := c.SetTodoDueDateWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTodoDueDateResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetTodoDueDateWithResponse(ctx context.Context, id todoDomain.TodoID, params *SetTodoDueDateParams, body SetTodoDueDateJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTodoDueDateResponse, error) {
+	rsp, err := c.SetTodoDueDate(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTodoDueDateResponse(rsp)
+}
+
+// StartFocusWithBodyWithResponse request with arbitrary body returning *StartFocusResponse
+func (c *ClientWithResponses) StartFocusWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartFocusResponse, error) {
+	rsp, err := c.StartFocusWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartFocusResponse(rsp)
+}
+
+func (c *ClientWithResponses) StartFocusWithResponse(ctx context.Context, id todoDomain.TodoID, body StartFocusJSONRequestBody, reqEditors ...RequestEditorFn) (*StartFocusResponse, error) {
+	rsp, err := c.StartFocus(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartFocusResponse(rsp)
+}
+
+// StopFocusWithResponse request returning *StopFocusResponse
+func (c *ClientWithResponses) StopFocusWithResponse(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*StopFocusResponse, error) {
+	rsp, err := c.StopFocus(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStopFocusResponse(rsp)
+}
+
+// SetTodoRecurrenceWithBodyWithResponse request with arbitrary body returning *SetTodoRecurrenceResponse
+func (c *ClientWithResponses) SetTodoRecurrenceWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, params *SetTodoRecurrenceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetTodoRecurrenceResponse, error) {
+	rsp, err := c.SetTodoRecurrenceWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTodoRecurrenceResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetTodoRecurrenceWithResponse(ctx context.Context, id todoDomain.TodoID, params *SetTodoRecurrenceParams, body SetTodoRecurrenceJSONRequestBody, reqEditors ...RequestEditorFn) (*SetTodoRecurrenceResponse, error) {
+	rsp, err := c.SetTodoRecurrence(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetTodoRecurrenceResponse(rsp)
+}
+
+// ReopenTodoWithResponse request returning *ReopenTodoResponse
+func (c *ClientWithResponses) ReopenTodoWithResponse(ctx context.Context, id todoDomain.TodoID, params *ReopenTodoParams, reqEditors ...RequestEditorFn) (*ReopenTodoResponse, error) {
+	rsp, err := c.ReopenTodo(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReopenTodoResponse(rsp)
+}
+
+// AssignTagToTodoWithBodyWithResponse request with arbitrary body returning *AssignTagToTodoResponse
+func (c *ClientWithResponses) AssignTagToTodoWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, params *AssignTagToTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignTagToTodoResponse, error) {
+	rsp, err := c.AssignTagToTodoWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAssignTagToTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) AssignTagToTodoWithResponse(ctx context.Context, id todoDomain.TodoID, params *AssignTagToTodoParams, body AssignTagToTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignTagToTodoResponse, error) {
+	rsp, err := c.AssignTagToTodo(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAssignTagToTodoResponse(rsp)
+}
+
+// RemoveTagFromTodoWithResponse request returning *RemoveTagFromTodoResponse
+func (c *ClientWithResponses) RemoveTagFromTodoWithResponse(ctx context.Context, id todoDomain.TodoID, tagId todoDomain.TagID, params *RemoveTagFromTodoParams, reqEditors ...RequestEditorFn) (*RemoveTagFromTodoResponse, error) {
+	rsp, err := c.RemoveTagFromTodo(ctx, id, tagId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveTagFromTodoResponse(rsp)
+}
+
+// UnarchiveTodoWithResponse request returning *UnarchiveTodoResponse
+func (c *ClientWithResponses) UnarchiveTodoWithResponse(ctx context.Context, id todoDomain.TodoID, params *UnarchiveTodoParams, reqEditors ...RequestEditorFn) (*UnarchiveTodoResponse, error) {
+	rsp, err := c.UnarchiveTodo(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnarchiveTodoResponse(rsp)
+}
+
+// DeleteUserWithResponse request returning *DeleteUserResponse
+func (c *ClientWithResponses) DeleteUserWithResponse(ctx context.Context, id userDomain.UserID, reqEditors ...RequestEditorFn) (*DeleteUserResponse, error) {
+	rsp, err := c.DeleteUser(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUserResponse(rsp)
+}
+
+// GetUserByIDWithResponse request returning *GetUserByIDResponse
+func (c *ClientWithResponses) GetUserByIDWithResponse(ctx context.Context, id userDomain.UserID, reqEditors ...RequestEditorFn) (*GetUserByIDResponse, error) {
+	rsp, err := c.GetUserByID(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserByIDResponse(rsp)
+}
+
+// GetUserAssignedTodosWithResponse request returning *GetUserAssignedTodosResponse
+func (c *ClientWithResponses) GetUserAssignedTodosWithResponse(ctx context.Context, id userDomain.UserID, params *GetUserAssignedTodosParams, reqEditors ...RequestEditorFn) (*GetUserAssignedTodosResponse, error) {
+	rsp, err := c.GetUserAssignedTodos(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserAssignedTodosResponse(rsp)
+}
+
+// SetUserDailyCapacityWithBodyWithResponse request with arbitrary body returning *SetUserDailyCapacityResponse
+func (c *ClientWithResponses) SetUserDailyCapacityWithBodyWithResponse(ctx context.Context, id userDomain.UserID, params *SetUserDailyCapacityParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetUserDailyCapacityResponse, error) {
+	rsp, err := c.SetUserDailyCapacityWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetUserDailyCapacityResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetUserDailyCapacityWithResponse(ctx context.Context, id userDomain.UserID, params *SetUserDailyCapacityParams, body SetUserDailyCapacityJSONRequestBody, reqEditors ...RequestEditorFn) (*SetUserDailyCapacityResponse, error) {
+	rsp, err := c.SetUserDailyCapacity(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetUserDailyCapacityResponse(rsp)
+}
+
+// RequestUserDataExportWithResponse request returning *RequestUserDataExportResponse
+func (c *ClientWithResponses) RequestUserDataExportWithResponse(ctx context.Context, id userDomain.UserID, params *RequestUserDataExportParams, reqEditors ...RequestEditorFn) (*RequestUserDataExportResponse, error) {
+	rsp, err := c.RequestUserDataExport(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRequestUserDataExportResponse(rsp)
+}
+
+// GetUserDataExportWithResponse request returning *GetUserDataExportResponse
+func (c *ClientWithResponses) GetUserDataExportWithResponse(ctx context.Context, id userDomain.UserID, exportId userDomain.DataExportID, reqEditors ...RequestEditorFn) (*GetUserDataExportResponse, error) {
+	rsp, err := c.GetUserDataExport(ctx, id, exportId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserDataExportResponse(rsp)
+}
+
+// DownloadUserDataExportWithResponse request returning *DownloadUserDataExportResponse
+func (c *ClientWithResponses) DownloadUserDataExportWithResponse(ctx context.Context, id userDomain.UserID, exportId userDomain.DataExportID, reqEditors ...RequestEditorFn) (*DownloadUserDataExportResponse, error) {
+	rsp, err := c.DownloadUserDataExport(ctx, id, exportId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDownloadUserDataExportResponse(rsp)
+}
+
+// SetUserTimezoneWithBodyWithResponse request with arbitrary body returning *SetUserTimezoneResponse
+func (c *ClientWithResponses) SetUserTimezoneWithBodyWithResponse(ctx context.Context, id userDomain.UserID, params *SetUserTimezoneParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetUserTimezoneResponse, error) {
+	rsp, err := c.SetUserTimezoneWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetUserTimezoneResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetUserTimezoneWithResponse(ctx context.Context, id userDomain.UserID, params *SetUserTimezoneParams, body SetUserTimezoneJSONRequestBody, reqEditors ...RequestEditorFn) (*SetUserTimezoneResponse, error) {
+	rsp, err := c.SetUserTimezone(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetUserTimezoneResponse(rsp)
+}
+
+// ResetUserTOTPWithResponse request returning *ResetUserTOTPResponse
+func (c *ClientWithResponses) ResetUserTOTPWithResponse(ctx context.Context, id userDomain.UserID, reqEditors ...RequestEditorFn) (*ResetUserTOTPResponse, error) {
+	rsp, err := c.ResetUserTOTP(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseResetUserTOTPResponse(rsp)
+}
+
+// GetUserWorkspacesWithResponse request returning *GetUserWorkspacesResponse
+func (c *ClientWithResponses) GetUserWorkspacesWithResponse(ctx context.Context, id userDomain.UserID, params *GetUserWorkspacesParams, reqEditors ...RequestEditorFn) (*GetUserWorkspacesResponse, error) {
+	rsp, err := c.GetUserWorkspaces(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserWorkspacesResponse(rsp)
+}
+
+// ListWorkspacesWithResponse request returning *ListWorkspacesResponse
+func (c *ClientWithResponses) ListWorkspacesWithResponse(ctx context.Context, params *ListWorkspacesParams, reqEditors ...RequestEditorFn) (*ListWorkspacesResponse, error) {
+	rsp, err := c.ListWorkspaces(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListWorkspacesResponse(rsp)
+}
+
+// OnboardWorkspaceWithBodyWithResponse request with arbitrary body returning *OnboardWorkspaceResponse
+func (c *ClientWithResponses) OnboardWorkspaceWithBodyWithResponse(ctx context.Context, params *OnboardWorkspaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OnboardWorkspaceResponse, error) {
+	rsp, err := c.OnboardWorkspaceWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOnboardWorkspaceResponse(rsp)
+}
+
+func (c *ClientWithResponses) OnboardWorkspaceWithResponse(ctx context.Context, params *OnboardWorkspaceParams, body OnboardWorkspaceJSONRequestBody, reqEditors ...RequestEditorFn) (*OnboardWorkspaceResponse, error) {
+	rsp, err := c.OnboardWorkspace(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOnboardWorkspaceResponse(rsp)
+}
+
+// DeleteWorkspaceWithResponse request returning *DeleteWorkspaceResponse
+func (c *ClientWithResponses) DeleteWorkspaceWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, reqEditors ...RequestEditorFn) (*DeleteWorkspaceResponse, error) {
+	rsp, err := c.DeleteWorkspace(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWorkspaceResponse(rsp)
+}
+
+// GetWorkspaceAuditLogsWithResponse request returning *GetWorkspaceAuditLogsResponse
+func (c *ClientWithResponses) GetWorkspaceAuditLogsWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *GetWorkspaceAuditLogsParams, reqEditors ...RequestEditorFn) (*GetWorkspaceAuditLogsResponse, error) {
+	rsp, err := c.GetWorkspaceAuditLogs(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkspaceAuditLogsResponse(rsp)
+}
+
+// VerifyWorkspaceAuditChainWithResponse request returning *VerifyWorkspaceAuditChainResponse
+func (c *ClientWithResponses) VerifyWorkspaceAuditChainWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, reqEditors ...RequestEditorFn) (*VerifyWorkspaceAuditChainResponse, error) {
+	rsp, err := c.VerifyWorkspaceAuditChain(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyWorkspaceAuditChainResponse(rsp)
+}
+
+// AddWorkspaceMemberWithBodyWithResponse request with arbitrary body returning *AddWorkspaceMemberResponse
+func (c *ClientWithResponses) AddWorkspaceMemberWithBodyWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *AddWorkspaceMemberParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddWorkspaceMemberResponse, error) {
+	rsp, err := c.AddWorkspaceMemberWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddWorkspaceMemberResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddWorkspaceMemberWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *AddWorkspaceMemberParams, body AddWorkspaceMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*AddWorkspaceMemberResponse, error) {
+	rsp, err := c.AddWorkspaceMember(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddWorkspaceMemberResponse(rsp)
+}
+
+// RemoveWorkspaceMemberWithResponse request returning *RemoveWorkspaceMemberResponse
+func (c *ClientWithResponses) RemoveWorkspaceMemberWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, userId userDomain.UserID, reqEditors ...RequestEditorFn) (*RemoveWorkspaceMemberResponse, error) {
+	rsp, err := c.RemoveWorkspaceMember(ctx, id, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveWorkspaceMemberResponse(rsp)
+}
+
+// GetWorkspaceFocusReportWithResponse request returning *GetWorkspaceFocusReportResponse
+func (c *ClientWithResponses) GetWorkspaceFocusReportWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *GetWorkspaceFocusReportParams, reqEditors ...RequestEditorFn) (*GetWorkspaceFocusReportResponse, error) {
+	rsp, err := c.GetWorkspaceFocusReport(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkspaceFocusReportResponse(rsp)
+}
+
+// GetWorkspaceTagsWithResponse request returning *GetWorkspaceTagsResponse
+func (c *ClientWithResponses) GetWorkspaceTagsWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, reqEditors ...RequestEditorFn) (*GetWorkspaceTagsResponse, error) {
+	rsp, err := c.GetWorkspaceTags(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkspaceTagsResponse(rsp)
+}
+
+// CreateTagWithBodyWithResponse request with arbitrary body returning *CreateTagResponse
+func (c *ClientWithResponses) CreateTagWithBodyWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTagParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResponse, error) {
+	rsp, err := c.CreateTagWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTagResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTagWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTagParams, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResponse, error) {
+	rsp, err := c.CreateTag(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTagResponse(rsp)
+}
+
+// DeleteTagWithResponse request returning *DeleteTagResponse
+func (c *ClientWithResponses) DeleteTagWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, tagId todoDomain.TagID, params *DeleteTagParams, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error) {
+	rsp, err := c.DeleteTag(ctx, id, tagId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTagResponse(rsp)
+}
+
+// UpdateTagWithBodyWithResponse request with arbitrary body returning *UpdateTagResponse
+func (c *ClientWithResponses) UpdateTagWithBodyWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, tagId todoDomain.TagID, params *UpdateTagParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error) {
+	rsp, err := c.UpdateTagWithBody(ctx, id, tagId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTagResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTagWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, tagId todoDomain.TagID, params *UpdateTagParams, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error) {
+	rsp, err := c.UpdateTag(ctx, id, tagId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTagResponse(rsp)
+}
+
+// GetWorkspaceTodosWithResponse request returning *GetWorkspaceTodosResponse
+func (c *ClientWithResponses) GetWorkspaceTodosWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *GetWorkspaceTodosParams, reqEditors ...RequestEditorFn) (*GetWorkspaceTodosResponse, error) {
+	rsp, err := c.GetWorkspaceTodos(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkspaceTodosResponse(rsp)
+}
+
+// CreateTodoWithBodyWithResponse request with arbitrary body returning *CreateTodoResponse
+func (c *ClientWithResponses) CreateTodoWithBodyWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error) {
+	rsp, err := c.CreateTodoWithBody(ctx, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTodoResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTodoWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTodoParams, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error) {
+	rsp, err := c.CreateTodo(ctx, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTodoResponse(rsp)
+}
+
+// SearchWorkspaceTodosWithResponse request returning *SearchWorkspaceTodosResponse
+func (c *ClientWithResponses) SearchWorkspaceTodosWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *SearchWorkspaceTodosParams, reqEditors ...RequestEditorFn) (*SearchWorkspaceTodosResponse, error) {
+	rsp, err := c.SearchWorkspaceTodos(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchWorkspaceTodosResponse(rsp)
+}
+
+// ParseVerifyGlobalAuditChainsResponse parses an HTTP response from a VerifyGlobalAuditChainsWithResponse call
+func ParseVerifyGlobalAuditChainsResponse(rsp *http.Response) (*VerifyGlobalAuditChainsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyGlobalAuditChainsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GlobalAuditChainVerification
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLoginResponse parses an HTTP response from a LoginWithResponse call
+func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLogoutResponse parses an HTTP response from a LogoutWithResponse call
+func ParseLogoutResponse(rsp *http.Response) (*LogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseLogoutAllResponse parses an HTTP response from a LogoutAllWithResponse call
+func ParseLogoutAllResponse(rsp *http.Response) (*LogoutAllResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &LogoutAllResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReauthenticateResponse parses an HTTP response from a ReauthenticateWithResponse call
+func ParseReauthenticateResponse(rsp *http.Response) (*ReauthenticateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReauthenticateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReauthenticateResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRefreshSessionResponse parses an HTTP response from a RefreshSessionWithResponse call
+func ParseRefreshSessionResponse(rsp *http.Response) (*RefreshSessionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RefreshSessionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
 
-type CompleteTodoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON4XX      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CompleteTodoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseRegisterResponse parses an HTTP response from a RegisterWithResponse call
+func ParseRegisterResponse(rsp *http.Response) (*RegisterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CompleteTodoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &RegisterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type StartFocusResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON4XX      *ErrorResponse
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest IdResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r StartFocusResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDisableTOTPResponse parses an HTTP response from a DisableTOTPWithResponse call
+func ParseDisableTOTPResponse(rsp *http.Response) (*DisableTOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &DisableTOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StartFocusResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseInitiateTOTPResponse parses an HTTP response from a InitiateTOTPWithResponse call
+func ParseInitiateTOTPResponse(rsp *http.Response) (*InitiateTOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	response := &InitiateTOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InitiateTOTPResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-type StopFocusResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON4XX      *ErrorResponse
+// ParseRecoverTOTPResponse parses an HTTP response from a RecoverTOTPWithResponse call
+func ParseRecoverTOTPResponse(rsp *http.Response) (*RecoverTOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RecoverTOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LoginResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r StopFocusResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseVerifyTOTPResponse parses an HTTP response from a VerifyTOTPWithResponse call
+func ParseVerifyTOTPResponse(rsp *http.Response) (*VerifyTOTPResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &VerifyTOTPResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest VerifyTOTPResponseBody
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r StopFocusResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseHealthzResponse parses an HTTP response from a HealthzWithResponse call
+func ParseHealthzResponse(rsp *http.Response) (*HealthzResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	response := &HealthzResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-type AssignTagToTodoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON4XX      *ErrorResponse
+// ParseCommitTaskResponse parses an HTTP response from a CommitTaskWithResponse call
+func ParseCommitTaskResponse(rsp *http.Response) (*CommitTaskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CommitTaskResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r AssignTagToTodoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetScheduleResponse parses an HTTP response from a GetScheduleWithResponse call
+func ParseGetScheduleResponse(rsp *http.Response) (*GetScheduleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &GetScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Schedule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AssignTagToTodoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseUpdateScheduleCapacityResponse parses an HTTP response from a UpdateScheduleCapacityWithResponse call
+func ParseUpdateScheduleCapacityResponse(rsp *http.Response) (*UpdateScheduleCapacityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateScheduleCapacityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
 	}
-	return 0
-}
 
-type GetUserByIDResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetUserByIDResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseAutoPlanScheduleResponse parses an HTTP response from a AutoPlanScheduleWithResponse call
+func ParseAutoPlanScheduleResponse(rsp *http.Response) (*AutoPlanScheduleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUserByIDResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &AutoPlanScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetUserWorkspacesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Workspace
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AutoPlanResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetUserWorkspacesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUserWorkspacesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type ListWorkspacesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Workspace
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListWorkspacesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseRemoveScheduledTaskResponse parses an HTTP response from a RemoveScheduledTaskWithResponse call
+func ParseRemoveScheduledTaskResponse(rsp *http.Response) (*RemoveScheduledTaskResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListWorkspacesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &RemoveScheduledTaskResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type OnboardWorkspaceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *IdResponse
-	JSON400      *ErrorResponse
-	JSON4XX      *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
 
-// Status returns HTTPResponse.Status
-func (r OnboardWorkspaceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r OnboardWorkspaceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type DeleteWorkspaceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON4XX      *ErrorResponse
-}
+// ParseGetTodoByIDResponse parses an HTTP response from a GetTodoByIDWithResponse call
+func ParseGetTodoByIDResponse(rsp *http.Response) (*GetTodoByIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteWorkspaceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &GetTodoByIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteWorkspaceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Todo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
 	}
-	return 0
-}
 
-type AddWorkspaceMemberResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON4XX      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r AddWorkspaceMemberResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseUpdateTodoResponse parses an HTTP response from a UpdateTodoWithResponse call
+func ParseUpdateTodoResponse(rsp *http.Response) (*UpdateTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r AddWorkspaceMemberResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &UpdateTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type RemoveWorkspaceMemberResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON4XX      *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
 
-// Status returns HTTPResponse.Status
-func (r RemoveWorkspaceMemberResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RemoveWorkspaceMemberResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type GetWorkspaceTagsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Tag
-}
+// ParseArchiveTodoResponse parses an HTTP response from a ArchiveTodoWithResponse call
+func ParseArchiveTodoResponse(rsp *http.Response) (*ArchiveTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetWorkspaceTagsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &ArchiveTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetWorkspaceTagsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
 	}
-	return 0
-}
 
-type CreateTagResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *IdResponse
-	JSON4XX      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateTagResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseUnassignTodoResponse parses an HTTP response from a UnassignTodoWithResponse call
+func ParseUnassignTodoResponse(rsp *http.Response) (*UnassignTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateTagResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &UnassignTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
 	}
-	return 0
-}
 
-type GetWorkspaceTodosResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Todo
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetWorkspaceTodosResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseAssignTodoResponse parses an HTTP response from a AssignTodoWithResponse call
+func ParseAssignTodoResponse(rsp *http.Response) (*AssignTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetWorkspaceTodosResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &AssignTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type CreateTodoResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *IdResponse
-	JSON4XX      *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
 
-// Status returns HTTPResponse.Status
-func (r CreateTodoResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateTodoResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-// LoginWithBodyWithResponse request with arbitrary body returning *LoginResponse
-func (c *ClientWithResponses) LoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
-	rsp, err := c.LoginWithBody(ctx, contentType, body, reqEditors...)
+// ParseAddTodoBlockerResponse parses an HTTP response from a AddTodoBlockerWithResponse call
+func ParseAddTodoBlockerResponse(rsp *http.Response) (*AddTodoBlockerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseLoginResponse(rsp)
-}
 
-func (c *ClientWithResponses) LoginWithResponse(ctx context.Context, body LoginJSONRequestBody, reqEditors ...RequestEditorFn) (*LoginResponse, error) {
-	rsp, err := c.Login(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AddTodoBlockerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseLoginResponse(rsp)
-}
 
-// RegisterWithBodyWithResponse request with arbitrary body returning *RegisterResponse
-func (c *ClientWithResponses) RegisterWithBodyWithResponse(ctx context.Context, params *RegisterParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RegisterResponse, error) {
-	rsp, err := c.RegisterWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
 	}
-	return ParseRegisterResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) RegisterWithResponse(ctx context.Context, params *RegisterParams, body RegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*RegisterResponse, error) {
-	rsp, err := c.Register(ctx, params, body, reqEditors...)
+// ParseRemoveTodoBlockerResponse parses an HTTP response from a RemoveTodoBlockerWithResponse call
+func ParseRemoveTodoBlockerResponse(rsp *http.Response) (*RemoveTodoBlockerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseRegisterResponse(rsp)
+
+	response := &RemoveTodoBlockerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
 }
 
-// InitiateTOTPWithResponse request returning *InitiateTOTPResponse
-func (c *ClientWithResponses) InitiateTOTPWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*InitiateTOTPResponse, error) {
-	rsp, err := c.InitiateTOTP(ctx, reqEditors...)
+// ParseAddChecklistItemResponse parses an HTTP response from a AddChecklistItemWithResponse call
+func ParseAddChecklistItemResponse(rsp *http.Response) (*AddChecklistItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseInitiateTOTPResponse(rsp)
+
+	response := &AddChecklistItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest IdResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
 }
 
-// VerifyTOTPWithBodyWithResponse request with arbitrary body returning *VerifyTOTPResponse
-func (c *ClientWithResponses) VerifyTOTPWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*VerifyTOTPResponse, error) {
-	rsp, err := c.VerifyTOTPWithBody(ctx, contentType, body, reqEditors...)
+// ParseReorderChecklistResponse parses an HTTP response from a ReorderChecklistWithResponse call
+func ParseReorderChecklistResponse(rsp *http.Response) (*ReorderChecklistResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseVerifyTOTPResponse(rsp)
+
+	response := &ReorderChecklistResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) VerifyTOTPWithResponse(ctx context.Context, body VerifyTOTPJSONRequestBody, reqEditors ...RequestEditorFn) (*VerifyTOTPResponse, error) {
-	rsp, err := c.VerifyTOTP(ctx, body, reqEditors...)
+// ParseRemoveChecklistItemResponse parses an HTTP response from a RemoveChecklistItemWithResponse call
+func ParseRemoveChecklistItemResponse(rsp *http.Response) (*RemoveChecklistItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseVerifyTOTPResponse(rsp)
+
+	response := &RemoveChecklistItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
 }
 
-// HealthzWithResponse request returning *HealthzResponse
-func (c *ClientWithResponses) HealthzWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthzResponse, error) {
-	rsp, err := c.Healthz(ctx, reqEditors...)
+// ParseToggleChecklistItemResponse parses an HTTP response from a ToggleChecklistItemWithResponse call
+func ParseToggleChecklistItemResponse(rsp *http.Response) (*ToggleChecklistItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseHealthzResponse(rsp)
+
+	response := &ToggleChecklistItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
 }
 
-// CommitTaskWithBodyWithResponse request with arbitrary body returning *CommitTaskResponse
-func (c *ClientWithResponses) CommitTaskWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CommitTaskResponse, error) {
-	rsp, err := c.CommitTaskWithBody(ctx, contentType, body, reqEditors...)
+// ParseGetTodoCommentsResponse parses an HTTP response from a GetTodoCommentsWithResponse call
+func ParseGetTodoCommentsResponse(rsp *http.Response) (*GetTodoCommentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCommitTaskResponse(rsp)
+
+	response := &GetTodoCommentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Comment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CommitTaskWithResponse(ctx context.Context, body CommitTaskJSONRequestBody, reqEditors ...RequestEditorFn) (*CommitTaskResponse, error) {
-	rsp, err := c.CommitTask(ctx, body, reqEditors...)
+// ParseCreateTodoCommentResponse parses an HTTP response from a CreateTodoCommentWithResponse call
+func ParseCreateTodoCommentResponse(rsp *http.Response) (*CreateTodoCommentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCommitTaskResponse(rsp)
+
+	response := &CreateTodoCommentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest IdResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetTodoByIDWithResponse request returning *GetTodoByIDResponse
-func (c *ClientWithResponses) GetTodoByIDWithResponse(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*GetTodoByIDResponse, error) {
-	rsp, err := c.GetTodoByID(ctx, id, reqEditors...)
+// ParseDeleteTodoCommentResponse parses an HTTP response from a DeleteTodoCommentWithResponse call
+func ParseDeleteTodoCommentResponse(rsp *http.Response) (*DeleteTodoCommentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetTodoByIDResponse(rsp)
-}
 
-// CompleteTodoWithResponse request returning *CompleteTodoResponse
-func (c *ClientWithResponses) CompleteTodoWithResponse(ctx context.Context, id todoDomain.TodoID, params *CompleteTodoParams, reqEditors ...RequestEditorFn) (*CompleteTodoResponse, error) {
-	rsp, err := c.CompleteTodo(ctx, id, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeleteTodoCommentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCompleteTodoResponse(rsp)
-}
 
-// StartFocusWithResponse request returning *StartFocusResponse
-func (c *ClientWithResponses) StartFocusWithResponse(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*StartFocusResponse, error) {
-	rsp, err := c.StartFocus(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
 	}
-	return ParseStartFocusResponse(rsp)
+
+	return response, nil
 }
 
-// StopFocusWithResponse request returning *StopFocusResponse
-func (c *ClientWithResponses) StopFocusWithResponse(ctx context.Context, id todoDomain.TodoID, reqEditors ...RequestEditorFn) (*StopFocusResponse, error) {
-	rsp, err := c.StopFocus(ctx, id, reqEditors...)
+// ParseUpdateTodoCommentResponse parses an HTTP response from a UpdateTodoCommentWithResponse call
+func ParseUpdateTodoCommentResponse(rsp *http.Response) (*UpdateTodoCommentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseStopFocusResponse(rsp)
-}
 
-// AssignTagToTodoWithBodyWithResponse request with arbitrary body returning *AssignTagToTodoResponse
-func (c *ClientWithResponses) AssignTagToTodoWithBodyWithResponse(ctx context.Context, id todoDomain.TodoID, params *AssignTagToTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AssignTagToTodoResponse, error) {
-	rsp, err := c.AssignTagToTodoWithBody(ctx, id, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateTodoCommentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAssignTagToTodoResponse(rsp)
-}
 
-func (c *ClientWithResponses) AssignTagToTodoWithResponse(ctx context.Context, id todoDomain.TodoID, params *AssignTagToTodoParams, body AssignTagToTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*AssignTagToTodoResponse, error) {
-	rsp, err := c.AssignTagToTodo(ctx, id, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
 	}
-	return ParseAssignTagToTodoResponse(rsp)
+
+	return response, nil
 }
 
-// GetUserByIDWithResponse request returning *GetUserByIDResponse
-func (c *ClientWithResponses) GetUserByIDWithResponse(ctx context.Context, id userDomain.UserID, reqEditors ...RequestEditorFn) (*GetUserByIDResponse, error) {
-	rsp, err := c.GetUserByID(ctx, id, reqEditors...)
+// ParseCompleteTodoResponse parses an HTTP response from a CompleteTodoWithResponse call
+func ParseCompleteTodoResponse(rsp *http.Response) (*CompleteTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetUserByIDResponse(rsp)
-}
 
-// GetUserWorkspacesWithResponse request returning *GetUserWorkspacesResponse
-func (c *ClientWithResponses) GetUserWorkspacesWithResponse(ctx context.Context, id userDomain.UserID, params *GetUserWorkspacesParams, reqEditors ...RequestEditorFn) (*GetUserWorkspacesResponse, error) {
-	rsp, err := c.GetUserWorkspaces(ctx, id, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CompleteTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetUserWorkspacesResponse(rsp)
-}
 
-// ListWorkspacesWithResponse request returning *ListWorkspacesResponse
-func (c *ClientWithResponses) ListWorkspacesWithResponse(ctx context.Context, params *ListWorkspacesParams, reqEditors ...RequestEditorFn) (*ListWorkspacesResponse, error) {
-	rsp, err := c.ListWorkspaces(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
 	}
-	return ParseListWorkspacesResponse(rsp)
+
+	return response, nil
 }
 
-// OnboardWorkspaceWithBodyWithResponse request with arbitrary body returning *OnboardWorkspaceResponse
-func (c *ClientWithResponses) OnboardWorkspaceWithBodyWithResponse(ctx context.Context, params *OnboardWorkspaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*OnboardWorkspaceResponse, error) {
-	rsp, err := c.OnboardWorkspaceWithBody(ctx, params, contentType, body, reqEditors...)
+// ParseSetTodoDueDateResponse parses an HTTP response from a SetTodoDueDateWithResponse call
+func ParseSetTodoDueDateResponse(rsp *http.Response) (*SetTodoDueDateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseOnboardWorkspaceResponse(rsp)
-}
 
-func (c *ClientWithResponses) OnboardWorkspaceWithResponse(ctx context.Context, params *OnboardWorkspaceParams, body OnboardWorkspaceJSONRequestBody, reqEditors ...RequestEditorFn) (*OnboardWorkspaceResponse, error) {
-	rsp, err := c.OnboardWorkspace(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SetTodoDueDateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseOnboardWorkspaceResponse(rsp)
-}
 
-// DeleteWorkspaceWithResponse request returning *DeleteWorkspaceResponse
-func (c *ClientWithResponses) DeleteWorkspaceWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, reqEditors ...RequestEditorFn) (*DeleteWorkspaceResponse, error) {
-	rsp, err := c.DeleteWorkspace(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
 	}
-	return ParseDeleteWorkspaceResponse(rsp)
+
+	return response, nil
 }
 
-// AddWorkspaceMemberWithBodyWithResponse request with arbitrary body returning *AddWorkspaceMemberResponse
-func (c *ClientWithResponses) AddWorkspaceMemberWithBodyWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *AddWorkspaceMemberParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddWorkspaceMemberResponse, error) {
-	rsp, err := c.AddWorkspaceMemberWithBody(ctx, id, params, contentType, body, reqEditors...)
+// ParseStartFocusResponse parses an HTTP response from a StartFocusWithResponse call
+func ParseStartFocusResponse(rsp *http.Response) (*StartFocusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseAddWorkspaceMemberResponse(rsp)
-}
 
-func (c *ClientWithResponses) AddWorkspaceMemberWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *AddWorkspaceMemberParams, body AddWorkspaceMemberJSONRequestBody, reqEditors ...RequestEditorFn) (*AddWorkspaceMemberResponse, error) {
-	rsp, err := c.AddWorkspaceMember(ctx, id, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &StartFocusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseAddWorkspaceMemberResponse(rsp)
-}
 
-// RemoveWorkspaceMemberWithResponse request returning *RemoveWorkspaceMemberResponse
-func (c *ClientWithResponses) RemoveWorkspaceMemberWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, userId userDomain.UserID, reqEditors ...RequestEditorFn) (*RemoveWorkspaceMemberResponse, error) {
-	rsp, err := c.RemoveWorkspaceMember(ctx, id, userId, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
 	}
-	return ParseRemoveWorkspaceMemberResponse(rsp)
+
+	return response, nil
 }
 
-// GetWorkspaceTagsWithResponse request returning *GetWorkspaceTagsResponse
-func (c *ClientWithResponses) GetWorkspaceTagsWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, reqEditors ...RequestEditorFn) (*GetWorkspaceTagsResponse, error) {
-	rsp, err := c.GetWorkspaceTags(ctx, id, reqEditors...)
+// ParseStopFocusResponse parses an HTTP response from a StopFocusWithResponse call
+func ParseStopFocusResponse(rsp *http.Response) (*StopFocusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetWorkspaceTagsResponse(rsp)
-}
 
-// CreateTagWithBodyWithResponse request with arbitrary body returning *CreateTagResponse
-func (c *ClientWithResponses) CreateTagWithBodyWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTagParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTagResponse, error) {
-	rsp, err := c.CreateTagWithBody(ctx, id, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &StopFocusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateTagResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateTagWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTagParams, body CreateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTagResponse, error) {
-	rsp, err := c.CreateTag(ctx, id, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
 	}
-	return ParseCreateTagResponse(rsp)
+
+	return response, nil
 }
 
-// GetWorkspaceTodosWithResponse request returning *GetWorkspaceTodosResponse
-func (c *ClientWithResponses) GetWorkspaceTodosWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *GetWorkspaceTodosParams, reqEditors ...RequestEditorFn) (*GetWorkspaceTodosResponse, error) {
-	rsp, err := c.GetWorkspaceTodos(ctx, id, params, reqEditors...)
+// ParseSetTodoRecurrenceResponse parses an HTTP response from a SetTodoRecurrenceWithResponse call
+func ParseSetTodoRecurrenceResponse(rsp *http.Response) (*SetTodoRecurrenceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetWorkspaceTodosResponse(rsp)
-}
 
-// CreateTodoWithBodyWithResponse request with arbitrary body returning *CreateTodoResponse
-func (c *ClientWithResponses) CreateTodoWithBodyWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTodoParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error) {
-	rsp, err := c.CreateTodoWithBody(ctx, id, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &SetTodoRecurrenceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateTodoResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateTodoWithResponse(ctx context.Context, id workspaceDomain.WorkspaceID, params *CreateTodoParams, body CreateTodoJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTodoResponse, error) {
-	rsp, err := c.CreateTodo(ctx, id, params, body, reqEditors...)
+// ParseReopenTodoResponse parses an HTTP response from a ReopenTodoWithResponse call
+func ParseReopenTodoResponse(rsp *http.Response) (*ReopenTodoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateTodoResponse(rsp)
+
+	response := &ReopenTodoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseLoginResponse parses an HTTP response from a LoginWithResponse call
-func ParseLoginResponse(rsp *http.Response) (*LoginResponse, error) {
+// ParseAssignTagToTodoResponse parses an HTTP response from a AssignTagToTodoWithResponse call
+func ParseAssignTagToTodoResponse(rsp *http.Response) (*AssignTagToTodoResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &LoginResponse{
+	response := &AssignTagToTodoResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LoginResponseBody
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON4XX = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseRegisterResponse parses an HTTP response from a RegisterWithResponse call
-func ParseRegisterResponse(rsp *http.Response) (*RegisterResponse, error) {
+// ParseRemoveTagFromTodoResponse parses an HTTP response from a RemoveTagFromTodoWithResponse call
+func ParseRemoveTagFromTodoResponse(rsp *http.Response) (*RemoveTagFromTodoResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &RegisterResponse{
+	response := &RemoveTagFromTodoResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest IdResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON4XX = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseInitiateTOTPResponse parses an HTTP response from a InitiateTOTPWithResponse call
-func ParseInitiateTOTPResponse(rsp *http.Response) (*InitiateTOTPResponse, error) {
+// ParseUnarchiveTodoResponse parses an HTTP response from a UnarchiveTodoWithResponse call
+func ParseUnarchiveTodoResponse(rsp *http.Response) (*UnarchiveTodoResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &InitiateTOTPResponse{
+	response := &UnarchiveTodoResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest InitiateTOTPResponseBody
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON4XX = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseVerifyTOTPResponse parses an HTTP response from a VerifyTOTPWithResponse call
-func ParseVerifyTOTPResponse(rsp *http.Response) (*VerifyTOTPResponse, error) {
+// ParseDeleteUserResponse parses an HTTP response from a DeleteUserWithResponse call
+func ParseDeleteUserResponse(rsp *http.Response) (*DeleteUserResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &VerifyTOTPResponse{
+	response := &DeleteUserResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LoginResponseBody
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON4XX = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseHealthzResponse parses an HTTP response from a HealthzWithResponse call
-func ParseHealthzResponse(rsp *http.Response) (*HealthzResponse, error) {
+// ParseGetUserByIDResponse parses an HTTP response from a GetUserByIDWithResponse call
+func ParseGetUserByIDResponse(rsp *http.Response) (*GetUserByIDResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &HealthzResponse{
+	response := &GetUserByIDResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseCommitTaskResponse parses an HTTP response from a CommitTaskWithResponse call
-func ParseCommitTaskResponse(rsp *http.Response) (*CommitTaskResponse, error) {
+// ParseGetUserAssignedTodosResponse parses an HTTP response from a GetUserAssignedTodosWithResponse call
+func ParseGetUserAssignedTodosResponse(rsp *http.Response) (*GetUserAssignedTodosResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CommitTaskResponse{
+	response := &GetUserAssignedTodosResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Todo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2907,27 +9701,20 @@ func ParseCommitTaskResponse(rsp *http.Response) (*CommitTaskResponse, error) {
 	return response, nil
 }
 
-// ParseGetTodoByIDResponse parses an HTTP response from a GetTodoByIDWithResponse call
-func ParseGetTodoByIDResponse(rsp *http.Response) (*GetTodoByIDResponse, error) {
+// ParseSetUserDailyCapacityResponse parses an HTTP response from a SetUserDailyCapacityWithResponse call
+func ParseSetUserDailyCapacityResponse(rsp *http.Response) (*SetUserDailyCapacityResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetTodoByIDResponse{
+	response := &SetUserDailyCapacityResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Todo
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2940,20 +9727,27 @@ func ParseGetTodoByIDResponse(rsp *http.Response) (*GetTodoByIDResponse, error)
 	return response, nil
 }
 
-// ParseCompleteTodoResponse parses an HTTP response from a CompleteTodoWithResponse call
-func ParseCompleteTodoResponse(rsp *http.Response) (*CompleteTodoResponse, error) {
+// ParseRequestUserDataExportResponse parses an HTTP response from a RequestUserDataExportWithResponse call
+func ParseRequestUserDataExportResponse(rsp *http.Response) (*RequestUserDataExportResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CompleteTodoResponse{
+	response := &RequestUserDataExportResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest DataExport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2966,20 +9760,27 @@ func ParseCompleteTodoResponse(rsp *http.Response) (*CompleteTodoResponse, error
 	return response, nil
 }
 
-// ParseStartFocusResponse parses an HTTP response from a StartFocusWithResponse call
-func ParseStartFocusResponse(rsp *http.Response) (*StartFocusResponse, error) {
+// ParseGetUserDataExportResponse parses an HTTP response from a GetUserDataExportWithResponse call
+func ParseGetUserDataExportResponse(rsp *http.Response) (*GetUserDataExportResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StartFocusResponse{
+	response := &GetUserDataExportResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DataExport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2992,15 +9793,15 @@ func ParseStartFocusResponse(rsp *http.Response) (*StartFocusResponse, error) {
 	return response, nil
 }
 
-// ParseStopFocusResponse parses an HTTP response from a StopFocusWithResponse call
-func ParseStopFocusResponse(rsp *http.Response) (*StopFocusResponse, error) {
+// ParseDownloadUserDataExportResponse parses an HTTP response from a DownloadUserDataExportWithResponse call
+func ParseDownloadUserDataExportResponse(rsp *http.Response) (*DownloadUserDataExportResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &StopFocusResponse{
+	response := &DownloadUserDataExportResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3018,15 +9819,15 @@ func ParseStopFocusResponse(rsp *http.Response) (*StopFocusResponse, error) {
 	return response, nil
 }
 
-// ParseAssignTagToTodoResponse parses an HTTP response from a AssignTagToTodoWithResponse call
-func ParseAssignTagToTodoResponse(rsp *http.Response) (*AssignTagToTodoResponse, error) {
+// ParseSetUserTimezoneResponse parses an HTTP response from a SetUserTimezoneWithResponse call
+func ParseSetUserTimezoneResponse(rsp *http.Response) (*SetUserTimezoneResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &AssignTagToTodoResponse{
+	response := &SetUserTimezoneResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3044,26 +9845,26 @@ func ParseAssignTagToTodoResponse(rsp *http.Response) (*AssignTagToTodoResponse,
 	return response, nil
 }
 
-// ParseGetUserByIDResponse parses an HTTP response from a GetUserByIDWithResponse call
-func ParseGetUserByIDResponse(rsp *http.Response) (*GetUserByIDResponse, error) {
+// ParseResetUserTOTPResponse parses an HTTP response from a ResetUserTOTPWithResponse call
+func ParseResetUserTOTPResponse(rsp *http.Response) (*ResetUserTOTPResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetUserByIDResponse{
+	response := &ResetUserTOTPResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON4XX = &dest
 
 	}
 
@@ -3188,6 +9989,72 @@ func ParseDeleteWorkspaceResponse(rsp *http.Response) (*DeleteWorkspaceResponse,
 	return response, nil
 }
 
+// ParseGetWorkspaceAuditLogsResponse parses an HTTP response from a GetWorkspaceAuditLogsWithResponse call
+func ParseGetWorkspaceAuditLogsResponse(rsp *http.Response) (*GetWorkspaceAuditLogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWorkspaceAuditLogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AuditLog
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyWorkspaceAuditChainResponse parses an HTTP response from a VerifyWorkspaceAuditChainWithResponse call
+func ParseVerifyWorkspaceAuditChainResponse(rsp *http.Response) (*VerifyWorkspaceAuditChainResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyWorkspaceAuditChainResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditChainVerification
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseAddWorkspaceMemberResponse parses an HTTP response from a AddWorkspaceMemberWithResponse call
 func ParseAddWorkspaceMemberResponse(rsp *http.Response) (*AddWorkspaceMemberResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -3240,6 +10107,39 @@ func ParseRemoveWorkspaceMemberResponse(rsp *http.Response) (*RemoveWorkspaceMem
 	return response, nil
 }
 
+// ParseGetWorkspaceFocusReportResponse parses an HTTP response from a GetWorkspaceFocusReportWithResponse call
+func ParseGetWorkspaceFocusReportResponse(rsp *http.Response) (*GetWorkspaceFocusReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWorkspaceFocusReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FocusReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetWorkspaceTagsResponse parses an HTTP response from a GetWorkspaceTagsWithResponse call
 func ParseGetWorkspaceTagsResponse(rsp *http.Response) (*GetWorkspaceTagsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -3299,6 +10199,58 @@ func ParseCreateTagResponse(rsp *http.Response) (*CreateTagResponse, error) {
 	return response, nil
 }
 
+// ParseDeleteTagResponse parses an HTTP response from a DeleteTagWithResponse call
+func ParseDeleteTagResponse(rsp *http.Response) (*DeleteTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateTagResponse parses an HTTP response from a UpdateTagWithResponse call
+func ParseUpdateTagResponse(rsp *http.Response) (*UpdateTagResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateTagResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetWorkspaceTodosResponse parses an HTTP response from a GetWorkspaceTodosWithResponse call
 func ParseGetWorkspaceTodosResponse(rsp *http.Response) (*GetWorkspaceTodosResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -3357,3 +10309,36 @@ func ParseCreateTodoResponse(rsp *http.Response) (*CreateTodoResponse, error) {
 
 	return response, nil
 }
+
+// ParseSearchWorkspaceTodosResponse parses an HTTP response from a SearchWorkspaceTodosWithResponse call
+func ParseSearchWorkspaceTodosResponse(rsp *http.Response) (*SearchWorkspaceTodosResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchWorkspaceTodosResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TodoSearchResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode/100 == 4:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON4XX = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,439 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: audit.sql
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const GetAuditChainHead = `-- name: GetAuditChainHead :one
+SELECT
+  a.hash
+FROM
+  audit_logs a
+WHERE
+  a.chain_key = coalesce($1::uuid::text, $2::text || ':' || $3::uuid::text)
+ORDER BY
+  a.seq DESC
+LIMIT 1
+`
+
+type GetAuditChainHeadParams struct {
+	WorkspaceID   *uuid.UUID `db:"workspace_id" json:"workspace_id"`
+	AggregateType string     `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID   uuid.UUID  `db:"aggregate_id" json:"aggregate_id"`
+}
+
+func (q *Queries) GetAuditChainHead(ctx context.Context, db DBTX, arg GetAuditChainHeadParams) (string, error) {
+	row := db.QueryRow(ctx, GetAuditChainHead, arg.WorkspaceID, arg.AggregateType, arg.AggregateID)
+	var hash string
+	err := row.Scan(&hash)
+	return hash, err
+}
+
+const InsertAuditLog = `-- name: InsertAuditLog :exec
+INSERT INTO audit_logs(id, workspace_id, correlation_id, causation_id, actor_id, actor_ip, user_agent_hash, aggregate_type, aggregate_id, operation, changes, occurred_at, prev_hash, hash, pii_salt, pii_digest)
+  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+`
+
+type InsertAuditLogParams struct {
+	ID            uuid.UUID  `db:"id" json:"id"`
+	WorkspaceID   *uuid.UUID `db:"workspace_id" json:"workspace_id"`
+	CorrelationID string     `db:"correlation_id" json:"correlation_id"`
+	CausationID   string     `db:"causation_id" json:"causation_id"`
+	ActorID       *uuid.UUID `db:"actor_id" json:"actor_id"`
+	ActorIp       string     `db:"actor_ip" json:"actor_ip"`
+	UserAgentHash string     `db:"user_agent_hash" json:"user_agent_hash"`
+	AggregateType string     `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID   uuid.UUID  `db:"aggregate_id" json:"aggregate_id"`
+	Operation     string     `db:"operation" json:"operation"`
+	Changes       []byte     `db:"changes" json:"changes"`
+	OccurredAt    time.Time  `db:"occurred_at" json:"occurred_at"`
+	PrevHash      string     `db:"prev_hash" json:"prev_hash"`
+	Hash          string     `db:"hash" json:"hash"`
+	PiiSalt       []byte     `db:"pii_salt" json:"pii_salt"`
+	PiiDigest     string     `db:"pii_digest" json:"pii_digest"`
+}
+
+func (q *Queries) InsertAuditLog(ctx context.Context, db DBTX, arg InsertAuditLogParams) error {
+	_, err := db.Exec(ctx, InsertAuditLog,
+		arg.ID,
+		arg.WorkspaceID,
+		arg.CorrelationID,
+		arg.CausationID,
+		arg.ActorID,
+		arg.ActorIp,
+		arg.UserAgentHash,
+		arg.AggregateType,
+		arg.AggregateID,
+		arg.Operation,
+		arg.Changes,
+		arg.OccurredAt,
+		arg.PrevHash,
+		arg.Hash,
+		arg.PiiSalt,
+		arg.PiiDigest,
+	)
+	return err
+}
+
+const ListAuditChain = `-- name: ListAuditChain :many
+SELECT
+  id, workspace_id, correlation_id, causation_id, actor_id, actor_ip, user_agent_hash, aggregate_type, aggregate_id, operation, changes, occurred_at, prev_hash, hash, seq, pii_salt, pii_digest, chain_key
+FROM
+  audit_logs a
+WHERE
+  a.chain_key = coalesce($1::uuid::text, $2::text || ':' || $3::uuid::text)
+ORDER BY
+  a.seq ASC
+LIMIT $5 OFFSET $4
+`
+
+type ListAuditChainParams struct {
+	WorkspaceID   *uuid.UUID `db:"workspace_id" json:"workspace_id"`
+	AggregateType string     `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID   uuid.UUID  `db:"aggregate_id" json:"aggregate_id"`
+	Off           int32      `db:"off" json:"off"`
+	Lim           int32      `db:"lim" json:"lim"`
+}
+
+func (q *Queries) ListAuditChain(ctx context.Context, db DBTX, arg ListAuditChainParams) ([]AuditLogs, error) {
+	rows, err := db.Query(ctx, ListAuditChain,
+		arg.WorkspaceID,
+		arg.AggregateType,
+		arg.AggregateID,
+		arg.Off,
+		arg.Lim,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AuditLogs{}
+	for rows.Next() {
+		var i AuditLogs
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkspaceID,
+			&i.CorrelationID,
+			&i.CausationID,
+			&i.ActorID,
+			&i.ActorIp,
+			&i.UserAgentHash,
+			&i.AggregateType,
+			&i.AggregateID,
+			&i.Operation,
+			&i.Changes,
+			&i.OccurredAt,
+			&i.PrevHash,
+			&i.Hash,
+			&i.Seq,
+			&i.PiiSalt,
+			&i.PiiDigest,
+			&i.ChainKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ListAuditLogsReferencingUserForUpdate = `-- name: ListAuditLogsReferencingUserForUpdate :many
+SELECT
+  id, workspace_id, correlation_id, causation_id, actor_id, actor_ip, user_agent_hash, aggregate_type, aggregate_id, operation, changes, occurred_at, prev_hash, hash, seq, pii_salt, pii_digest, chain_key
+FROM
+  audit_logs a
+WHERE
+  a.actor_id = $1::uuid
+  OR a.aggregate_id = $1::uuid
+  OR a.changes::text LIKE '%' || $1::uuid::text || '%'
+ORDER BY
+  a.seq ASC
+FOR UPDATE
+`
+
+// Any entry that references the user as actor, aggregate or within its changes, locked for erasure.
+func (q *Queries) ListAuditLogsReferencingUserForUpdate(ctx context.Context, db DBTX, userID uuid.UUID) ([]AuditLogs, error) {
+	rows, err := db.Query(ctx, ListAuditLogsReferencingUserForUpdate, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AuditLogs{}
+	for rows.Next() {
+		var i AuditLogs
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkspaceID,
+			&i.CorrelationID,
+			&i.CausationID,
+			&i.ActorID,
+			&i.ActorIp,
+			&i.UserAgentHash,
+			&i.AggregateType,
+			&i.AggregateID,
+			&i.Operation,
+			&i.Changes,
+			&i.OccurredAt,
+			&i.PrevHash,
+			&i.Hash,
+			&i.Seq,
+			&i.PiiSalt,
+			&i.PiiDigest,
+			&i.ChainKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ListUnscopedAuditChains = `-- name: ListUnscopedAuditChains :many
+SELECT DISTINCT
+  a.aggregate_type,
+  a.aggregate_id
+FROM
+  audit_logs a
+WHERE
+  a.workspace_id IS NULL
+  AND (a.aggregate_type, a.aggregate_id) > ($1::text, $2::uuid)
+ORDER BY
+  a.aggregate_type,
+  a.aggregate_id
+LIMIT $3
+`
+
+type ListUnscopedAuditChainsParams struct {
+	AfterType string    `db:"after_type" json:"after_type"`
+	AfterID   uuid.UUID `db:"after_id" json:"after_id"`
+	Lim       int32     `db:"lim" json:"lim"`
+}
+
+type ListUnscopedAuditChainsRow struct {
+	AggregateType string    `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID   uuid.UUID `db:"aggregate_id" json:"aggregate_id"`
+}
+
+// Keyset pages over the chains of logs outside any workspace, which are kept per aggregate.
+func (q *Queries) ListUnscopedAuditChains(ctx context.Context, db DBTX, arg ListUnscopedAuditChainsParams) ([]ListUnscopedAuditChainsRow, error) {
+	rows, err := db.Query(ctx, ListUnscopedAuditChains, arg.AfterType, arg.AfterID, arg.Lim)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUnscopedAuditChainsRow{}
+	for rows.Next() {
+		var i ListUnscopedAuditChainsRow
+		if err := rows.Scan(&i.AggregateType, &i.AggregateID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ListUserAuditLogs = `-- name: ListUserAuditLogs :many
+SELECT
+  id, workspace_id, correlation_id, causation_id, actor_id, actor_ip, user_agent_hash, aggregate_type, aggregate_id, operation, changes, occurred_at, prev_hash, hash, seq, pii_salt, pii_digest, chain_key
+FROM
+  audit_logs a
+WHERE
+  a.actor_id = $1::uuid
+  OR (a.aggregate_type = 'USER'
+    AND a.aggregate_id = $1::uuid)
+ORDER BY
+  a.occurred_at ASC,
+  a.id ASC
+`
+
+func (q *Queries) ListUserAuditLogs(ctx context.Context, db DBTX, userID uuid.UUID) ([]AuditLogs, error) {
+	rows, err := db.Query(ctx, ListUserAuditLogs, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AuditLogs{}
+	for rows.Next() {
+		var i AuditLogs
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkspaceID,
+			&i.CorrelationID,
+			&i.CausationID,
+			&i.ActorID,
+			&i.ActorIp,
+			&i.UserAgentHash,
+			&i.AggregateType,
+			&i.AggregateID,
+			&i.Operation,
+			&i.Changes,
+			&i.OccurredAt,
+			&i.PrevHash,
+			&i.Hash,
+			&i.Seq,
+			&i.PiiSalt,
+			&i.PiiDigest,
+			&i.ChainKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ListWorkspaceAuditLogs = `-- name: ListWorkspaceAuditLogs :many
+SELECT
+  id, workspace_id, correlation_id, causation_id, actor_id, actor_ip, user_agent_hash, aggregate_type, aggregate_id, operation, changes, occurred_at, prev_hash, hash, seq, pii_salt, pii_digest, chain_key
+FROM
+  audit_logs a
+WHERE
+  a.workspace_id = $1::uuid
+  AND ($2::text IS NULL
+    OR a.aggregate_type = $2::text)
+  AND ($3::uuid IS NULL
+    OR a.aggregate_id = $3::uuid)
+  AND ($4::uuid IS NULL
+    OR a.actor_id = $4::uuid)
+  AND ($5::text IS NULL
+    OR a.operation = $5::text)
+  AND ($6::timestamptz IS NULL
+    OR a.occurred_at >= $6::timestamptz)
+  AND ($7::timestamptz IS NULL
+    OR a.occurred_at < $7::timestamptz)
+ORDER BY
+  a.occurred_at DESC,
+  a.id DESC
+LIMIT $9 OFFSET $8
+`
+
+type ListWorkspaceAuditLogsParams struct {
+	WorkspaceID   uuid.UUID  `db:"workspace_id" json:"workspace_id"`
+	AggregateType *string    `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID   *uuid.UUID `db:"aggregate_id" json:"aggregate_id"`
+	ActorID       *uuid.UUID `db:"actor_id" json:"actor_id"`
+	Operation     *string    `db:"operation" json:"operation"`
+	FromTime      *time.Time `db:"from_time" json:"from_time"`
+	ToTime        *time.Time `db:"to_time" json:"to_time"`
+	Off           int32      `db:"off" json:"off"`
+	Lim           int32      `db:"lim" json:"lim"`
+}
+
+func (q *Queries) ListWorkspaceAuditLogs(ctx context.Context, db DBTX, arg ListWorkspaceAuditLogsParams) ([]AuditLogs, error) {
+	rows, err := db.Query(ctx, ListWorkspaceAuditLogs,
+		arg.WorkspaceID,
+		arg.AggregateType,
+		arg.AggregateID,
+		arg.ActorID,
+		arg.Operation,
+		arg.FromTime,
+		arg.ToTime,
+		arg.Off,
+		arg.Lim,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AuditLogs{}
+	for rows.Next() {
+		var i AuditLogs
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkspaceID,
+			&i.CorrelationID,
+			&i.CausationID,
+			&i.ActorID,
+			&i.ActorIp,
+			&i.UserAgentHash,
+			&i.AggregateType,
+			&i.AggregateID,
+			&i.Operation,
+			&i.Changes,
+			&i.OccurredAt,
+			&i.PrevHash,
+			&i.Hash,
+			&i.Seq,
+			&i.PiiSalt,
+			&i.PiiDigest,
+			&i.ChainKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const LockAuditChain = `-- name: LockAuditChain :exec
+SELECT
+  pg_advisory_xact_lock(hashtextextended('audit_logs:' || coalesce($1::uuid::text, $2::text || ':' || $3::uuid::text), 0))
+`
+
+type LockAuditChainParams struct {
+	WorkspaceID   *uuid.UUID `db:"workspace_id" json:"workspace_id"`
+	AggregateType string     `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID   uuid.UUID  `db:"aggregate_id" json:"aggregate_id"`
+}
+
+// Serializes appends to a chain until the transaction ends. The key matches audit_logs.chain_key:
+// the workspace, or the aggregate for unscoped logs, so unrelated unscoped writes don't wait on each other.
+func (q *Queries) LockAuditChain(ctx context.Context, db DBTX, arg LockAuditChainParams) error {
+	_, err := db.Exec(ctx, LockAuditChain, arg.WorkspaceID, arg.AggregateType, arg.AggregateID)
+	return err
+}
+
+const UpdateErasedAuditLog = `-- name: UpdateErasedAuditLog :exec
+UPDATE
+  audit_logs
+SET
+  actor_id = $1::uuid,
+  actor_ip = $2::text,
+  aggregate_id = $3::uuid,
+  changes = $4::jsonb,
+  pii_salt = NULL
+WHERE
+  id = $5::uuid
+`
+
+type UpdateErasedAuditLogParams struct {
+	ActorID     *uuid.UUID `db:"actor_id" json:"actor_id"`
+	ActorIp     string     `db:"actor_ip" json:"actor_ip"`
+	AggregateID uuid.UUID  `db:"aggregate_id" json:"aggregate_id"`
+	Changes     []byte     `db:"changes" json:"changes"`
+	ID          uuid.UUID  `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateErasedAuditLog(ctx context.Context, db DBTX, arg UpdateErasedAuditLogParams) error {
+	_, err := db.Exec(ctx, UpdateErasedAuditLog,
+		arg.ActorID,
+		arg.ActorIp,
+		arg.AggregateID,
+		arg.Changes,
+		arg.ID,
+	)
+	return err
+}
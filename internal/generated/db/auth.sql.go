@@ -7,13 +7,42 @@ package db
 
 import (
 	"context"
+	"time"
 
+	"github.com/danicc097/todo-ddd-example/internal/infrastructure/db/types"
 	"github.com/google/uuid"
 )
 
+const GetRefreshTokenByHashForUpdate = `-- name: GetRefreshTokenByHashForUpdate :one
+SELECT
+  id, family_id, user_id, token_hash, created_at, expires_at, rotated_at, revoked_at, mfa_verified_at
+FROM
+  refresh_tokens
+WHERE
+  token_hash = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetRefreshTokenByHashForUpdate(ctx context.Context, db DBTX, tokenHash string) (RefreshTokens, error) {
+	row := db.QueryRow(ctx, GetRefreshTokenByHashForUpdate, tokenHash)
+	var i RefreshTokens
+	err := row.Scan(
+		&i.ID,
+		&i.FamilyID,
+		&i.UserID,
+		&i.TokenHash,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.RotatedAt,
+		&i.RevokedAt,
+		&i.MfaVerifiedAt,
+	)
+	return i, err
+}
+
 const GetUserAuth = `-- name: GetUserAuth :one
 SELECT
-  user_id, password_hash, totp_status, totp_secret_cipher, totp_secret_nonce
+  user_id, password_hash, totp_status, totp_secret_cipher, totp_secret_nonce, totp_recovery_codes
 FROM
   user_auth
 WHERE
@@ -29,27 +58,130 @@ func (q *Queries) GetUserAuth(ctx context.Context, db DBTX, userID uuid.UUID) (U
 		&i.TotpStatus,
 		&i.TotpSecretCipher,
 		&i.TotpSecretNonce,
+		&i.TotpRecoveryCodes,
 	)
 	return i, err
 }
 
+const GetUserAuthForUpdate = `-- name: GetUserAuthForUpdate :one
+SELECT
+  user_id, password_hash, totp_status, totp_secret_cipher, totp_secret_nonce, totp_recovery_codes
+FROM
+  user_auth
+WHERE
+  user_id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetUserAuthForUpdate(ctx context.Context, db DBTX, userID uuid.UUID) (UserAuth, error) {
+	row := db.QueryRow(ctx, GetUserAuthForUpdate, userID)
+	var i UserAuth
+	err := row.Scan(
+		&i.UserID,
+		&i.PasswordHash,
+		&i.TotpStatus,
+		&i.TotpSecretCipher,
+		&i.TotpSecretNonce,
+		&i.TotpRecoveryCodes,
+	)
+	return i, err
+}
+
+const RevokeRefreshTokenFamily = `-- name: RevokeRefreshTokenFamily :exec
+UPDATE
+  refresh_tokens
+SET
+  revoked_at = $1
+WHERE
+  family_id = $2
+  AND revoked_at IS NULL
+`
+
+type RevokeRefreshTokenFamilyParams struct {
+	RevokedAt *time.Time `db:"revoked_at" json:"revoked_at"`
+	FamilyID  uuid.UUID  `db:"family_id" json:"family_id"`
+}
+
+func (q *Queries) RevokeRefreshTokenFamily(ctx context.Context, db DBTX, arg RevokeRefreshTokenFamilyParams) error {
+	_, err := db.Exec(ctx, RevokeRefreshTokenFamily, arg.RevokedAt, arg.FamilyID)
+	return err
+}
+
+const RevokeUserRefreshTokens = `-- name: RevokeUserRefreshTokens :exec
+UPDATE
+  refresh_tokens
+SET
+  revoked_at = $1
+WHERE
+  user_id = $2
+  AND revoked_at IS NULL
+`
+
+type RevokeUserRefreshTokensParams struct {
+	RevokedAt *time.Time   `db:"revoked_at" json:"revoked_at"`
+	UserID    types.UserID `db:"user_id" json:"user_id"`
+}
+
+func (q *Queries) RevokeUserRefreshTokens(ctx context.Context, db DBTX, arg RevokeUserRefreshTokensParams) error {
+	_, err := db.Exec(ctx, RevokeUserRefreshTokens, arg.RevokedAt, arg.UserID)
+	return err
+}
+
+const UpsertRefreshToken = `-- name: UpsertRefreshToken :exec
+INSERT INTO refresh_tokens(id, family_id, user_id, token_hash, created_at, expires_at, rotated_at, revoked_at, mfa_verified_at)
+  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (id)
+  DO UPDATE SET
+    rotated_at = EXCLUDED.rotated_at,
+    revoked_at = EXCLUDED.revoked_at
+`
+
+type UpsertRefreshTokenParams struct {
+	ID            types.RefreshTokenID `db:"id" json:"id"`
+	FamilyID      uuid.UUID            `db:"family_id" json:"family_id"`
+	UserID        types.UserID         `db:"user_id" json:"user_id"`
+	TokenHash     string               `db:"token_hash" json:"token_hash"`
+	CreatedAt     time.Time            `db:"created_at" json:"created_at"`
+	ExpiresAt     time.Time            `db:"expires_at" json:"expires_at"`
+	RotatedAt     *time.Time           `db:"rotated_at" json:"rotated_at"`
+	RevokedAt     *time.Time           `db:"revoked_at" json:"revoked_at"`
+	MfaVerifiedAt *time.Time           `db:"mfa_verified_at" json:"mfa_verified_at"`
+}
+
+func (q *Queries) UpsertRefreshToken(ctx context.Context, db DBTX, arg UpsertRefreshTokenParams) error {
+	_, err := db.Exec(ctx, UpsertRefreshToken,
+		arg.ID,
+		arg.FamilyID,
+		arg.UserID,
+		arg.TokenHash,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+		arg.RotatedAt,
+		arg.RevokedAt,
+		arg.MfaVerifiedAt,
+	)
+	return err
+}
+
 const UpsertUserAuth = `-- name: UpsertUserAuth :exec
-INSERT INTO user_auth(user_id, totp_status, totp_secret_cipher, totp_secret_nonce, password_hash)
-  VALUES ($1, $2, $3, $4, $5)
+INSERT INTO user_auth(user_id, totp_status, totp_secret_cipher, totp_secret_nonce, totp_recovery_codes, password_hash)
+  VALUES ($1, $2, $3, $4, $5, $6)
 ON CONFLICT (user_id)
   DO UPDATE SET
     totp_status = EXCLUDED.totp_status,
     totp_secret_cipher = EXCLUDED.totp_secret_cipher,
     totp_secret_nonce = EXCLUDED.totp_secret_nonce,
+    totp_recovery_codes = EXCLUDED.totp_recovery_codes,
     password_hash = EXCLUDED.password_hash
 `
 
 type UpsertUserAuthParams struct {
-	UserID           uuid.UUID `db:"user_id" json:"user_id"`
-	TotpStatus       string    `db:"totp_status" json:"totp_status"`
-	TotpSecretCipher []byte    `db:"totp_secret_cipher" json:"totp_secret_cipher"`
-	TotpSecretNonce  []byte    `db:"totp_secret_nonce" json:"totp_secret_nonce"`
-	PasswordHash     *string   `db:"password_hash" json:"-"`
+	UserID            uuid.UUID `db:"user_id" json:"user_id"`
+	TotpStatus        string    `db:"totp_status" json:"totp_status"`
+	TotpSecretCipher  []byte    `db:"totp_secret_cipher" json:"totp_secret_cipher"`
+	TotpSecretNonce   []byte    `db:"totp_secret_nonce" json:"totp_secret_nonce"`
+	TotpRecoveryCodes []string  `db:"totp_recovery_codes" json:"totp_recovery_codes"`
+	PasswordHash      *string   `db:"password_hash" json:"-"`
 }
 
 func (q *Queries) UpsertUserAuth(ctx context.Context, db DBTX, arg UpsertUserAuthParams) error {
@@ -58,6 +190,7 @@ func (q *Queries) UpsertUserAuth(ctx context.Context, db DBTX, arg UpsertUserAut
 		arg.TotpStatus,
 		arg.TotpSecretCipher,
 		arg.TotpSecretNonce,
+		arg.TotpRecoveryCodes,
 		arg.PasswordHash,
 	)
 	return err
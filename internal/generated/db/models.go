@@ -12,11 +12,33 @@ import (
 	"github.com/google/uuid"
 )
 
+type AuditLogs struct {
+	ID            uuid.UUID  `db:"id" json:"id"`
+	WorkspaceID   *uuid.UUID `db:"workspace_id" json:"workspace_id"`
+	CorrelationID string     `db:"correlation_id" json:"correlation_id"`
+	CausationID   string     `db:"causation_id" json:"causation_id"`
+	ActorID       *uuid.UUID `db:"actor_id" json:"actor_id"`
+	ActorIp       string     `db:"actor_ip" json:"actor_ip"`
+	UserAgentHash string     `db:"user_agent_hash" json:"user_agent_hash"`
+	AggregateType string     `db:"aggregate_type" json:"aggregate_type"`
+	AggregateID   uuid.UUID  `db:"aggregate_id" json:"aggregate_id"`
+	Operation     string     `db:"operation" json:"operation"`
+	Changes       []byte     `db:"changes" json:"changes"`
+	OccurredAt    time.Time  `db:"occurred_at" json:"occurred_at"`
+	PrevHash      string     `db:"prev_hash" json:"prev_hash"`
+	Hash          string     `db:"hash" json:"hash"`
+	Seq           int64      `db:"seq" json:"seq"`
+	PiiSalt       []byte     `db:"pii_salt" json:"pii_salt"`
+	PiiDigest     string     `db:"pii_digest" json:"pii_digest"`
+	ChainKey      *string    `db:"chain_key" json:"chain_key"`
+}
+
 type DailySchedules struct {
-	UserID      uuid.UUID `db:"user_id" json:"user_id"`
-	Date        time.Time `db:"date" json:"date"`
-	MaxCapacity int32     `db:"max_capacity" json:"max_capacity"`
-	Version     int32     `db:"version" json:"version"`
+	UserID       uuid.UUID  `db:"user_id" json:"user_id"`
+	Date         time.Time  `db:"date" json:"date"`
+	MaxCapacity  int32      `db:"max_capacity" json:"max_capacity"`
+	Version      int32      `db:"version" json:"version"`
+	RolledOverAt *time.Time `db:"rolled_over_at" json:"rolled_over_at"`
 }
 
 type IdempotencyKeys struct {
@@ -42,17 +64,51 @@ type Outbox struct {
 	LastAttemptedAt *time.Time       `db:"last_attempted_at" json:"last_attempted_at"`
 }
 
+type RefreshTokens struct {
+	ID            types.RefreshTokenID `db:"id" json:"id"`
+	FamilyID      uuid.UUID            `db:"family_id" json:"family_id"`
+	UserID        types.UserID         `db:"user_id" json:"user_id"`
+	TokenHash     string               `db:"token_hash" json:"token_hash"`
+	CreatedAt     time.Time            `db:"created_at" json:"created_at"`
+	ExpiresAt     time.Time            `db:"expires_at" json:"expires_at"`
+	RotatedAt     *time.Time           `db:"rotated_at" json:"rotated_at"`
+	RevokedAt     *time.Time           `db:"revoked_at" json:"revoked_at"`
+	MfaVerifiedAt *time.Time           `db:"mfa_verified_at" json:"mfa_verified_at"`
+}
+
 type ScheduleTasks struct {
-	UserID     uuid.UUID `db:"user_id" json:"user_id"`
-	Date       time.Time `db:"date" json:"date"`
-	TodoID     uuid.UUID `db:"todo_id" json:"todo_id"`
-	EnergyCost int32     `db:"energy_cost" json:"energy_cost"`
+	UserID      uuid.UUID  `db:"user_id" json:"user_id"`
+	Date        time.Time  `db:"date" json:"date"`
+	TodoID      uuid.UUID  `db:"todo_id" json:"todo_id"`
+	EnergyCost  int32      `db:"energy_cost" json:"energy_cost"`
+	CompletedAt *time.Time `db:"completed_at" json:"completed_at"`
 }
 
 type Tags struct {
 	ID          types.TagID       `db:"id" json:"id"`
 	Name        string            `db:"name" json:"name"`
 	WorkspaceID types.WorkspaceID `db:"workspace_id" json:"workspace_id"`
+	Color       string            `db:"color" json:"color"`
+}
+
+type TodoChecklistItems struct {
+	ID       uuid.UUID `db:"id" json:"id"`
+	TodoID   uuid.UUID `db:"todo_id" json:"todo_id"`
+	Title    string    `db:"title" json:"title"`
+	Done     bool      `db:"done" json:"done"`
+	Required bool      `db:"required" json:"required"`
+	Position int32     `db:"position" json:"position"`
+}
+
+type TodoComments struct {
+	ID        types.CommentID `db:"id" json:"id"`
+	TodoID    types.TodoID    `db:"todo_id" json:"todo_id"`
+	AuthorID  types.UserID    `db:"author_id" json:"author_id"`
+	Body      string          `db:"body" json:"body"`
+	Mentions  []uuid.UUID     `db:"mentions" json:"mentions"`
+	CreatedAt time.Time       `db:"created_at" json:"created_at"`
+	EditedAt  *time.Time      `db:"edited_at" json:"edited_at"`
+	DeletedAt *time.Time      `db:"deleted_at" json:"deleted_at"`
 }
 
 type TodoCompletionLogs struct {
@@ -62,12 +118,19 @@ type TodoCompletionLogs struct {
 	OccurredAt time.Time `db:"occurred_at" json:"occurred_at"`
 }
 
+type TodoDependencies struct {
+	TodoID      types.TodoID `db:"todo_id" json:"todo_id"`
+	BlockedByID types.TodoID `db:"blocked_by_id" json:"blocked_by_id"`
+	CreatedAt   time.Time    `db:"created_at" json:"created_at"`
+}
+
 type TodoFocusSessions struct {
-	ID        uuid.UUID  `db:"id" json:"id"`
-	TodoID    uuid.UUID  `db:"todo_id" json:"todo_id"`
-	UserID    *uuid.UUID `db:"user_id" json:"user_id"`
-	StartTime time.Time  `db:"start_time" json:"start_time"`
-	EndTime   *time.Time `db:"end_time" json:"end_time"`
+	ID                     uuid.UUID  `db:"id" json:"id"`
+	TodoID                 uuid.UUID  `db:"todo_id" json:"todo_id"`
+	UserID                 *uuid.UUID `db:"user_id" json:"user_id"`
+	StartTime              time.Time  `db:"start_time" json:"start_time"`
+	EndTime                *time.Time `db:"end_time" json:"end_time"`
+	PlannedDurationSeconds *int32     `db:"planned_duration_seconds" json:"planned_duration_seconds"`
 }
 
 type TodoTags struct {
@@ -76,32 +139,48 @@ type TodoTags struct {
 }
 
 type Todos struct {
-	ID                 types.TodoID      `db:"id" json:"id"`
-	Title              string            `db:"title" json:"title"`
-	Status             string            `db:"status" json:"status"`
-	CreatedAt          time.Time         `db:"created_at" json:"created_at"`
-	WorkspaceID        types.WorkspaceID `db:"workspace_id" json:"workspace_id"`
-	UpdatedAt          time.Time         `db:"updated_at" json:"updated_at"`
-	DueDate            *time.Time        `db:"due_date" json:"due_date"`
-	RecurrenceInterval *string           `db:"recurrence_interval" json:"recurrence_interval"`
-	RecurrenceAmount   *int32            `db:"recurrence_amount" json:"recurrence_amount"`
-	LastCompletedAt    *time.Time        `db:"last_completed_at" json:"last_completed_at"`
-	DeletedAt          *time.Time        `db:"deleted_at" json:"deleted_at"`
+	ID                    types.TodoID      `db:"id" json:"id"`
+	Title                 string            `db:"title" json:"title"`
+	Status                string            `db:"status" json:"status"`
+	CreatedAt             time.Time         `db:"created_at" json:"created_at"`
+	WorkspaceID           types.WorkspaceID `db:"workspace_id" json:"workspace_id"`
+	UpdatedAt             time.Time         `db:"updated_at" json:"updated_at"`
+	DueDate               *time.Time        `db:"due_date" json:"due_date"`
+	RecurrenceInterval    *string           `db:"recurrence_interval" json:"recurrence_interval"`
+	RecurrenceAmount      *int32            `db:"recurrence_amount" json:"recurrence_amount"`
+	LastCompletedAt       *time.Time        `db:"last_completed_at" json:"last_completed_at"`
+	DeletedAt             *time.Time        `db:"deleted_at" json:"deleted_at"`
+	RecurrenceRule        *string           `db:"recurrence_rule" json:"recurrence_rule"`
+	RecurrenceOccurrences int32             `db:"recurrence_occurrences" json:"recurrence_occurrences"`
+	SearchVector          string            `db:"search_vector" json:"-"`
+	AssigneeID            *types.UserID     `db:"assignee_id" json:"assignee_id"`
 }
 
 type UserAuth struct {
-	UserID           uuid.UUID `db:"user_id" json:"user_id"`
-	PasswordHash     *string   `db:"password_hash" json:"-"`
-	TotpStatus       string    `db:"totp_status" json:"totp_status"`
-	TotpSecretCipher []byte    `db:"totp_secret_cipher" json:"totp_secret_cipher"`
-	TotpSecretNonce  []byte    `db:"totp_secret_nonce" json:"totp_secret_nonce"`
+	UserID            uuid.UUID `db:"user_id" json:"user_id"`
+	PasswordHash      *string   `db:"password_hash" json:"-"`
+	TotpStatus        string    `db:"totp_status" json:"totp_status"`
+	TotpSecretCipher  []byte    `db:"totp_secret_cipher" json:"totp_secret_cipher"`
+	TotpSecretNonce   []byte    `db:"totp_secret_nonce" json:"totp_secret_nonce"`
+	TotpRecoveryCodes []string  `db:"totp_recovery_codes" json:"totp_recovery_codes"`
+}
+
+type UserDataExports struct {
+	ID          types.DataExportID `db:"id" json:"id"`
+	UserID      types.UserID       `db:"user_id" json:"user_id"`
+	Status      string             `db:"status" json:"status"`
+	RequestedAt time.Time          `db:"requested_at" json:"requested_at"`
+	CompletedAt *time.Time         `db:"completed_at" json:"completed_at"`
+	Archive     []byte             `db:"archive" json:"archive"`
 }
 
 type Users struct {
-	ID        types.UserID `db:"id" json:"id"`
-	Email     string       `db:"email" json:"email"`
-	Name      string       `db:"name" json:"name"`
-	CreatedAt time.Time    `db:"created_at" json:"created_at"`
+	ID            types.UserID `db:"id" json:"id"`
+	Email         string       `db:"email" json:"email"`
+	Name          string       `db:"name" json:"name"`
+	CreatedAt     time.Time    `db:"created_at" json:"created_at"`
+	Timezone      string       `db:"timezone" json:"timezone"`
+	DailyCapacity int32        `db:"daily_capacity" json:"daily_capacity"`
 }
 
 type WorkspaceMembers struct {
@@ -13,11 +13,11 @@ import (
 
 type Querier interface {
 	BulkAddTagsToTodo(ctx context.Context, db DBTX, arg BulkAddTagsToTodoParams) error
+	BulkAddTodoDependencies(ctx context.Context, db DBTX, arg BulkAddTodoDependenciesParams) error
+	BulkUpsertChecklistItems(ctx context.Context, db DBTX, arg BulkUpsertChecklistItemsParams) error
 	BulkUpsertFocusSessions(ctx context.Context, db DBTX, arg BulkUpsertFocusSessionsParams) error
 	BulkUpsertScheduleTasks(ctx context.Context, db DBTX, arg BulkUpsertScheduleTasksParams) error
 	BulkUpsertWorkspaceMembers(ctx context.Context, db DBTX, arg BulkUpsertWorkspaceMembersParams) error
-	CreateTag(ctx context.Context, db DBTX, arg CreateTagParams) (Tags, error)
-	CreateUser(ctx context.Context, db DBTX, arg CreateUserParams) (Users, error)
 	CreateWorkspace(ctx context.Context, db DBTX, arg CreateWorkspaceParams) (Workspaces, error)
 	DeleteIdempotencyKey(ctx context.Context, db DBTX, id uuid.UUID) error
 	DeleteProcessedOutboxEvents(ctx context.Context, db DBTX) error
@@ -25,39 +25,101 @@ type Querier interface {
 	DeleteTodo(ctx context.Context, db DBTX, id types.TodoID) error
 	DeleteUser(ctx context.Context, db DBTX, id types.UserID) error
 	DeleteWorkspace(ctx context.Context, db DBTX, id types.WorkspaceID) error
+	GetAuditChainHead(ctx context.Context, db DBTX, arg GetAuditChainHeadParams) (string, error)
 	GetDailySchedule(ctx context.Context, db DBTX, arg GetDailyScheduleParams) (DailySchedules, error)
+	GetFocusByDay(ctx context.Context, db DBTX, arg GetFocusByDayParams) ([]GetFocusByDayRow, error)
+	GetFocusByMember(ctx context.Context, db DBTX, arg GetFocusByMemberParams) ([]GetFocusByMemberRow, error)
+	// A session on a todo with several tags counts towards each of them.
+	GetFocusByTag(ctx context.Context, db DBTX, arg GetFocusByTagParams) ([]GetFocusByTagRow, error)
+	GetFocusByTodo(ctx context.Context, db DBTX, arg GetFocusByTodoParams) ([]GetFocusByTodoRow, error)
+	// Streaks are runs of consecutive days with focus. The current one must reach the
+	// last day or the day before, so a day that hasn't been worked yet doesn't break it.
+	GetFocusStreaks(ctx context.Context, db DBTX, arg GetFocusStreaksParams) (GetFocusStreaksRow, error)
+	// Sessions count towards the day they started on. Open sessions are excluded.
+	GetFocusTotals(ctx context.Context, db DBTX, arg GetFocusTotalsParams) (GetFocusTotalsRow, error)
 	GetIdempotencyKey(ctx context.Context, db DBTX, id uuid.UUID) (IdempotencyKeys, error)
+	GetLatestTaskCosts(ctx context.Context, db DBTX, arg GetLatestTaskCostsParams) ([]GetLatestTaskCostsRow, error)
 	GetOutboxLag(ctx context.Context, db DBTX) (GetOutboxLagRow, error)
+	GetRefreshTokenByHashForUpdate(ctx context.Context, db DBTX, tokenHash string) (RefreshTokens, error)
 	GetScheduleTasks(ctx context.Context, db DBTX, arg GetScheduleTasksParams) ([]ScheduleTasks, error)
 	GetSchedulesByTodoID(ctx context.Context, db DBTX, todoID uuid.UUID) ([]GetSchedulesByTodoIDRow, error)
 	GetTagByID(ctx context.Context, db DBTX, id types.TagID) (Tags, error)
 	GetTagByName(ctx context.Context, db DBTX, arg GetTagByNameParams) (Tags, error)
 	GetTodoAggregateByID(ctx context.Context, db DBTX, id types.TodoID) (GetTodoAggregateByIDRow, error)
+	GetTodoCommentByID(ctx context.Context, db DBTX, id types.CommentID) (GetTodoCommentByIDRow, error)
 	GetTodoReadModelByID(ctx context.Context, db DBTX, id types.TodoID) (GetTodoReadModelByIDRow, error)
 	// lock per tx in replica: e.g. 200 rows - a locks 100, b locks next 100, ...
 	GetUnprocessedOutboxEvents(ctx context.Context, db DBTX) ([]Outbox, error)
 	GetUserAuth(ctx context.Context, db DBTX, userID uuid.UUID) (UserAuth, error)
+	GetUserAuthForUpdate(ctx context.Context, db DBTX, userID uuid.UUID) (UserAuth, error)
 	GetUserByEmail(ctx context.Context, db DBTX, email string) (Users, error)
 	GetUserByID(ctx context.Context, db DBTX, id types.UserID) (Users, error)
+	GetUserDataExportByID(ctx context.Context, db DBTX, id types.DataExportID) (UserDataExports, error)
 	GetWorkspaceByID(ctx context.Context, db DBTX, id types.WorkspaceID) (Workspaces, error)
 	GetWorkspaceMembers(ctx context.Context, db DBTX, workspaceID types.WorkspaceID) ([]WorkspaceMembers, error)
+	HasActiveFocusSession(ctx context.Context, db DBTX, userID uuid.UUID) (bool, error)
+	InsertAuditLog(ctx context.Context, db DBTX, arg InsertAuditLogParams) error
+	ListAuditChain(ctx context.Context, db DBTX, arg ListAuditChainParams) ([]AuditLogs, error)
+	// Any entry that references the user as actor, aggregate or within its changes, locked for erasure.
+	ListAuditLogsReferencingUserForUpdate(ctx context.Context, db DBTX, userID uuid.UUID) ([]AuditLogs, error)
+	// A session elapses at its planned end or after max_seconds, whichever comes first.
+	ListElapsedFocusTodoIDs(ctx context.Context, db DBTX, arg ListElapsedFocusTodoIDsParams) ([]uuid.UUID, error)
+	// Pages by (date, user_id) so that schedules skipped or failed in a run don't starve later ones.
+	ListSchedulesPendingRollover(ctx context.Context, db DBTX, arg ListSchedulesPendingRolloverParams) ([]ListSchedulesPendingRolloverRow, error)
 	ListTagsByWorkspaceID(ctx context.Context, db DBTX, workspaceID types.WorkspaceID) ([]Tags, error)
+	ListTodoBlockerIDs(ctx context.Context, db DBTX, todoID types.TodoID) ([]types.TodoID, error)
+	ListTodoComments(ctx context.Context, db DBTX, arg ListTodoCommentsParams) ([]ListTodoCommentsRow, error)
+	ListTodoDependentIDs(ctx context.Context, db DBTX, blockedByID types.TodoID) ([]types.TodoID, error)
+	ListTodoIDsAssignedInWorkspace(ctx context.Context, db DBTX, arg ListTodoIDsAssignedInWorkspaceParams) ([]types.TodoID, error)
+	ListTodoIDsByTagID(ctx context.Context, db DBTX, tagID types.TagID) ([]types.TodoID, error)
+	ListTodosByAssigneeID(ctx context.Context, db DBTX, arg ListTodosByAssigneeIDParams) ([]ListTodosByAssigneeIDRow, error)
+	// Keyset pagination: the cursor holds the sort value of the last row (cursor_time for
+	// timestamp keys, cursor_text for title) plus its id as tiebreaker. A missing due date
+	// sorts as infinity on both sides so the key is never NULL.
 	ListTodosByWorkspaceID(ctx context.Context, db DBTX, arg ListTodosByWorkspaceIDParams) ([]ListTodosByWorkspaceIDRow, error)
+	ListTodosTouchedByUser(ctx context.Context, db DBTX, userID uuid.UUID) ([]ListTodosTouchedByUserRow, error)
+	ListUnassignedTodosDueForMember(ctx context.Context, db DBTX, arg ListUnassignedTodosDueForMemberParams) ([]ListUnassignedTodosDueForMemberRow, error)
+	// Keyset pages over the chains of logs outside any workspace, which are kept per aggregate.
+	ListUnscopedAuditChains(ctx context.Context, db DBTX, arg ListUnscopedAuditChainsParams) ([]ListUnscopedAuditChainsRow, error)
+	ListUserAuditLogs(ctx context.Context, db DBTX, userID uuid.UUID) ([]AuditLogs, error)
+	ListUserComments(ctx context.Context, db DBTX, authorID types.UserID) ([]ListUserCommentsRow, error)
+	ListUserFocusSessions(ctx context.Context, db DBTX, userID uuid.UUID) ([]ListUserFocusSessionsRow, error)
+	ListUserMemberships(ctx context.Context, db DBTX, userID types.UserID) ([]ListUserMembershipsRow, error)
+	ListWorkspaceAuditLogs(ctx context.Context, db DBTX, arg ListWorkspaceAuditLogsParams) ([]AuditLogs, error)
 	ListWorkspaces(ctx context.Context, db DBTX, arg ListWorkspacesParams) ([]Workspaces, error)
 	ListWorkspacesByUserID(ctx context.Context, db DBTX, userID types.UserID) ([]Workspaces, error)
+	// Serializes appends to a chain until the transaction ends. The key matches audit_logs.chain_key:
+	// the workspace, or the aggregate for unscoped logs, so unrelated unscoped writes don't wait on each other.
+	LockAuditChain(ctx context.Context, db DBTX, arg LockAuditChainParams) error
+	LockTodo(ctx context.Context, db DBTX, id types.TodoID) error
+	// Serializes blocked-by changes in a workspace until the transaction ends, so that
+	// concurrent cycle checks cannot miss each other's links.
+	LockTodoDependencies(ctx context.Context, db DBTX, workspaceID uuid.UUID) error
 	MarkOutboxEventProcessed(ctx context.Context, db DBTX, id uuid.UUID) error
+	MarkScheduleTasksCompleted(ctx context.Context, db DBTX, arg MarkScheduleTasksCompletedParams) error
+	RemoveMissingChecklistItemsFromTodo(ctx context.Context, db DBTX, arg RemoveMissingChecklistItemsFromTodoParams) error
 	RemoveMissingFocusSessionsFromTodo(ctx context.Context, db DBTX, arg RemoveMissingFocusSessionsFromTodoParams) error
 	RemoveMissingTagsFromTodo(ctx context.Context, db DBTX, arg RemoveMissingTagsFromTodoParams) error
 	RemoveMissingTasksFromSchedule(ctx context.Context, db DBTX, arg RemoveMissingTasksFromScheduleParams) error
+	RemoveMissingTodoDependencies(ctx context.Context, db DBTX, arg RemoveMissingTodoDependenciesParams) error
 	RemoveWorkspaceMember(ctx context.Context, db DBTX, arg RemoveWorkspaceMemberParams) error
+	RevokeRefreshTokenFamily(ctx context.Context, db DBTX, arg RevokeRefreshTokenFamilyParams) error
+	RevokeUserRefreshTokens(ctx context.Context, db DBTX, arg RevokeUserRefreshTokensParams) error
 	SaveOutboxEvent(ctx context.Context, db DBTX, arg SaveOutboxEventParams) error
+	SearchTodosByWorkspaceID(ctx context.Context, db DBTX, arg SearchTodosByWorkspaceIDParams) ([]SearchTodosByWorkspaceIDRow, error)
 	TryLockIdempotencyKey(ctx context.Context, db DBTX, id uuid.UUID) (int64, error)
+	UpdateErasedAuditLog(ctx context.Context, db DBTX, arg UpdateErasedAuditLogParams) error
 	UpdateIdempotencyKey(ctx context.Context, db DBTX, arg UpdateIdempotencyKeyParams) error
 	UpdateOutboxRetries(ctx context.Context, db DBTX, arg UpdateOutboxRetriesParams) error
 	UpsertDailySchedule(ctx context.Context, db DBTX, arg UpsertDailyScheduleParams) (DailySchedules, error)
 	UpsertFocusSession(ctx context.Context, db DBTX, arg UpsertFocusSessionParams) error
+	UpsertRefreshToken(ctx context.Context, db DBTX, arg UpsertRefreshTokenParams) error
+	UpsertTag(ctx context.Context, db DBTX, arg UpsertTagParams) error
 	UpsertTodo(ctx context.Context, db DBTX, arg UpsertTodoParams) (Todos, error)
+	UpsertTodoComment(ctx context.Context, db DBTX, arg UpsertTodoCommentParams) error
+	UpsertUser(ctx context.Context, db DBTX, arg UpsertUserParams) (Users, error)
 	UpsertUserAuth(ctx context.Context, db DBTX, arg UpsertUserAuthParams) error
+	UpsertUserDataExport(ctx context.Context, db DBTX, arg UpsertUserDataExportParams) error
 	UpsertWorkspace(ctx context.Context, db DBTX, arg UpsertWorkspaceParams) (Workspaces, error)
 }
 
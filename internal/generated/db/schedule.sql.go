@@ -45,7 +45,7 @@ func (q *Queries) BulkUpsertScheduleTasks(ctx context.Context, db DBTX, arg Bulk
 
 const GetDailySchedule = `-- name: GetDailySchedule :one
 SELECT
-  user_id, date, max_capacity, version
+  user_id, date, max_capacity, version, rolled_over_at
 FROM
   daily_schedules
 WHERE
@@ -66,13 +66,58 @@ func (q *Queries) GetDailySchedule(ctx context.Context, db DBTX, arg GetDailySch
 		&i.Date,
 		&i.MaxCapacity,
 		&i.Version,
+		&i.RolledOverAt,
 	)
 	return i, err
 }
 
+const GetLatestTaskCosts = `-- name: GetLatestTaskCosts :many
+SELECT DISTINCT ON (todo_id)
+  todo_id,
+  energy_cost
+FROM
+  schedule_tasks
+WHERE
+  user_id = $1
+  AND todo_id = ANY ($2::uuid[])
+ORDER BY
+  todo_id,
+  date DESC
+`
+
+type GetLatestTaskCostsParams struct {
+	UserID  uuid.UUID   `db:"user_id" json:"user_id"`
+	TodoIds []uuid.UUID `db:"todo_ids" json:"todo_ids"`
+}
+
+type GetLatestTaskCostsRow struct {
+	TodoID     uuid.UUID `db:"todo_id" json:"todo_id"`
+	EnergyCost int32     `db:"energy_cost" json:"energy_cost"`
+}
+
+func (q *Queries) GetLatestTaskCosts(ctx context.Context, db DBTX, arg GetLatestTaskCostsParams) ([]GetLatestTaskCostsRow, error) {
+	rows, err := db.Query(ctx, GetLatestTaskCosts, arg.UserID, arg.TodoIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetLatestTaskCostsRow{}
+	for rows.Next() {
+		var i GetLatestTaskCostsRow
+		if err := rows.Scan(&i.TodoID, &i.EnergyCost); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const GetScheduleTasks = `-- name: GetScheduleTasks :many
 SELECT
-  user_id, date, todo_id, energy_cost
+  user_id, date, todo_id, energy_cost, completed_at
 FROM
   schedule_tasks
 WHERE
@@ -99,6 +144,7 @@ func (q *Queries) GetScheduleTasks(ctx context.Context, db DBTX, arg GetSchedule
 			&i.Date,
 			&i.TodoID,
 			&i.EnergyCost,
+			&i.CompletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -118,6 +164,8 @@ FROM
   schedule_tasks
 WHERE
   todo_id = $1
+ORDER BY
+  date
 `
 
 type GetSchedulesByTodoIDRow struct {
@@ -145,6 +193,104 @@ func (q *Queries) GetSchedulesByTodoID(ctx context.Context, db DBTX, todoID uuid
 	return items, nil
 }
 
+const ListSchedulesPendingRollover = `-- name: ListSchedulesPendingRollover :many
+SELECT
+  ds.user_id,
+  ds.date
+FROM
+  daily_schedules ds
+WHERE
+  ds.rolled_over_at IS NULL
+  AND ds.date < $1
+  AND EXISTS (
+    SELECT
+      1
+    FROM
+      schedule_tasks st
+    WHERE
+      st.user_id = ds.user_id
+      AND st.date = ds.date)
+  AND ($2::uuid IS NULL
+    OR ds.date > $3
+    OR (ds.date = $3
+      AND ds.user_id > $2::uuid))
+ORDER BY
+  ds.date,
+  ds.user_id
+LIMIT $4
+`
+
+type ListSchedulesPendingRolloverParams struct {
+	Before      time.Time  `db:"before" json:"before"`
+	AfterUserID *uuid.UUID `db:"after_user_id" json:"after_user_id"`
+	AfterDate   *time.Time `db:"after_date" json:"after_date"`
+	Lim         int32      `db:"lim" json:"lim"`
+}
+
+type ListSchedulesPendingRolloverRow struct {
+	UserID uuid.UUID `db:"user_id" json:"user_id"`
+	Date   time.Time `db:"date" json:"date"`
+}
+
+// Pages by (date, user_id) so that schedules skipped or failed in a run don't starve later ones.
+func (q *Queries) ListSchedulesPendingRollover(ctx context.Context, db DBTX, arg ListSchedulesPendingRolloverParams) ([]ListSchedulesPendingRolloverRow, error) {
+	rows, err := db.Query(ctx, ListSchedulesPendingRollover,
+		arg.Before,
+		arg.AfterUserID,
+		arg.AfterDate,
+		arg.Lim,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListSchedulesPendingRolloverRow{}
+	for rows.Next() {
+		var i ListSchedulesPendingRolloverRow
+		if err := rows.Scan(&i.UserID, &i.Date); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const MarkScheduleTasksCompleted = `-- name: MarkScheduleTasksCompleted :exec
+UPDATE
+  schedule_tasks st
+SET
+  completed_at = c.completed_at
+FROM (
+  SELECT
+    UNNEST($3::uuid[]) AS todo_id,
+    UNNEST($4::timestamptz[]) AS completed_at) c
+WHERE
+  st.user_id = $1
+  AND st.date = $2
+  AND st.todo_id = c.todo_id
+  AND st.completed_at IS NULL
+`
+
+type MarkScheduleTasksCompletedParams struct {
+	UserID       uuid.UUID   `db:"user_id" json:"user_id"`
+	Date         time.Time   `db:"date" json:"date"`
+	TodoIds      []uuid.UUID `db:"todo_ids" json:"todo_ids"`
+	CompletedAts []time.Time `db:"completed_ats" json:"completed_ats"`
+}
+
+func (q *Queries) MarkScheduleTasksCompleted(ctx context.Context, db DBTX, arg MarkScheduleTasksCompletedParams) error {
+	_, err := db.Exec(ctx, MarkScheduleTasksCompleted,
+		arg.UserID,
+		arg.Date,
+		arg.TodoIds,
+		arg.CompletedAts,
+	)
+	return err
+}
+
 const RemoveMissingTasksFromSchedule = `-- name: RemoveMissingTasksFromSchedule :exec
 DELETE FROM schedule_tasks
 WHERE user_id = $1
@@ -164,23 +310,25 @@ func (q *Queries) RemoveMissingTasksFromSchedule(ctx context.Context, db DBTX, a
 }
 
 const UpsertDailySchedule = `-- name: UpsertDailySchedule :one
-INSERT INTO daily_schedules(user_id, date, max_capacity, version)
-  VALUES ($1, $2, $3, 1)
+INSERT INTO daily_schedules(user_id, date, max_capacity, version, rolled_over_at)
+  VALUES ($1, $2, $3, 1, $4)
 ON CONFLICT (user_id, date)
   DO UPDATE SET
     max_capacity = EXCLUDED.max_capacity,
+    rolled_over_at = EXCLUDED.rolled_over_at,
     version = daily_schedules.version + 1
   WHERE
-    daily_schedules.version = $4
+    daily_schedules.version = $5
   RETURNING
-    user_id, date, max_capacity, version
+    user_id, date, max_capacity, version, rolled_over_at
 `
 
 type UpsertDailyScheduleParams struct {
-	UserID         uuid.UUID `db:"user_id" json:"user_id"`
-	Date           time.Time `db:"date" json:"date"`
-	MaxCapacity    int32     `db:"max_capacity" json:"max_capacity"`
-	CurrentVersion int32     `db:"current_version" json:"current_version"`
+	UserID         uuid.UUID  `db:"user_id" json:"user_id"`
+	Date           time.Time  `db:"date" json:"date"`
+	MaxCapacity    int32      `db:"max_capacity" json:"max_capacity"`
+	RolledOverAt   *time.Time `db:"rolled_over_at" json:"rolled_over_at"`
+	CurrentVersion int32      `db:"current_version" json:"current_version"`
 }
 
 func (q *Queries) UpsertDailySchedule(ctx context.Context, db DBTX, arg UpsertDailyScheduleParams) (DailySchedules, error) {
@@ -188,6 +336,7 @@ func (q *Queries) UpsertDailySchedule(ctx context.Context, db DBTX, arg UpsertDa
 		arg.UserID,
 		arg.Date,
 		arg.MaxCapacity,
+		arg.RolledOverAt,
 		arg.CurrentVersion,
 	)
 	var i DailySchedules
@@ -196,6 +345,7 @@ func (q *Queries) UpsertDailySchedule(ctx context.Context, db DBTX, arg UpsertDa
 		&i.Date,
 		&i.MaxCapacity,
 		&i.Version,
+		&i.RolledOverAt,
 	)
 	return i, err
 }
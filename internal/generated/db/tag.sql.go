@@ -11,26 +11,6 @@ import (
 	"github.com/danicc097/todo-ddd-example/internal/infrastructure/db/types"
 )
 
-const CreateTag = `-- name: CreateTag :one
-INSERT INTO tags(id, name, workspace_id)
-  VALUES ($1, $2, $3)
-RETURNING
-  id, name, workspace_id
-`
-
-type CreateTagParams struct {
-	ID          types.TagID       `db:"id" json:"id"`
-	Name        string            `db:"name" json:"name"`
-	WorkspaceID types.WorkspaceID `db:"workspace_id" json:"workspace_id"`
-}
-
-func (q *Queries) CreateTag(ctx context.Context, db DBTX, arg CreateTagParams) (Tags, error) {
-	row := db.QueryRow(ctx, CreateTag, arg.ID, arg.Name, arg.WorkspaceID)
-	var i Tags
-	err := row.Scan(&i.ID, &i.Name, &i.WorkspaceID)
-	return i, err
-}
-
 const DeleteTag = `-- name: DeleteTag :exec
 DELETE FROM tags
 WHERE id = $1
@@ -43,7 +23,7 @@ func (q *Queries) DeleteTag(ctx context.Context, db DBTX, id types.TagID) error
 
 const GetTagByID = `-- name: GetTagByID :one
 SELECT
-  id, name, workspace_id
+  id, name, workspace_id, color
 FROM
   tags
 WHERE
@@ -53,13 +33,18 @@ WHERE
 func (q *Queries) GetTagByID(ctx context.Context, db DBTX, id types.TagID) (Tags, error) {
 	row := db.QueryRow(ctx, GetTagByID, id)
 	var i Tags
-	err := row.Scan(&i.ID, &i.Name, &i.WorkspaceID)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.WorkspaceID,
+		&i.Color,
+	)
 	return i, err
 }
 
 const GetTagByName = `-- name: GetTagByName :one
 SELECT
-  id, name, workspace_id
+  id, name, workspace_id, color
 FROM
   tags
 WHERE
@@ -75,13 +60,18 @@ type GetTagByNameParams struct {
 func (q *Queries) GetTagByName(ctx context.Context, db DBTX, arg GetTagByNameParams) (Tags, error) {
 	row := db.QueryRow(ctx, GetTagByName, arg.WorkspaceID, arg.Name)
 	var i Tags
-	err := row.Scan(&i.ID, &i.Name, &i.WorkspaceID)
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.WorkspaceID,
+		&i.Color,
+	)
 	return i, err
 }
 
 const ListTagsByWorkspaceID = `-- name: ListTagsByWorkspaceID :many
 SELECT
-  id, name, workspace_id
+  id, name, workspace_id, color
 FROM
   tags
 WHERE
@@ -99,7 +89,12 @@ func (q *Queries) ListTagsByWorkspaceID(ctx context.Context, db DBTX, workspaceI
 	items := []Tags{}
 	for rows.Next() {
 		var i Tags
-		if err := rows.Scan(&i.ID, &i.Name, &i.WorkspaceID); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.WorkspaceID,
+			&i.Color,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -109,3 +104,29 @@ func (q *Queries) ListTagsByWorkspaceID(ctx context.Context, db DBTX, workspaceI
 	}
 	return items, nil
 }
+
+const UpsertTag = `-- name: UpsertTag :exec
+INSERT INTO tags(id, name, workspace_id, color)
+  VALUES ($1, $2, $3, $4)
+ON CONFLICT (id)
+  DO UPDATE SET
+    name = EXCLUDED.name,
+    color = EXCLUDED.color
+`
+
+type UpsertTagParams struct {
+	ID          types.TagID       `db:"id" json:"id"`
+	Name        string            `db:"name" json:"name"`
+	WorkspaceID types.WorkspaceID `db:"workspace_id" json:"workspace_id"`
+	Color       string            `db:"color" json:"color"`
+}
+
+func (q *Queries) UpsertTag(ctx context.Context, db DBTX, arg UpsertTagParams) error {
+	_, err := db.Exec(ctx, UpsertTag,
+		arg.ID,
+		arg.Name,
+		arg.WorkspaceID,
+		arg.Color,
+	)
+	return err
+}
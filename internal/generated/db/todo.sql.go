@@ -32,25 +32,84 @@ func (q *Queries) BulkAddTagsToTodo(ctx context.Context, db DBTX, arg BulkAddTag
 	return err
 }
 
+const BulkAddTodoDependencies = `-- name: BulkAddTodoDependencies :exec
+INSERT INTO todo_dependencies(todo_id, blocked_by_id)
+SELECT
+  UNNEST($1::uuid[]),
+  UNNEST($2::uuid[])
+ON CONFLICT
+  DO NOTHING
+`
+
+type BulkAddTodoDependenciesParams struct {
+	TodoIds      []uuid.UUID `db:"todo_ids" json:"todo_ids"`
+	BlockedByIds []uuid.UUID `db:"blocked_by_ids" json:"blocked_by_ids"`
+}
+
+func (q *Queries) BulkAddTodoDependencies(ctx context.Context, db DBTX, arg BulkAddTodoDependenciesParams) error {
+	_, err := db.Exec(ctx, BulkAddTodoDependencies, arg.TodoIds, arg.BlockedByIds)
+	return err
+}
+
+const BulkUpsertChecklistItems = `-- name: BulkUpsertChecklistItems :exec
+INSERT INTO todo_checklist_items(id, todo_id, title, done, required, position)
+SELECT
+  UNNEST($1::uuid[]),
+  UNNEST($2::uuid[]),
+  UNNEST($3::text[]),
+  UNNEST($4::boolean[]),
+  UNNEST($5::boolean[]),
+  UNNEST($6::integer[])
+ON CONFLICT (id)
+  DO UPDATE SET
+    title = EXCLUDED.title,
+    done = EXCLUDED.done,
+    required = EXCLUDED.required,
+    position = EXCLUDED.position
+`
+
+type BulkUpsertChecklistItemsParams struct {
+	Ids       []uuid.UUID `db:"ids" json:"ids"`
+	TodoIds   []uuid.UUID `db:"todo_ids" json:"todo_ids"`
+	Titles    []string    `db:"titles" json:"titles"`
+	Dones     []bool      `db:"dones" json:"dones"`
+	Requireds []bool      `db:"requireds" json:"requireds"`
+	Positions []int32     `db:"positions" json:"positions"`
+}
+
+func (q *Queries) BulkUpsertChecklistItems(ctx context.Context, db DBTX, arg BulkUpsertChecklistItemsParams) error {
+	_, err := db.Exec(ctx, BulkUpsertChecklistItems,
+		arg.Ids,
+		arg.TodoIds,
+		arg.Titles,
+		arg.Dones,
+		arg.Requireds,
+		arg.Positions,
+	)
+	return err
+}
+
 const BulkUpsertFocusSessions = `-- name: BulkUpsertFocusSessions :exec
-INSERT INTO todo_focus_sessions(id, todo_id, user_id, start_time, end_time)
+INSERT INTO todo_focus_sessions(id, todo_id, user_id, start_time, end_time, planned_duration_seconds)
 SELECT
   UNNEST($1::uuid[]),
   UNNEST($2::uuid[]),
   UNNEST($3::uuid[]),
   UNNEST($4::timestamptz[]),
-  NULLIF(UNNEST($5::timestamptz[]), '0001-01-01 00:00:00+00'::timestamptz)
+  NULLIF(UNNEST($5::timestamptz[]), '0001-01-01 00:00:00+00'::timestamptz),
+  NULLIF(UNNEST($6::integer[]), 0)
 ON CONFLICT (id)
   DO UPDATE SET
     end_time = EXCLUDED.end_time
 `
 
 type BulkUpsertFocusSessionsParams struct {
-	Ids        []uuid.UUID `db:"ids" json:"ids"`
-	TodoIds    []uuid.UUID `db:"todo_ids" json:"todo_ids"`
-	UserIds    []uuid.UUID `db:"user_ids" json:"user_ids"`
-	StartTimes []time.Time `db:"start_times" json:"start_times"`
-	EndTimes   []time.Time `db:"end_times" json:"end_times"`
+	Ids                     []uuid.UUID `db:"ids" json:"ids"`
+	TodoIds                 []uuid.UUID `db:"todo_ids" json:"todo_ids"`
+	UserIds                 []uuid.UUID `db:"user_ids" json:"user_ids"`
+	StartTimes              []time.Time `db:"start_times" json:"start_times"`
+	EndTimes                []time.Time `db:"end_times" json:"end_times"`
+	PlannedDurationsSeconds []int32     `db:"planned_durations_seconds" json:"planned_durations_seconds"`
 }
 
 func (q *Queries) BulkUpsertFocusSessions(ctx context.Context, db DBTX, arg BulkUpsertFocusSessionsParams) error {
@@ -60,6 +119,7 @@ func (q *Queries) BulkUpsertFocusSessions(ctx context.Context, db DBTX, arg Bulk
 		arg.UserIds,
 		arg.StartTimes,
 		arg.EndTimes,
+		arg.PlannedDurationsSeconds,
 	)
 	return err
 }
@@ -80,14 +140,26 @@ func (q *Queries) DeleteTodo(ctx context.Context, db DBTX, id types.TodoID) erro
 
 const GetTodoAggregateByID = `-- name: GetTodoAggregateByID :one
 SELECT
-  t.id, t.title, t.status, t.created_at, t.workspace_id, t.updated_at, t.due_date, t.recurrence_interval, t.recurrence_amount, t.last_completed_at, t.deleted_at,
+  t.id, t.title, t.status, t.created_at, t.workspace_id, t.updated_at, t.due_date, t.recurrence_interval, t.recurrence_amount, t.last_completed_at, t.deleted_at, t.recurrence_rule, t.recurrence_occurrences, t.search_vector, t.assignee_id,
   COALESCE(array_remove(array_agg(DISTINCT tt.tag_id), NULL), '{}')::uuid[] AS tags,
   COALESCE((
     SELECT
       json_agg(fs.*)
     FROM todo_focus_sessions fs
     WHERE
-      fs.todo_id = t.id), '[]'::json) AS focus_sessions
+      fs.todo_id = t.id), '[]'::json) AS focus_sessions,
+  COALESCE((
+    SELECT
+      json_agg(ci.* ORDER BY ci.position)
+    FROM todo_checklist_items ci
+    WHERE
+      ci.todo_id = t.id), '[]'::json) AS checklist_items,
+  COALESCE((
+    SELECT
+      array_agg(td.blocked_by_id ORDER BY td.created_at, td.blocked_by_id)
+    FROM todo_dependencies td
+    WHERE
+      td.todo_id = t.id), '{}')::uuid[] AS blocked_by
 FROM
   todos t
   LEFT JOIN todo_tags tt ON t.id = tt.todo_id
@@ -99,19 +171,25 @@ GROUP BY
 `
 
 type GetTodoAggregateByIDRow struct {
-	ID                 types.TodoID      `db:"id" json:"id"`
-	Title              string            `db:"title" json:"title"`
-	Status             string            `db:"status" json:"status"`
-	CreatedAt          time.Time         `db:"created_at" json:"created_at"`
-	WorkspaceID        types.WorkspaceID `db:"workspace_id" json:"workspace_id"`
-	UpdatedAt          time.Time         `db:"updated_at" json:"updated_at"`
-	DueDate            *time.Time        `db:"due_date" json:"due_date"`
-	RecurrenceInterval *string           `db:"recurrence_interval" json:"recurrence_interval"`
-	RecurrenceAmount   *int32            `db:"recurrence_amount" json:"recurrence_amount"`
-	LastCompletedAt    *time.Time        `db:"last_completed_at" json:"last_completed_at"`
-	DeletedAt          *time.Time        `db:"deleted_at" json:"deleted_at"`
-	Tags               []uuid.UUID       `db:"tags" json:"tags"`
-	FocusSessions      interface{}       `db:"focus_sessions" json:"focus_sessions"`
+	ID                    types.TodoID      `db:"id" json:"id"`
+	Title                 string            `db:"title" json:"title"`
+	Status                string            `db:"status" json:"status"`
+	CreatedAt             time.Time         `db:"created_at" json:"created_at"`
+	WorkspaceID           types.WorkspaceID `db:"workspace_id" json:"workspace_id"`
+	UpdatedAt             time.Time         `db:"updated_at" json:"updated_at"`
+	DueDate               *time.Time        `db:"due_date" json:"due_date"`
+	RecurrenceInterval    *string           `db:"recurrence_interval" json:"recurrence_interval"`
+	RecurrenceAmount      *int32            `db:"recurrence_amount" json:"recurrence_amount"`
+	LastCompletedAt       *time.Time        `db:"last_completed_at" json:"last_completed_at"`
+	DeletedAt             *time.Time        `db:"deleted_at" json:"deleted_at"`
+	RecurrenceRule        *string           `db:"recurrence_rule" json:"recurrence_rule"`
+	RecurrenceOccurrences int32             `db:"recurrence_occurrences" json:"recurrence_occurrences"`
+	SearchVector          string            `db:"search_vector" json:"-"`
+	AssigneeID            *types.UserID     `db:"assignee_id" json:"assignee_id"`
+	Tags                  []uuid.UUID       `db:"tags" json:"tags"`
+	FocusSessions         interface{}       `db:"focus_sessions" json:"focus_sessions"`
+	ChecklistItems        interface{}       `db:"checklist_items" json:"checklist_items"`
+	BlockedBy             []uuid.UUID       `db:"blocked_by" json:"blocked_by"`
 }
 
 func (q *Queries) GetTodoAggregateByID(ctx context.Context, db DBTX, id types.TodoID) (GetTodoAggregateByIDRow, error) {
@@ -129,22 +207,40 @@ func (q *Queries) GetTodoAggregateByID(ctx context.Context, db DBTX, id types.To
 		&i.RecurrenceAmount,
 		&i.LastCompletedAt,
 		&i.DeletedAt,
+		&i.RecurrenceRule,
+		&i.RecurrenceOccurrences,
+		&i.SearchVector,
+		&i.AssigneeID,
 		&i.Tags,
 		&i.FocusSessions,
+		&i.ChecklistItems,
+		&i.BlockedBy,
 	)
 	return i, err
 }
 
 const GetTodoReadModelByID = `-- name: GetTodoReadModelByID :one
 SELECT
-  t.id, t.title, t.status, t.created_at, t.workspace_id, t.updated_at, t.due_date, t.recurrence_interval, t.recurrence_amount, t.last_completed_at, t.deleted_at,
+  t.id, t.title, t.status, t.created_at, t.workspace_id, t.updated_at, t.due_date, t.recurrence_interval, t.recurrence_amount, t.last_completed_at, t.deleted_at, t.recurrence_rule, t.recurrence_occurrences, t.search_vector, t.assignee_id,
   COALESCE(array_remove(array_agg(DISTINCT tt.tag_id), NULL), '{}')::uuid[] AS tags,
   COALESCE((
     SELECT
       json_agg(fs.*)
     FROM todo_focus_sessions fs
     WHERE
-      fs.todo_id = t.id), '[]'::json) AS focus_sessions
+      fs.todo_id = t.id), '[]'::json) AS focus_sessions,
+  COALESCE((
+    SELECT
+      json_agg(ci.* ORDER BY ci.position)
+    FROM todo_checklist_items ci
+    WHERE
+      ci.todo_id = t.id), '[]'::json) AS checklist_items,
+  COALESCE((
+    SELECT
+      array_agg(td.blocked_by_id ORDER BY td.created_at, td.blocked_by_id)
+    FROM todo_dependencies td
+    WHERE
+      td.todo_id = t.id), '{}')::uuid[] AS blocked_by
 FROM
   todos t
   LEFT JOIN todo_tags tt ON t.id = tt.todo_id
@@ -156,19 +252,25 @@ GROUP BY
 `
 
 type GetTodoReadModelByIDRow struct {
-	ID                 types.TodoID      `db:"id" json:"id"`
-	Title              string            `db:"title" json:"title"`
-	Status             string            `db:"status" json:"status"`
-	CreatedAt          time.Time         `db:"created_at" json:"created_at"`
-	WorkspaceID        types.WorkspaceID `db:"workspace_id" json:"workspace_id"`
-	UpdatedAt          time.Time         `db:"updated_at" json:"updated_at"`
-	DueDate            *time.Time        `db:"due_date" json:"due_date"`
-	RecurrenceInterval *string           `db:"recurrence_interval" json:"recurrence_interval"`
-	RecurrenceAmount   *int32            `db:"recurrence_amount" json:"recurrence_amount"`
-	LastCompletedAt    *time.Time        `db:"last_completed_at" json:"last_completed_at"`
-	DeletedAt          *time.Time        `db:"deleted_at" json:"deleted_at"`
-	Tags               []uuid.UUID       `db:"tags" json:"tags"`
-	FocusSessions      interface{}       `db:"focus_sessions" json:"focus_sessions"`
+	ID                    types.TodoID      `db:"id" json:"id"`
+	Title                 string            `db:"title" json:"title"`
+	Status                string            `db:"status" json:"status"`
+	CreatedAt             time.Time         `db:"created_at" json:"created_at"`
+	WorkspaceID           types.WorkspaceID `db:"workspace_id" json:"workspace_id"`
+	UpdatedAt             time.Time         `db:"updated_at" json:"updated_at"`
+	DueDate               *time.Time        `db:"due_date" json:"due_date"`
+	RecurrenceInterval    *string           `db:"recurrence_interval" json:"recurrence_interval"`
+	RecurrenceAmount      *int32            `db:"recurrence_amount" json:"recurrence_amount"`
+	LastCompletedAt       *time.Time        `db:"last_completed_at" json:"last_completed_at"`
+	DeletedAt             *time.Time        `db:"deleted_at" json:"deleted_at"`
+	RecurrenceRule        *string           `db:"recurrence_rule" json:"recurrence_rule"`
+	RecurrenceOccurrences int32             `db:"recurrence_occurrences" json:"recurrence_occurrences"`
+	SearchVector          string            `db:"search_vector" json:"-"`
+	AssigneeID            *types.UserID     `db:"assignee_id" json:"assignee_id"`
+	Tags                  []uuid.UUID       `db:"tags" json:"tags"`
+	FocusSessions         interface{}       `db:"focus_sessions" json:"focus_sessions"`
+	ChecklistItems        interface{}       `db:"checklist_items" json:"checklist_items"`
+	BlockedBy             []uuid.UUID       `db:"blocked_by" json:"blocked_by"`
 }
 
 func (q *Queries) GetTodoReadModelByID(ctx context.Context, db DBTX, id types.TodoID) (GetTodoReadModelByIDRow, error) {
@@ -186,59 +288,473 @@ func (q *Queries) GetTodoReadModelByID(ctx context.Context, db DBTX, id types.To
 		&i.RecurrenceAmount,
 		&i.LastCompletedAt,
 		&i.DeletedAt,
+		&i.RecurrenceRule,
+		&i.RecurrenceOccurrences,
+		&i.SearchVector,
+		&i.AssigneeID,
 		&i.Tags,
 		&i.FocusSessions,
+		&i.ChecklistItems,
+		&i.BlockedBy,
 	)
 	return i, err
 }
 
+const HasActiveFocusSession = `-- name: HasActiveFocusSession :one
+SELECT
+  EXISTS (
+    SELECT
+      1
+    FROM
+      todo_focus_sessions
+    WHERE
+      user_id = $1::uuid
+      AND end_time IS NULL)
+`
+
+func (q *Queries) HasActiveFocusSession(ctx context.Context, db DBTX, userID uuid.UUID) (bool, error) {
+	row := db.QueryRow(ctx, HasActiveFocusSession, userID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const ListElapsedFocusTodoIDs = `-- name: ListElapsedFocusTodoIDs :many
+SELECT
+  fs.todo_id
+FROM
+  todo_focus_sessions fs
+WHERE
+  fs.end_time IS NULL
+  AND fs.start_time + make_interval(secs => LEAST(COALESCE(fs.planned_duration_seconds, $1::integer), $1::integer)) <= $2::timestamptz
+ORDER BY
+  fs.start_time
+LIMIT $3::integer
+`
+
+type ListElapsedFocusTodoIDsParams struct {
+	MaxSeconds int32     `db:"max_seconds" json:"max_seconds"`
+	Now        time.Time `db:"now" json:"now"`
+	Lim        int32     `db:"lim" json:"lim"`
+}
+
+// A session elapses at its planned end or after max_seconds, whichever comes first.
+func (q *Queries) ListElapsedFocusTodoIDs(ctx context.Context, db DBTX, arg ListElapsedFocusTodoIDsParams) ([]uuid.UUID, error) {
+	rows, err := db.Query(ctx, ListElapsedFocusTodoIDs, arg.MaxSeconds, arg.Now, arg.Lim)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var todo_id uuid.UUID
+		if err := rows.Scan(&todo_id); err != nil {
+			return nil, err
+		}
+		items = append(items, todo_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ListTodoBlockerIDs = `-- name: ListTodoBlockerIDs :many
+SELECT
+  blocked_by_id
+FROM
+  todo_dependencies
+WHERE
+  todo_id = $1
+`
+
+func (q *Queries) ListTodoBlockerIDs(ctx context.Context, db DBTX, todoID types.TodoID) ([]types.TodoID, error) {
+	rows, err := db.Query(ctx, ListTodoBlockerIDs, todoID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []types.TodoID{}
+	for rows.Next() {
+		var blocked_by_id types.TodoID
+		if err := rows.Scan(&blocked_by_id); err != nil {
+			return nil, err
+		}
+		items = append(items, blocked_by_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ListTodoDependentIDs = `-- name: ListTodoDependentIDs :many
+SELECT
+  td.todo_id
+FROM
+  todo_dependencies td
+  JOIN todos t ON t.id = td.todo_id
+WHERE
+  td.blocked_by_id = $1
+  AND t.deleted_at IS NULL
+`
+
+func (q *Queries) ListTodoDependentIDs(ctx context.Context, db DBTX, blockedByID types.TodoID) ([]types.TodoID, error) {
+	rows, err := db.Query(ctx, ListTodoDependentIDs, blockedByID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []types.TodoID{}
+	for rows.Next() {
+		var todo_id types.TodoID
+		if err := rows.Scan(&todo_id); err != nil {
+			return nil, err
+		}
+		items = append(items, todo_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ListTodoIDsAssignedInWorkspace = `-- name: ListTodoIDsAssignedInWorkspace :many
+SELECT
+  id
+FROM
+  todos
+WHERE
+  workspace_id = $1
+  AND assignee_id = $2
+  AND deleted_at IS NULL
+`
+
+type ListTodoIDsAssignedInWorkspaceParams struct {
+	WorkspaceID types.WorkspaceID `db:"workspace_id" json:"workspace_id"`
+	AssigneeID  *types.UserID     `db:"assignee_id" json:"assignee_id"`
+}
+
+func (q *Queries) ListTodoIDsAssignedInWorkspace(ctx context.Context, db DBTX, arg ListTodoIDsAssignedInWorkspaceParams) ([]types.TodoID, error) {
+	rows, err := db.Query(ctx, ListTodoIDsAssignedInWorkspace, arg.WorkspaceID, arg.AssigneeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []types.TodoID{}
+	for rows.Next() {
+		var id types.TodoID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ListTodoIDsByTagID = `-- name: ListTodoIDsByTagID :many
+SELECT
+  t.id
+FROM
+  todos t
+  JOIN todo_tags tt ON tt.todo_id = t.id
+WHERE
+  tt.tag_id = $1
+  AND t.deleted_at IS NULL
+`
+
+func (q *Queries) ListTodoIDsByTagID(ctx context.Context, db DBTX, tagID types.TagID) ([]types.TodoID, error) {
+	rows, err := db.Query(ctx, ListTodoIDsByTagID, tagID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []types.TodoID{}
+	for rows.Next() {
+		var id types.TodoID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ListTodosByAssigneeID = `-- name: ListTodosByAssigneeID :many
+SELECT
+  t.id, t.title, t.status, t.created_at, t.workspace_id, t.updated_at, t.due_date, t.recurrence_interval, t.recurrence_amount, t.last_completed_at, t.deleted_at, t.recurrence_rule, t.recurrence_occurrences, t.search_vector, t.assignee_id,
+  COALESCE(array_remove(array_agg(DISTINCT tt.tag_id), NULL), '{}')::uuid[] AS tags,
+  COALESCE((
+    SELECT
+      json_agg(fs.*)
+    FROM todo_focus_sessions fs
+    WHERE
+      fs.todo_id = t.id), '[]'::json) AS focus_sessions,
+  COALESCE((
+    SELECT
+      json_agg(ci.* ORDER BY ci.position)
+    FROM todo_checklist_items ci
+    WHERE
+      ci.todo_id = t.id), '[]'::json) AS checklist_items,
+  COALESCE((
+    SELECT
+      array_agg(td.blocked_by_id ORDER BY td.created_at, td.blocked_by_id)
+    FROM todo_dependencies td
+    WHERE
+      td.todo_id = t.id), '{}')::uuid[] AS blocked_by
+FROM
+  todos t
+  LEFT JOIN todo_tags tt ON t.id = tt.todo_id
+WHERE
+  t.assignee_id = $1
+  AND t.deleted_at IS NULL
+GROUP BY
+  t.id
+ORDER BY
+  COALESCE(t.due_date, 'infinity') ASC,
+  t.created_at DESC,
+  t.id
+LIMIT $3 OFFSET $2
+`
+
+type ListTodosByAssigneeIDParams struct {
+	AssigneeID *types.UserID `db:"assignee_id" json:"assignee_id"`
+	Off        int32         `db:"off" json:"off"`
+	Lim        int32         `db:"lim" json:"lim"`
+}
+
+type ListTodosByAssigneeIDRow struct {
+	ID                    types.TodoID      `db:"id" json:"id"`
+	Title                 string            `db:"title" json:"title"`
+	Status                string            `db:"status" json:"status"`
+	CreatedAt             time.Time         `db:"created_at" json:"created_at"`
+	WorkspaceID           types.WorkspaceID `db:"workspace_id" json:"workspace_id"`
+	UpdatedAt             time.Time         `db:"updated_at" json:"updated_at"`
+	DueDate               *time.Time        `db:"due_date" json:"due_date"`
+	RecurrenceInterval    *string           `db:"recurrence_interval" json:"recurrence_interval"`
+	RecurrenceAmount      *int32            `db:"recurrence_amount" json:"recurrence_amount"`
+	LastCompletedAt       *time.Time        `db:"last_completed_at" json:"last_completed_at"`
+	DeletedAt             *time.Time        `db:"deleted_at" json:"deleted_at"`
+	RecurrenceRule        *string           `db:"recurrence_rule" json:"recurrence_rule"`
+	RecurrenceOccurrences int32             `db:"recurrence_occurrences" json:"recurrence_occurrences"`
+	SearchVector          string            `db:"search_vector" json:"-"`
+	AssigneeID            *types.UserID     `db:"assignee_id" json:"assignee_id"`
+	Tags                  []uuid.UUID       `db:"tags" json:"tags"`
+	FocusSessions         interface{}       `db:"focus_sessions" json:"focus_sessions"`
+	ChecklistItems        interface{}       `db:"checklist_items" json:"checklist_items"`
+	BlockedBy             []uuid.UUID       `db:"blocked_by" json:"blocked_by"`
+}
+
+func (q *Queries) ListTodosByAssigneeID(ctx context.Context, db DBTX, arg ListTodosByAssigneeIDParams) ([]ListTodosByAssigneeIDRow, error) {
+	rows, err := db.Query(ctx, ListTodosByAssigneeID, arg.AssigneeID, arg.Off, arg.Lim)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTodosByAssigneeIDRow{}
+	for rows.Next() {
+		var i ListTodosByAssigneeIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Status,
+			&i.CreatedAt,
+			&i.WorkspaceID,
+			&i.UpdatedAt,
+			&i.DueDate,
+			&i.RecurrenceInterval,
+			&i.RecurrenceAmount,
+			&i.LastCompletedAt,
+			&i.DeletedAt,
+			&i.RecurrenceRule,
+			&i.RecurrenceOccurrences,
+			&i.SearchVector,
+			&i.AssigneeID,
+			&i.Tags,
+			&i.FocusSessions,
+			&i.ChecklistItems,
+			&i.BlockedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const ListTodosByWorkspaceID = `-- name: ListTodosByWorkspaceID :many
 SELECT
-  t.id, t.title, t.status, t.created_at, t.workspace_id, t.updated_at, t.due_date, t.recurrence_interval, t.recurrence_amount, t.last_completed_at, t.deleted_at,
+  t.id, t.title, t.status, t.created_at, t.workspace_id, t.updated_at, t.due_date, t.recurrence_interval, t.recurrence_amount, t.last_completed_at, t.deleted_at, t.recurrence_rule, t.recurrence_occurrences, t.search_vector, t.assignee_id,
   COALESCE(array_remove(array_agg(DISTINCT tt.tag_id), NULL), '{}')::uuid[] AS tags,
   COALESCE((
     SELECT
       json_agg(fs.*)
     FROM todo_focus_sessions fs
     WHERE
-      fs.todo_id = t.id), '[]'::json) AS focus_sessions
+      fs.todo_id = t.id), '[]'::json) AS focus_sessions,
+  COALESCE((
+    SELECT
+      json_agg(ci.* ORDER BY ci.position)
+    FROM todo_checklist_items ci
+    WHERE
+      ci.todo_id = t.id), '[]'::json) AS checklist_items,
+  COALESCE((
+    SELECT
+      array_agg(td.blocked_by_id ORDER BY td.created_at, td.blocked_by_id)
+    FROM todo_dependencies td
+    WHERE
+      td.todo_id = t.id), '{}')::uuid[] AS blocked_by
 FROM
   todos t
   LEFT JOIN todo_tags tt ON t.id = tt.todo_id
 WHERE
   t.workspace_id = $1
   AND t.deleted_at IS NULL
+  AND ($2::text[] IS NULL
+    OR t.status = ANY ($2::text[]))
+  AND ($3::uuid[] IS NULL
+    OR EXISTS (
+      SELECT
+        1
+      FROM
+        todo_tags ft
+      WHERE
+        ft.todo_id = t.id
+        AND ft.tag_id = ANY ($3::uuid[])))
+  AND ($4::timestamptz IS NULL
+    OR t.due_date < $4::timestamptz)
+  AND ($5::timestamptz IS NULL
+    OR t.due_date >= $5::timestamptz)
+  AND ($6::boolean IS NULL
+    OR (t.status = 'PENDING'
+      AND t.due_date IS NOT NULL
+      AND t.due_date < NOW()) = $6::boolean)
+  AND ($7::boolean IS NULL
+    OR (t.recurrence_rule IS NOT NULL
+      OR t.recurrence_interval IS NOT NULL) = $7::boolean)
+  AND ($8::uuid IS NULL
+    OR ($9::text = 'created_at'
+      AND $10::boolean
+      AND (t.created_at, t.id) < ($11::timestamptz, $8::uuid))
+    OR ($9::text = 'created_at'
+      AND NOT $10::boolean
+      AND (t.created_at, t.id) > ($11::timestamptz, $8::uuid))
+    OR ($9::text = 'due_date'
+      AND $10::boolean
+      AND (COALESCE(t.due_date, 'infinity'), t.id) < (COALESCE($11::timestamptz, 'infinity'), $8::uuid))
+    OR ($9::text = 'due_date'
+      AND NOT $10::boolean
+      AND (COALESCE(t.due_date, 'infinity'), t.id) > (COALESCE($11::timestamptz, 'infinity'), $8::uuid))
+    OR ($9::text = 'title'
+      AND $10::boolean
+      AND (t.title, t.id) < ($12::text, $8::uuid))
+    OR ($9::text = 'title'
+      AND NOT $10::boolean
+      AND (t.title, t.id) > ($12::text, $8::uuid)))
 GROUP BY
   t.id
 ORDER BY
-  t.created_at DESC
-LIMIT $2 OFFSET $3
+  CASE WHEN $9::text = 'created_at'
+    AND NOT $10::boolean THEN
+    t.created_at
+  END ASC,
+  CASE WHEN $9::text = 'created_at'
+    AND $10::boolean THEN
+    t.created_at
+  END DESC,
+  CASE WHEN $9::text = 'due_date'
+    AND NOT $10::boolean THEN
+    COALESCE(t.due_date, 'infinity')
+  END ASC,
+  CASE WHEN $9::text = 'due_date'
+    AND $10::boolean THEN
+    COALESCE(t.due_date, 'infinity')
+  END DESC,
+  CASE WHEN $9::text = 'title'
+    AND NOT $10::boolean THEN
+    t.title
+  END ASC,
+  CASE WHEN $9::text = 'title'
+    AND $10::boolean THEN
+    t.title
+  END DESC,
+  CASE WHEN NOT $10::boolean THEN
+    t.id
+  END ASC,
+  CASE WHEN $10::boolean THEN
+    t.id
+  END DESC
+LIMIT $14 OFFSET $13
 `
 
 type ListTodosByWorkspaceIDParams struct {
 	WorkspaceID types.WorkspaceID `db:"workspace_id" json:"workspace_id"`
-	Limit       int32             `db:"limit" json:"limit"`
-	Offset      int32             `db:"offset" json:"offset"`
+	Statuses    []string          `db:"statuses" json:"statuses"`
+	TagIds      []uuid.UUID       `db:"tag_ids" json:"tag_ids"`
+	DueBefore   *time.Time        `db:"due_before" json:"due_before"`
+	DueAfter    *time.Time        `db:"due_after" json:"due_after"`
+	Overdue     *bool             `db:"overdue" json:"overdue"`
+	Recurring   *bool             `db:"recurring" json:"recurring"`
+	CursorID    *uuid.UUID        `db:"cursor_id" json:"cursor_id"`
+	SortKey     string            `db:"sort_key" json:"sort_key"`
+	SortDesc    bool              `db:"sort_desc" json:"sort_desc"`
+	CursorTime  *time.Time        `db:"cursor_time" json:"cursor_time"`
+	CursorText  *string           `db:"cursor_text" json:"cursor_text"`
+	Off         int32             `db:"off" json:"off"`
+	Lim         int32             `db:"lim" json:"lim"`
 }
 
 type ListTodosByWorkspaceIDRow struct {
-	ID                 types.TodoID      `db:"id" json:"id"`
-	Title              string            `db:"title" json:"title"`
-	Status             string            `db:"status" json:"status"`
-	CreatedAt          time.Time         `db:"created_at" json:"created_at"`
-	WorkspaceID        types.WorkspaceID `db:"workspace_id" json:"workspace_id"`
-	UpdatedAt          time.Time         `db:"updated_at" json:"updated_at"`
-	DueDate            *time.Time        `db:"due_date" json:"due_date"`
-	RecurrenceInterval *string           `db:"recurrence_interval" json:"recurrence_interval"`
-	RecurrenceAmount   *int32            `db:"recurrence_amount" json:"recurrence_amount"`
-	LastCompletedAt    *time.Time        `db:"last_completed_at" json:"last_completed_at"`
-	DeletedAt          *time.Time        `db:"deleted_at" json:"deleted_at"`
-	Tags               []uuid.UUID       `db:"tags" json:"tags"`
-	FocusSessions      interface{}       `db:"focus_sessions" json:"focus_sessions"`
+	ID                    types.TodoID      `db:"id" json:"id"`
+	Title                 string            `db:"title" json:"title"`
+	Status                string            `db:"status" json:"status"`
+	CreatedAt             time.Time         `db:"created_at" json:"created_at"`
+	WorkspaceID           types.WorkspaceID `db:"workspace_id" json:"workspace_id"`
+	UpdatedAt             time.Time         `db:"updated_at" json:"updated_at"`
+	DueDate               *time.Time        `db:"due_date" json:"due_date"`
+	RecurrenceInterval    *string           `db:"recurrence_interval" json:"recurrence_interval"`
+	RecurrenceAmount      *int32            `db:"recurrence_amount" json:"recurrence_amount"`
+	LastCompletedAt       *time.Time        `db:"last_completed_at" json:"last_completed_at"`
+	DeletedAt             *time.Time        `db:"deleted_at" json:"deleted_at"`
+	RecurrenceRule        *string           `db:"recurrence_rule" json:"recurrence_rule"`
+	RecurrenceOccurrences int32             `db:"recurrence_occurrences" json:"recurrence_occurrences"`
+	SearchVector          string            `db:"search_vector" json:"-"`
+	AssigneeID            *types.UserID     `db:"assignee_id" json:"assignee_id"`
+	Tags                  []uuid.UUID       `db:"tags" json:"tags"`
+	FocusSessions         interface{}       `db:"focus_sessions" json:"focus_sessions"`
+	ChecklistItems        interface{}       `db:"checklist_items" json:"checklist_items"`
+	BlockedBy             []uuid.UUID       `db:"blocked_by" json:"blocked_by"`
 }
 
+// Keyset pagination: the cursor holds the sort value of the last row (cursor_time for
+// timestamp keys, cursor_text for title) plus its id as tiebreaker. A missing due date
+// sorts as infinity on both sides so the key is never NULL.
 func (q *Queries) ListTodosByWorkspaceID(ctx context.Context, db DBTX, arg ListTodosByWorkspaceIDParams) ([]ListTodosByWorkspaceIDRow, error) {
-	rows, err := db.Query(ctx, ListTodosByWorkspaceID, arg.WorkspaceID, arg.Limit, arg.Offset)
+	rows, err := db.Query(ctx, ListTodosByWorkspaceID,
+		arg.WorkspaceID,
+		arg.Statuses,
+		arg.TagIds,
+		arg.DueBefore,
+		arg.DueAfter,
+		arg.Overdue,
+		arg.Recurring,
+		arg.CursorID,
+		arg.SortKey,
+		arg.SortDesc,
+		arg.CursorTime,
+		arg.CursorText,
+		arg.Off,
+		arg.Lim,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -258,8 +774,14 @@ func (q *Queries) ListTodosByWorkspaceID(ctx context.Context, db DBTX, arg ListT
 			&i.RecurrenceAmount,
 			&i.LastCompletedAt,
 			&i.DeletedAt,
+			&i.RecurrenceRule,
+			&i.RecurrenceOccurrences,
+			&i.SearchVector,
+			&i.AssigneeID,
 			&i.Tags,
 			&i.FocusSessions,
+			&i.ChecklistItems,
+			&i.BlockedBy,
 		); err != nil {
 			return nil, err
 		}
@@ -271,6 +793,293 @@ func (q *Queries) ListTodosByWorkspaceID(ctx context.Context, db DBTX, arg ListT
 	return items, nil
 }
 
+const ListTodosTouchedByUser = `-- name: ListTodosTouchedByUser :many
+SELECT
+  t.id,
+  t.workspace_id,
+  t.title,
+  t.status,
+  (t.assignee_id IS NOT DISTINCT FROM $1::uuid)::boolean AS assigned,
+  t.created_at,
+  t.updated_at
+FROM
+  todos t
+WHERE
+  t.assignee_id = $1::uuid
+  OR EXISTS (
+    SELECT
+      1
+    FROM
+      todo_completion_logs l
+    WHERE
+      l.todo_id = t.id
+      AND l.actor_id = $1::uuid)
+  OR EXISTS (
+    SELECT
+      1
+    FROM
+      todo_comments c
+    WHERE
+      c.todo_id = t.id
+      AND c.author_id = $1::uuid)
+  OR EXISTS (
+    SELECT
+      1
+    FROM
+      todo_focus_sessions fs
+    WHERE
+      fs.todo_id = t.id
+      AND fs.user_id = $1::uuid)
+ORDER BY
+  t.created_at,
+  t.id
+`
+
+type ListTodosTouchedByUserRow struct {
+	ID          types.TodoID      `db:"id" json:"id"`
+	WorkspaceID types.WorkspaceID `db:"workspace_id" json:"workspace_id"`
+	Title       string            `db:"title" json:"title"`
+	Status      string            `db:"status" json:"status"`
+	Assigned    bool              `db:"assigned" json:"assigned"`
+	CreatedAt   time.Time         `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time         `db:"updated_at" json:"updated_at"`
+}
+
+func (q *Queries) ListTodosTouchedByUser(ctx context.Context, db DBTX, userID uuid.UUID) ([]ListTodosTouchedByUserRow, error) {
+	rows, err := db.Query(ctx, ListTodosTouchedByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListTodosTouchedByUserRow{}
+	for rows.Next() {
+		var i ListTodosTouchedByUserRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkspaceID,
+			&i.Title,
+			&i.Status,
+			&i.Assigned,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ListUnassignedTodosDueForMember = `-- name: ListUnassignedTodosDueForMember :many
+SELECT
+  t.id, t.title, t.status, t.created_at, t.workspace_id, t.updated_at, t.due_date, t.recurrence_interval, t.recurrence_amount, t.last_completed_at, t.deleted_at, t.recurrence_rule, t.recurrence_occurrences, t.search_vector, t.assignee_id,
+  COALESCE(array_remove(array_agg(DISTINCT tt.tag_id), NULL), '{}')::uuid[] AS tags,
+  COALESCE((
+    SELECT
+      json_agg(fs.*)
+    FROM todo_focus_sessions fs
+    WHERE
+      fs.todo_id = t.id), '[]'::json) AS focus_sessions,
+  COALESCE((
+    SELECT
+      json_agg(ci.* ORDER BY ci.position)
+    FROM todo_checklist_items ci
+    WHERE
+      ci.todo_id = t.id), '[]'::json) AS checklist_items,
+  COALESCE((
+    SELECT
+      array_agg(td.blocked_by_id ORDER BY td.created_at, td.blocked_by_id)
+    FROM todo_dependencies td
+    WHERE
+      td.todo_id = t.id), '{}')::uuid[] AS blocked_by
+FROM
+  todos t
+  JOIN workspace_members wm ON wm.workspace_id = t.workspace_id
+  LEFT JOIN todo_tags tt ON t.id = tt.todo_id
+WHERE
+  wm.user_id = $1::uuid
+  AND t.assignee_id IS NULL
+  AND t.status = 'PENDING'
+  AND t.due_date < $2::timestamptz
+  AND t.deleted_at IS NULL
+GROUP BY
+  t.id
+ORDER BY
+  t.due_date ASC,
+  t.created_at DESC,
+  t.id
+LIMIT $4 OFFSET $3
+`
+
+type ListUnassignedTodosDueForMemberParams struct {
+	UserID    uuid.UUID `db:"user_id" json:"user_id"`
+	DueBefore time.Time `db:"due_before" json:"due_before"`
+	Off       int32     `db:"off" json:"off"`
+	Lim       int32     `db:"lim" json:"lim"`
+}
+
+type ListUnassignedTodosDueForMemberRow struct {
+	ID                    types.TodoID      `db:"id" json:"id"`
+	Title                 string            `db:"title" json:"title"`
+	Status                string            `db:"status" json:"status"`
+	CreatedAt             time.Time         `db:"created_at" json:"created_at"`
+	WorkspaceID           types.WorkspaceID `db:"workspace_id" json:"workspace_id"`
+	UpdatedAt             time.Time         `db:"updated_at" json:"updated_at"`
+	DueDate               *time.Time        `db:"due_date" json:"due_date"`
+	RecurrenceInterval    *string           `db:"recurrence_interval" json:"recurrence_interval"`
+	RecurrenceAmount      *int32            `db:"recurrence_amount" json:"recurrence_amount"`
+	LastCompletedAt       *time.Time        `db:"last_completed_at" json:"last_completed_at"`
+	DeletedAt             *time.Time        `db:"deleted_at" json:"deleted_at"`
+	RecurrenceRule        *string           `db:"recurrence_rule" json:"recurrence_rule"`
+	RecurrenceOccurrences int32             `db:"recurrence_occurrences" json:"recurrence_occurrences"`
+	SearchVector          string            `db:"search_vector" json:"-"`
+	AssigneeID            *types.UserID     `db:"assignee_id" json:"assignee_id"`
+	Tags                  []uuid.UUID       `db:"tags" json:"tags"`
+	FocusSessions         interface{}       `db:"focus_sessions" json:"focus_sessions"`
+	ChecklistItems        interface{}       `db:"checklist_items" json:"checklist_items"`
+	BlockedBy             []uuid.UUID       `db:"blocked_by" json:"blocked_by"`
+}
+
+func (q *Queries) ListUnassignedTodosDueForMember(ctx context.Context, db DBTX, arg ListUnassignedTodosDueForMemberParams) ([]ListUnassignedTodosDueForMemberRow, error) {
+	rows, err := db.Query(ctx, ListUnassignedTodosDueForMember,
+		arg.UserID,
+		arg.DueBefore,
+		arg.Off,
+		arg.Lim,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUnassignedTodosDueForMemberRow{}
+	for rows.Next() {
+		var i ListUnassignedTodosDueForMemberRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Status,
+			&i.CreatedAt,
+			&i.WorkspaceID,
+			&i.UpdatedAt,
+			&i.DueDate,
+			&i.RecurrenceInterval,
+			&i.RecurrenceAmount,
+			&i.LastCompletedAt,
+			&i.DeletedAt,
+			&i.RecurrenceRule,
+			&i.RecurrenceOccurrences,
+			&i.SearchVector,
+			&i.AssigneeID,
+			&i.Tags,
+			&i.FocusSessions,
+			&i.ChecklistItems,
+			&i.BlockedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ListUserFocusSessions = `-- name: ListUserFocusSessions :many
+SELECT
+  fs.id,
+  fs.todo_id,
+  fs.start_time,
+  fs.end_time,
+  fs.planned_duration_seconds
+FROM
+  todo_focus_sessions fs
+WHERE
+  fs.user_id = $1::uuid
+ORDER BY
+  fs.start_time
+`
+
+type ListUserFocusSessionsRow struct {
+	ID                     uuid.UUID  `db:"id" json:"id"`
+	TodoID                 uuid.UUID  `db:"todo_id" json:"todo_id"`
+	StartTime              time.Time  `db:"start_time" json:"start_time"`
+	EndTime                *time.Time `db:"end_time" json:"end_time"`
+	PlannedDurationSeconds *int32     `db:"planned_duration_seconds" json:"planned_duration_seconds"`
+}
+
+func (q *Queries) ListUserFocusSessions(ctx context.Context, db DBTX, userID uuid.UUID) ([]ListUserFocusSessionsRow, error) {
+	rows, err := db.Query(ctx, ListUserFocusSessions, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUserFocusSessionsRow{}
+	for rows.Next() {
+		var i ListUserFocusSessionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TodoID,
+			&i.StartTime,
+			&i.EndTime,
+			&i.PlannedDurationSeconds,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const LockTodo = `-- name: LockTodo :exec
+SELECT
+  1
+FROM
+  todos
+WHERE
+  id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockTodo(ctx context.Context, db DBTX, id types.TodoID) error {
+	_, err := db.Exec(ctx, LockTodo, id)
+	return err
+}
+
+const LockTodoDependencies = `-- name: LockTodoDependencies :exec
+SELECT
+  pg_advisory_xact_lock(hashtextextended('todo_dependencies:' || $1::uuid::text, 0))
+`
+
+// Serializes blocked-by changes in a workspace until the transaction ends, so that
+// concurrent cycle checks cannot miss each other's links.
+func (q *Queries) LockTodoDependencies(ctx context.Context, db DBTX, workspaceID uuid.UUID) error {
+	_, err := db.Exec(ctx, LockTodoDependencies, workspaceID)
+	return err
+}
+
+const RemoveMissingChecklistItemsFromTodo = `-- name: RemoveMissingChecklistItemsFromTodo :exec
+DELETE FROM todo_checklist_items
+WHERE todo_id = $1
+  AND NOT (id = ANY ($2::uuid[]))
+`
+
+type RemoveMissingChecklistItemsFromTodoParams struct {
+	TodoID  uuid.UUID   `db:"todo_id" json:"todo_id"`
+	ItemIds []uuid.UUID `db:"item_ids" json:"item_ids"`
+}
+
+func (q *Queries) RemoveMissingChecklistItemsFromTodo(ctx context.Context, db DBTX, arg RemoveMissingChecklistItemsFromTodoParams) error {
+	_, err := db.Exec(ctx, RemoveMissingChecklistItemsFromTodo, arg.TodoID, arg.ItemIds)
+	return err
+}
+
 const RemoveMissingFocusSessionsFromTodo = `-- name: RemoveMissingFocusSessionsFromTodo :exec
 DELETE FROM todo_focus_sessions
 WHERE todo_id = $1
@@ -303,6 +1112,103 @@ func (q *Queries) RemoveMissingTagsFromTodo(ctx context.Context, db DBTX, arg Re
 	return err
 }
 
+const RemoveMissingTodoDependencies = `-- name: RemoveMissingTodoDependencies :exec
+DELETE FROM todo_dependencies
+WHERE todo_id = $1
+  AND NOT (blocked_by_id = ANY ($2::uuid[]))
+`
+
+type RemoveMissingTodoDependenciesParams struct {
+	TodoID       types.TodoID `db:"todo_id" json:"todo_id"`
+	BlockedByIds []uuid.UUID  `db:"blocked_by_ids" json:"blocked_by_ids"`
+}
+
+func (q *Queries) RemoveMissingTodoDependencies(ctx context.Context, db DBTX, arg RemoveMissingTodoDependenciesParams) error {
+	_, err := db.Exec(ctx, RemoveMissingTodoDependencies, arg.TodoID, arg.BlockedByIds)
+	return err
+}
+
+const SearchTodosByWorkspaceID = `-- name: SearchTodosByWorkspaceID :many
+WITH q AS (
+  SELECT
+    websearch_to_tsquery('english', $4::text) AS query
+)
+SELECT
+  t.id,
+  t.workspace_id,
+  t.title,
+  t.status,
+  t.created_at,
+  t.due_date,
+  ts_rank(t.search_vector, q.query)::real AS rank,
+  -- escape the title so that <mark> is the only markup in the highlight
+  ts_headline('english', replace(replace(replace(replace(replace(t.title, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;'), q.query, 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true')::text AS highlight
+FROM
+  todos t,
+  q
+WHERE
+  t.workspace_id = $1
+  AND t.deleted_at IS NULL
+  AND t.search_vector @@ q.query
+ORDER BY
+  rank DESC,
+  t.created_at DESC,
+  t.id
+LIMIT $3 OFFSET $2
+`
+
+type SearchTodosByWorkspaceIDParams struct {
+	WorkspaceID types.WorkspaceID `db:"workspace_id" json:"workspace_id"`
+	Off         int32             `db:"off" json:"off"`
+	Lim         int32             `db:"lim" json:"lim"`
+	Query       string            `db:"query" json:"query"`
+}
+
+type SearchTodosByWorkspaceIDRow struct {
+	ID          types.TodoID      `db:"id" json:"id"`
+	WorkspaceID types.WorkspaceID `db:"workspace_id" json:"workspace_id"`
+	Title       string            `db:"title" json:"title"`
+	Status      string            `db:"status" json:"status"`
+	CreatedAt   time.Time         `db:"created_at" json:"created_at"`
+	DueDate     *time.Time        `db:"due_date" json:"due_date"`
+	Rank        float32           `db:"rank" json:"rank"`
+	Highlight   string            `db:"highlight" json:"highlight"`
+}
+
+func (q *Queries) SearchTodosByWorkspaceID(ctx context.Context, db DBTX, arg SearchTodosByWorkspaceIDParams) ([]SearchTodosByWorkspaceIDRow, error) {
+	rows, err := db.Query(ctx, SearchTodosByWorkspaceID,
+		arg.WorkspaceID,
+		arg.Off,
+		arg.Lim,
+		arg.Query,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchTodosByWorkspaceIDRow{}
+	for rows.Next() {
+		var i SearchTodosByWorkspaceIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WorkspaceID,
+			&i.Title,
+			&i.Status,
+			&i.CreatedAt,
+			&i.DueDate,
+			&i.Rank,
+			&i.Highlight,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const UpsertFocusSession = `-- name: UpsertFocusSession :exec
 INSERT INTO todo_focus_sessions(id, todo_id, start_time, end_time)
   VALUES ($1, $2, $3, $4)
@@ -329,8 +1235,8 @@ func (q *Queries) UpsertFocusSession(ctx context.Context, db DBTX, arg UpsertFoc
 }
 
 const UpsertTodo = `-- name: UpsertTodo :one
-INSERT INTO todos(id, title, status, created_at, updated_at, workspace_id, due_date, recurrence_interval, recurrence_amount, last_completed_at, deleted_at)
-  VALUES ($1, $2, $3, $4, $4, $5, $6, $7, $8, $9, NULL)
+INSERT INTO todos(id, title, status, created_at, updated_at, workspace_id, due_date, recurrence_interval, recurrence_amount, last_completed_at, deleted_at, recurrence_rule, recurrence_occurrences, assignee_id)
+  VALUES ($1, $2, $3, $4, $4, $5, $6, $7, $8, $9, NULL, $10, $11, $12)
 ON CONFLICT (id)
   DO UPDATE SET
     title = EXCLUDED.title,
@@ -340,21 +1246,27 @@ ON CONFLICT (id)
     recurrence_interval = EXCLUDED.recurrence_interval,
     recurrence_amount = EXCLUDED.recurrence_amount,
     last_completed_at = EXCLUDED.last_completed_at,
+    recurrence_rule = EXCLUDED.recurrence_rule,
+    recurrence_occurrences = EXCLUDED.recurrence_occurrences,
+    assignee_id = EXCLUDED.assignee_id,
     deleted_at = NULL
   RETURNING
-    id, title, status, created_at, workspace_id, updated_at, due_date, recurrence_interval, recurrence_amount, last_completed_at, deleted_at
+    id, title, status, created_at, workspace_id, updated_at, due_date, recurrence_interval, recurrence_amount, last_completed_at, deleted_at, recurrence_rule, recurrence_occurrences, search_vector, assignee_id
 `
 
 type UpsertTodoParams struct {
-	ID                 types.TodoID      `db:"id" json:"id"`
-	Title              string            `db:"title" json:"title"`
-	Status             string            `db:"status" json:"status"`
-	CreatedAt          time.Time         `db:"created_at" json:"created_at"`
-	WorkspaceID        types.WorkspaceID `db:"workspace_id" json:"workspace_id"`
-	DueDate            *time.Time        `db:"due_date" json:"due_date"`
-	RecurrenceInterval *string           `db:"recurrence_interval" json:"recurrence_interval"`
-	RecurrenceAmount   *int32            `db:"recurrence_amount" json:"recurrence_amount"`
-	LastCompletedAt    *time.Time        `db:"last_completed_at" json:"last_completed_at"`
+	ID                    types.TodoID      `db:"id" json:"id"`
+	Title                 string            `db:"title" json:"title"`
+	Status                string            `db:"status" json:"status"`
+	CreatedAt             time.Time         `db:"created_at" json:"created_at"`
+	WorkspaceID           types.WorkspaceID `db:"workspace_id" json:"workspace_id"`
+	DueDate               *time.Time        `db:"due_date" json:"due_date"`
+	RecurrenceInterval    *string           `db:"recurrence_interval" json:"recurrence_interval"`
+	RecurrenceAmount      *int32            `db:"recurrence_amount" json:"recurrence_amount"`
+	LastCompletedAt       *time.Time        `db:"last_completed_at" json:"last_completed_at"`
+	RecurrenceRule        *string           `db:"recurrence_rule" json:"recurrence_rule"`
+	RecurrenceOccurrences int32             `db:"recurrence_occurrences" json:"recurrence_occurrences"`
+	AssigneeID            *types.UserID     `db:"assignee_id" json:"assignee_id"`
 }
 
 func (q *Queries) UpsertTodo(ctx context.Context, db DBTX, arg UpsertTodoParams) (Todos, error) {
@@ -368,6 +1280,9 @@ func (q *Queries) UpsertTodo(ctx context.Context, db DBTX, arg UpsertTodoParams)
 		arg.RecurrenceInterval,
 		arg.RecurrenceAmount,
 		arg.LastCompletedAt,
+		arg.RecurrenceRule,
+		arg.RecurrenceOccurrences,
+		arg.AssigneeID,
 	)
 	var i Todos
 	err := row.Scan(
@@ -382,6 +1297,10 @@ func (q *Queries) UpsertTodo(ctx context.Context, db DBTX, arg UpsertTodoParams)
 		&i.RecurrenceAmount,
 		&i.LastCompletedAt,
 		&i.DeletedAt,
+		&i.RecurrenceRule,
+		&i.RecurrenceOccurrences,
+		&i.SearchVector,
+		&i.AssigneeID,
 	)
 	return i, err
 }
@@ -12,37 +12,6 @@ import (
 	"github.com/danicc097/todo-ddd-example/internal/infrastructure/db/types"
 )
 
-const CreateUser = `-- name: CreateUser :one
-INSERT INTO users(id, email, name, created_at)
-  VALUES ($1, $2, $3, $4)
-RETURNING
-  id, email, name, created_at
-`
-
-type CreateUserParams struct {
-	ID        types.UserID `db:"id" json:"id"`
-	Email     string       `db:"email" json:"email"`
-	Name      string       `db:"name" json:"name"`
-	CreatedAt time.Time    `db:"created_at" json:"created_at"`
-}
-
-func (q *Queries) CreateUser(ctx context.Context, db DBTX, arg CreateUserParams) (Users, error) {
-	row := db.QueryRow(ctx, CreateUser,
-		arg.ID,
-		arg.Email,
-		arg.Name,
-		arg.CreatedAt,
-	)
-	var i Users
-	err := row.Scan(
-		&i.ID,
-		&i.Email,
-		&i.Name,
-		&i.CreatedAt,
-	)
-	return i, err
-}
-
 const DeleteUser = `-- name: DeleteUser :exec
 DELETE FROM users
 WHERE id = $1
@@ -55,7 +24,7 @@ func (q *Queries) DeleteUser(ctx context.Context, db DBTX, id types.UserID) erro
 
 const GetUserByEmail = `-- name: GetUserByEmail :one
 SELECT
-  id, email, name, created_at
+  id, email, name, created_at, timezone, daily_capacity
 FROM
   users
 WHERE
@@ -70,13 +39,15 @@ func (q *Queries) GetUserByEmail(ctx context.Context, db DBTX, email string) (Us
 		&i.Email,
 		&i.Name,
 		&i.CreatedAt,
+		&i.Timezone,
+		&i.DailyCapacity,
 	)
 	return i, err
 }
 
 const GetUserByID = `-- name: GetUserByID :one
 SELECT
-  id, email, name, created_at
+  id, email, name, created_at, timezone, daily_capacity
 FROM
   users
 WHERE
@@ -91,6 +62,105 @@ func (q *Queries) GetUserByID(ctx context.Context, db DBTX, id types.UserID) (Us
 		&i.Email,
 		&i.Name,
 		&i.CreatedAt,
+		&i.Timezone,
+		&i.DailyCapacity,
 	)
 	return i, err
 }
+
+const GetUserDataExportByID = `-- name: GetUserDataExportByID :one
+SELECT
+  id, user_id, status, requested_at, completed_at, archive
+FROM
+  user_data_exports
+WHERE
+  id = $1
+`
+
+func (q *Queries) GetUserDataExportByID(ctx context.Context, db DBTX, id types.DataExportID) (UserDataExports, error) {
+	row := db.QueryRow(ctx, GetUserDataExportByID, id)
+	var i UserDataExports
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Status,
+		&i.RequestedAt,
+		&i.CompletedAt,
+		&i.Archive,
+	)
+	return i, err
+}
+
+const UpsertUser = `-- name: UpsertUser :one
+INSERT INTO users(id, email, name, created_at, timezone, daily_capacity)
+  VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (id)
+  DO UPDATE SET
+    email = EXCLUDED.email,
+    name = EXCLUDED.name,
+    timezone = EXCLUDED.timezone,
+    daily_capacity = EXCLUDED.daily_capacity
+  RETURNING
+    id, email, name, created_at, timezone, daily_capacity
+`
+
+type UpsertUserParams struct {
+	ID            types.UserID `db:"id" json:"id"`
+	Email         string       `db:"email" json:"email"`
+	Name          string       `db:"name" json:"name"`
+	CreatedAt     time.Time    `db:"created_at" json:"created_at"`
+	Timezone      string       `db:"timezone" json:"timezone"`
+	DailyCapacity int32        `db:"daily_capacity" json:"daily_capacity"`
+}
+
+func (q *Queries) UpsertUser(ctx context.Context, db DBTX, arg UpsertUserParams) (Users, error) {
+	row := db.QueryRow(ctx, UpsertUser,
+		arg.ID,
+		arg.Email,
+		arg.Name,
+		arg.CreatedAt,
+		arg.Timezone,
+		arg.DailyCapacity,
+	)
+	var i Users
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Name,
+		&i.CreatedAt,
+		&i.Timezone,
+		&i.DailyCapacity,
+	)
+	return i, err
+}
+
+const UpsertUserDataExport = `-- name: UpsertUserDataExport :exec
+INSERT INTO user_data_exports(id, user_id, status, requested_at, completed_at, archive)
+  VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (id)
+  DO UPDATE SET
+    status = EXCLUDED.status,
+    completed_at = EXCLUDED.completed_at,
+    archive = EXCLUDED.archive
+`
+
+type UpsertUserDataExportParams struct {
+	ID          types.DataExportID `db:"id" json:"id"`
+	UserID      types.UserID       `db:"user_id" json:"user_id"`
+	Status      string             `db:"status" json:"status"`
+	RequestedAt time.Time          `db:"requested_at" json:"requested_at"`
+	CompletedAt *time.Time         `db:"completed_at" json:"completed_at"`
+	Archive     []byte             `db:"archive" json:"archive"`
+}
+
+func (q *Queries) UpsertUserDataExport(ctx context.Context, db DBTX, arg UpsertUserDataExportParams) error {
+	_, err := db.Exec(ctx, UpsertUserDataExport,
+		arg.ID,
+		arg.UserID,
+		arg.Status,
+		arg.RequestedAt,
+		arg.CompletedAt,
+		arg.Archive,
+	)
+	return err
+}
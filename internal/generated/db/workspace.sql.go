@@ -126,6 +126,46 @@ func (q *Queries) GetWorkspaceMembers(ctx context.Context, db DBTX, workspaceID
 	return items, nil
 }
 
+const ListUserMemberships = `-- name: ListUserMemberships :many
+SELECT
+  w.id,
+  w.name,
+  wm.role
+FROM
+  workspace_members wm
+  JOIN workspaces w ON w.id = wm.workspace_id
+WHERE
+  wm.user_id = $1
+ORDER BY
+  w.name
+`
+
+type ListUserMembershipsRow struct {
+	ID   types.WorkspaceID `db:"id" json:"id"`
+	Name string            `db:"name" json:"name"`
+	Role string            `db:"role" json:"role"`
+}
+
+func (q *Queries) ListUserMemberships(ctx context.Context, db DBTX, userID types.UserID) ([]ListUserMembershipsRow, error) {
+	rows, err := db.Query(ctx, ListUserMemberships, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListUserMembershipsRow{}
+	for rows.Next() {
+		var i ListUserMembershipsRow
+		if err := rows.Scan(&i.ID, &i.Name, &i.Role); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const ListWorkspaces = `-- name: ListWorkspaces :many
 SELECT
   id, name, description, created_at
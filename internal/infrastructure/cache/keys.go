@@ -1,6 +1,8 @@
 package cache
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -20,6 +22,7 @@ const (
 	prefixWorkspace = "ws"
 	prefixRateLimit = "ratelimit"
 	prefixUser      = "user"
+	prefixAuth      = "auth"
 	prefixCacheTags = "cache_tags"
 )
 
@@ -35,8 +38,16 @@ func (keys) TodoWorkspaceCollection(wsID types.WorkspaceID, revision string) str
 	return fmt.Sprintf("%s:collection:GetAllByWorkspace:%s:rev:%s", prefixTodoRead, wsID, revision)
 }
 
-func (keys) TodoWorkspaceCollectionPaginated(wsID types.WorkspaceID, limit, offset int32, revision string) string {
-	return fmt.Sprintf("%s:limit:%d:offset:%d", keys{}.TodoWorkspaceCollection(wsID, revision), limit, offset)
+// TodoWorkspaceCollectionQuery keys a filtered page by a hash of its filter signature.
+func (keys) TodoWorkspaceCollectionQuery(wsID types.WorkspaceID, signature, revision string) string {
+	sum := sha256.Sum256([]byte(signature))
+	return fmt.Sprintf("%s:query:%s", keys{}.TodoWorkspaceCollection(wsID, revision), hex.EncodeToString(sum[:16]))
+}
+
+// FocusReport keys a workspace focus report by a hash of its filter signature.
+func (keys) FocusReport(wsID types.WorkspaceID, signature, revision string) string {
+	sum := sha256.Sum256([]byte(signature))
+	return fmt.Sprintf("%s:%s:rev:%s:focus_report:%s", prefixWorkspace, wsID, revision, hex.EncodeToString(sum[:16]))
 }
 
 func (keys) IdempotencyKey(id uuid.UUID) string {
@@ -63,6 +74,14 @@ func (keys) TOTPUsedCode(userID types.UserID, code string) string {
 	return fmt.Sprintf("%s:%s:used_totp:%s", prefixUser, userID, code)
 }
 
+func (keys) RevokedAccessToken(tokenID string) string {
+	return fmt.Sprintf("%s:revoked_token:%s", prefixAuth, tokenID)
+}
+
+func (keys) UserTokensNotBefore(userID types.UserID) string {
+	return fmt.Sprintf("%s:%s:tokens_not_before", prefixUser, userID)
+}
+
 func (keys) WorkspaceTag(wsID types.WorkspaceID) string {
 	return fmt.Sprintf("%s:%s", prefixWorkspace, wsID)
 }
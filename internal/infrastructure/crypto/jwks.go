@@ -0,0 +1,168 @@
+package crypto
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// JWK is the public part of a signing key, as published in a JWKS document (RFC 7517).
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Ed25519
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// VerificationKey is a public key that tokens with its kid are verified against.
+type VerificationKey struct {
+	public   crypto.PublicKey
+	method   jwt.SigningMethod
+	jwk      JWK
+	notAfter time.Time
+}
+
+// NewVerificationKey supports RSA and Ed25519 keys.
+// The kid is the key's JWK thumbprint (RFC 7638), so it is the same on every instance.
+func NewVerificationKey(public crypto.PublicKey) (*VerificationKey, error) {
+	enc := base64.RawURLEncoding
+
+	var (
+		method     jwt.SigningMethod
+		jwk        JWK
+		thumbprint string
+	)
+
+	switch pub := public.(type) {
+	case *rsa.PublicKey:
+		method = jwt.SigningMethodRS256
+		jwk = JWK{Kty: "RSA", N: enc.EncodeToString(pub.N.Bytes()), E: enc.EncodeToString(big.NewInt(int64(pub.E)).Bytes())}
+		thumbprint = fmt.Sprintf(`{"e":%q,"kty":"RSA","n":%q}`, jwk.E, jwk.N)
+	case ed25519.PublicKey:
+		method = jwt.SigningMethodEdDSA
+		jwk = JWK{Kty: "OKP", Crv: "Ed25519", X: enc.EncodeToString(pub)}
+		thumbprint = fmt.Sprintf(`{"crv":"Ed25519","kty":"OKP","x":%q}`, jwk.X)
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", public)
+	}
+
+	sum := sha256.Sum256([]byte(thumbprint))
+	jwk.Kid = enc.EncodeToString(sum[:])
+	jwk.Use = "sig"
+	jwk.Alg = method.Alg()
+
+	return &VerificationKey{public: public, method: method, jwk: jwk}, nil
+}
+
+func (k *VerificationKey) ID() string {
+	return k.jwk.Kid
+}
+
+// AcceptedUntil returns a copy of the key that is no longer accepted nor published after t.
+func (k *VerificationKey) AcceptedUntil(t time.Time) *VerificationKey {
+	c := *k
+	c.notAfter = t
+
+	return &c
+}
+
+func (k *VerificationKey) retired(now time.Time) bool {
+	return !k.notAfter.IsZero() && now.After(k.notAfter)
+}
+
+// SigningKey signs tokens and sets its kid in their header.
+type SigningKey struct {
+	private crypto.Signer
+	public  *VerificationKey
+}
+
+func NewSigningKey(private crypto.Signer) (*SigningKey, error) {
+	public, err := NewVerificationKey(private.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	return &SigningKey{private: private, public: public}, nil
+}
+
+func (k *SigningKey) ID() string {
+	return k.public.ID()
+}
+
+func (k *SigningKey) VerificationKey() *VerificationKey {
+	return k.public
+}
+
+// LoadSigningKey reads a PEM encoded RSA or Ed25519 private key.
+func LoadSigningKey(path string) (*SigningKey, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key: %w", err)
+	}
+
+	private, err := parsePrivateKey(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key %s: %w", path, err)
+	}
+
+	return NewSigningKey(private)
+}
+
+// LoadVerificationKey reads a PEM encoded RSA or Ed25519 key, either public or private.
+func LoadVerificationKey(path string) (*VerificationKey, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key: %w", err)
+	}
+
+	if private, err := parsePrivateKey(b); err == nil {
+		return NewVerificationKey(private.Public())
+	}
+
+	if public, err := jwt.ParseRSAPublicKeyFromPEM(b); err == nil {
+		return NewVerificationKey(public)
+	}
+
+	if public, err := jwt.ParseEdPublicKeyFromPEM(b); err == nil {
+		return NewVerificationKey(public)
+	}
+
+	return nil, fmt.Errorf("failed to parse key %s: not a PEM encoded RSA or Ed25519 key", path)
+}
+
+func parsePrivateKey(b []byte) (crypto.Signer, error) {
+	if private, err := jwt.ParseRSAPrivateKeyFromPEM(b); err == nil {
+		return private, nil
+	}
+
+	private, err := jwt.ParseEdPrivateKeyFromPEM(b)
+	if err != nil {
+		return nil, errors.New("not a PEM encoded RSA or Ed25519 private key")
+	}
+
+	signer, ok := private.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", private)
+	}
+
+	return signer, nil
+}
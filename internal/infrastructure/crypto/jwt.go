@@ -1,10 +1,8 @@
 package crypto
 
 import (
-	"crypto/rsa"
 	"errors"
 	"fmt"
-	"os"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -18,8 +16,33 @@ const (
 type AuthClaims struct {
 	jwt.RegisteredClaims
 
-	UserID      uuid.UUID `json:"uid"`
-	MFAVerified bool      `json:"mfa"`
+	UserID uuid.UUID `json:"uid"`
+	// MFAVerifiedAt is when MFA was completed, so that sensitive operations can demand a recent one.
+	MFAVerifiedAt *jwt.NumericDate `json:"mfa_at,omitempty"`
+	// ReauthenticatedAt is when a user without TOTP re-entered their password, which stands in for MFA
+	// on the privileged actions they must still be able to perform.
+	ReauthenticatedAt *jwt.NumericDate `json:"reauth_at,omitempty"`
+	// IssuedAtMilli is the issue time in milliseconds, since iat is in whole seconds
+	// and a global logout must spare tokens issued later in the same second.
+	IssuedAtMilli int64 `json:"iat_ms,omitempty"`
+}
+
+// MFAVerified reports whether the token was issued after completing MFA.
+func (c *AuthClaims) MFAVerified() bool {
+	return c.MFAVerifiedAt != nil
+}
+
+// IssueTime returns when the token was issued, as precisely as the token tells.
+func (c *AuthClaims) IssueTime() time.Time {
+	if c.IssuedAtMilli != 0 {
+		return time.UnixMilli(c.IssuedAtMilli)
+	}
+
+	if c.IssuedAt != nil {
+		return c.IssuedAt.Time
+	}
+
+	return time.Time{}
 }
 
 type TokenProvider struct {
@@ -27,46 +50,76 @@ type TokenProvider struct {
 	Verifier *TokenVerifier
 }
 
-func NewTokenProvider(privKeyPath, pubKeyPath, issuerName string) (*TokenProvider, error) {
-	privKeyBytes, err := os.ReadFile(privKeyPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read private key: %w", err)
-	}
+type TokenProviderConfig struct {
+	Issuer         string
+	SigningKeyPath string
+	// PreviousKeyPaths are keys that signed tokens before the current one.
+	// They are still accepted, and published, until RotatedAt + Overlap.
+	PreviousKeyPaths []string
+	// RotatedAt is when the signing key replaced the previous keys.
+	// Previous keys are accepted indefinitely if zero.
+	RotatedAt time.Time
+	Overlap   time.Duration
+}
 
-	privKey, err := jwt.ParseRSAPrivateKeyFromPEM(privKeyBytes)
+func NewTokenProvider(cfg TokenProviderConfig) (*TokenProvider, error) {
+	signingKey, err := LoadSigningKey(cfg.SigningKeyPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse private key: %w", err)
+		return nil, err
 	}
 
-	pubKeyBytes, err := os.ReadFile(pubKeyPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read public key: %w", err)
-	}
+	keys := []*VerificationKey{signingKey.VerificationKey()}
 
-	pubKey, err := jwt.ParseRSAPublicKeyFromPEM(pubKeyBytes)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse public key: %w", err)
+	for _, path := range cfg.PreviousKeyPaths {
+		key, err := LoadVerificationKey(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if !cfg.RotatedAt.IsZero() {
+			key = key.AcceptedUntil(cfg.RotatedAt.Add(cfg.Overlap))
+		}
+
+		keys = append(keys, key)
 	}
 
 	return &TokenProvider{
-		Issuer:   NewTokenIssuer(privKey, issuerName),
-		Verifier: NewTokenVerifier(pubKey),
+		Issuer:   NewTokenIssuer(signingKey, cfg.Issuer),
+		Verifier: NewTokenVerifier(keys...),
 	}, nil
 }
 
-// TokenIssuer handles signing JWTs using a private RSA key.
+// TokenIssuer handles signing JWTs with the current signing key.
 type TokenIssuer struct {
-	privateKey *rsa.PrivateKey
-	issuer     string
+	key    *SigningKey
+	issuer string
 }
 
-func NewTokenIssuer(privateKey *rsa.PrivateKey, issuer string) *TokenIssuer {
-	return &TokenIssuer{privateKey: privateKey, issuer: issuer}
+func NewTokenIssuer(key *SigningKey, issuer string) *TokenIssuer {
+	return &TokenIssuer{key: key, issuer: issuer}
 }
 
-func (i *TokenIssuer) Issue(userID uuid.UUID, mfaVerified bool, duration time.Duration) (string, error) {
+func (i *TokenIssuer) Issue(userID uuid.UUID, mfaVerifiedAt *time.Time, duration time.Duration) (string, error) {
+	claims := i.newClaims(userID, duration)
+
+	if mfaVerifiedAt != nil {
+		claims.MFAVerifiedAt = jwt.NewNumericDate(*mfaVerifiedAt)
+	}
+
+	return i.sign(claims)
+}
+
+func (i *TokenIssuer) IssueReauthenticated(userID uuid.UUID, reauthenticatedAt time.Time, duration time.Duration) (string, error) {
+	claims := i.newClaims(userID, duration)
+	claims.ReauthenticatedAt = jwt.NewNumericDate(reauthenticatedAt)
+
+	return i.sign(claims)
+}
+
+func (i *TokenIssuer) newClaims(userID uuid.UUID, duration time.Duration) AuthClaims {
 	now := time.Now()
-	claims := AuthClaims{
+
+	return AuthClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
 			Issuer:    i.issuer,
 			Audience:  jwt.ClaimStrings{tokenAudience},
@@ -75,13 +128,16 @@ func (i *TokenIssuer) Issue(userID uuid.UUID, mfaVerified bool, duration time.Du
 			IssuedAt:  jwt.NewNumericDate(now),
 			ID:        uuid.New().String(),
 		},
-		UserID:      userID,
-		MFAVerified: mfaVerified,
+		UserID:        userID,
+		IssuedAtMilli: now.UnixMilli(),
 	}
+}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+func (i *TokenIssuer) sign(claims AuthClaims) (string, error) {
+	token := jwt.NewWithClaims(i.key.public.method, claims)
+	token.Header["kid"] = i.key.ID()
 
-	signed, err := token.SignedString(i.privateKey)
+	signed, err := token.SignedString(i.key.private)
 	if err != nil {
 		return "", fmt.Errorf("token.SignedString failed: %w", err)
 	}
@@ -89,22 +145,29 @@ func (i *TokenIssuer) Issue(userID uuid.UUID, mfaVerified bool, duration time.Du
 	return signed, nil
 }
 
-// TokenVerifier handles verifying JWTs using a public RSA key.
+// TokenVerifier handles verifying JWTs against the key named by their kid header.
 type TokenVerifier struct {
-	publicKey *rsa.PublicKey
+	keys []*VerificationKey
 }
 
-func NewTokenVerifier(publicKey *rsa.PublicKey) *TokenVerifier {
-	return &TokenVerifier{publicKey: publicKey}
+func NewTokenVerifier(keys ...*VerificationKey) *TokenVerifier {
+	return &TokenVerifier{keys: keys}
 }
 
 func (v *TokenVerifier) Verify(tokenString string) (*AuthClaims, error) {
 	token, err := jwt.ParseWithClaims(tokenString, &AuthClaims{}, func(token *jwt.Token) (any, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+		kid, _ := token.Header["kid"].(string)
+
+		key := v.key(kid, time.Now())
+		if key == nil {
+			return nil, fmt.Errorf("unknown signing key %q", kid)
+		}
+
+		if token.Method.Alg() != key.method.Alg() {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 		}
 
-		return v.publicKey, nil
+		return key.public, nil
 	})
 	if err != nil {
 		return nil, fmt.Errorf("jwt.ParseWithClaims failed: %w", err)
@@ -116,3 +179,27 @@ func (v *TokenVerifier) Verify(tokenString string) (*AuthClaims, error) {
 
 	return nil, errors.New("invalid token")
 }
+
+// JWKS returns the public keys that tokens are currently accepted from.
+func (v *TokenVerifier) JWKS() JWKS {
+	now := time.Now()
+	jwks := JWKS{Keys: []JWK{}}
+
+	for _, k := range v.keys {
+		if !k.retired(now) {
+			jwks.Keys = append(jwks.Keys, k.jwk)
+		}
+	}
+
+	return jwks
+}
+
+func (v *TokenVerifier) key(kid string, now time.Time) *VerificationKey {
+	for _, k := range v.keys {
+		if k.ID() == kid && !k.retired(now) {
+			return k
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package crypto_test
 
 import (
+	"crypto/ed25519"
 	"crypto/rand"
 	"crypto/rsa"
 	"testing"
@@ -19,28 +20,71 @@ func TestJWT(t *testing.T) {
 	privKey, err := rsa.GenerateKey(rand.Reader, 2048)
 	require.NoError(t, err)
 
-	pubKey := &privKey.PublicKey
+	key, err := crypto.NewSigningKey(privKey)
+	require.NoError(t, err)
 
 	issuer := "test-issuer"
 	userID := uuid.New()
 
-	ti := crypto.NewTokenIssuer(privKey, issuer)
-	tv := crypto.NewTokenVerifier(pubKey)
+	ti := crypto.NewTokenIssuer(key, issuer)
+	tv := crypto.NewTokenVerifier(key.VerificationKey())
 
 	t.Run("issue and verify success", func(t *testing.T) {
-		token, err := ti.Issue(userID, true, time.Hour)
+		mfaAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+
+		token, err := ti.Issue(userID, &mfaAt, time.Hour)
 		require.NoError(t, err)
 		assert.NotEmpty(t, token)
 
 		claims, err := tv.Verify(token)
 		require.NoError(t, err)
 		assert.Equal(t, userID, claims.UserID)
-		assert.True(t, claims.MFAVerified)
+		assert.True(t, claims.MFAVerified())
+		require.NotNil(t, claims.MFAVerifiedAt)
+		assert.True(t, mfaAt.Equal(claims.MFAVerifiedAt.Time))
 		assert.Equal(t, issuer, claims.Issuer)
 	})
 
+	t.Run("tokens without mfa carry no mfa time", func(t *testing.T) {
+		token, err := ti.Issue(userID, nil, time.Hour)
+		require.NoError(t, err)
+
+		claims, err := tv.Verify(token)
+		require.NoError(t, err)
+		assert.False(t, claims.MFAVerified())
+		assert.Nil(t, claims.MFAVerifiedAt)
+	})
+
+	t.Run("reauthenticated tokens carry the password time without mfa", func(t *testing.T) {
+		reauthAt := time.Now().Truncate(time.Second)
+
+		token, err := ti.IssueReauthenticated(userID, reauthAt, time.Hour)
+		require.NoError(t, err)
+
+		claims, err := tv.Verify(token)
+		require.NoError(t, err)
+		assert.False(t, claims.MFAVerified())
+		require.NotNil(t, claims.ReauthenticatedAt)
+		assert.True(t, reauthAt.Equal(claims.ReauthenticatedAt.Time))
+	})
+
+	t.Run("issue time keeps milliseconds beside whole second registered claims", func(t *testing.T) {
+		before := time.Now().Truncate(time.Millisecond)
+
+		token, err := ti.Issue(userID, nil, time.Hour)
+		require.NoError(t, err)
+
+		claims, err := tv.Verify(token)
+		require.NoError(t, err)
+		require.NotNil(t, claims.IssuedAt)
+		assert.True(t, claims.IssuedAt.Equal(claims.IssuedAt.Truncate(time.Second)))
+		assert.True(t, claims.ExpiresAt.Equal(claims.ExpiresAt.Truncate(time.Second)))
+		assert.False(t, claims.IssueTime().Before(before))
+		assert.True(t, claims.IssueTime().Truncate(time.Second).Equal(claims.IssuedAt.Time))
+	})
+
 	t.Run("verify failure with expired token", func(t *testing.T) {
-		token, err := ti.Issue(userID, false, -time.Hour)
+		token, err := ti.Issue(userID, nil, -time.Hour)
 		require.NoError(t, err)
 
 		_, err = tv.Verify(token)
@@ -48,13 +92,89 @@ func TestJWT(t *testing.T) {
 	})
 
 	t.Run("verify failure with wrong key", func(t *testing.T) {
-		token, err := ti.Issue(userID, false, time.Hour)
+		token, err := ti.Issue(userID, nil, time.Hour)
 		require.NoError(t, err)
 
 		otherPriv, _ := rsa.GenerateKey(rand.Reader, 2048)
-		otherTv := crypto.NewTokenVerifier(&otherPriv.PublicKey)
+		otherKey, _ := crypto.NewSigningKey(otherPriv)
+		otherTv := crypto.NewTokenVerifier(otherKey.VerificationKey())
 
 		_, err = otherTv.Verify(token)
 		assert.Error(t, err)
 	})
+
+	t.Run("ed25519 keys", func(t *testing.T) {
+		_, edPriv, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		edKey, err := crypto.NewSigningKey(edPriv)
+		require.NoError(t, err)
+
+		token, err := crypto.NewTokenIssuer(edKey, issuer).Issue(userID, nil, time.Hour)
+		require.NoError(t, err)
+
+		claims, err := crypto.NewTokenVerifier(edKey.VerificationKey()).Verify(token)
+		require.NoError(t, err)
+		assert.Equal(t, userID, claims.UserID)
+	})
+}
+
+func TestTokenVerifier_Rotation(t *testing.T) {
+	t.Parallel()
+
+	oldPriv, _ := rsa.GenerateKey(rand.Reader, 2048)
+	oldKey, err := crypto.NewSigningKey(oldPriv)
+	require.NoError(t, err)
+
+	_, newPriv, _ := ed25519.GenerateKey(rand.Reader)
+	newKey, err := crypto.NewSigningKey(newPriv)
+	require.NoError(t, err)
+
+	userID := uuid.New()
+
+	oldToken, err := crypto.NewTokenIssuer(oldKey, "test").Issue(userID, nil, time.Hour)
+	require.NoError(t, err)
+
+	newToken, err := crypto.NewTokenIssuer(newKey, "test").Issue(userID, nil, time.Hour)
+	require.NoError(t, err)
+
+	t.Run("selects the key by kid during the overlap", func(t *testing.T) {
+		tv := crypto.NewTokenVerifier(newKey.VerificationKey(), oldKey.VerificationKey().AcceptedUntil(time.Now().Add(time.Hour)))
+
+		_, err := tv.Verify(oldToken)
+		require.NoError(t, err)
+
+		_, err = tv.Verify(newToken)
+		require.NoError(t, err)
+
+		jwks := tv.JWKS()
+		require.Len(t, jwks.Keys, 2)
+		assert.Equal(t, newKey.ID(), jwks.Keys[0].Kid)
+		assert.Equal(t, "OKP", jwks.Keys[0].Kty)
+		assert.Equal(t, "EdDSA", jwks.Keys[0].Alg)
+		assert.Equal(t, oldKey.ID(), jwks.Keys[1].Kid)
+		assert.Equal(t, "RSA", jwks.Keys[1].Kty)
+		assert.Equal(t, "RS256", jwks.Keys[1].Alg)
+	})
+
+	t.Run("rejects and hides retired keys after the overlap", func(t *testing.T) {
+		tv := crypto.NewTokenVerifier(newKey.VerificationKey(), oldKey.VerificationKey().AcceptedUntil(time.Now().Add(-time.Minute)))
+
+		_, err := tv.Verify(oldToken)
+		require.Error(t, err)
+
+		_, err = tv.Verify(newToken)
+		require.NoError(t, err)
+
+		jwks := tv.JWKS()
+		require.Len(t, jwks.Keys, 1)
+		assert.Equal(t, newKey.ID(), jwks.Keys[0].Kid)
+	})
+
+	t.Run("kid is stable for the same key", func(t *testing.T) {
+		again, err := crypto.NewSigningKey(oldPriv)
+		require.NoError(t, err)
+		assert.Equal(t, oldKey.ID(), again.ID())
+		assert.NotEqual(t, oldKey.ID(), newKey.ID())
+	})
 }
@@ -1,14 +1,18 @@
 package types
 
 import (
+	auth "github.com/danicc097/todo-ddd-example/internal/modules/auth/domain"
 	todo "github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
 	user "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
 	workspace "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
 )
 
 type (
-	TodoID      = todo.TodoID
-	TagID       = todo.TagID
-	UserID      = user.UserID
-	WorkspaceID = workspace.WorkspaceID
+	TodoID         = todo.TodoID
+	TagID          = todo.TagID
+	CommentID      = todo.CommentID
+	UserID         = user.UserID
+	DataExportID   = user.DataExportID
+	RefreshTokenID = auth.RefreshTokenID
+	WorkspaceID    = workspace.WorkspaceID
 )
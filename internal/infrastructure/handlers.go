@@ -5,6 +5,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	auditHttp "github.com/danicc097/todo-ddd-example/internal/modules/audit/infrastructure/http"
 	authHttp "github.com/danicc097/todo-ddd-example/internal/modules/auth/infrastructure/http"
 	scheduleHttp "github.com/danicc097/todo-ddd-example/internal/modules/schedule/infrastructure/http"
 	todoHttp "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/http"
@@ -19,6 +20,7 @@ type CompositeHandler struct {
 	*wsHttp.WorkspaceHandler
 	*authHttp.AuthHandler
 	*scheduleHttp.ScheduleHandler
+	*auditHttp.AuditHandler
 }
 
 func NewHandlers(s *Services, c *Container) *CompositeHandler {
@@ -26,10 +28,11 @@ func NewHandlers(s *Services, c *Container) *CompositeHandler {
 
 	return &CompositeHandler{
 		TodoHandler:      todoHttp.NewTodoHandler(s.Todo, s.TodoQuery, hub, c.Redis),
-		UserHandler:      userHttp.NewUserHandler(s.UserQuery, s.WorkspaceQuery),
+		UserHandler:      userHttp.NewUserHandler(s.User, s.UserQuery, s.WorkspaceQuery),
 		WorkspaceHandler: wsHttp.NewWorkspaceHandler(s.Workspace, s.WorkspaceQuery),
 		AuthHandler:      authHttp.NewAuthHandler(s.Auth),
 		ScheduleHandler:  scheduleHttp.NewScheduleHandler(s.Schedule),
+		AuditHandler:     auditHttp.NewAuditHandler(s.Audit),
 	}
 }
 
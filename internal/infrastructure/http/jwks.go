@@ -0,0 +1,18 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/danicc097/todo-ddd-example/internal/infrastructure/crypto"
+)
+
+// JWKSHandler publishes the public keys that access tokens can be verified with.
+func JWKSHandler(verifier *crypto.TokenVerifier) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// short enough for other services to pick up a rotation well within the overlap window
+		c.Header("Cache-Control", "public, max-age=300")
+		c.JSON(http.StatusOK, verifier.JWKS())
+	}
+}
@@ -1,7 +1,10 @@
 package middleware
 
 import (
+	"context"
+	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -11,8 +14,14 @@ import (
 	sharedHttp "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/http"
 )
 
-// IdentityAndMFAResolver verifies the RS256 JWT and establishes identity.
-func IdentityAndMFAResolver(verifier *crypto.TokenVerifier) gin.HandlerFunc {
+// TokenRevocations tells whether a verified access token was revoked before it expired.
+type TokenRevocations interface {
+	IsRevoked(ctx context.Context, tokenID string, userID uuid.UUID, issuedAt time.Time) (bool, error)
+}
+
+// IdentityAndMFAResolver verifies the RS256 or Ed25519 JWT and establishes identity.
+// Revoked tokens are treated like invalid ones, as are tokens whose revocation can't be checked.
+func IdentityAndMFAResolver(verifier *crypto.TokenVerifier, revocations TokenRevocations) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader(sharedHttp.AuthorizationHeader)
 		if authHeader == "" {
@@ -34,12 +43,35 @@ func IdentityAndMFAResolver(verifier *crypto.TokenVerifier) gin.HandlerFunc {
 			return
 		}
 
+		revoked, err := revocations.IsRevoked(c.Request.Context(), claims.ID, claims.UserID, claims.IssueTime())
+		if err != nil {
+			slog.WarnContext(c.Request.Context(), "failed to check token revocation", slog.String("error", err.Error()))
+		}
+
+		if err != nil || revoked {
+			c.Next()
+			return
+		}
+
 		meta := causation.Metadata{
 			UserID:        claims.UserID,
 			UserIP:        c.ClientIP(),
 			CorrelationID: uuid.NewString(),
-			MFAVerified:   claims.MFAVerified,
+			MFAVerified:   claims.MFAVerified(),
 			UserAgent:     c.Request.UserAgent(),
+			TokenID:       claims.ID,
+		}
+
+		if claims.ExpiresAt != nil {
+			meta.TokenExpiresAt = claims.ExpiresAt.Time
+		}
+
+		if claims.MFAVerifiedAt != nil {
+			meta.MFAVerifiedAt = claims.MFAVerifiedAt.Time
+		}
+
+		if claims.ReauthenticatedAt != nil {
+			meta.ReauthenticatedAt = claims.ReauthenticatedAt.Time
 		}
 
 		ctx := causation.WithMetadata(c.Request.Context(), meta)
@@ -1,15 +1,15 @@
 package middleware_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
-	"os"
 	"testing"
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -20,21 +20,30 @@ import (
 	sharedHttp "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/http"
 )
 
+type fakeRevocations map[string]bool
+
+func (f fakeRevocations) IsRevoked(_ context.Context, tokenID string, _ uuid.UUID, _ time.Time) (bool, error) {
+	return f[tokenID], nil
+}
+
 func TestIdentityAndMFAResolver_Integration(t *testing.T) {
 	t.Parallel()
 
-	privKeyBytes, _ := os.ReadFile("../../../../private.pem")
-	privKey, _ := jwt.ParseRSAPrivateKeyFromPEM(privKeyBytes)
-	pubKeyBytes, _ := os.ReadFile("../../../../public.pem")
-	pubKey, _ := jwt.ParseRSAPublicKeyFromPEM(pubKeyBytes)
+	signingKey, err := crypto.LoadSigningKey("../../../../private.pem")
+	require.NoError(t, err)
 
-	tokenIssuer := crypto.NewTokenIssuer(privKey, "test")
-	tokenVerifier := crypto.NewTokenVerifier(pubKey)
+	verificationKey, err := crypto.LoadVerificationKey("../../../../public.pem")
+	require.NoError(t, err)
+
+	tokenIssuer := crypto.NewTokenIssuer(signingKey, "test")
+	tokenVerifier := crypto.NewTokenVerifier(verificationKey)
 
 	gin.SetMode(gin.TestMode)
 
+	revocations := fakeRevocations{}
+
 	r := gin.New()
-	r.Use(middleware.IdentityAndMFAResolver(tokenVerifier))
+	r.Use(middleware.IdentityAndMFAResolver(tokenVerifier, revocations))
 	r.GET("/test", func(c *gin.Context) {
 		meta := causation.FromContext(c.Request.Context())
 		c.JSON(http.StatusOK, meta)
@@ -42,7 +51,8 @@ func TestIdentityAndMFAResolver_Integration(t *testing.T) {
 
 	t.Run("valid JWT", func(t *testing.T) {
 		uid := uuid.New()
-		token, err := tokenIssuer.Issue(uid, true, time.Hour)
+		mfaAt := time.Now()
+		token, err := tokenIssuer.Issue(uid, &mfaAt, time.Hour)
 		require.NoError(t, err)
 
 		w := httptest.NewRecorder()
@@ -53,6 +63,29 @@ func TestIdentityAndMFAResolver_Integration(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 		assert.Contains(t, w.Body.String(), `"MFAVerified":true`)
 		assert.Contains(t, w.Body.String(), uid.String())
+
+		var meta causation.Metadata
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &meta))
+		assert.True(t, mfaAt.Truncate(time.Second).Equal(meta.MFAVerifiedAt))
+	})
+
+	t.Run("revoked JWT", func(t *testing.T) {
+		mfaAt := time.Now()
+		token, err := tokenIssuer.Issue(uuid.New(), &mfaAt, time.Hour)
+		require.NoError(t, err)
+
+		claims, err := tokenVerifier.Verify(token)
+		require.NoError(t, err)
+
+		revocations[claims.ID] = true
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/test", nil)
+		req.Header.Set(sharedHttp.AuthorizationHeader, fmt.Sprintf("%s %s", sharedHttp.BearerScheme, token))
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"UserID":"00000000-0000-0000-0000-000000000000"`)
 	})
 
 	t.Run("invalid JWT", func(t *testing.T) {
@@ -0,0 +1,139 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/danicc097/todo-ddd-example/internal/infrastructure/http/middleware"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+func TestRequireMFA(t *testing.T) {
+	t.Parallel()
+
+	specRaw := []byte(`
+openapi: 3.0.0
+info:
+  title: Test API
+  version: 1.0.0
+paths:
+  /open:
+    get:
+      operationId: open
+      responses:
+        '200':
+          description: OK
+  /any-mfa:
+    get:
+      operationId: anyMFA
+      x-require-mfa: true
+      responses:
+        '200':
+          description: OK
+  /recent-mfa:
+    get:
+      operationId: recentMFA
+      x-require-mfa:
+        maxAge: 10m
+      responses:
+        '200':
+          description: OK
+  /recent-step-up:
+    get:
+      operationId: recentStepUp
+      x-require-mfa:
+        maxAge: 10m
+        allowPassword: true
+      responses:
+        '200':
+          description: OK
+  /malformed:
+    get:
+      operationId: malformed
+      x-require-mfa:
+        maxAge: soon
+      responses:
+        '200':
+          description: OK
+`)
+
+	spec, err := openapi3.NewLoader().LoadFromData(specRaw)
+	require.NoError(t, err)
+
+	spec.Servers = append(spec.Servers, &openapi3.Server{URL: "/"})
+
+	router, err := gorillamux.NewRouter(spec)
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+
+	serve := func(path string, meta causation.Metadata) *httptest.ResponseRecorder {
+		r := gin.New()
+		r.Use(middleware.ErrorHandler())
+		r.Use(func(c *gin.Context) {
+			c.Request = c.Request.WithContext(causation.WithMetadata(c.Request.Context(), meta))
+			c.Next()
+		})
+		r.Use(middleware.RequireMFA(router))
+		r.GET(path, func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, path, nil)
+		r.ServeHTTP(w, req)
+
+		return w
+	}
+
+	user := uuid.New()
+	noMFA := causation.Metadata{UserID: user}
+	freshMFA := causation.Metadata{UserID: user, MFAVerified: true, MFAVerifiedAt: time.Now().Add(-time.Minute)}
+	staleMFA := causation.Metadata{UserID: user, MFAVerified: true, MFAVerifiedAt: time.Now().Add(-time.Hour)}
+	unknownMFA := causation.Metadata{UserID: user, MFAVerified: true}
+	freshPassword := causation.Metadata{UserID: user, ReauthenticatedAt: time.Now().Add(-time.Minute)}
+	stalePassword := causation.Metadata{UserID: user, ReauthenticatedAt: time.Now().Add(-time.Hour)}
+
+	tests := []struct {
+		name       string
+		path       string
+		meta       causation.Metadata
+		wantStatus int
+	}{
+		{"no extension", "/open", noMFA, http.StatusOK},
+		{"anonymous", "/any-mfa", causation.Metadata{}, http.StatusOK},
+		{"missing MFA", "/any-mfa", noMFA, http.StatusForbidden},
+		{"any MFA session", "/any-mfa", staleMFA, http.StatusOK},
+		{"recent MFA", "/recent-mfa", freshMFA, http.StatusOK},
+		{"stale MFA", "/recent-mfa", staleMFA, http.StatusForbidden},
+		{"MFA of unknown age", "/recent-mfa", unknownMFA, http.StatusForbidden},
+		{"password instead of MFA", "/recent-mfa", freshPassword, http.StatusForbidden},
+		{"recent password", "/recent-step-up", freshPassword, http.StatusOK},
+		{"stale password", "/recent-step-up", stalePassword, http.StatusForbidden},
+		{"recent MFA with password allowed", "/recent-step-up", freshMFA, http.StatusOK},
+		{"malformed extension", "/malformed", freshMFA, http.StatusInternalServerError},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			w := serve(tc.path, tc.meta)
+
+			assert.Equal(t, tc.wantStatus, w.Code)
+
+			if tc.wantStatus == http.StatusForbidden {
+				assert.Contains(t, w.Body.String(), `"code":"MFA_REQUIRED"`)
+			}
+		})
+	}
+}
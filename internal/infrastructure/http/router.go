@@ -28,6 +28,7 @@ type RouterConfig struct {
 	Pool          *pgxpool.Pool
 	Redis         redis.UniversalClient
 	TokenVerifier *crypto.TokenVerifier
+	Revocations   middleware.TokenRevocations
 	Handler       api.ServerInterface
 	WSHandler     gin.HandlerFunc
 }
@@ -47,9 +48,9 @@ func NewRouter(cfg RouterConfig) (*gin.Engine, error) {
 	r.Use(middleware.CORS())
 	r.Use(gin.Recovery())
 	r.Use(middleware.DBIdempotency(cfg.Pool))
-	r.Use(middleware.IdentityAndMFAResolver(cfg.TokenVerifier))
+	r.Use(middleware.IdentityAndMFAResolver(cfg.TokenVerifier, cfg.Revocations))
 
-	// load openapi spec explicitly to share the router with validation and rate limiting
+	// load openapi spec explicitly to share the router with validation, rate limiting and MFA requirements
 	loader := openapi3.NewLoader()
 
 	doc, err := loader.LoadFromFile("./openapi.yaml")
@@ -63,6 +64,7 @@ func NewRouter(cfg RouterConfig) (*gin.Engine, error) {
 	}
 
 	r.Use(middleware.RateLimiter(cfg.Redis, openapiRouter, cfg.Env))
+	r.Use(middleware.RequireMFA(openapiRouter))
 
 	validator := middleware.NewOpenapiMiddleware(doc).RequestValidatorWithOptions(&middleware.OAValidatorOptions{
 		ValidateResponse: true,
@@ -83,6 +85,7 @@ func NewRouter(cfg RouterConfig) (*gin.Engine, error) {
 			p == sharedHttp.RouteHealthz ||
 			p == sharedHttp.RouteDocs ||
 			p == sharedHttp.RouteFavicon ||
+			p == sharedHttp.RouteJWKS ||
 			p == sharedHttp.RouteOpenAPISpec {
 			c.Next()
 			return
@@ -100,6 +103,7 @@ func NewRouter(cfg RouterConfig) (*gin.Engine, error) {
 		c.Data(http.StatusOK, "application/x-yaml", explodedSpec)
 	})
 	r.GET(sharedHttp.RouteDocs, SwaggerUIHandler(sharedHttp.RouteOpenAPISpec))
+	r.GET(sharedHttp.RouteJWKS, JWKSHandler(cfg.TokenVerifier))
 
 	api.RegisterHandlers(r.Group(sharedHttp.APIV1Prefix), cfg.Handler)
 
@@ -25,10 +25,16 @@ func (keys) WorkspaceTodoAPIUpdatesChannel(wsID uuid.UUID) string {
 	return fmt.Sprintf("%s%s", keys{}.WorkspaceTodoAPIUpdatesChannelPrefix(), wsID)
 }
 
-func (keys) ScheduleTodoDeletedQueue() string { return "schedule_todo_deleted" }
-func (keys) TodoEventsExchange() string       { return "todo_events" }
-func (keys) ServiceName() string              { return "todo-ddd-api" }
-func (keys) AppDisplayName() string           { return "Todo-DDD-App" }
+func (keys) ScheduleTodoDeletedQueue() string   { return "schedule_todo_deleted" }
+func (keys) ScheduleTodoCompletedQueue() string { return "schedule_todo_completed" }
+func (keys) TodoBlockerResolvedQueue() string   { return "todo_blocker_resolved" }
+func (keys) TodoMemberRemovedQueue() string     { return "todo_member_removed" }
+func (keys) AuditUserDeletedQueue() string      { return "audit_user_deleted" }
+func (keys) AuditAuthEventsQueue() string       { return "audit_auth_events" }
+func (keys) UserDataExportQueue() string        { return "user_data_export_requested" }
+func (keys) TodoEventsExchange() string         { return "todo_events" }
+func (keys) ServiceName() string                { return "todo-ddd-api" }
+func (keys) AppDisplayName() string             { return "Todo-DDD-App" }
 
 func (keys) EventRoutingKey(eventType sharedDomain.EventType, aggID uuid.UUID) string {
 	return fmt.Sprintf("%s.%s", eventType, aggID)
@@ -2,6 +2,7 @@ package infrastructure
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/danicc097/todo-ddd-example/internal"
@@ -9,23 +10,29 @@ import (
 	"github.com/danicc097/todo-ddd-example/internal/infrastructure/crypto"
 	"github.com/danicc097/todo-ddd-example/internal/infrastructure/messaging"
 	infraRedis "github.com/danicc097/todo-ddd-example/internal/infrastructure/redis"
-	auditMem "github.com/danicc097/todo-ddd-example/internal/modules/audit/infrastructure/memory"
+	auditApp "github.com/danicc097/todo-ddd-example/internal/modules/audit/application"
+	auditDomain "github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+	auditPg "github.com/danicc097/todo-ddd-example/internal/modules/audit/infrastructure/postgres"
 	authApp "github.com/danicc097/todo-ddd-example/internal/modules/auth/application"
 	authDomain "github.com/danicc097/todo-ddd-example/internal/modules/auth/domain"
 	authAdapters "github.com/danicc097/todo-ddd-example/internal/modules/auth/infrastructure/adapters"
+	authDecorator "github.com/danicc097/todo-ddd-example/internal/modules/auth/infrastructure/decorator"
 	authPg "github.com/danicc097/todo-ddd-example/internal/modules/auth/infrastructure/postgres"
 	authRedis "github.com/danicc097/todo-ddd-example/internal/modules/auth/infrastructure/redis"
 	scheduleApp "github.com/danicc097/todo-ddd-example/internal/modules/schedule/application"
 	scheduleDomain "github.com/danicc097/todo-ddd-example/internal/modules/schedule/domain"
+	scheduleDecorator "github.com/danicc097/todo-ddd-example/internal/modules/schedule/infrastructure/decorator"
 	schedulePg "github.com/danicc097/todo-ddd-example/internal/modules/schedule/infrastructure/postgres"
 	todoApp "github.com/danicc097/todo-ddd-example/internal/modules/todo/application"
 	todoDomain "github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	todoAdapters "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/adapters"
 	todoDecorator "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/decorator"
 	todoPg "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/postgres"
 	todoRedis "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/redis"
 	userApp "github.com/danicc097/todo-ddd-example/internal/modules/user/application"
 	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
 	userAdapters "github.com/danicc097/todo-ddd-example/internal/modules/user/infrastructure/adapters"
+	userDecorator "github.com/danicc097/todo-ddd-example/internal/modules/user/infrastructure/decorator"
 	userPg "github.com/danicc097/todo-ddd-example/internal/modules/user/infrastructure/postgres"
 	wsApp "github.com/danicc097/todo-ddd-example/internal/modules/workspace/application"
 	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
@@ -42,46 +49,94 @@ type Services struct {
 	Workspace wsApp.WorkspaceUseCases
 	Auth      authApp.AuthUseCases
 	Schedule  scheduleApp.ScheduleUseCases
+	User      userApp.UserUseCases
+	Audit     auditApp.AuditUseCases
 
 	UserQuery      *userApp.GetUserUseCase
 	TodoQuery      todoApp.TodoQueryService
 	WorkspaceQuery wsApp.WorkspaceQueryService
 
 	ScheduleRepo  scheduleDomain.ScheduleRepository
+	TodoRepo      todoDomain.TodoRepository
+	Rollover      *scheduleApp.ScheduleRollover
+	FocusSweeper  *todoApp.FocusSessionSweeper
+	AuditErasure  *auditApp.UserDeletedEventHandler
+	AuthAudit     *auditApp.AuthEventHandler
+	DataExporter  *userApp.DataExportRequestedEventHandler
+	UnitOfWork    sharedApp.UnitOfWork
 	TokenProvider *crypto.TokenProvider
+	TokenDenylist *authRedis.TokenDenylist
 }
 
 func NewServices(ctx context.Context, cfg *internal.AppConfig, cnt *Container) (*Services, error) {
 	uow, svcName := sharedPg.NewUnitOfWork(cnt.Pool), messaging.Keys.ServiceName()
-	tokenProvider, _ := crypto.NewTokenProvider("private.pem", "public.pem", svcName)
-	hasher, audit, totp := crypto.NewArgon2PasswordHasher(), auditMem.NewAuditRepository(), authRedis.NewTOTPGuard(cnt.Redis)
+	tokenProvider, err := crypto.NewTokenProvider(crypto.TokenProviderConfig{
+		Issuer:           svcName,
+		SigningKeyPath:   cfg.JWTSigningKey,
+		PreviousKeyPaths: cfg.JWTPreviousKeys,
+		RotatedAt:        cfg.JWTRotatedAt,
+		Overlap:          cfg.JWTRotationOverlap,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load token keys: %w", err)
+	}
+
+	hasher, totp := crypto.NewArgon2PasswordHasher(), authRedis.NewTOTPGuard(cnt.Redis)
+	denylist := authRedis.NewTokenDenylist(cnt.Redis, authApp.AccessTokenTTL, cfg.TokenRevocationCacheTTL)
 	cacheStore := infraRedis.NewCacheStore(cnt.Redis)
 	encryptor := authAdapters.NewAESGCMEncryptor()
 	appConfig := authAdapters.NewMessagingAppConfig()
 
 	/** Repositories **/
+	audit := sharedApp.Apply(auditDomain.AuditRepository(auditPg.NewAuditRepo(cnt.Pool)),
+		func(r auditDomain.AuditRepository) auditDomain.AuditRepository {
+			return auditPg.NewAuditRepositoryWithTracing(r, svcName)
+		})
+
 	userRepo := sharedApp.Apply(userDomain.UserRepository(userPg.NewUserRepo(cnt.Pool, uow)),
+		func(r userDomain.UserRepository) userDomain.UserRepository {
+			return userDecorator.NewUserAuditWrapper(r, audit)
+		},
 		func(r userDomain.UserRepository) userDomain.UserRepository {
 			return userPg.NewUserRepositoryWithTracing(r, svcName)
 		})
 
 	authRepo := sharedApp.Apply(authDomain.AuthRepository(authPg.NewAuthRepo(cnt.Pool, uow)),
+		func(r authDomain.AuthRepository) authDomain.AuthRepository {
+			return authDecorator.NewAuthAuditWrapper(r, audit)
+		},
 		func(r authDomain.AuthRepository) authDomain.AuthRepository {
 			return authPg.NewAuthRepositoryWithTracing(r, svcName)
 		})
 
+	refreshRepo := sharedApp.Apply(authDomain.RefreshTokenRepository(authPg.NewRefreshTokenRepo(cnt.Pool)),
+		func(r authDomain.RefreshTokenRepository) authDomain.RefreshTokenRepository {
+			return authPg.NewRefreshTokenRepositoryWithTracing(r, svcName)
+		})
+
 	todoRepo := sharedApp.Apply(todoDomain.TodoRepository(todoPg.NewTodoRepo(cnt.Pool, uow)),
 		func(r todoDomain.TodoRepository) todoDomain.TodoRepository {
 			return todoDecorator.NewTodoRepositoryCache(r, cacheStore, 5*time.Minute, todoRedis.NewTodoCacheCodec())
 		},
+		func(r todoDomain.TodoRepository) todoDomain.TodoRepository {
+			return todoDecorator.NewTodoAuditWrapper(r, audit)
+		},
 		func(r todoDomain.TodoRepository) todoDomain.TodoRepository {
 			return todoPg.NewTodoRepositoryWithTracing(r, svcName)
 		})
 
+	commentRepo := sharedApp.Apply(todoDomain.CommentRepository(todoPg.NewCommentRepo(cnt.Pool, uow)),
+		func(r todoDomain.CommentRepository) todoDomain.CommentRepository {
+			return todoPg.NewCommentRepositoryWithTracing(r, svcName)
+		})
+
 	tagRepo := sharedApp.Apply(todoDomain.TagRepository(todoPg.NewTagRepo(cnt.Pool)),
 		func(r todoDomain.TagRepository) todoDomain.TagRepository {
 			return todoDecorator.NewTagRepositoryCache(r, cacheStore, 60*time.Minute, todoRedis.NewTagCacheCodec())
 		},
+		func(r todoDomain.TagRepository) todoDomain.TagRepository {
+			return todoDecorator.NewTagAuditWrapper(r, audit)
+		},
 		func(r todoDomain.TagRepository) todoDomain.TagRepository {
 			return todoPg.NewTagRepositoryWithTracing(r, svcName)
 		})
@@ -97,8 +152,15 @@ func NewServices(ctx context.Context, cfg *internal.AppConfig, cnt *Container) (
 			return wsPg.NewWorkspaceRepositoryWithTracing(r, svcName)
 		})
 
+	exportRepo := sharedApp.Apply(userDomain.DataExportRepository(userPg.NewDataExportRepo(cnt.Pool, uow)),
+		func(r userDomain.DataExportRepository) userDomain.DataExportRepository {
+			return userPg.NewDataExportRepositoryWithTracing(r, svcName)
+		})
+
 	scheduleRepo := sharedApp.Apply(scheduleDomain.ScheduleRepository(schedulePg.NewScheduleRepo(cnt.Pool, uow)),
-		func(r scheduleDomain.ScheduleRepository) scheduleDomain.ScheduleRepository { return r })
+		func(r scheduleDomain.ScheduleRepository) scheduleDomain.ScheduleRepository {
+			return scheduleDecorator.NewScheduleAuditWrapper(r, audit)
+		})
 
 	/** Query **/
 	wsQuery := sharedApp.Apply(wsPg.NewWorkspaceQueryService(cnt.Pool),
@@ -114,18 +176,70 @@ func NewServices(ctx context.Context, cfg *internal.AppConfig, cnt *Container) (
 			return todoPg.NewTodoQueryServiceWithTracing(qs, svcName)
 		})
 
+	focusReportQuery := sharedApp.Apply(todoPg.NewFocusReportQueryService(cnt.Pool),
+		func(qs todoApp.FocusReportQueryService) todoApp.FocusReportQueryService {
+			return todoDecorator.NewFocusReportQueryServiceCache(qs, cacheStore, 5*time.Minute)
+		},
+		func(qs todoApp.FocusReportQueryService) todoApp.FocusReportQueryService {
+			return todoPg.NewFocusReportQueryServiceWithTracing(qs, svcName)
+		})
+
+	auditQuery := sharedApp.Apply(auditPg.NewAuditQueryService(cnt.Pool),
+		func(qs auditApp.AuditQueryService) auditApp.AuditQueryService {
+			return auditPg.NewAuditQueryServiceWithTracing(qs, svcName)
+		})
+
+	auditWsProv := wsAdapters.NewAuditWorkspaceProvider(wsRepo)
+
 	/** Wiring **/
 	wsProv := wsAdapters.NewTodoWorkspaceProvider(wsRepo)
 	wsUserProv := userAdapters.NewWorkspaceUserProvider(userRepo)
+	tzProv := userAdapters.NewUserTimezoneProvider(userRepo)
+	capProv := userAdapters.NewUserCapacityProvider(userRepo)
+	plannableProv := todoAdapters.NewScheduleTodoProvider(todoQuery)
+	sessions := authApp.NewSessionIssuer(tokenProvider.Issuer, refreshRepo, cfg.RefreshTokenTTL)
+	personalData := append([]userApp.PersonalDataSource{
+		wsPg.NewPersonalDataSource(cnt.Pool),
+		auditPg.NewPersonalDataSource(cnt.Pool),
+	}, todoPg.NewPersonalDataSources(cnt.Pool)...)
 
 	return &Services{
 		Todo: todoApp.TodoUseCases{
-			CreateTodo: sharedApp.BuildCommand(todoApp.NewCreateTodoHandler(todoRepo, wsProv), uow, "create-todo"),
-			Complete:   sharedApp.BuildCommand(todoApp.NewCompleteTodoHandler(todoRepo, wsProv), uow, "complete-todo"),
-			CreateTag:  sharedApp.BuildCommand(todoApp.NewCreateTagHandler(tagRepo), uow, "create-tag"),
-			AssignTag:  sharedApp.BuildCommand(todoApp.NewAssignTagToTodoHandler(todoRepo, tagRepo), uow, "assign-tag-to-todo"),
-			StartFocus: sharedApp.BuildCommand(todoApp.NewStartFocusHandler(todoRepo, wsProv), uow, "start-focus"),
-			StopFocus:  sharedApp.BuildCommand(todoApp.NewStopFocusHandler(todoRepo, wsProv), uow, "stop-focus"),
+			CreateTodo:    sharedApp.BuildCommand(todoApp.NewCreateTodoHandler(todoRepo, wsProv), uow, "create-todo"),
+			Complete:      sharedApp.BuildCommand(todoApp.NewCompleteTodoHandler(todoRepo, wsProv, tzProv), uow, "complete-todo"),
+			CreateTag:     sharedApp.BuildCommand(todoApp.NewCreateTagHandler(tagRepo), uow, "create-tag"),
+			AssignTag:     sharedApp.BuildCommand(todoApp.NewAssignTagToTodoHandler(todoRepo, tagRepo), uow, "assign-tag-to-todo"),
+			RemoveTag:     sharedApp.BuildCommand(todoApp.NewRemoveTagFromTodoHandler(todoRepo, wsProv), uow, "remove-tag-from-todo"),
+			UpdateTag:     sharedApp.BuildCommand(todoApp.NewUpdateTagHandler(tagRepo, wsProv), uow, "update-tag"),
+			DeleteTag:     sharedApp.BuildCommand(todoApp.NewDeleteTagHandler(tagRepo, todoRepo, wsProv), uow, "delete-tag"),
+			StartFocus:    sharedApp.BuildCommand(todoApp.NewStartFocusHandler(todoRepo, wsProv), uow, "start-focus"),
+			StopFocus:     sharedApp.BuildCommand(todoApp.NewStopFocusHandler(todoRepo, wsProv), uow, "stop-focus"),
+			Rename:        sharedApp.BuildCommand(todoApp.NewRenameTodoHandler(todoRepo, wsProv), uow, "rename-todo"),
+			Archive:       sharedApp.BuildCommand(todoApp.NewArchiveTodoHandler(todoRepo, wsProv), uow, "archive-todo"),
+			Unarchive:     sharedApp.BuildCommand(todoApp.NewUnarchiveTodoHandler(todoRepo, wsProv), uow, "unarchive-todo"),
+			Reopen:        sharedApp.BuildCommand(todoApp.NewReopenTodoHandler(todoRepo, wsProv), uow, "reopen-todo"),
+			SetDueDate:    sharedApp.BuildCommand(todoApp.NewSetDueDateHandler(todoRepo, wsProv), uow, "set-todo-due-date"),
+			SetRecurrence: sharedApp.BuildCommand(todoApp.NewSetRecurrenceHandler(todoRepo, wsProv), uow, "set-todo-recurrence"),
+			Search:        sharedApp.BuildQuery(todoApp.NewSearchTodosHandler(todoQuery, wsProv), "search-todos"),
+
+			AddChecklistItem:    sharedApp.BuildCommand(todoApp.NewAddChecklistItemHandler(todoRepo, wsProv), uow, "add-checklist-item"),
+			ToggleChecklistItem: sharedApp.BuildCommand(todoApp.NewToggleChecklistItemHandler(todoRepo, wsProv), uow, "toggle-checklist-item"),
+			ReorderChecklist:    sharedApp.BuildCommand(todoApp.NewReorderChecklistHandler(todoRepo, wsProv), uow, "reorder-checklist"),
+			RemoveChecklistItem: sharedApp.BuildCommand(todoApp.NewRemoveChecklistItemHandler(todoRepo, wsProv), uow, "remove-checklist-item"),
+
+			AddBlocker:    sharedApp.BuildCommand(todoApp.NewAddBlockerHandler(todoRepo, wsProv), uow, "add-todo-blocker"),
+			RemoveBlocker: sharedApp.BuildCommand(todoApp.NewRemoveBlockerHandler(todoRepo, wsProv), uow, "remove-todo-blocker"),
+
+			Assign:           sharedApp.BuildCommand(todoApp.NewAssignTodoHandler(todoRepo, wsProv), uow, "assign-todo"),
+			Unassign:         sharedApp.BuildCommand(todoApp.NewUnassignTodoHandler(todoRepo, wsProv), uow, "unassign-todo"),
+			GetAssignedTodos: sharedApp.BuildQuery(todoApp.NewGetAssignedTodosHandler(todoQuery), "get-assigned-todos"),
+
+			AddComment:    sharedApp.BuildCommand(todoApp.NewAddCommentHandler(todoRepo, commentRepo, wsProv), uow, "add-todo-comment"),
+			EditComment:   sharedApp.BuildCommand(todoApp.NewEditCommentHandler(commentRepo, wsProv), uow, "edit-todo-comment"),
+			DeleteComment: sharedApp.BuildCommand(todoApp.NewDeleteCommentHandler(commentRepo, wsProv), uow, "delete-todo-comment"),
+			GetComments:   sharedApp.BuildQuery(todoApp.NewGetCommentsHandler(todoQuery, wsProv), "get-todo-comments"),
+
+			GetFocusReport: sharedApp.BuildQuery(todoApp.NewGetFocusReportHandler(focusReportQuery, wsProv, tzProv), "get-focus-report"),
 		},
 		Workspace: wsApp.WorkspaceUseCases{
 			Onboard:      sharedApp.BuildCommand(wsApp.NewOnboardWorkspaceHandler(wsRepo, wsUserProv), uow, "onboard-workspace"),
@@ -134,18 +248,51 @@ func NewServices(ctx context.Context, cfg *internal.AppConfig, cnt *Container) (
 			Delete:       sharedApp.BuildCommand(wsApp.NewDeleteWorkspaceHandler(wsRepo), uow, "delete-workspace"),
 		},
 		Auth: authApp.AuthUseCases{
-			Login:        sharedApp.BuildQuery(authApp.NewLoginHandler(userRepo, authRepo, tokenProvider.Issuer, hasher), "login"),
-			Register:     sharedApp.BuildCommand(authApp.NewRegisterHandler(userRepo, authRepo, hasher), uow, "register"),
-			InitiateTOTP: sharedApp.BuildCommand(authApp.NewInitiateTOTPHandler(authRepo, encryptor, appConfig, []byte(cfg.MFAMasterKey)), uow, "initiate-totp"),
-			VerifyTOTP:   sharedApp.BuildCommand(authApp.NewVerifyTOTPHandler(authRepo, totp, tokenProvider.Issuer, encryptor, []byte(cfg.MFAMasterKey)), uow, "verify-totp"),
+			Login:          sharedApp.BuildCommand(authApp.NewLoginHandler(userRepo, authRepo, sessions, hasher), uow, "login"),
+			Refresh:        sharedApp.BuildQuery(authApp.NewRefreshHandler(sessions, refreshRepo, uow), "refresh-session"),
+			Logout:         sharedApp.BuildCommand(authApp.NewLogoutHandler(denylist, refreshRepo), uow, "logout"),
+			LogoutAll:      sharedApp.BuildCommand(authApp.NewLogoutAllHandler(denylist, refreshRepo), uow, "logout-all"),
+			Register:       sharedApp.BuildCommand(authApp.NewRegisterHandler(userRepo, authRepo, hasher), uow, "register"),
+			InitiateTOTP:   sharedApp.BuildCommand(authApp.NewInitiateTOTPHandler(authRepo, encryptor, appConfig, []byte(cfg.MFAMasterKey)), uow, "initiate-totp"),
+			VerifyTOTP:     sharedApp.BuildCommand(authApp.NewVerifyTOTPHandler(authRepo, totp, sessions, encryptor, []byte(cfg.MFAMasterKey)), uow, "verify-totp"),
+			RecoverTOTP:    sharedApp.BuildCommand(authApp.NewRecoverTOTPHandler(authRepo, sessions), uow, "recover-totp"),
+			DisableTOTP:    sharedApp.BuildCommand(authApp.NewDisableTOTPHandler(authRepo, hasher, totp, encryptor, []byte(cfg.MFAMasterKey)), uow, "disable-totp"),
+			ResetTOTP:      sharedApp.BuildCommand(authApp.NewResetTOTPHandler(authRepo, refreshRepo, denylist, cfg.AdminUserIDs), uow, "reset-totp"),
+			Reauthenticate: sharedApp.BuildQuery(authApp.NewReauthenticateHandler(authRepo, sessions, hasher), "reauthenticate"),
 		},
 		Schedule: scheduleApp.ScheduleUseCases{
-			CommitTask: sharedApp.BuildCommand(scheduleApp.NewCommitTaskHandler(scheduleRepo, todoRepo), uow, "commit-task"),
+			CommitTask:  sharedApp.BuildCommand(scheduleApp.NewCommitTaskHandler(scheduleRepo, todoRepo, tzProv, capProv), uow, "commit-task"),
+			GetSchedule: sharedApp.BuildQuery(scheduleApp.NewGetScheduleHandler(scheduleRepo, capProv), "get-schedule"),
+			SetCapacity: sharedApp.BuildCommand(scheduleApp.NewSetScheduleCapacityHandler(scheduleRepo), uow, "set-schedule-capacity"),
+			RemoveTask:  sharedApp.BuildCommand(scheduleApp.NewRemoveScheduledTaskHandler(scheduleRepo), uow, "remove-scheduled-task"),
+			AutoPlan:    sharedApp.BuildCommand(scheduleApp.NewAutoPlanHandler(scheduleRepo, plannableProv, tzProv, capProv), uow, "auto-plan-schedule"),
+		},
+		Audit: auditApp.AuditUseCases{
+			GetWorkspaceAudit:         sharedApp.BuildQuery(auditApp.NewGetWorkspaceAuditHandler(auditQuery, auditWsProv), "get-workspace-audit"),
+			VerifyWorkspaceAuditChain: sharedApp.BuildQuery(auditApp.NewVerifyWorkspaceAuditChainHandler(audit, auditWsProv), "verify-workspace-audit-chain"),
+			VerifyGlobalAuditChains:   sharedApp.BuildQuery(auditApp.NewVerifyGlobalAuditChainsHandler(audit, cfg.AdminUserIDs), "verify-global-audit-chains"),
+		},
+		User: userApp.UserUseCases{
+			SetTimezone:      sharedApp.BuildCommand(userApp.NewSetUserTimezoneHandler(userRepo), uow, "set-user-timezone"),
+			SetDailyCapacity: sharedApp.BuildCommand(userApp.NewSetUserDailyCapacityHandler(userRepo), uow, "set-user-daily-capacity"),
+			Delete:           sharedApp.BuildCommand(userApp.NewDeleteUserHandler(userRepo), uow, "delete-user"),
+
+			RequestDataExport:  sharedApp.BuildCommand(userApp.NewRequestDataExportHandler(userRepo, exportRepo), uow, "request-data-export"),
+			GetDataExport:      sharedApp.BuildQuery(userApp.NewGetDataExportHandler(exportRepo), "get-data-export"),
+			DownloadDataExport: sharedApp.BuildQuery(userApp.NewDownloadDataExportHandler(exportRepo), "download-data-export"),
 		},
 		UserQuery:      userApp.NewGetUserUseCase(userRepo),
 		TodoQuery:      todoQuery,
 		WorkspaceQuery: wsQuery,
 		ScheduleRepo:   scheduleRepo,
+		Rollover:       scheduleApp.NewScheduleRollover(scheduleRepo, todoRepo, tzProv, capProv, uow),
+		FocusSweeper:   todoApp.NewFocusSessionSweeper(todoRepo, uow, cfg.FocusMaxDuration),
+		AuditErasure:   auditApp.NewUserDeletedEventHandler(audit),
+		AuthAudit:      auditApp.NewAuthEventHandler(audit),
+		DataExporter:   userApp.NewDataExportRequestedEventHandler(userRepo, exportRepo, uow, personalData...),
+		TodoRepo:       todoRepo,
+		UnitOfWork:     uow,
 		TokenProvider:  tokenProvider,
+		TokenDenylist:  denylist,
 	}, nil
 }
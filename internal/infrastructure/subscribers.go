@@ -8,8 +8,13 @@ import (
 
 	"github.com/danicc097/todo-ddd-example/internal/infrastructure/messaging"
 	infraRabbit "github.com/danicc097/todo-ddd-example/internal/infrastructure/rabbitmq"
+	auditApp "github.com/danicc097/todo-ddd-example/internal/modules/audit/application"
 	scheduleApp "github.com/danicc097/todo-ddd-example/internal/modules/schedule/application"
 	scheduleDomain "github.com/danicc097/todo-ddd-example/internal/modules/schedule/domain"
+	todoApp "github.com/danicc097/todo-ddd-example/internal/modules/todo/application"
+	todoDomain "github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userApp "github.com/danicc097/todo-ddd-example/internal/modules/user/application"
+	sharedApp "github.com/danicc097/todo-ddd-example/internal/shared/application"
 	sharedMessaging "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/messaging"
 )
 
@@ -17,10 +22,24 @@ type Closer interface {
 	Close()
 }
 
-func RegisterSubscribers(conn *rabbitmq.Conn, scheduleRepo scheduleDomain.ScheduleRepository) ([]Closer, error) {
+func RegisterSubscribers(
+	conn *rabbitmq.Conn,
+	scheduleRepo scheduleDomain.ScheduleRepository,
+	todoRepo todoDomain.TodoRepository,
+	uow sharedApp.UnitOfWork,
+	auditErasureHandler *auditApp.UserDeletedEventHandler,
+	authAuditHandler *auditApp.AuthEventHandler,
+	dataExportHandler *userApp.DataExportRequestedEventHandler,
+) ([]Closer, error) {
 	subscriber := infraRabbit.NewSubscriber(conn)
 	scheduleTracer := otel.Tracer("schedule-consumer")
+	todoTracer := otel.Tracer("todo-consumer")
+	auditTracer := otel.Tracer("audit-consumer")
+	userTracer := otel.Tracer("user-consumer")
 	todoDeletedHandler := scheduleApp.NewTodoDeletedEventHandler(scheduleRepo)
+	todoCompletedHandler := scheduleApp.NewTodoCompletedEventHandler(scheduleRepo, uow)
+	blockerResolvedHandler := todoApp.NewBlockerResolvedEventHandler(todoRepo, uow)
+	memberRemovedHandler := todoApp.NewMemberRemovedEventHandler(todoRepo, uow)
 
 	mw := sharedMessaging.TraceAndCausationMiddleware(scheduleTracer, func(ctx context.Context, d rabbitmq.Delivery) error {
 		return todoDeletedHandler.Handle(ctx, d.Body)
@@ -36,5 +55,119 @@ func RegisterSubscribers(conn *rabbitmq.Conn, scheduleRepo scheduleDomain.Schedu
 		return nil, err
 	}
 
-	return []Closer{todoDeletedConsumer}, nil
+	completedMw := sharedMessaging.TraceAndCausationMiddleware(scheduleTracer, func(ctx context.Context, d rabbitmq.Delivery) error {
+		return todoCompletedHandler.Handle(ctx, d.Body)
+	})
+
+	todoCompletedConsumer, err := subscriber.Subscribe(
+		messaging.Keys.ScheduleTodoCompletedQueue(),
+		messaging.Keys.TodoEventsExchange(),
+		[]string{"todo.completed.*", "todo.rolled_over.*"},
+		completedMw,
+	)
+	if err != nil {
+		todoDeletedConsumer.Close()
+
+		return nil, err
+	}
+
+	blockerMw := sharedMessaging.TraceAndCausationMiddleware(todoTracer, func(ctx context.Context, d rabbitmq.Delivery) error {
+		return blockerResolvedHandler.Handle(ctx, d.Body)
+	})
+
+	blockerResolvedConsumer, err := subscriber.Subscribe(
+		messaging.Keys.TodoBlockerResolvedQueue(),
+		messaging.Keys.TodoEventsExchange(),
+		[]string{"todo.completed.*", "todo.rolled_over.*", "todo.deleted.*"},
+		blockerMw,
+	)
+	if err != nil {
+		todoDeletedConsumer.Close()
+		todoCompletedConsumer.Close()
+
+		return nil, err
+	}
+
+	memberMw := sharedMessaging.TraceAndCausationMiddleware(todoTracer, func(ctx context.Context, d rabbitmq.Delivery) error {
+		return memberRemovedHandler.Handle(ctx, d.Body)
+	})
+
+	memberRemovedConsumer, err := subscriber.Subscribe(
+		messaging.Keys.TodoMemberRemovedQueue(),
+		messaging.Keys.TodoEventsExchange(),
+		[]string{"workspace.member_removed.*"},
+		memberMw,
+	)
+	if err != nil {
+		todoDeletedConsumer.Close()
+		todoCompletedConsumer.Close()
+		blockerResolvedConsumer.Close()
+
+		return nil, err
+	}
+
+	erasureMw := sharedMessaging.TraceAndCausationMiddleware(auditTracer, func(ctx context.Context, d rabbitmq.Delivery) error {
+		return auditErasureHandler.Handle(ctx, d.Body)
+	})
+
+	userDeletedConsumer, err := subscriber.Subscribe(
+		messaging.Keys.AuditUserDeletedQueue(),
+		messaging.Keys.TodoEventsExchange(),
+		[]string{"user.deleted.*"},
+		erasureMw,
+	)
+	if err != nil {
+		todoDeletedConsumer.Close()
+		todoCompletedConsumer.Close()
+		blockerResolvedConsumer.Close()
+		memberRemovedConsumer.Close()
+
+		return nil, err
+	}
+
+	exportMw := sharedMessaging.TraceAndCausationMiddleware(userTracer, func(ctx context.Context, d rabbitmq.Delivery) error {
+		return dataExportHandler.Handle(ctx, d.Body)
+	})
+
+	dataExportConsumer, err := subscriber.Subscribe(
+		messaging.Keys.UserDataExportQueue(),
+		messaging.Keys.TodoEventsExchange(),
+		[]string{"user.data_export_requested.*"},
+		exportMw,
+	)
+	if err != nil {
+		todoDeletedConsumer.Close()
+		todoCompletedConsumer.Close()
+		blockerResolvedConsumer.Close()
+		memberRemovedConsumer.Close()
+		userDeletedConsumer.Close()
+
+		return nil, err
+	}
+
+	authAuditMw := sharedMessaging.TraceAndCausationMiddleware(auditTracer, func(ctx context.Context, d rabbitmq.Delivery) error {
+		return authAuditHandler.Handle(ctx, d.Body)
+	})
+
+	authEventsConsumer, err := subscriber.Subscribe(
+		messaging.Keys.AuditAuthEventsQueue(),
+		messaging.Keys.TodoEventsExchange(),
+		[]string{"auth.*.*"},
+		authAuditMw,
+	)
+	if err != nil {
+		todoDeletedConsumer.Close()
+		todoCompletedConsumer.Close()
+		blockerResolvedConsumer.Close()
+		memberRemovedConsumer.Close()
+		userDeletedConsumer.Close()
+		dataExportConsumer.Close()
+
+		return nil, err
+	}
+
+	return []Closer{
+		todoDeletedConsumer, todoCompletedConsumer, blockerResolvedConsumer, memberRemovedConsumer,
+		userDeletedConsumer, dataExportConsumer, authEventsConsumer,
+	}, nil
 }
@@ -0,0 +1,30 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	auditDomain "github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+// AuditLogFilter narrows a workspace audit trail. Nil fields match everything.
+type AuditLogFilter struct {
+	AggregateType *shared.AggregateType
+	AggregateID   *uuid.UUID
+	ActorID       *uuid.UUID
+	Operation     *auditDomain.AuditOperation
+	From          *time.Time
+	To            *time.Time
+	Limit         int32
+	Offset        int32
+}
+
+//go:generate go tool gowrap gen -g -i AuditQueryService -t ../../../../templates/opentelemetry.gotmpl -o ../infrastructure/postgres/audit_query_service_tracing.gen.go
+type AuditQueryService interface {
+	// ListByWorkspace returns the newest logs first.
+	ListByWorkspace(ctx context.Context, wsID wsDomain.WorkspaceID, filter AuditLogFilter) ([]AuditLogReadModel, error)
+}
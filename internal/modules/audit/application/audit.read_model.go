@@ -0,0 +1,42 @@
+package application
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	auditDomain "github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+)
+
+// AuditLogReadModel leaves out the actor IP and user agent, which are kept for investigations only.
+type AuditLogReadModel struct {
+	ID            uuid.UUID
+	CorrelationID string
+	CausationID   string
+	ActorID       *uuid.UUID
+	AggregateType string
+	AggregateID   uuid.UUID
+	Operation     string
+	Changes       []FieldChangeReadModel
+	OccurredAt    time.Time
+}
+
+type FieldChangeReadModel struct {
+	Field  string
+	Before any
+	After  any
+}
+
+// RenderChanges lists a stored diff ordered by field name.
+func RenderChanges(changes map[string]auditDomain.FieldChange) []FieldChangeReadModel {
+	rendered := make([]FieldChangeReadModel, 0, len(changes))
+	for field, c := range changes {
+		rendered = append(rendered, FieldChangeReadModel{Field: field, Before: c.Before(), After: c.After()})
+	}
+
+	slices.SortFunc(rendered, func(a, b FieldChangeReadModel) int { return strings.Compare(a.Field, b.Field) })
+
+	return rendered
+}
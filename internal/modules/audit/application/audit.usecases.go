@@ -0,0 +1,11 @@
+package application
+
+import (
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+)
+
+type AuditUseCases struct {
+	GetWorkspaceAudit         application.RequestHandler[GetWorkspaceAuditQuery, GetWorkspaceAuditResponse]
+	VerifyWorkspaceAuditChain application.RequestHandler[VerifyWorkspaceAuditChainQuery, VerifyWorkspaceAuditChainResponse]
+	VerifyGlobalAuditChains   application.RequestHandler[VerifyGlobalAuditChainsQuery, VerifyGlobalAuditChainsResponse]
+}
@@ -0,0 +1,78 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+// AuthEventHandler records auth.* events in the audit trail of the user they concern.
+// The payload fields are logged as set values, next to the event type.
+type AuthEventHandler struct {
+	repo domain.AuditRepository
+}
+
+func NewAuthEventHandler(repo domain.AuditRepository) *AuthEventHandler {
+	return &AuthEventHandler{repo: repo}
+}
+
+func (h *AuthEventHandler) Handle(ctx context.Context, data []byte) error {
+	var envelope struct {
+		Event string         `json:"event"`
+		Data  map[string]any `json:"data"`
+	}
+
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return fmt.Errorf("failed to unmarshal auth event: %w", err)
+	}
+
+	rawUserID, _ := envelope.Data["user_id"].(string)
+
+	userID, err := uuid.Parse(rawUserID)
+	if err != nil {
+		return fmt.Errorf("invalid user_id in %s event: %w", envelope.Event, err)
+	}
+
+	changes := map[string]domain.FieldChange{
+		"event": domain.NewFieldChange(nil, envelope.Event),
+	}
+
+	for field, value := range envelope.Data {
+		if field == "user_id" || field == "event_version" {
+			continue
+		}
+
+		changes[field] = domain.NewFieldChange(nil, value)
+	}
+
+	meta := causation.FromContext(ctx)
+
+	var actorID *uuid.UUID
+	if meta.IsUser() {
+		actorID = &meta.UserID
+	}
+
+	auditLog, err := domain.NewAuditLog(
+		meta.CorrelationID,
+		meta.CausationID,
+		actorID,
+		meta.UserIP,
+		meta.UserAgent,
+		shared.AggUser,
+		userID,
+		nil,
+		domain.OpEvent,
+		changes,
+	)
+	if err != nil {
+		return fmt.Errorf("audit log creation failed: %w", err)
+	}
+
+	return h.repo.Save(ctx, auditLog)
+}
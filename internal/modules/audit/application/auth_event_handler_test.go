@@ -0,0 +1,53 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/audit/application"
+	"github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+	"github.com/danicc097/todo-ddd-example/internal/modules/audit/infrastructure/memory"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+func TestAuthEventHandler(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	adminID := uuid.New()
+
+	t.Run("records the event under the user", func(t *testing.T) {
+		repo := memory.NewAuditRepository()
+		handler := application.NewAuthEventHandler(repo)
+
+		ctx := causation.WithMetadata(context.Background(), causation.Metadata{UserID: adminID, UserIP: "10.0.0.1", CorrelationID: "corr"})
+		body := `{"event":"auth.totp_reset","version":1,"data":{"user_id":"` + userID.String() + `","reset_by":"` + adminID.String() + `","event_version":1}}`
+
+		require.NoError(t, handler.Handle(ctx, []byte(body)))
+
+		logs := repo.FindAll()
+		require.Len(t, logs, 1)
+		assert.Equal(t, shared.AggUser, logs[0].AggregateType())
+		assert.Equal(t, userID, logs[0].AggregateID())
+		assert.Nil(t, logs[0].WorkspaceID())
+		assert.Equal(t, domain.OpEvent.String(), logs[0].Operation())
+		assert.Equal(t, &adminID, logs[0].ActorID())
+		assert.Equal(t, "corr", logs[0].CorrelationID())
+		assert.Equal(t, map[string]domain.FieldChange{
+			"event":    domain.NewFieldChange(nil, "auth.totp_reset"),
+			"reset_by": domain.NewFieldChange(nil, adminID.String()),
+		}, logs[0].Changes())
+	})
+
+	t.Run("rejects events without a user", func(t *testing.T) {
+		handler := application.NewAuthEventHandler(memory.NewAuditRepository())
+
+		err := handler.Handle(context.Background(), []byte(`{"event":"auth.totp_disabled","data":{}}`))
+		assert.Error(t, err)
+	})
+}
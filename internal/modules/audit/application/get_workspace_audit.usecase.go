@@ -0,0 +1,78 @@
+package application
+
+import (
+	"context"
+
+	"github.com/danicc097/todo-ddd-example/internal/apperrors"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+const auditDefaultLimit = 20
+
+var ErrInvalidAuditRange = apperrors.New(apperrors.InvalidInput, "audit range start must be before its end")
+
+type WorkspaceProvider interface {
+	IsOwner(ctx context.Context, wsID wsDomain.WorkspaceID, userID userDomain.UserID) (bool, error)
+}
+
+type GetWorkspaceAuditQuery struct {
+	WorkspaceID wsDomain.WorkspaceID
+	Filter      AuditLogFilter
+}
+
+func (q *GetWorkspaceAuditQuery) Validate() error {
+	if q.Filter.Operation != nil {
+		if err := q.Filter.Operation.IsValid(); err != nil {
+			return apperrors.Wrap(err, apperrors.InvalidInput, err.Error())
+		}
+	}
+
+	if q.Filter.From != nil && q.Filter.To != nil && !q.Filter.From.Before(*q.Filter.To) {
+		return ErrInvalidAuditRange
+	}
+
+	return nil
+}
+
+type GetWorkspaceAuditResponse struct {
+	Logs []AuditLogReadModel
+}
+
+type GetWorkspaceAuditHandler struct {
+	qs     AuditQueryService
+	wsProv WorkspaceProvider
+}
+
+var _ application.RequestHandler[GetWorkspaceAuditQuery, GetWorkspaceAuditResponse] = (*GetWorkspaceAuditHandler)(nil)
+
+func NewGetWorkspaceAuditHandler(qs AuditQueryService, wsProv WorkspaceProvider) *GetWorkspaceAuditHandler {
+	return &GetWorkspaceAuditHandler{qs: qs, wsProv: wsProv}
+}
+
+func (h *GetWorkspaceAuditHandler) Handle(ctx context.Context, q GetWorkspaceAuditQuery) (GetWorkspaceAuditResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	isOwner, err := h.wsProv.IsOwner(ctx, q.WorkspaceID, userDomain.UserID(meta.UserID))
+	if err != nil {
+		return GetWorkspaceAuditResponse{}, err
+	}
+
+	if !isOwner && !meta.IsSystem() {
+		return GetWorkspaceAuditResponse{}, wsDomain.ErrNotOwner
+	}
+
+	filter := q.Filter
+	if filter.Limit == 0 {
+		filter.Limit = auditDefaultLimit
+	}
+
+	logs, err := h.qs.ListByWorkspace(ctx, q.WorkspaceID, filter)
+	if err != nil {
+		return GetWorkspaceAuditResponse{}, err
+	}
+
+	return GetWorkspaceAuditResponse{Logs: logs}, nil
+}
@@ -0,0 +1,55 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type UserDeletedEventPayload struct {
+	ID uuid.UUID `json:"id"`
+}
+
+// UserDeletedEventHandler pseudonymizes the audit trail of a deleted user.
+// Entries stay in their chains, but can no longer be linked back to the user or their IP,
+// whether they were the actor, the aggregate or referenced in the changes.
+type UserDeletedEventHandler struct {
+	repo domain.AuditRepository
+}
+
+func NewUserDeletedEventHandler(repo domain.AuditRepository) *UserDeletedEventHandler {
+	return &UserDeletedEventHandler{repo: repo}
+}
+
+func (h *UserDeletedEventHandler) Handle(ctx context.Context, data []byte) error {
+	var envelope struct {
+		Data UserDeletedEventPayload `json:"data"`
+	}
+
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		if err := json.Unmarshal(data, &envelope.Data); err != nil {
+			return fmt.Errorf("failed to unmarshal UserDeleted event: %w", err)
+		}
+	}
+
+	correlationID := causation.FromContext(ctx).CorrelationID
+	if correlationID == "" {
+		correlationID = uuid.New().String()
+	}
+
+	// a fresh pseudonym per user, so redelivery finds nothing left to erase
+	n, err := h.repo.EraseUser(ctx, envelope.Data.ID, uuid.New(), correlationID)
+	if err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "erased user from audit logs", slog.Int64("entries", n))
+
+	return nil
+}
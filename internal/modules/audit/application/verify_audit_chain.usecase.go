@@ -0,0 +1,102 @@
+package application
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	auditDomain "github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+const auditChainBatchSize = 500
+
+type VerifyWorkspaceAuditChainQuery struct {
+	WorkspaceID wsDomain.WorkspaceID
+}
+
+// AuditChainBreak is the first entry that doesn't fit the chain.
+// Position is 1-based and counts every entry of the chain.
+type AuditChainBreak struct {
+	LogID    uuid.UUID
+	Chain    auditDomain.ChainKey
+	Position int
+	Reason   auditDomain.ChainBreakReason
+}
+
+type VerifyWorkspaceAuditChainResponse struct {
+	CheckedEntries int
+	Break          *AuditChainBreak
+}
+
+type VerifyWorkspaceAuditChainHandler struct {
+	repo   auditDomain.AuditRepository
+	wsProv WorkspaceProvider
+}
+
+var _ application.RequestHandler[VerifyWorkspaceAuditChainQuery, VerifyWorkspaceAuditChainResponse] = (*VerifyWorkspaceAuditChainHandler)(nil)
+
+func NewVerifyWorkspaceAuditChainHandler(repo auditDomain.AuditRepository, wsProv WorkspaceProvider) *VerifyWorkspaceAuditChainHandler {
+	return &VerifyWorkspaceAuditChainHandler{repo: repo, wsProv: wsProv}
+}
+
+func (h *VerifyWorkspaceAuditChainHandler) Handle(ctx context.Context, q VerifyWorkspaceAuditChainQuery) (VerifyWorkspaceAuditChainResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	isOwner, err := h.wsProv.IsOwner(ctx, q.WorkspaceID, userDomain.UserID(meta.UserID))
+	if err != nil {
+		return VerifyWorkspaceAuditChainResponse{}, err
+	}
+
+	if !isOwner && !meta.IsSystem() {
+		return VerifyWorkspaceAuditChainResponse{}, wsDomain.ErrNotOwner
+	}
+
+	checked, brk, err := verifyChain(ctx, h.repo, auditDomain.WorkspaceChain(q.WorkspaceID.UUID()))
+	if err != nil {
+		return VerifyWorkspaceAuditChainResponse{}, err
+	}
+
+	return VerifyWorkspaceAuditChainResponse{CheckedEntries: checked, Break: brk}, nil
+}
+
+// verifyChain walks a chain from its first entry and returns the number of entries checked
+// up to and including the first one that doesn't fit.
+func verifyChain(ctx context.Context, repo auditDomain.AuditRepository, chain auditDomain.ChainKey) (int, *AuditChainBreak, error) {
+	v := auditDomain.NewChainVerifier()
+
+	for offset := int32(0); ; offset += auditChainBatchSize {
+		logs, err := repo.ListChain(ctx, chain, offset, auditChainBatchSize)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		for _, l := range logs {
+			brk, err := v.Check(l)
+			if err != nil {
+				return 0, nil, err
+			}
+
+			if brk != nil {
+				return v.Checked(), toChainBreak(chain, brk), nil
+			}
+		}
+
+		if len(logs) < auditChainBatchSize {
+			break
+		}
+	}
+
+	if brk := v.Finish(); brk != nil {
+		return v.Checked(), toChainBreak(chain, brk), nil
+	}
+
+	return v.Checked(), nil, nil
+}
+
+func toChainBreak(chain auditDomain.ChainKey, brk *auditDomain.ChainBreak) *AuditChainBreak {
+	return &AuditChainBreak{LogID: brk.LogID, Chain: chain, Position: brk.Position, Reason: brk.Reason}
+}
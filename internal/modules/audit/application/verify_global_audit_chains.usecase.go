@@ -0,0 +1,76 @@
+package application
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/danicc097/todo-ddd-example/internal/apperrors"
+	auditDomain "github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+var ErrNotAuditAdmin = apperrors.New(apperrors.Unauthorized, "only administrators can verify the global audit trail")
+
+type VerifyGlobalAuditChainsQuery struct{}
+
+type VerifyGlobalAuditChainsResponse struct {
+	CheckedChains  int
+	CheckedEntries int
+	Break          *AuditChainBreak
+}
+
+// VerifyGlobalAuditChainsHandler verifies the global audit trail, made of the logs outside any workspace
+// such as user and authentication events. They are chained per aggregate, so every chain is walked
+// until the first break.
+type VerifyGlobalAuditChainsHandler struct {
+	repo   auditDomain.AuditRepository
+	admins []uuid.UUID
+}
+
+var _ application.RequestHandler[VerifyGlobalAuditChainsQuery, VerifyGlobalAuditChainsResponse] = (*VerifyGlobalAuditChainsHandler)(nil)
+
+func NewVerifyGlobalAuditChainsHandler(repo auditDomain.AuditRepository, admins []uuid.UUID) *VerifyGlobalAuditChainsHandler {
+	return &VerifyGlobalAuditChainsHandler{repo: repo, admins: admins}
+}
+
+func (h *VerifyGlobalAuditChainsHandler) Handle(ctx context.Context, _ VerifyGlobalAuditChainsQuery) (VerifyGlobalAuditChainsResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	if !slices.Contains(h.admins, meta.UserID) && !meta.IsSystem() {
+		return VerifyGlobalAuditChainsResponse{}, ErrNotAuditAdmin
+	}
+
+	resp := VerifyGlobalAuditChainsResponse{}
+	after := auditDomain.ChainKey{}
+
+	for {
+		chains, err := h.repo.ListUnscopedChains(ctx, after, auditChainBatchSize)
+		if err != nil {
+			return VerifyGlobalAuditChainsResponse{}, err
+		}
+
+		for _, chain := range chains {
+			checked, brk, err := verifyChain(ctx, h.repo, chain)
+			if err != nil {
+				return VerifyGlobalAuditChainsResponse{}, err
+			}
+
+			resp.CheckedChains++
+			resp.CheckedEntries += checked
+
+			if brk != nil {
+				resp.Break = brk
+				return resp, nil
+			}
+
+			after = chain
+		}
+
+		if len(chains) < auditChainBatchSize {
+			return resp, nil
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/audit/application"
+	"github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+	"github.com/danicc097/todo-ddd-example/internal/modules/audit/infrastructure/memory"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+func TestVerifyGlobalAuditChains(t *testing.T) {
+	t.Parallel()
+
+	adminID := uuid.New()
+	wsID := uuid.New()
+	users := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+
+	repo := memory.NewAuditRepository()
+	ctx := context.Background()
+
+	for _, userID := range append(users, users[0]) {
+		l, err := domain.NewAuditLog("corr", "cause", &userID, "", "", shared.AggUser, userID, nil, domain.OpUpdate, nil)
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(ctx, l))
+	}
+
+	l, err := domain.NewAuditLog("corr", "cause", &users[0], "", "", shared.AggTodo, uuid.New(), &wsID, domain.OpCreate, nil)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, l))
+
+	handler := application.NewVerifyGlobalAuditChainsHandler(repo, []uuid.UUID{adminID})
+
+	t.Run("walks every chain outside workspaces", func(t *testing.T) {
+		adminCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: adminID})
+
+		resp, err := handler.Handle(adminCtx, application.VerifyGlobalAuditChainsQuery{})
+		require.NoError(t, err)
+		assert.Nil(t, resp.Break)
+		assert.Equal(t, len(users), resp.CheckedChains)
+		assert.Equal(t, len(users)+1, resp.CheckedEntries)
+	})
+
+	t.Run("accepts recorded erasures", func(t *testing.T) {
+		erasing := memory.NewAuditRepository()
+
+		l, err := domain.NewAuditLog("corr", "cause", &users[1], "10.0.0.1", "", shared.AggUser, users[1], nil, domain.OpUpdate, nil)
+		require.NoError(t, err)
+		require.NoError(t, erasing.Save(ctx, l))
+
+		n, err := erasing.EraseUser(ctx, users[1], uuid.New(), "corr")
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, n)
+
+		adminCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: adminID})
+
+		resp, err := application.NewVerifyGlobalAuditChainsHandler(erasing, []uuid.UUID{adminID}).Handle(adminCtx, application.VerifyGlobalAuditChainsQuery{})
+		require.NoError(t, err)
+		assert.Nil(t, resp.Break)
+		assert.Equal(t, 1, resp.CheckedChains)
+		assert.Equal(t, 2, resp.CheckedEntries)
+	})
+
+	t.Run("rejects non administrators", func(t *testing.T) {
+		userCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: users[0]})
+
+		_, err := handler.Handle(userCtx, application.VerifyGlobalAuditChainsQuery{})
+		assert.ErrorIs(t, err, application.ErrNotAuditAdmin)
+	})
+}
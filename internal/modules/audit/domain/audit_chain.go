@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+// ChainKey identifies a hash chain. Logs of a workspace share its chain, while logs outside
+// any workspace are chained per aggregate, so that unrelated users don't append to the same chain.
+//
+// Keys are comparable.
+type ChainKey struct {
+	workspaceID   uuid.UUID
+	aggregateType shared.AggregateType
+	aggregateID   uuid.UUID
+}
+
+func WorkspaceChain(workspaceID uuid.UUID) ChainKey {
+	return ChainKey{workspaceID: workspaceID}
+}
+
+func AggregateChain(aggType shared.AggregateType, aggID uuid.UUID) ChainKey {
+	return ChainKey{aggregateType: aggType, aggregateID: aggID}
+}
+
+// WorkspaceID is nil for aggregate chains.
+func (k ChainKey) WorkspaceID() *uuid.UUID {
+	if k.workspaceID == uuid.Nil {
+		return nil
+	}
+
+	return &k.workspaceID
+}
+
+func (k ChainKey) AggregateType() shared.AggregateType { return k.aggregateType }
+func (k ChainKey) AggregateID() uuid.UUID              { return k.aggregateID }
+
+// String matches the chain key stored with each log.
+func (k ChainKey) String() string {
+	if k.workspaceID != uuid.Nil {
+		return k.workspaceID.String()
+	}
+
+	return string(k.aggregateType) + ":" + k.aggregateID.String()
+}
+
+// ChainBreakReason explains why an entry doesn't fit the audit chain.
+type ChainBreakReason string
+
+const (
+	// ChainPrevHashMismatch means an entry before this one was removed, inserted or reordered.
+	ChainPrevHashMismatch ChainBreakReason = "PREVIOUS_HASH_MISMATCH"
+	// ChainHashMismatch means the entry itself was edited, including fields that weren't erased.
+	ChainHashMismatch ChainBreakReason = "HASH_MISMATCH"
+	// ChainUnrecordedErasure means the entry lost its PII salt without a later erasure log
+	// committing to its current content, so it may have been edited.
+	ChainUnrecordedErasure ChainBreakReason = "UNRECORDED_ERASURE"
+)
+
+// CheckLink verifies an entry against the hash of the entry before it.
+// It returns an empty reason if the link holds. The content of erased entries is checked by ChainVerifier.
+func CheckLink(log *AuditLog, prevHash string) (ChainBreakReason, error) {
+	if log.prevHash != prevHash {
+		return ChainPrevHashMismatch, nil
+	}
+
+	hash, err := log.ComputeHash()
+	if err != nil {
+		return "", err
+	}
+
+	if hash != log.hash {
+		return ChainHashMismatch, nil
+	}
+
+	if log.IsErased() {
+		return "", nil
+	}
+
+	content, err := log.erasableContent()
+	if err != nil {
+		return "", err
+	}
+
+	if saltedHash(log.piiSalt, content) != log.piiDigest {
+		return ChainHashMismatch, nil
+	}
+
+	return "", nil
+}
+
+// ChainBreak is the first entry that doesn't fit a chain. Position is 1-based.
+type ChainBreak struct {
+	LogID    uuid.UUID
+	Position int
+	Reason   ChainBreakReason
+}
+
+// ChainVerifier checks the entries of a chain in order, oldest first.
+// Erased entries can only be checked once the whole chain is seen, since the erasure log
+// committing to their content comes after them.
+type ChainVerifier struct {
+	prevHash    string
+	position    int
+	erased      []erasedEntry
+	commitments map[uuid.UUID]string
+}
+
+type erasedEntry struct {
+	logID    uuid.UUID
+	position int
+	digest   string
+}
+
+func NewChainVerifier() *ChainVerifier {
+	return &ChainVerifier{commitments: map[uuid.UUID]string{}}
+}
+
+// Check verifies the next entry and returns a break if it doesn't fit.
+func (v *ChainVerifier) Check(log *AuditLog) (*ChainBreak, error) {
+	v.position++
+
+	reason, err := CheckLink(log, v.prevHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if reason != "" {
+		return &ChainBreak{LogID: log.ID(), Position: v.position, Reason: reason}, nil
+	}
+
+	if log.IsErased() {
+		digest, err := log.ErasedContentDigest()
+		if err != nil {
+			return nil, err
+		}
+
+		v.erased = append(v.erased, erasedEntry{logID: log.ID(), position: v.position, digest: digest})
+	}
+
+	// only the latest erasure of an entry matches its current content
+	for id, digest := range log.ErasureCommitments() {
+		v.commitments[id] = digest
+	}
+
+	v.prevHash = log.Hash()
+
+	return nil, nil
+}
+
+// Finish returns the first erased entry that no later erasure log commits to.
+func (v *ChainVerifier) Finish() *ChainBreak {
+	for _, e := range v.erased {
+		if v.commitments[e.logID] != e.digest {
+			return &ChainBreak{LogID: e.logID, Position: e.position, Reason: ChainUnrecordedErasure}
+		}
+	}
+
+	return nil
+}
+
+// Checked is the number of entries checked so far.
+func (v *ChainVerifier) Checked() int {
+	return v.position
+}
@@ -0,0 +1,217 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	auditDomain "github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+func TestCheckLink(t *testing.T) {
+	t.Parallel()
+
+	newChain := func(t *testing.T) (*auditDomain.AuditLog, *auditDomain.AuditLog) {
+		t.Helper()
+
+		aggID := uuid.New()
+
+		first, err := auditDomain.NewAuditLog("corr", "cause", nil, "", "", shared.AggTodo, aggID, nil, auditDomain.OpCreate,
+			map[string]auditDomain.FieldChange{"title": auditDomain.NewFieldChange(nil, "a")})
+		require.NoError(t, err)
+		require.NoError(t, first.ChainTo(""))
+
+		second, err := auditDomain.NewAuditLog("corr", "cause", nil, "", "", shared.AggTodo, aggID, nil, auditDomain.OpUpdate,
+			map[string]auditDomain.FieldChange{"title": auditDomain.NewFieldChange("a", "b")})
+		require.NoError(t, err)
+		require.NoError(t, second.ChainTo(first.Hash()))
+
+		return first, second
+	}
+
+	t.Run("intact", func(t *testing.T) {
+		first, second := newChain(t)
+
+		assert.Equal(t, first.Hash(), second.PrevHash())
+		assert.Len(t, second.Hash(), 64)
+
+		reason, err := auditDomain.CheckLink(first, "")
+		require.NoError(t, err)
+		assert.Empty(t, reason)
+
+		reason, err = auditDomain.CheckLink(second, first.Hash())
+		require.NoError(t, err)
+		assert.Empty(t, reason)
+	})
+
+	t.Run("edited entry", func(t *testing.T) {
+		first, _ := newChain(t)
+
+		edited := auditDomain.ReconstituteAuditLog(auditDomain.ReconstituteAuditLogArgs{
+			ID:            first.ID(),
+			CorrelationID: first.CorrelationID(),
+			CausationID:   first.CausationID(),
+			AggregateType: first.AggregateType(),
+			AggregateID:   first.AggregateID(),
+			Operation:     auditDomain.OpCreate,
+			Changes:       map[string]auditDomain.FieldChange{"title": auditDomain.NewFieldChange(nil, "forged")},
+			OccurredAt:    first.OccurredAt(),
+			PrevHash:      first.PrevHash(),
+			Hash:          first.Hash(),
+		})
+
+		reason, err := auditDomain.CheckLink(edited, "")
+		require.NoError(t, err)
+		assert.Equal(t, auditDomain.ChainHashMismatch, reason)
+	})
+
+	t.Run("removed entry", func(t *testing.T) {
+		_, second := newChain(t)
+
+		reason, err := auditDomain.CheckLink(second, "")
+		require.NoError(t, err)
+		assert.Equal(t, auditDomain.ChainPrevHashMismatch, reason)
+	})
+
+	t.Run("erased user", func(t *testing.T) {
+		userID := uuid.New()
+		pseudonym := uuid.New()
+
+		log, err := auditDomain.NewAuditLog("corr", "cause", &userID, "10.0.0.1", "", shared.AggUser, userID, nil, auditDomain.OpUpdate,
+			map[string]auditDomain.FieldChange{"assignee_id": auditDomain.NewFieldChange(nil, userID.String())})
+		require.NoError(t, err)
+		require.NoError(t, log.ChainTo(""))
+
+		erased, err := log.Erase(userID, pseudonym)
+		require.NoError(t, err)
+		assert.True(t, erased)
+
+		assert.True(t, log.IsErased())
+		assert.Equal(t, pseudonym, *log.ActorID())
+		assert.Empty(t, log.ActorIP())
+		assert.Equal(t, pseudonym, log.AggregateID())
+		assert.Equal(t, pseudonym.String(), log.Changes()["assignee_id"].After())
+
+		reason, err := auditDomain.CheckLink(log, "")
+		require.NoError(t, err)
+		assert.Empty(t, reason)
+	})
+
+	t.Run("edited actor", func(t *testing.T) {
+		actorID := uuid.New()
+
+		log, err := auditDomain.NewAuditLog("corr", "cause", &actorID, "10.0.0.1", "", shared.AggTodo, uuid.New(), nil, auditDomain.OpCreate, nil)
+		require.NoError(t, err)
+		require.NoError(t, log.ChainTo(""))
+
+		forged := uuid.New()
+		edited := auditDomain.ReconstituteAuditLog(auditDomain.ReconstituteAuditLogArgs{
+			ID:            log.ID(),
+			CorrelationID: log.CorrelationID(),
+			CausationID:   log.CausationID(),
+			ActorID:       &forged,
+			ActorIP:       log.ActorIP(),
+			AggregateType: log.AggregateType(),
+			AggregateID:   log.AggregateID(),
+			Operation:     auditDomain.OpCreate,
+			OccurredAt:    log.OccurredAt(),
+			PrevHash:      log.PrevHash(),
+			Hash:          log.Hash(),
+			PIISalt:       log.PIISalt(),
+			PIIDigest:     log.PIIDigest(),
+		})
+
+		reason, err := auditDomain.CheckLink(edited, "")
+		require.NoError(t, err)
+		assert.Equal(t, auditDomain.ChainHashMismatch, reason)
+	})
+}
+
+func TestChainVerifier(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	pseudonym := uuid.New()
+
+	newErasedChain := func(t *testing.T) (*auditDomain.AuditLog, auditDomain.ChainKey) {
+		t.Helper()
+
+		log, err := auditDomain.NewAuditLog("corr", "cause", &userID, "10.0.0.1", "", shared.AggTodo, uuid.New(), nil, auditDomain.OpCreate,
+			map[string]auditDomain.FieldChange{"title": auditDomain.NewFieldChange(nil, "a")})
+		require.NoError(t, err)
+		require.NoError(t, log.ChainTo(""))
+
+		erased, err := log.Erase(userID, pseudonym)
+		require.NoError(t, err)
+		require.True(t, erased)
+
+		return log, log.Chain()
+	}
+
+	verify := func(t *testing.T, logs ...*auditDomain.AuditLog) *auditDomain.ChainBreak {
+		t.Helper()
+
+		v := auditDomain.NewChainVerifier()
+
+		for _, l := range logs {
+			brk, err := v.Check(l)
+			require.NoError(t, err)
+
+			if brk != nil {
+				return brk
+			}
+		}
+
+		return v.Finish()
+	}
+
+	t.Run("recorded erasure", func(t *testing.T) {
+		log, chain := newErasedChain(t)
+
+		erasure, err := auditDomain.NewErasureLog("corr", chain, pseudonym, []*auditDomain.AuditLog{log})
+		require.NoError(t, err)
+		require.NoError(t, erasure.ChainTo(log.Hash()))
+
+		assert.Nil(t, verify(t, log, erasure))
+	})
+
+	t.Run("unrecorded erasure", func(t *testing.T) {
+		log, _ := newErasedChain(t)
+
+		brk := verify(t, log)
+		require.NotNil(t, brk)
+		assert.Equal(t, log.ID(), brk.LogID)
+		assert.Equal(t, 1, brk.Position)
+		assert.Equal(t, auditDomain.ChainUnrecordedErasure, brk.Reason)
+	})
+
+	t.Run("edited after erasure", func(t *testing.T) {
+		log, chain := newErasedChain(t)
+
+		erasure, err := auditDomain.NewErasureLog("corr", chain, pseudonym, []*auditDomain.AuditLog{log})
+		require.NoError(t, err)
+		require.NoError(t, erasure.ChainTo(log.Hash()))
+
+		edited := auditDomain.ReconstituteAuditLog(auditDomain.ReconstituteAuditLogArgs{
+			ID:            log.ID(),
+			CorrelationID: log.CorrelationID(),
+			CausationID:   log.CausationID(),
+			ActorID:       log.ActorID(),
+			AggregateType: log.AggregateType(),
+			AggregateID:   log.AggregateID(),
+			Operation:     auditDomain.OpCreate,
+			Changes:       map[string]auditDomain.FieldChange{"title": auditDomain.NewFieldChange(nil, "forged")},
+			OccurredAt:    log.OccurredAt(),
+			PrevHash:      log.PrevHash(),
+			Hash:          log.Hash(),
+			PIIDigest:     log.PIIDigest(),
+		})
+
+		brk := verify(t, edited, erasure)
+		require.NotNil(t, brk)
+		assert.Equal(t, auditDomain.ChainUnrecordedErasure, brk.Reason)
+	})
+}
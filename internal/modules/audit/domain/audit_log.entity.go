@@ -1,9 +1,13 @@
 package domain
 
 import (
+	"bytes"
+	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,6 +15,8 @@ import (
 	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
 )
 
+const piiSaltSize = 32
+
 type AuditLog struct {
 	id            uuid.UUID
 	correlationID string
@@ -20,9 +26,14 @@ type AuditLog struct {
 	userAgentHash string
 	aggregateType shared.AggregateType
 	aggregateID   uuid.UUID
+	workspaceID   *uuid.UUID // nil for aggregates outside any workspace
 	operation     AuditOperation
-	changes       map[string]any
+	changes       map[string]FieldChange
 	occurredAt    time.Time
+	prevHash      string // hash of the previous entry in the same chain
+	hash          string
+	piiSalt       []byte // nil once a user is erased from the entry
+	piiDigest     string
 }
 
 func NewAuditLog(
@@ -33,8 +44,9 @@ func NewAuditLog(
 	userAgentRaw string,
 	aggType shared.AggregateType,
 	aggID uuid.UUID,
+	workspaceID *uuid.UUID,
 	op AuditOperation,
-	changes map[string]any,
+	changes map[string]FieldChange,
 ) (*AuditLog, error) {
 	if err := op.IsValid(); err != nil {
 		return nil, err
@@ -44,7 +56,10 @@ func NewAuditLog(
 		return nil, errors.New("audit log requires correlation_id")
 	}
 
-	return &AuditLog{
+	salt := make([]byte, piiSaltSize)
+	_, _ = rand.Read(salt)
+
+	log := &AuditLog{
 		id:            uuid.New(),
 		correlationID: correlationID,
 		causationID:   causationID,
@@ -53,10 +68,271 @@ func NewAuditLog(
 		userAgentHash: hashString(userAgentRaw), // only care whether it changed
 		aggregateType: aggType,
 		aggregateID:   aggID,
+		workspaceID:   workspaceID,
 		operation:     op,
 		changes:       changes,
-		occurredAt:    time.Now(),
-	}, nil
+		occurredAt:    time.Now().UTC().Truncate(time.Microsecond), // postgres precision, so the hash survives a round trip
+		piiSalt:       salt,
+	}
+
+	content, err := log.erasableContent()
+	if err != nil {
+		return nil, err
+	}
+
+	log.piiDigest = saltedHash(salt, content)
+
+	return log, nil
+}
+
+// NewErasureLog records that a user was erased from earlier entries of a chain.
+// It commits to the content of each erased entry, so that erased entries can be told apart from
+// entries whose salt was dropped to hide an edit.
+func NewErasureLog(correlationID string, chain ChainKey, pseudonym uuid.UUID, erased []*AuditLog) (*AuditLog, error) {
+	changes := make(map[string]FieldChange, len(erased))
+
+	for _, l := range erased {
+		digest, err := l.ErasedContentDigest()
+		if err != nil {
+			return nil, err
+		}
+
+		changes[l.id.String()] = NewFieldChange(nil, digest)
+	}
+
+	aggType, aggID := chain.AggregateType(), chain.AggregateID()
+	if chain.WorkspaceID() != nil {
+		aggType, aggID = shared.AggUser, pseudonym
+	}
+
+	return NewAuditLog(correlationID, correlationID, nil, "", "", aggType, aggID, chain.WorkspaceID(), OpErase, changes)
+}
+
+type ReconstituteAuditLogArgs struct {
+	ID            uuid.UUID
+	CorrelationID string
+	CausationID   string
+	ActorID       *uuid.UUID
+	ActorIP       string
+	UserAgentHash string
+	AggregateType shared.AggregateType
+	AggregateID   uuid.UUID
+	WorkspaceID   *uuid.UUID
+	Operation     AuditOperation
+	Changes       map[string]FieldChange
+	OccurredAt    time.Time
+	PrevHash      string
+	Hash          string
+	PIISalt       []byte
+	PIIDigest     string
+}
+
+func ReconstituteAuditLog(args ReconstituteAuditLogArgs) *AuditLog {
+	return &AuditLog{
+		id:            args.ID,
+		correlationID: args.CorrelationID,
+		causationID:   args.CausationID,
+		actorID:       args.ActorID,
+		actorIP:       args.ActorIP,
+		userAgentHash: args.UserAgentHash,
+		aggregateType: args.AggregateType,
+		aggregateID:   args.AggregateID,
+		workspaceID:   args.WorkspaceID,
+		operation:     args.Operation,
+		changes:       args.Changes,
+		occurredAt:    args.OccurredAt,
+		prevHash:      args.PrevHash,
+		hash:          args.Hash,
+		piiSalt:       args.PIISalt,
+		piiDigest:     args.PIIDigest,
+	}
+}
+
+// ChainTo links the entry after the one with the given hash, which is empty for the first entry.
+func (a *AuditLog) ChainTo(prevHash string) error {
+	a.prevHash = prevHash
+
+	hash, err := a.ComputeHash()
+	if err != nil {
+		return err
+	}
+
+	a.hash = hash
+
+	return nil
+}
+
+// ComputeHash returns the SHA-256 of the previous hash and every stored field.
+// Fields that may reference a user are covered through the salted PII digest instead,
+// so erasing them keeps the chain intact.
+func (a *AuditLog) ComputeHash() (string, error) {
+	payload := struct {
+		PrevHash      string     `json:"prev_hash"`
+		ID            uuid.UUID  `json:"id"`
+		WorkspaceID   *uuid.UUID `json:"workspace_id"`
+		CorrelationID string     `json:"correlation_id"`
+		CausationID   string     `json:"causation_id"`
+		PIIDigest     string     `json:"pii_digest"`
+		UserAgentHash string     `json:"user_agent_hash"`
+		AggregateType string     `json:"aggregate_type"`
+		Operation     string     `json:"operation"`
+		OccurredAt    string     `json:"occurred_at"`
+	}{
+		PrevHash:      a.prevHash,
+		ID:            a.id,
+		WorkspaceID:   a.workspaceID,
+		CorrelationID: a.correlationID,
+		CausationID:   a.causationID,
+		PIIDigest:     a.piiDigest,
+		UserAgentHash: a.userAgentHash,
+		AggregateType: string(a.aggregateType),
+		Operation:     a.operation.String(),
+		OccurredAt:    a.occurredAt.UTC().Format(time.RFC3339Nano),
+	}
+
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal audit log %s for hashing: %w", a.id, err)
+	}
+
+	return hashString(string(b)), nil
+}
+
+// Erase replaces every reference to userID with pseudonym: the actor, the aggregate and values in the changes.
+// The actor IP is dropped along with the actor. It reports whether the entry referenced the user.
+func (a *AuditLog) Erase(userID, pseudonym uuid.UUID) (bool, error) {
+	erased := false
+
+	if a.actorID != nil && *a.actorID == userID {
+		a.actorID = &pseudonym
+		a.actorIP = ""
+		erased = true
+	}
+
+	if a.aggregateID == userID {
+		a.aggregateID = pseudonym
+		erased = true
+	}
+
+	for field, c := range a.changes {
+		before, beforeErased, err := replaceID(c.before, userID, pseudonym)
+		if err != nil {
+			return false, err
+		}
+
+		after, afterErased, err := replaceID(c.after, userID, pseudonym)
+		if err != nil {
+			return false, err
+		}
+
+		if beforeErased || afterErased {
+			a.changes[field] = NewFieldChange(before, after)
+			erased = true
+		}
+	}
+
+	if erased {
+		a.piiSalt = nil
+	}
+
+	return erased, nil
+}
+
+// IsErased reports whether a user was erased from the entry, after which the PII digest can't be checked.
+func (a *AuditLog) IsErased() bool {
+	return a.piiSalt == nil
+}
+
+// ErasedContentDigest is the unsalted SHA-256 of the fields covered by the PII digest.
+// Erasure logs commit to it, since it no longer holds personal data once the entry is erased.
+func (a *AuditLog) ErasedContentDigest() (string, error) {
+	content, err := a.erasableContent()
+	if err != nil {
+		return "", err
+	}
+
+	return hashString(string(content)), nil
+}
+
+// ErasureCommitments returns the erased content digest recorded for each entry by an erasure log.
+func (a *AuditLog) ErasureCommitments() map[uuid.UUID]string {
+	if a.operation != OpErase {
+		return nil
+	}
+
+	commitments := make(map[uuid.UUID]string, len(a.changes))
+
+	for id, c := range a.changes {
+		logID, err := uuid.Parse(id)
+		if err != nil {
+			continue
+		}
+
+		if digest, ok := c.after.(string); ok {
+			commitments[logID] = digest
+		}
+	}
+
+	return commitments
+}
+
+// erasableContent holds the fields that may reference a user.
+func (a *AuditLog) erasableContent() ([]byte, error) {
+	changes := a.changes
+	if changes == nil {
+		changes = map[string]FieldChange{}
+	}
+
+	b, err := json.Marshal(struct {
+		ActorID     *uuid.UUID             `json:"actor_id"`
+		ActorIP     string                 `json:"actor_ip"`
+		AggregateID uuid.UUID              `json:"aggregate_id"`
+		Changes     map[string]FieldChange `json:"changes"`
+	}{
+		ActorID:     a.actorID,
+		ActorIP:     a.actorIP,
+		AggregateID: a.aggregateID,
+		Changes:     changes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal audit log %s content: %w", a.id, err)
+	}
+
+	return b, nil
+}
+
+// replaceID replaces the user ID anywhere in a change value, including within longer strings and keys.
+// UUIDs need no escaping, so replacing them in the JSON form stored for the value is enough.
+func replaceID(v any, userID, pseudonym uuid.UUID) (any, bool, error) {
+	if v == nil {
+		return nil, false, nil
+	}
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to marshal audit change: %w", err)
+	}
+
+	if !bytes.Contains(raw, []byte(userID.String())) {
+		return v, false, nil
+	}
+
+	var replaced any
+	if err := json.Unmarshal(bytes.ReplaceAll(raw, []byte(userID.String()), []byte(pseudonym.String())), &replaced); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal audit change: %w", err)
+	}
+
+	return replaced, true, nil
+}
+
+// saltedHash commits to the fields that may reference a user. The random salt is dropped on erasure,
+// so the digest can't be brute forced from the few possible user IDs and IPs.
+func saltedHash(salt, content []byte) string {
+	h := sha256.New()
+	h.Write(salt)
+	h.Write(content)
+
+	return hex.EncodeToString(h.Sum(nil))
 }
 
 func hashString(s string) string {
@@ -69,6 +345,15 @@ func hashString(s string) string {
 	return hex.EncodeToString(h[:])
 }
 
+// Chain returns the chain the log is appended to.
+func (a *AuditLog) Chain() ChainKey {
+	if a.workspaceID != nil {
+		return WorkspaceChain(*a.workspaceID)
+	}
+
+	return AggregateChain(a.aggregateType, a.aggregateID)
+}
+
 func (a *AuditLog) ID() uuid.UUID                       { return a.id }
 func (a *AuditLog) CorrelationID() string               { return a.correlationID }
 func (a *AuditLog) CausationID() string                 { return a.causationID }
@@ -77,6 +362,11 @@ func (a *AuditLog) ActorIP() string                     { return a.actorIP }
 func (a *AuditLog) UserAgentHash() string               { return a.userAgentHash }
 func (a *AuditLog) AggregateType() shared.AggregateType { return shared.AggregateType(a.aggregateType) }
 func (a *AuditLog) AggregateID() uuid.UUID              { return a.aggregateID }
+func (a *AuditLog) WorkspaceID() *uuid.UUID             { return a.workspaceID }
 func (a *AuditLog) Operation() string                   { return a.operation.String() }
-func (a *AuditLog) Changes() map[string]any             { return a.changes }
+func (a *AuditLog) Changes() map[string]FieldChange     { return a.changes }
 func (a *AuditLog) OccurredAt() time.Time               { return a.occurredAt }
+func (a *AuditLog) PrevHash() string                    { return a.prevHash }
+func (a *AuditLog) Hash() string                        { return a.hash }
+func (a *AuditLog) PIISalt() []byte                     { return a.piiSalt }
+func (a *AuditLog) PIIDigest() string                   { return a.piiDigest }
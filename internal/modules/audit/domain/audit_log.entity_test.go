@@ -24,7 +24,7 @@ func TestAuditLog_Instantiation(t *testing.T) {
 		ip := "127.0.0.1"
 		ua := "Mozilla/5.0"
 		aggID := uuid.New()
-		changes := map[string]any{"field": "new"}
+		changes := map[string]auditDomain.FieldChange{"field": auditDomain.NewFieldChange("old", "new")}
 
 		log, err := auditDomain.NewAuditLog(
 			corrID,
@@ -34,6 +34,7 @@ func TestAuditLog_Instantiation(t *testing.T) {
 			ua,
 			shared.AggTodo, // Use shared.AggTodo
 			aggID,
+			nil,
 			auditDomain.OpCreate,
 			changes,
 		)
@@ -63,6 +64,7 @@ func TestAuditLog_Instantiation(t *testing.T) {
 			"ua",
 			shared.AggTodo, // Use shared.AggTodo
 			uuid.New(),
+			nil,
 			auditDomain.OpCreate,
 			nil,
 		)
@@ -13,11 +13,15 @@ const (
 	OpUpsert AuditOperation = "UPSERT"
 	OpDelete AuditOperation = "DELETE"
 	OpRead   AuditOperation = "READ"
+	// OpEvent records a domain event that isn't tied to a repository operation, e.g. a recovery code being used.
+	OpEvent AuditOperation = "EVENT"
+	// OpErase records the erasure of a user from earlier entries of the same chain.
+	OpErase AuditOperation = "ERASE"
 )
 
 func (o AuditOperation) IsValid() error {
 	switch o {
-	case OpCreate, OpUpdate, OpDelete, OpRead, OpUpsert:
+	case OpCreate, OpUpdate, OpDelete, OpRead, OpUpsert, OpEvent, OpErase:
 		return nil
 	default:
 		return fmt.Errorf("invalid audit operation: %s", o)
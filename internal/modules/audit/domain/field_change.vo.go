@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// RedactedValue replaces the values of sensitive fields in audit diffs.
+const RedactedValue = "[REDACTED]"
+
+// FieldChange holds the value of a single audited field before and after an operation.
+// Before is nil for created entities and After is nil for deleted ones.
+type FieldChange struct {
+	before any
+	after  any
+}
+
+func NewFieldChange(before, after any) FieldChange {
+	return FieldChange{before: before, after: after}
+}
+
+func (c FieldChange) Before() any { return c.before }
+func (c FieldChange) After() any  { return c.after }
+
+type fieldChangeJSON struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+func (c FieldChange) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fieldChangeJSON{Before: c.before, After: c.after})
+}
+
+func (c *FieldChange) UnmarshalJSON(data []byte) error {
+	var v fieldChangeJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*c = FieldChange{before: v.Before, after: v.After}
+
+	return nil
+}
+
+// DiffSnapshots compares two entity snapshots field by field and returns the fields that differ.
+// A nil snapshot stands for a missing entity.
+// Values are compared by their JSON form, so the result is what gets stored.
+// Redacted fields still show up when they change, with RedactedValue in place of any set value.
+func DiffSnapshots(before, after map[string]any, redacted []string) (map[string]FieldChange, error) {
+	b, err := normalizeSnapshot(before)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := normalizeSnapshot(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make(map[string]FieldChange)
+
+	for field, bv := range b {
+		if av, ok := a[field]; !ok || !reflect.DeepEqual(bv, av) {
+			changes[field] = FieldChange{before: bv, after: a[field]}
+		}
+	}
+
+	for field, av := range a {
+		if _, ok := b[field]; !ok {
+			changes[field] = FieldChange{after: av}
+		}
+	}
+
+	for field, c := range changes {
+		if slices.Contains(redacted, field) {
+			changes[field] = FieldChange{before: redact(c.before), after: redact(c.after)}
+		}
+	}
+
+	return changes, nil
+}
+
+func normalizeSnapshot(snapshot map[string]any) (map[string]any, error) {
+	if snapshot == nil {
+		return map[string]any{}, nil
+	}
+
+	raw, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal audit snapshot: %w", err)
+	}
+
+	var normalized map[string]any
+	if err := json.Unmarshal(raw, &normalized); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal audit snapshot: %w", err)
+	}
+
+	return normalized, nil
+}
+
+func redact(v any) any {
+	if v == nil {
+		return nil
+	}
+
+	return RedactedValue
+}
@@ -0,0 +1,101 @@
+package domain_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	auditDomain "github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+)
+
+func TestDiffSnapshots(t *testing.T) {
+	t.Parallel()
+
+	due := time.Date(2026, 1, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		before   map[string]any
+		after    map[string]any
+		redacted []string
+		want     map[string]auditDomain.FieldChange
+	}{
+		{
+			name:   "created",
+			before: nil,
+			after:  map[string]any{"title": "a", "due_date": &due},
+			want: map[string]auditDomain.FieldChange{
+				"title":    auditDomain.NewFieldChange(nil, "a"),
+				"due_date": auditDomain.NewFieldChange(nil, "2026-01-15T09:00:00Z"),
+			},
+		},
+		{
+			name:   "deleted",
+			before: map[string]any{"title": "a"},
+			after:  nil,
+			want:   map[string]auditDomain.FieldChange{"title": auditDomain.NewFieldChange("a", nil)},
+		},
+		{
+			name:   "only changed fields",
+			before: map[string]any{"title": "a", "tags": []string{"x"}, "due_date": &due},
+			after:  map[string]any{"title": "b", "tags": []string{"x"}, "due_date": nil},
+			want: map[string]auditDomain.FieldChange{
+				"title":    auditDomain.NewFieldChange("a", "b"),
+				"due_date": auditDomain.NewFieldChange("2026-01-15T09:00:00Z", nil),
+			},
+		},
+		{
+			name:   "unchanged",
+			before: map[string]any{"title": "a", "capacity": 3},
+			after:  map[string]any{"title": "a", "capacity": 3},
+			want:   map[string]auditDomain.FieldChange{},
+		},
+		{
+			name:     "redacted",
+			before:   map[string]any{"password_hash": "old", "secret": nil, "status": "DISABLED"},
+			after:    map[string]any{"password_hash": "new", "secret": []byte("s"), "status": "PENDING"},
+			redacted: []string{"password_hash", "secret"},
+			want: map[string]auditDomain.FieldChange{
+				"password_hash": auditDomain.NewFieldChange(auditDomain.RedactedValue, auditDomain.RedactedValue),
+				"secret":        auditDomain.NewFieldChange(nil, auditDomain.RedactedValue),
+				"status":        auditDomain.NewFieldChange("DISABLED", "PENDING"),
+			},
+		},
+		{
+			name:     "redacted unchanged",
+			before:   map[string]any{"password_hash": "same"},
+			after:    map[string]any{"password_hash": "same"},
+			redacted: []string{"password_hash"},
+			want:     map[string]auditDomain.FieldChange{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := auditDomain.DiffSnapshots(tt.before, tt.after, tt.redacted)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFieldChange_JSON(t *testing.T) {
+	t.Parallel()
+
+	c := auditDomain.NewFieldChange("a", nil)
+
+	raw, err := json.Marshal(c)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"before":"a","after":null}`, string(raw))
+
+	var decoded auditDomain.FieldChange
+	require.NoError(t, json.Unmarshal(raw, &decoded))
+	assert.Equal(t, c, decoded)
+	assert.Equal(t, "a", decoded.Before())
+	assert.Nil(t, decoded.After())
+}
@@ -1,8 +1,25 @@
 package domain
 
-import "context"
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+//go:generate go tool gowrap gen -g -i AuditRepository -t ../../../../templates/opentelemetry.gotmpl -o ../infrastructure/postgres/audit_repository_tracing.gen.go
 
 // AuditRepository allows saving audit logs.
 type AuditRepository interface {
+	// Save joins the caller's transaction if any, so logs are only kept for committed changes.
+	// It chains the log after the latest one of its chain.
 	Save(ctx context.Context, log *AuditLog) error
+	// ListChain returns the logs of a chain, oldest first.
+	ListChain(ctx context.Context, chain ChainKey, offset, limit int32) ([]*AuditLog, error)
+	// ListUnscopedChains returns the chains of logs outside any workspace that sort after the given one,
+	// ordered by aggregate. The zero ChainKey starts from the first chain.
+	ListUnscopedChains(ctx context.Context, after ChainKey, limit int32) ([]ChainKey, error)
+	// EraseUser replaces every reference to userID with pseudonym, as in AuditLog.Erase, and appends
+	// an erasure log to each affected chain so the erased entries remain verifiable.
+	// It returns the number of erased entries.
+	EraseUser(ctx context.Context, userID, pseudonym uuid.UUID, correlationID string) (int64, error)
 }
@@ -2,10 +2,12 @@ package decorator
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
 
+	"github.com/danicc097/todo-ddd-example/internal/apperrors"
 	auditDomain "github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
 	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
 	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
@@ -17,11 +19,16 @@ type Identifiable[ID any] interface {
 
 type ChangeExtractorFunc[T any] func(entity T) map[string]any
 
+// WorkspaceScopeFunc returns the workspace an entity belongs to, if any.
+type WorkspaceScopeFunc[T any] func(entity T) *uuid.UUID
+
 type AuditRepoDecorator[T Identifiable[ID], ID any] struct {
 	auditRepo     auditDomain.AuditRepository
 	aggregateType shared.AggregateType
 	extractor     ChangeExtractorFunc[T]
 	idToUUID      func(ID) uuid.UUID
+	scope         WorkspaceScopeFunc[T]
+	redacted      []string
 }
 
 func NewAuditRepoDecorator[T Identifiable[ID], ID any](
@@ -29,27 +36,58 @@ func NewAuditRepoDecorator[T Identifiable[ID], ID any](
 	aggType shared.AggregateType,
 	extractor ChangeExtractorFunc[T],
 	idToUUID func(ID) uuid.UUID,
+	scope WorkspaceScopeFunc[T],
 ) *AuditRepoDecorator[T, ID] {
 	return &AuditRepoDecorator[T, ID]{
 		auditRepo:     auditRepo,
 		aggregateType: aggType,
 		extractor:     extractor,
 		idToUUID:      idToUUID,
+		scope:         scope,
 	}
 }
 
+// WithRedactedFields hides the values of the given extracted fields in diffs, e.g. secrets and hashes.
+func (d *AuditRepoDecorator[T, ID]) WithRedactedFields(fields ...string) *AuditRepoDecorator[T, ID] {
+	d.redacted = append(d.redacted, fields...)
+
+	return d
+}
+
 // AuditSave wraps a save/update operation.
+// It requires a fetchFn to diff against the stored state. OpUpsert is logged as
+// OpCreate when fetchFn finds nothing and as OpUpdate otherwise.
 func (d *AuditRepoDecorator[T, ID]) AuditSave(
 	ctx context.Context,
 	entity T,
 	op auditDomain.AuditOperation,
+	fetchFn func(context.Context, ID) (T, error),
 	saveFn func(context.Context, T) error,
 ) error {
+	var before map[string]any
+
+	prior, err := fetchFn(ctx, entity.ID())
+
+	switch {
+	case err == nil:
+		before = d.extractor(prior)
+	case isNotFound(err):
+		if op == auditDomain.OpUpsert {
+			op = auditDomain.OpCreate
+		}
+	default:
+		return err
+	}
+
+	if op == auditDomain.OpUpsert {
+		op = auditDomain.OpUpdate
+	}
+
 	if err := saveFn(ctx, entity); err != nil {
 		return err
 	}
 
-	return d.log(ctx, entity, op)
+	return d.log(ctx, entity, op, before, d.extractor(entity))
 }
 
 // AuditDelete wraps a delete operation.
@@ -69,22 +107,36 @@ func (d *AuditRepoDecorator[T, ID]) AuditDelete(
 		return err
 	}
 
-	// log the snapshot
-	return d.log(ctx, entity, auditDomain.OpDelete)
+	return d.log(ctx, entity, auditDomain.OpDelete, d.extractor(entity), nil)
 }
 
-func (d *AuditRepoDecorator[T, ID]) log(ctx context.Context, entity T, op auditDomain.AuditOperation) error {
+func (d *AuditRepoDecorator[T, ID]) log(ctx context.Context, entity T, op auditDomain.AuditOperation, before, after map[string]any) error {
 	meta := causation.FromContext(ctx)
-	changes := d.extractor(entity)
+
+	changes, err := auditDomain.DiffSnapshots(before, after, d.redacted)
+	if err != nil {
+		return fmt.Errorf("audit diff failed: %w", err)
+	}
+
+	var actorID *uuid.UUID
+	if meta.IsUser() {
+		actorID = &meta.UserID
+	}
+
+	var workspaceID *uuid.UUID
+	if d.scope != nil {
+		workspaceID = d.scope(entity)
+	}
 
 	auditLog, err := auditDomain.NewAuditLog(
 		meta.CorrelationID,
 		meta.CausationID,
-		&meta.UserID,
+		actorID,
 		meta.UserIP,
 		meta.UserAgent,
 		shared.AggregateType(d.aggregateType),
 		d.idToUUID(entity.ID()),
+		workspaceID,
 		op,
 		changes,
 	)
@@ -98,3 +150,9 @@ func (d *AuditRepoDecorator[T, ID]) log(ctx context.Context, entity T, op auditD
 
 	return nil
 }
+
+func isNotFound(err error) bool {
+	var domainErr shared.DomainError
+
+	return errors.As(err, &domainErr) && domainErr.Code() == apperrors.NotFound
+}
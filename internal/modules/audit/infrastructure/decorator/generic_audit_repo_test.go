@@ -0,0 +1,98 @@
+package decorator_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/danicc097/todo-ddd-example/internal/apperrors"
+	auditDomain "github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+	"github.com/danicc097/todo-ddd-example/internal/modules/audit/infrastructure/decorator"
+	"github.com/danicc097/todo-ddd-example/internal/modules/audit/infrastructure/memory"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+var errNotFound = shared.NewDomainError(apperrors.NotFound, "not found")
+
+type account struct {
+	id     uuid.UUID
+	name   string
+	secret string
+}
+
+func (a *account) ID() uuid.UUID { return a.id }
+
+type accountStore map[uuid.UUID]account
+
+func (s accountStore) find(_ context.Context, id uuid.UUID) (*account, error) {
+	a, ok := s[id]
+	if !ok {
+		return nil, errNotFound
+	}
+
+	return &a, nil
+}
+
+func (s accountStore) save(_ context.Context, a *account) error {
+	s[a.id] = *a
+	return nil
+}
+
+func (s accountStore) delete(_ context.Context, id uuid.UUID) error {
+	delete(s, id)
+	return nil
+}
+
+func TestAuditRepoDecorator(t *testing.T) {
+	t.Parallel()
+
+	ctx := causation.WithMetadata(context.Background(), causation.Metadata{CorrelationID: "corr", UserID: uuid.New()})
+	auditRepo := memory.NewAuditRepository()
+	store := accountStore{}
+
+	auditor := decorator.NewAuditRepoDecorator(
+		auditRepo,
+		shared.AggUser,
+		func(a *account) map[string]any { return map[string]any{"name": a.name, "secret": a.secret} },
+		func(id uuid.UUID) uuid.UUID { return id },
+		nil,
+	).WithRedactedFields("secret")
+
+	acc := &account{id: uuid.New(), name: "a", secret: "s1"}
+
+	require.NoError(t, auditor.AuditSave(ctx, acc, auditDomain.OpUpsert, store.find, store.save))
+
+	acc.name = "b"
+	require.NoError(t, auditor.AuditSave(ctx, acc, auditDomain.OpUpsert, store.find, store.save))
+
+	acc.secret = "s2"
+	require.NoError(t, auditor.AuditSave(ctx, acc, auditDomain.OpUpsert, store.find, store.save))
+
+	require.NoError(t, auditor.AuditDelete(ctx, acc.id, store.find, store.delete))
+
+	logs := auditRepo.FindAll()
+	require.Len(t, logs, 4)
+
+	assert.Equal(t, auditDomain.OpCreate.String(), logs[0].Operation())
+	assert.Equal(t, map[string]auditDomain.FieldChange{
+		"name":   auditDomain.NewFieldChange(nil, "a"),
+		"secret": auditDomain.NewFieldChange(nil, auditDomain.RedactedValue),
+	}, logs[0].Changes())
+
+	assert.Equal(t, auditDomain.OpUpdate.String(), logs[1].Operation())
+	assert.Equal(t, map[string]auditDomain.FieldChange{"name": auditDomain.NewFieldChange("a", "b")}, logs[1].Changes())
+
+	assert.Equal(t, map[string]auditDomain.FieldChange{
+		"secret": auditDomain.NewFieldChange(auditDomain.RedactedValue, auditDomain.RedactedValue),
+	}, logs[2].Changes())
+
+	assert.Equal(t, auditDomain.OpDelete.String(), logs[3].Operation())
+	assert.Equal(t, map[string]auditDomain.FieldChange{
+		"name":   auditDomain.NewFieldChange("b", nil),
+		"secret": auditDomain.NewFieldChange(auditDomain.RedactedValue, nil),
+	}, logs[3].Changes())
+}
@@ -0,0 +1,130 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	api "github.com/danicc097/todo-ddd-example/internal/generated/api"
+	"github.com/danicc097/todo-ddd-example/internal/modules/audit/application"
+	"github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+	infraHttp "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/http"
+)
+
+type AuditHandler struct {
+	uc application.AuditUseCases
+}
+
+func NewAuditHandler(uc application.AuditUseCases) *AuditHandler {
+	return &AuditHandler{uc: uc}
+}
+
+func (h *AuditHandler) GetWorkspaceAuditLogs(c *gin.Context, id wsDomain.WorkspaceID, params api.GetWorkspaceAuditLogsParams) {
+	filter := application.AuditLogFilter{
+		AggregateID: params.AggregateID,
+		ActorID:     params.ActorID,
+		From:        params.From,
+		To:          params.To,
+		Limit:       int32(infraHttp.DefaultPaginationLimit),
+	}
+
+	if params.AggregateType != nil {
+		aggType := shared.AggregateType(*params.AggregateType)
+		filter.AggregateType = &aggType
+	}
+
+	if params.Operation != nil {
+		op := domain.AuditOperation(*params.Operation)
+		filter.Operation = &op
+	}
+
+	if params.Limit != nil {
+		filter.Limit = int32(*params.Limit)
+	}
+
+	if params.Offset != nil {
+		filter.Offset = int32(*params.Offset)
+	}
+
+	resp, ok := infraHttp.Execute(c, h.uc.GetWorkspaceAudit, application.GetWorkspaceAuditQuery{
+		WorkspaceID: id,
+		Filter:      filter,
+	})
+	if !ok {
+		return
+	}
+
+	logs := make([]api.AuditLog, len(resp.Logs))
+	for i, l := range resp.Logs {
+		changes := make([]api.AuditFieldChange, len(l.Changes))
+		for j, c := range l.Changes {
+			changes[j] = api.AuditFieldChange{Field: c.Field, Before: c.Before, After: c.After}
+		}
+
+		logs[i] = api.AuditLog{
+			Id:            l.ID,
+			CorrelationId: l.CorrelationID,
+			CausationId:   l.CausationID,
+			ActorId:       l.ActorID,
+			AggregateType: api.AuditAggregateType(l.AggregateType),
+			AggregateId:   l.AggregateID,
+			Operation:     api.AuditOperation(l.Operation),
+			Changes:       changes,
+			OccurredAt:    l.OccurredAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, logs)
+}
+
+func (h *AuditHandler) VerifyWorkspaceAuditChain(c *gin.Context, id wsDomain.WorkspaceID) {
+	resp, ok := infraHttp.Execute(c, h.uc.VerifyWorkspaceAuditChain, application.VerifyWorkspaceAuditChainQuery{WorkspaceID: id})
+	if !ok {
+		return
+	}
+
+	out := api.AuditChainVerification{
+		Valid:          resp.Break == nil,
+		CheckedEntries: resp.CheckedEntries,
+	}
+
+	if resp.Break != nil {
+		out.FirstBrokenLink = &api.AuditChainBreak{
+			LogId:    resp.Break.LogID,
+			Position: resp.Break.Position,
+			Reason:   api.AuditChainBreakReason(resp.Break.Reason),
+		}
+	}
+
+	c.JSON(http.StatusOK, out)
+}
+
+func (h *AuditHandler) VerifyGlobalAuditChains(c *gin.Context) {
+	resp, ok := infraHttp.Execute(c, h.uc.VerifyGlobalAuditChains, application.VerifyGlobalAuditChainsQuery{})
+	if !ok {
+		return
+	}
+
+	out := api.GlobalAuditChainVerification{
+		Valid:          resp.Break == nil,
+		CheckedChains:  resp.CheckedChains,
+		CheckedEntries: resp.CheckedEntries,
+	}
+
+	if resp.Break != nil {
+		aggType := api.AuditAggregateType(resp.Break.Chain.AggregateType())
+		aggID := resp.Break.Chain.AggregateID()
+
+		out.FirstBrokenLink = &api.AuditChainBreak{
+			LogId:         resp.Break.LogID,
+			AggregateType: &aggType,
+			AggregateId:   &aggID,
+			Position:      resp.Break.Position,
+			Reason:        api.AuditChainBreakReason(resp.Break.Reason),
+		}
+	}
+
+	c.JSON(http.StatusOK, out)
+}
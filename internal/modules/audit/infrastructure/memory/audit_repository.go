@@ -1,17 +1,23 @@
 package memory
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"slices"
 	"sync"
 
+	"github.com/google/uuid"
+
 	"github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
 )
 
 // InMemoryAuditRepo simulates a client to a remote audit storage service.
 // For demonstration purposes only.
+var _ domain.AuditRepository = (*InMemoryAuditRepo)(nil)
+
 type InMemoryAuditRepo struct {
 	mu   sync.RWMutex
 	logs []*domain.AuditLog
@@ -28,6 +34,22 @@ func (r *InMemoryAuditRepo) Save(ctx context.Context, log *domain.AuditLog) erro
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	return r.append(ctx, log)
+}
+
+func (r *InMemoryAuditRepo) append(ctx context.Context, log *domain.AuditLog) error {
+	prevHash := ""
+
+	for _, l := range r.logs {
+		if l.Chain() == log.Chain() {
+			prevHash = l.Hash()
+		}
+	}
+
+	if err := log.ChainTo(prevHash); err != nil {
+		return err
+	}
+
 	r.logs = append(r.logs, log)
 
 	debugDump(ctx, log)
@@ -54,6 +76,95 @@ func debugDump(ctx context.Context, log *domain.AuditLog) {
 	slog.InfoContext(ctx, "AUDIT_LOG_EMITTED", slog.String("payload", string(b)))
 }
 
+// ListChain implements domain.AuditRepository.
+func (r *InMemoryAuditRepo) ListChain(_ context.Context, key domain.ChainKey, offset, limit int32) ([]*domain.AuditLog, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var chain []*domain.AuditLog
+
+	for _, l := range r.logs {
+		if l.Chain() == key {
+			chain = append(chain, l)
+		}
+	}
+
+	if int(offset) >= len(chain) {
+		return nil, nil
+	}
+
+	return chain[offset:min(int(offset+limit), len(chain))], nil
+}
+
+// ListUnscopedChains implements domain.AuditRepository.
+func (r *InMemoryAuditRepo) ListUnscopedChains(_ context.Context, after domain.ChainKey, limit int32) ([]domain.ChainKey, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var chains []domain.ChainKey
+
+	for _, l := range r.logs {
+		if l.WorkspaceID() == nil && !slices.Contains(chains, l.Chain()) && compareChains(l.Chain(), after) > 0 {
+			chains = append(chains, l.Chain())
+		}
+	}
+
+	slices.SortFunc(chains, compareChains)
+
+	return chains[:min(int(limit), len(chains))], nil
+}
+
+func compareChains(a, b domain.ChainKey) int {
+	return cmp.Or(
+		cmp.Compare(a.AggregateType(), b.AggregateType()),
+		cmp.Compare(a.AggregateID().String(), b.AggregateID().String()),
+	)
+}
+
+// EraseUser implements domain.AuditRepository.
+func (r *InMemoryAuditRepo) EraseUser(ctx context.Context, userID, pseudonym uuid.UUID, correlationID string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	erasedByChain := map[domain.ChainKey][]*domain.AuditLog{}
+
+	var (
+		chains []domain.ChainKey
+		n      int64
+	)
+
+	for _, l := range r.logs {
+		erased, err := l.Erase(userID, pseudonym)
+		if err != nil {
+			return 0, err
+		}
+
+		if !erased {
+			continue
+		}
+
+		if _, ok := erasedByChain[l.Chain()]; !ok {
+			chains = append(chains, l.Chain())
+		}
+
+		erasedByChain[l.Chain()] = append(erasedByChain[l.Chain()], l)
+		n++
+	}
+
+	for _, chain := range chains {
+		erasure, err := domain.NewErasureLog(correlationID, chain, pseudonym, erasedByChain[chain])
+		if err != nil {
+			return 0, err
+		}
+
+		if err := r.append(ctx, erasure); err != nil {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
+
 func (r *InMemoryAuditRepo) FindAll() []*domain.AuditLog {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
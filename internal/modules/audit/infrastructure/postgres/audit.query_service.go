@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/danicc097/todo-ddd-example/internal/generated/db"
+	"github.com/danicc097/todo-ddd-example/internal/modules/audit/application"
+	auditDomain "github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+)
+
+type auditQueryService struct {
+	q    *db.Queries
+	pool *pgxpool.Pool
+}
+
+func NewAuditQueryService(pool *pgxpool.Pool) application.AuditQueryService {
+	return &auditQueryService{
+		q:    db.New(),
+		pool: pool,
+	}
+}
+
+func (s *auditQueryService) ListByWorkspace(ctx context.Context, wsID wsDomain.WorkspaceID, filter application.AuditLogFilter) ([]application.AuditLogReadModel, error) {
+	params := db.ListWorkspaceAuditLogsParams{
+		WorkspaceID: wsID.UUID(),
+		AggregateID: filter.AggregateID,
+		ActorID:     filter.ActorID,
+		FromTime:    filter.From,
+		ToTime:      filter.To,
+		Lim:         filter.Limit,
+		Off:         filter.Offset,
+	}
+
+	if filter.AggregateType != nil {
+		aggType := string(*filter.AggregateType)
+		params.AggregateType = &aggType
+	}
+
+	if filter.Operation != nil {
+		op := filter.Operation.String()
+		params.Operation = &op
+	}
+
+	rows, err := s.q.ListWorkspaceAuditLogs(ctx, s.pool, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit logs of workspace %s: %w", wsID, sharedPg.ParseDBError(err))
+	}
+
+	logs := make([]application.AuditLogReadModel, len(rows))
+	for i, r := range rows {
+		changes := map[string]auditDomain.FieldChange{}
+		_ = json.Unmarshal(r.Changes, &changes)
+
+		logs[i] = application.AuditLogReadModel{
+			ID:            r.ID,
+			CorrelationID: r.CorrelationID,
+			CausationID:   r.CausationID,
+			ActorID:       r.ActorID,
+			AggregateType: r.AggregateType,
+			AggregateID:   r.AggregateID,
+			Operation:     r.Operation,
+			Changes:       application.RenderChanges(changes),
+			OccurredAt:    r.OccurredAt,
+		}
+	}
+
+	return logs, nil
+}
@@ -0,0 +1,260 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/danicc097/todo-ddd-example/internal/generated/db"
+	infraDB "github.com/danicc097/todo-ddd-example/internal/infrastructure/db"
+	"github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+)
+
+type AuditRepo struct {
+	q    *db.Queries
+	pool *pgxpool.Pool
+}
+
+var _ domain.AuditRepository = (*AuditRepo)(nil)
+
+func NewAuditRepo(pool *pgxpool.Pool) *AuditRepo {
+	return &AuditRepo{
+		q:    db.New(),
+		pool: pool,
+	}
+}
+
+func (r *AuditRepo) Save(ctx context.Context, log *domain.AuditLog) error {
+	if tx := infraDB.ExtractTx(ctx); tx != nil {
+		return r.append(ctx, tx, log)
+	}
+
+	// the chain lock is held until the transaction ends
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		return r.append(ctx, tx, log)
+	})
+}
+
+func (r *AuditRepo) append(ctx context.Context, tx pgx.Tx, log *domain.AuditLog) error {
+	chain := log.Chain()
+
+	err := r.q.LockAuditChain(ctx, tx, db.LockAuditChainParams{
+		WorkspaceID:   chain.WorkspaceID(),
+		AggregateType: string(chain.AggregateType()),
+		AggregateID:   chain.AggregateID(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to lock audit chain: %w", sharedPg.ParseDBError(err))
+	}
+
+	prevHash, err := r.q.GetAuditChainHead(ctx, tx, db.GetAuditChainHeadParams{
+		WorkspaceID:   chain.WorkspaceID(),
+		AggregateType: string(chain.AggregateType()),
+		AggregateID:   chain.AggregateID(),
+	})
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to get audit chain head: %w", sharedPg.ParseDBError(err))
+	}
+
+	if err := log.ChainTo(prevHash); err != nil {
+		return err
+	}
+
+	changes := log.Changes()
+	if changes == nil {
+		changes = map[string]domain.FieldChange{}
+	}
+
+	b, err := json.Marshal(changes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal audit changes: %w", err)
+	}
+
+	err = r.q.InsertAuditLog(ctx, tx, db.InsertAuditLogParams{
+		ID:            log.ID(),
+		WorkspaceID:   log.WorkspaceID(),
+		CorrelationID: log.CorrelationID(),
+		CausationID:   log.CausationID(),
+		ActorID:       log.ActorID(),
+		ActorIp:       log.ActorIP(),
+		UserAgentHash: log.UserAgentHash(),
+		AggregateType: string(log.AggregateType()),
+		AggregateID:   log.AggregateID(),
+		Operation:     log.Operation(),
+		Changes:       b,
+		OccurredAt:    log.OccurredAt(),
+		PrevHash:      log.PrevHash(),
+		Hash:          log.Hash(),
+		PiiSalt:       log.PIISalt(),
+		PiiDigest:     log.PIIDigest(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to insert audit log %s: %w", log.ID(), sharedPg.ParseDBError(err))
+	}
+
+	return nil
+}
+
+func (r *AuditRepo) ListChain(ctx context.Context, chain domain.ChainKey, offset, limit int32) ([]*domain.AuditLog, error) {
+	rows, err := r.q.ListAuditChain(ctx, r.pool, db.ListAuditChainParams{
+		WorkspaceID:   chain.WorkspaceID(),
+		AggregateType: string(chain.AggregateType()),
+		AggregateID:   chain.AggregateID(),
+		Off:           offset,
+		Lim:           limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit chain: %w", sharedPg.ParseDBError(err))
+	}
+
+	return toDomainLogs(rows)
+}
+
+func (r *AuditRepo) ListUnscopedChains(ctx context.Context, after domain.ChainKey, limit int32) ([]domain.ChainKey, error) {
+	rows, err := r.q.ListUnscopedAuditChains(ctx, r.pool, db.ListUnscopedAuditChainsParams{
+		AfterType: string(after.AggregateType()),
+		AfterID:   after.AggregateID(),
+		Lim:       limit,
+	})
+	if err != nil {
+		return nil, sharedPg.ParseDBError(err)
+	}
+
+	chains := make([]domain.ChainKey, len(rows))
+	for i, row := range rows {
+		chains[i] = domain.AggregateChain(shared.AggregateType(row.AggregateType), row.AggregateID)
+	}
+
+	return chains, nil
+}
+
+func (r *AuditRepo) EraseUser(ctx context.Context, userID, pseudonym uuid.UUID, correlationID string) (int64, error) {
+	if tx := infraDB.ExtractTx(ctx); tx != nil {
+		return r.eraseUser(ctx, tx, userID, pseudonym, correlationID)
+	}
+
+	var n int64
+
+	err := pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		var err error
+
+		n, err = r.eraseUser(ctx, tx, userID, pseudonym, correlationID)
+
+		return err
+	})
+
+	return n, err
+}
+
+func (r *AuditRepo) eraseUser(ctx context.Context, tx pgx.Tx, userID, pseudonym uuid.UUID, correlationID string) (int64, error) {
+	rows, err := r.q.ListAuditLogsReferencingUserForUpdate(ctx, tx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list audit logs of user: %w", sharedPg.ParseDBError(err))
+	}
+
+	logs, err := toDomainLogs(rows)
+	if err != nil {
+		return 0, err
+	}
+
+	erasedByChain := map[domain.ChainKey][]*domain.AuditLog{}
+
+	var n int64
+
+	for _, l := range logs {
+		erased, err := l.Erase(userID, pseudonym)
+		if err != nil {
+			return 0, err
+		}
+
+		if !erased {
+			continue
+		}
+
+		changes, err := json.Marshal(l.Changes())
+		if err != nil {
+			return 0, fmt.Errorf("failed to marshal audit changes: %w", err)
+		}
+
+		err = r.q.UpdateErasedAuditLog(ctx, tx, db.UpdateErasedAuditLogParams{
+			ID:          l.ID(),
+			ActorID:     l.ActorID(),
+			ActorIp:     l.ActorIP(),
+			AggregateID: l.AggregateID(),
+			Changes:     changes,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to erase audit log %s: %w", l.ID(), sharedPg.ParseDBError(err))
+		}
+
+		erasedByChain[l.Chain()] = append(erasedByChain[l.Chain()], l)
+		n++
+	}
+
+	// a consistent order, so concurrent erasures take the chain locks without deadlocking
+	chains := slices.SortedFunc(maps.Keys(erasedByChain), func(a, b domain.ChainKey) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	for _, chain := range chains {
+		erasure, err := domain.NewErasureLog(correlationID, chain, pseudonym, erasedByChain[chain])
+		if err != nil {
+			return 0, err
+		}
+
+		if err := r.append(ctx, tx, erasure); err != nil {
+			return 0, err
+		}
+	}
+
+	return n, nil
+}
+
+func (r *AuditRepo) getDB(ctx context.Context) db.DBTX {
+	if tx := infraDB.ExtractTx(ctx); tx != nil {
+		return tx
+	}
+
+	return r.pool
+}
+
+func toDomainLogs(rows []db.AuditLogs) ([]*domain.AuditLog, error) {
+	logs := make([]*domain.AuditLog, len(rows))
+	for i, row := range rows {
+		changes := map[string]domain.FieldChange{}
+		if err := json.Unmarshal(row.Changes, &changes); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal changes of audit log %s: %w", row.ID, err)
+		}
+
+		logs[i] = domain.ReconstituteAuditLog(domain.ReconstituteAuditLogArgs{
+			ID:            row.ID,
+			CorrelationID: row.CorrelationID,
+			CausationID:   row.CausationID,
+			ActorID:       row.ActorID,
+			ActorIP:       row.ActorIp,
+			UserAgentHash: row.UserAgentHash,
+			AggregateType: shared.AggregateType(row.AggregateType),
+			AggregateID:   row.AggregateID,
+			WorkspaceID:   row.WorkspaceID,
+			Operation:     domain.AuditOperation(row.Operation),
+			Changes:       changes,
+			OccurredAt:    row.OccurredAt,
+			PrevHash:      row.PrevHash,
+			Hash:          row.Hash,
+			PIISalt:       row.PiiSalt,
+			PIIDigest:     row.PiiDigest,
+		})
+	}
+
+	return logs, nil
+}
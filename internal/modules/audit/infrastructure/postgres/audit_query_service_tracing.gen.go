@@ -0,0 +1,69 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../../../../../templates/opentelemetry.gotmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package postgres
+
+import (
+	"context"
+
+	_sourceApplication "github.com/danicc097/todo-ddd-example/internal/modules/audit/application"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"go.opentelemetry.io/otel/attribute"
+
+	"go.opentelemetry.io/otel"
+	_codes "go.opentelemetry.io/otel/codes"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AuditQueryServiceWithTracing implements AuditQueryService interface instrumented with open telemetry spans
+type AuditQueryServiceWithTracing struct {
+	_sourceApplication.AuditQueryService
+	_instance      string
+	_spanDecorator func(span trace.Span, params, results map[string]interface{})
+}
+
+// NewAuditQueryServiceWithTracing returns AuditQueryServiceWithTracing
+func NewAuditQueryServiceWithTracing(base _sourceApplication.AuditQueryService, instance string, spanDecorator ...func(span trace.Span, params, results map[string]interface{})) AuditQueryServiceWithTracing {
+	d := AuditQueryServiceWithTracing{
+		AuditQueryService: base,
+		_instance:         instance,
+	}
+
+	if len(spanDecorator) > 0 && spanDecorator[0] != nil {
+		d._spanDecorator = spanDecorator[0]
+	}
+
+	return d
+}
+
+// ListByWorkspace implements AuditQueryService
+func (_d AuditQueryServiceWithTracing) ListByWorkspace(ctx context.Context, wsID wsDomain.WorkspaceID, filter _sourceApplication.AuditLogFilter) (aa1 []_sourceApplication.AuditLogReadModel, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "AuditQueryService.ListByWorkspace", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "ListByWorkspace"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"wsID":   wsID,
+				"filter": filter}, map[string]interface{}{
+				"aa1": aa1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.AuditQueryService.ListByWorkspace(ctx, wsID, filter)
+}
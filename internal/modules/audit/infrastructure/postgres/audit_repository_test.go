@@ -0,0 +1,235 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/audit/application"
+	"github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+	auditPg "github.com/danicc097/todo-ddd-example/internal/modules/audit/infrastructure/postgres"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	sharedDomain "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+	"github.com/danicc097/todo-ddd-example/internal/testutils"
+)
+
+func TestAuditRepo_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pool := testutils.GetGlobalPostgresPool(t)
+	uow := sharedPg.NewUnitOfWork(pool)
+	repo := auditPg.NewAuditRepo(pool)
+	qs := auditPg.NewAuditQueryService(pool)
+
+	wsID := wsDomain.WorkspaceID(uuid.New())
+	wsUUID := wsID.UUID()
+	actorID := uuid.New()
+	todoID := uuid.New()
+
+	newLog := func(t *testing.T, actor *uuid.UUID, aggType sharedDomain.AggregateType, aggID uuid.UUID, op domain.AuditOperation) *domain.AuditLog {
+		t.Helper()
+
+		l, err := domain.NewAuditLog("corr", "cause", actor, "127.0.0.1", "ua", aggType, aggID, &wsUUID, op, map[string]domain.FieldChange{"title": domain.NewFieldChange("a", "b")})
+		require.NoError(t, err)
+
+		return l
+	}
+
+	created := newLog(t, &actorID, sharedDomain.AggTodo, todoID, domain.OpCreate)
+	updated := newLog(t, nil, sharedDomain.AggTodo, todoID, domain.OpUpdate)
+	tagged := newLog(t, &actorID, sharedDomain.AggTag, uuid.New(), domain.OpCreate)
+
+	err := uow.Execute(ctx, func(ctx context.Context) error {
+		for _, l := range []*domain.AuditLog{created, updated, tagged} {
+			if err := repo.Save(ctx, l); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	t.Run("rolled back with the caller transaction", func(t *testing.T) {
+		lost := newLog(t, &actorID, sharedDomain.AggTodo, todoID, domain.OpDelete)
+		errAbort := errors.New("abort")
+
+		err := uow.Execute(ctx, func(ctx context.Context) error {
+			require.NoError(t, repo.Save(ctx, lost))
+			return errAbort
+		})
+		require.ErrorIs(t, err, errAbort)
+
+		op := domain.OpDelete
+		logs, err := qs.ListByWorkspace(ctx, wsID, application.AuditLogFilter{Operation: &op, Limit: 10})
+		require.NoError(t, err)
+		assert.Empty(t, logs)
+	})
+
+	t.Run("lists workspace logs", func(t *testing.T) {
+		logs, err := qs.ListByWorkspace(ctx, wsID, application.AuditLogFilter{Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, logs, 3)
+
+		assert.Equal(t, []application.FieldChangeReadModel{{Field: "title", Before: "a", After: "b"}}, logs[0].Changes)
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		aggType := sharedDomain.AggTodo
+		op := domain.OpUpdate
+		future := time.Now().Add(time.Hour)
+
+		tests := []struct {
+			name   string
+			filter application.AuditLogFilter
+			want   []uuid.UUID
+		}{
+			{"aggregate type", application.AuditLogFilter{AggregateType: &aggType}, []uuid.UUID{created.ID(), updated.ID()}},
+			{"aggregate id", application.AuditLogFilter{AggregateID: &todoID}, []uuid.UUID{created.ID(), updated.ID()}},
+			{"actor", application.AuditLogFilter{ActorID: &actorID}, []uuid.UUID{created.ID(), tagged.ID()}},
+			{"operation", application.AuditLogFilter{Operation: &op}, []uuid.UUID{updated.ID()}},
+			{"time range", application.AuditLogFilter{From: &future}, nil},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				tt.filter.Limit = 10
+
+				logs, err := qs.ListByWorkspace(ctx, wsID, tt.filter)
+				require.NoError(t, err)
+
+				got := make([]uuid.UUID, len(logs))
+				for i, l := range logs {
+					got[i] = l.ID
+				}
+
+				assert.ElementsMatch(t, tt.want, got)
+			})
+		}
+	})
+
+	t.Run("chains workspace logs", func(t *testing.T) {
+		chain, err := repo.ListChain(ctx, domain.WorkspaceChain(wsUUID), 0, 10)
+		require.NoError(t, err)
+		require.Len(t, chain, 3)
+
+		prevHash := ""
+		for _, l := range chain {
+			reason, err := domain.CheckLink(l, prevHash)
+			require.NoError(t, err)
+			assert.Empty(t, reason)
+
+			prevHash = l.Hash()
+		}
+	})
+
+	t.Run("chains unscoped logs per aggregate", func(t *testing.T) {
+		userA, userB := uuid.New(), uuid.New()
+
+		for _, id := range []uuid.UUID{userA, userB, userA} {
+			l, err := domain.NewAuditLog("corr", "cause", &id, "", "", sharedDomain.AggUser, id, nil, domain.OpUpdate, nil)
+			require.NoError(t, err)
+			require.NoError(t, repo.Save(ctx, l))
+		}
+
+		chainA, err := repo.ListChain(ctx, domain.AggregateChain(sharedDomain.AggUser, userA), 0, 10)
+		require.NoError(t, err)
+		require.Len(t, chainA, 2)
+
+		chainB, err := repo.ListChain(ctx, domain.AggregateChain(sharedDomain.AggUser, userB), 0, 10)
+		require.NoError(t, err)
+		require.Len(t, chainB, 1)
+
+		reason, err := domain.CheckLink(chainB[0], "")
+		require.NoError(t, err)
+		assert.Empty(t, reason)
+
+		reason, err = domain.CheckLink(chainA[1], chainA[0].Hash())
+		require.NoError(t, err)
+		assert.Empty(t, reason)
+	})
+
+	t.Run("detects edits", func(t *testing.T) {
+		otherWs := uuid.New()
+
+		l, err := domain.NewAuditLog("corr", "cause", nil, "", "", sharedDomain.AggTag, uuid.New(), &otherWs, domain.OpCreate,
+			map[string]domain.FieldChange{"name": domain.NewFieldChange(nil, "a")})
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(ctx, l))
+
+		_, err = pool.Exec(ctx, `UPDATE audit_logs SET changes = '{"name": {"before": null, "after": "b"}}' WHERE id = $1`, l.ID())
+		require.NoError(t, err)
+
+		chain, err := repo.ListChain(ctx, domain.WorkspaceChain(otherWs), 0, 10)
+		require.NoError(t, err)
+		require.Len(t, chain, 1)
+
+		reason, err := domain.CheckLink(chain[0], "")
+		require.NoError(t, err)
+		assert.Equal(t, domain.ChainHashMismatch, reason)
+	})
+
+	t.Run("erases user without breaking the chain", func(t *testing.T) {
+		otherWs := uuid.New()
+		leaving := uuid.New()
+
+		for range 2 {
+			l, err := domain.NewAuditLog("corr", "cause", &leaving, "10.0.0.1", "ua", sharedDomain.AggTodo, uuid.New(), &otherWs, domain.OpCreate,
+				map[string]domain.FieldChange{"assignee_id": domain.NewFieldChange(nil, leaving.String())})
+			require.NoError(t, err)
+			require.NoError(t, repo.Save(ctx, l))
+		}
+
+		profile, err := domain.NewAuditLog("corr", "cause", &leaving, "10.0.0.1", "ua", sharedDomain.AggUser, leaving, nil, domain.OpUpdate, nil)
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(ctx, profile))
+
+		pseudonym := uuid.New()
+
+		n, err := repo.EraseUser(ctx, leaving, pseudonym, "corr")
+		require.NoError(t, err)
+		assert.EqualValues(t, 3, n)
+
+		chain, err := repo.ListChain(ctx, domain.WorkspaceChain(otherWs), 0, 10)
+		require.NoError(t, err)
+		require.Len(t, chain, 3)
+
+		for _, l := range chain[:2] {
+			assert.Equal(t, pseudonym, *l.ActorID())
+			assert.Empty(t, l.ActorIP())
+			assert.Equal(t, pseudonym.String(), l.Changes()["assignee_id"].After())
+			assert.True(t, l.IsErased())
+		}
+
+		assert.Equal(t, domain.OpErase.String(), chain[2].Operation())
+		assert.Len(t, chain[2].ErasureCommitments(), 2)
+
+		userChain, err := repo.ListChain(ctx, domain.AggregateChain(sharedDomain.AggUser, pseudonym), 0, 10)
+		require.NoError(t, err)
+		require.Len(t, userChain, 2)
+		assert.Equal(t, profile.ID(), userChain[0].ID())
+
+		for _, c := range [][]*domain.AuditLog{chain, userChain} {
+			v := domain.NewChainVerifier()
+
+			for _, l := range c {
+				brk, err := v.Check(l)
+				require.NoError(t, err)
+				assert.Nil(t, brk)
+			}
+
+			assert.Nil(t, v.Finish())
+		}
+
+		leftover, err := repo.ListChain(ctx, domain.AggregateChain(sharedDomain.AggUser, leaving), 0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, leftover)
+	})
+}
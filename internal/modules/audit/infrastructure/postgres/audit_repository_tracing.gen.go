@@ -0,0 +1,156 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../../../../../templates/opentelemetry.gotmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package postgres
+
+import (
+	"context"
+
+	_sourceDomain "github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"go.opentelemetry.io/otel"
+	_codes "go.opentelemetry.io/otel/codes"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AuditRepositoryWithTracing implements AuditRepository interface instrumented with open telemetry spans
+type AuditRepositoryWithTracing struct {
+	_sourceDomain.AuditRepository
+	_instance      string
+	_spanDecorator func(span trace.Span, params, results map[string]interface{})
+}
+
+// NewAuditRepositoryWithTracing returns AuditRepositoryWithTracing
+func NewAuditRepositoryWithTracing(base _sourceDomain.AuditRepository, instance string, spanDecorator ...func(span trace.Span, params, results map[string]interface{})) AuditRepositoryWithTracing {
+	d := AuditRepositoryWithTracing{
+		AuditRepository: base,
+		_instance:       instance,
+	}
+
+	if len(spanDecorator) > 0 && spanDecorator[0] != nil {
+		d._spanDecorator = spanDecorator[0]
+	}
+
+	return d
+}
+
+// EraseUser implements AuditRepository
+func (_d AuditRepositoryWithTracing) EraseUser(ctx context.Context, userID uuid.UUID, pseudonym uuid.UUID, correlationID string) (i1 int64, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "AuditRepository.EraseUser", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "EraseUser"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":           ctx,
+				"userID":        userID,
+				"pseudonym":     pseudonym,
+				"correlationID": correlationID}, map[string]interface{}{
+				"i1":  i1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.AuditRepository.EraseUser(ctx, userID, pseudonym, correlationID)
+}
+
+// ListChain implements AuditRepository
+func (_d AuditRepositoryWithTracing) ListChain(ctx context.Context, chain _sourceDomain.ChainKey, offset int32, limit int32) (apa1 []*_sourceDomain.AuditLog, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "AuditRepository.ListChain", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "ListChain"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"chain":  chain,
+				"offset": offset,
+				"limit":  limit}, map[string]interface{}{
+				"apa1": apa1,
+				"err":  err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.AuditRepository.ListChain(ctx, chain, offset, limit)
+}
+
+// ListUnscopedChains implements AuditRepository
+func (_d AuditRepositoryWithTracing) ListUnscopedChains(ctx context.Context, after _sourceDomain.ChainKey, limit int32) (ca1 []_sourceDomain.ChainKey, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "AuditRepository.ListUnscopedChains", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "ListUnscopedChains"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":   ctx,
+				"after": after,
+				"limit": limit}, map[string]interface{}{
+				"ca1": ca1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.AuditRepository.ListUnscopedChains(ctx, after, limit)
+}
+
+// Save implements AuditRepository
+func (_d AuditRepositoryWithTracing) Save(ctx context.Context, log *_sourceDomain.AuditLog) (err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "AuditRepository.Save", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "Save"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx": ctx,
+				"log": log}, map[string]interface{}{
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.AuditRepository.Save(ctx, log)
+}
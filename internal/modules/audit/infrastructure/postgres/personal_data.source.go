@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/danicc097/todo-ddd-example/internal/generated/db"
+	"github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+	userApp "github.com/danicc097/todo-ddd-example/internal/modules/user/application"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+)
+
+type auditDataSource struct {
+	q    *db.Queries
+	pool *pgxpool.Pool
+}
+
+// NewPersonalDataSource exports the audit entries a user caused or that changed their profile.
+func NewPersonalDataSource(pool *pgxpool.Pool) userApp.PersonalDataSource {
+	return &auditDataSource{
+		q:    db.New(),
+		pool: pool,
+	}
+}
+
+type auditEntryExport struct {
+	ID            uuid.UUID                     `json:"id"`
+	WorkspaceID   *uuid.UUID                    `json:"workspace_id"`
+	ActorID       *uuid.UUID                    `json:"actor_id"`
+	ActorIP       string                        `json:"actor_ip"`
+	AggregateType string                        `json:"aggregate_type"`
+	AggregateID   uuid.UUID                     `json:"aggregate_id"`
+	Operation     string                        `json:"operation"`
+	Changes       map[string]domain.FieldChange `json:"changes"`
+	OccurredAt    time.Time                     `json:"occurred_at"`
+}
+
+func (s *auditDataSource) Section() string { return "audit_logs" }
+
+func (s *auditDataSource) Collect(ctx context.Context, userID userDomain.UserID) (any, error) {
+	rows, err := s.q.ListUserAuditLogs(ctx, s.pool, userID.UUID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit logs of user %s: %w", userID, sharedPg.ParseDBError(err))
+	}
+
+	logs, err := toDomainLogs(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]auditEntryExport, len(logs))
+	for i, l := range logs {
+		entries[i] = auditEntryExport{
+			ID:            l.ID(),
+			WorkspaceID:   l.WorkspaceID(),
+			ActorID:       l.ActorID(),
+			ActorIP:       l.ActorIP(),
+			AggregateType: l.AggregateType().String(),
+			AggregateID:   l.AggregateID(),
+			Operation:     l.Operation(),
+			Changes:       l.Changes(),
+			OccurredAt:    l.OccurredAt(),
+		}
+	}
+
+	return entries, nil
+}
@@ -5,8 +5,15 @@ import (
 )
 
 type AuthUseCases struct {
-	Login        application.RequestHandler[LoginCommand, LoginResponse]
-	Register     application.RequestHandler[RegisterCommand, RegisterUserResponse]
-	InitiateTOTP application.RequestHandler[application.Void, string]
-	VerifyTOTP   application.RequestHandler[VerifyTOTPCommand, VerifyTOTPResponse]
+	Login          application.RequestHandler[LoginCommand, LoginResponse]
+	Refresh        application.RequestHandler[RefreshCommand, RefreshResponse]
+	Logout         application.RequestHandler[LogoutCommand, application.Void]
+	LogoutAll      application.RequestHandler[application.Void, application.Void]
+	Register       application.RequestHandler[RegisterCommand, RegisterUserResponse]
+	InitiateTOTP   application.RequestHandler[application.Void, string]
+	VerifyTOTP     application.RequestHandler[VerifyTOTPCommand, VerifyTOTPResponse]
+	RecoverTOTP    application.RequestHandler[RecoverTOTPCommand, RecoverTOTPResponse]
+	DisableTOTP    application.RequestHandler[DisableTOTPCommand, application.Void]
+	Reauthenticate application.RequestHandler[ReauthenticateCommand, ReauthenticateResponse]
+	ResetTOTP      application.RequestHandler[ResetTOTPCommand, application.Void]
 }
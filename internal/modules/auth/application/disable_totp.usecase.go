@@ -0,0 +1,62 @@
+package application
+
+import (
+	"context"
+
+	"github.com/negrel/secrecy"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/auth/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type DisableTOTPCommand struct {
+	Password secrecy.Secret[string]
+	Code     string
+}
+
+// DisableTOTPHandler turns TOTP off after checking both the password and a current TOTP code.
+type DisableTOTPHandler struct {
+	repo    domain.AuthRepository
+	hasher  domain.PasswordHasher
+	checker totpChecker
+}
+
+var _ application.RequestHandler[DisableTOTPCommand, application.Void] = (*DisableTOTPHandler)(nil)
+
+func NewDisableTOTPHandler(repo domain.AuthRepository, hasher domain.PasswordHasher, guard TOTPGuard, encryptor domain.Encryptor, masterKey []byte) *DisableTOTPHandler {
+	return &DisableTOTPHandler{repo: repo, hasher: hasher, checker: totpChecker{guard: guard, encryptor: encryptor, masterKey: masterKey}}
+}
+
+func (h *DisableTOTPHandler) Handle(ctx context.Context, cmd DisableTOTPCommand) (application.Void, error) {
+	userID := userDomain.UserID(causation.FromContext(ctx).UserID)
+
+	auth, err := h.repo.FindByUserIDForUpdate(ctx, userID)
+	if err != nil {
+		return application.Void{}, err
+	}
+
+	match, err := h.hasher.Compare(cmd.Password.ExposeSecret(), auth.PasswordHash())
+	if err != nil || !match {
+		return application.Void{}, domain.ErrInvalidCredentials
+	}
+
+	if !auth.IsTOTPActive() {
+		return application.Void{}, domain.ErrTOTPNotActive
+	}
+
+	if err := h.checker.check(ctx, auth, cmd.Code); err != nil {
+		return application.Void{}, err
+	}
+
+	if err := auth.DisableTOTP(); err != nil {
+		return application.Void{}, err
+	}
+
+	if err := h.repo.Save(ctx, auth); err != nil {
+		return application.Void{}, err
+	}
+
+	return application.Void{}, nil
+}
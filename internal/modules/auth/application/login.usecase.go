@@ -2,7 +2,6 @@ package application
 
 import (
 	"context"
-	"time"
 
 	"github.com/negrel/secrecy"
 
@@ -15,22 +14,20 @@ type LoginCommand struct {
 	Password secrecy.Secret[string]
 }
 
-type LoginResponse struct {
-	AccessToken string
-}
+type LoginResponse = SessionTokens
 
 type LoginHandler struct {
 	userRepo userDomain.UserRepository
 	authRepo domain.AuthRepository
-	issuer   domain.TokenIssuer
+	sessions *SessionIssuer
 	hasher   domain.PasswordHasher
 }
 
-func NewLoginHandler(userRepo userDomain.UserRepository, authRepo domain.AuthRepository, issuer domain.TokenIssuer, hasher domain.PasswordHasher) *LoginHandler {
+func NewLoginHandler(userRepo userDomain.UserRepository, authRepo domain.AuthRepository, sessions *SessionIssuer, hasher domain.PasswordHasher) *LoginHandler {
 	return &LoginHandler{
 		userRepo: userRepo,
 		authRepo: authRepo,
-		issuer:   issuer,
+		sessions: sessions,
 		hasher:   hasher,
 	}
 }
@@ -58,10 +55,5 @@ func (h *LoginHandler) Handle(ctx context.Context, cmd LoginCommand) (LoginRespo
 		return LoginResponse{}, domain.ErrInvalidCredentials
 	}
 
-	token, err := h.issuer.Issue(user.ID().UUID(), false, 15*time.Minute)
-	if err != nil {
-		return LoginResponse{}, err
-	}
-
-	return LoginResponse{AccessToken: token}, nil
+	return h.sessions.Start(ctx, user.ID(), false)
 }
@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"testing"
+	"time"
 
 	"github.com/negrel/secrecy"
 	"github.com/stretchr/testify/assert"
@@ -16,6 +17,7 @@ import (
 	"github.com/danicc097/todo-ddd-example/internal/modules/auth/domain"
 	authPg "github.com/danicc097/todo-ddd-example/internal/modules/auth/infrastructure/postgres"
 	userPg "github.com/danicc097/todo-ddd-example/internal/modules/user/infrastructure/postgres"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
 	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
 	"github.com/danicc097/todo-ddd-example/internal/testfixtures"
 	"github.com/danicc097/todo-ddd-example/internal/testutils"
@@ -34,9 +36,13 @@ func TestLoginHandler_Handle_Integration(t *testing.T) {
 	hasher := infraCrypto.NewArgon2PasswordHasher()
 
 	privKey, _ := rsa.GenerateKey(rand.Reader, 2048)
-	issuer := crypto.NewTokenIssuer(privKey, "test")
+	signingKey, _ := crypto.NewSigningKey(privKey)
+	issuer := crypto.NewTokenIssuer(signingKey, "test")
 
-	handler := application.NewLoginHandler(userRepo, authRepo, issuer, hasher)
+	refreshRepo := authPg.NewRefreshTokenRepo(pool)
+	sessions := application.NewSessionIssuer(issuer, refreshRepo, time.Hour)
+	handler := application.NewLoginHandler(userRepo, authRepo, sessions, hasher)
+	refreshHandler := application.NewRefreshHandler(sessions, refreshRepo, uow)
 
 	t.Run("success", func(t *testing.T) {
 		user := fixtures.RandomUser(ctx, t)
@@ -52,6 +58,7 @@ func TestLoginHandler_Handle_Integration(t *testing.T) {
 
 		require.NoError(t, err)
 		assert.NotEmpty(t, resp.AccessToken)
+		assert.NotEmpty(t, resp.RefreshToken)
 	})
 
 	t.Run("failure - user not found", func(t *testing.T) {
@@ -76,4 +83,64 @@ func TestLoginHandler_Handle_Integration(t *testing.T) {
 
 		assert.ErrorIs(t, err, domain.ErrInvalidCredentials)
 	})
+
+	t.Run("refresh rotates and detects reuse", func(t *testing.T) {
+		user := fixtures.RandomUser(ctx, t)
+		hash, _ := crypto.HashPassword("password123", crypto.DefaultArgon2Params)
+		require.NoError(t, authRepo.Save(ctx, domain.NewUserAuth(user.ID(), hash)))
+
+		login, err := handler.Handle(ctx, application.LoginCommand{
+			Email:    user.Email().String(),
+			Password: *secrecy.NewSecret("password123"),
+		})
+		require.NoError(t, err)
+
+		rotated, err := refreshHandler.Handle(ctx, application.RefreshCommand{RefreshToken: *secrecy.NewSecret(login.RefreshToken)})
+		require.NoError(t, err)
+		assert.NotEmpty(t, rotated.AccessToken)
+		assert.NotEqual(t, login.RefreshToken, rotated.RefreshToken)
+
+		_, err = refreshHandler.Handle(ctx, application.RefreshCommand{RefreshToken: *secrecy.NewSecret(login.RefreshToken)})
+		require.ErrorIs(t, err, domain.ErrRefreshTokenReused)
+
+		// the replay revoked the whole family, including the token the legitimate client holds
+		_, err = refreshHandler.Handle(ctx, application.RefreshCommand{RefreshToken: *secrecy.NewSecret(rotated.RefreshToken)})
+		assert.ErrorIs(t, err, domain.ErrRefreshTokenReused)
+	})
+
+	t.Run("reauthenticate steps up users without TOTP only", func(t *testing.T) {
+		reauthenticate := application.NewReauthenticateHandler(authRepo, sessions, hasher)
+		verifier := crypto.NewTokenVerifier(signingKey.VerificationKey())
+
+		user := fixtures.RandomUser(ctx, t)
+		hash, _ := crypto.HashPassword("password123", crypto.DefaultArgon2Params)
+		auth := domain.NewUserAuth(user.ID(), hash)
+		require.NoError(t, authRepo.Save(ctx, auth))
+
+		userCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: user.ID().UUID()})
+
+		_, err := reauthenticate.Handle(userCtx, application.ReauthenticateCommand{Password: *secrecy.NewSecret("wrong-password")})
+		require.ErrorIs(t, err, domain.ErrInvalidCredentials)
+
+		resp, err := reauthenticate.Handle(userCtx, application.ReauthenticateCommand{Password: *secrecy.NewSecret("password123")})
+		require.NoError(t, err)
+
+		claims, err := verifier.Verify(resp.AccessToken)
+		require.NoError(t, err)
+		require.NotNil(t, claims.ReauthenticatedAt)
+		assert.False(t, claims.MFAVerified())
+
+		require.NoError(t, auth.InitiateTOTP([]byte("c"), []byte("n")))
+		_, err = auth.ActivateTOTP()
+		require.NoError(t, err)
+		require.NoError(t, authRepo.Save(ctx, auth))
+
+		_, err = reauthenticate.Handle(userCtx, application.ReauthenticateCommand{Password: *secrecy.NewSecret("password123")})
+		assert.ErrorIs(t, err, domain.ErrReauthWithTOTP)
+	})
+
+	t.Run("refresh rejects unknown tokens", func(t *testing.T) {
+		_, err := refreshHandler.Handle(ctx, application.RefreshCommand{RefreshToken: *secrecy.NewSecret("unknown")})
+		assert.ErrorIs(t, err, domain.ErrInvalidRefreshToken)
+	})
 }
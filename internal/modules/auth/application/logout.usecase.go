@@ -0,0 +1,89 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/negrel/secrecy"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/auth/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+// TokenDenylist rejects access tokens before they expire.
+type TokenDenylist interface {
+	RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error
+	// RevokeUserTokens rejects every access token of the user issued up to notBefore.
+	RevokeUserTokens(ctx context.Context, userID userDomain.UserID, notBefore time.Time) error
+}
+
+type LogoutCommand struct {
+	// RefreshToken optionally ends the refresh token family of the session as well.
+	RefreshToken *secrecy.Secret[string]
+}
+
+// LogoutHandler revokes the access token the request was made with.
+type LogoutHandler struct {
+	denylist    TokenDenylist
+	refreshRepo domain.RefreshTokenRepository
+}
+
+var _ application.RequestHandler[LogoutCommand, application.Void] = (*LogoutHandler)(nil)
+
+func NewLogoutHandler(denylist TokenDenylist, refreshRepo domain.RefreshTokenRepository) *LogoutHandler {
+	return &LogoutHandler{denylist: denylist, refreshRepo: refreshRepo}
+}
+
+func (h *LogoutHandler) Handle(ctx context.Context, cmd LogoutCommand) (application.Void, error) {
+	meta := causation.FromContext(ctx)
+
+	if cmd.RefreshToken != nil {
+		current, err := h.refreshRepo.FindByHash(ctx, domain.HashRefreshToken(cmd.RefreshToken.ExposeSecret()))
+		if err != nil {
+			return application.Void{}, err
+		}
+
+		if current.UserID() != userDomain.UserID(meta.UserID) {
+			return application.Void{}, domain.ErrInvalidRefreshToken
+		}
+
+		if err := h.refreshRepo.RevokeFamily(ctx, current.FamilyID(), time.Now()); err != nil {
+			return application.Void{}, err
+		}
+	}
+
+	if err := h.denylist.RevokeToken(ctx, meta.TokenID, meta.TokenExpiresAt); err != nil {
+		return application.Void{}, err
+	}
+
+	return application.Void{}, nil
+}
+
+// LogoutAllHandler ends every session of the current user, on all devices.
+type LogoutAllHandler struct {
+	denylist    TokenDenylist
+	refreshRepo domain.RefreshTokenRepository
+}
+
+var _ application.RequestHandler[application.Void, application.Void] = (*LogoutAllHandler)(nil)
+
+func NewLogoutAllHandler(denylist TokenDenylist, refreshRepo domain.RefreshTokenRepository) *LogoutAllHandler {
+	return &LogoutAllHandler{denylist: denylist, refreshRepo: refreshRepo}
+}
+
+func (h *LogoutAllHandler) Handle(ctx context.Context, _ application.Void) (application.Void, error) {
+	userID := userDomain.UserID(causation.FromContext(ctx).UserID)
+	now := time.Now()
+
+	if err := h.refreshRepo.RevokeAllForUser(ctx, userID, now); err != nil {
+		return application.Void{}, err
+	}
+
+	if err := h.denylist.RevokeUserTokens(ctx, userID, now); err != nil {
+		return application.Void{}, err
+	}
+
+	return application.Void{}, nil
+}
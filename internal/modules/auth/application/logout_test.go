@@ -0,0 +1,146 @@
+package application_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/negrel/secrecy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/danicc097/todo-ddd-example/internal/infrastructure/crypto"
+	"github.com/danicc097/todo-ddd-example/internal/modules/auth/application"
+	"github.com/danicc097/todo-ddd-example/internal/modules/auth/domain"
+	authPg "github.com/danicc097/todo-ddd-example/internal/modules/auth/infrastructure/postgres"
+	authRedis "github.com/danicc097/todo-ddd-example/internal/modules/auth/infrastructure/redis"
+	userPg "github.com/danicc097/todo-ddd-example/internal/modules/user/infrastructure/postgres"
+	sharedApp "github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+	"github.com/danicc097/todo-ddd-example/internal/testfixtures"
+	"github.com/danicc097/todo-ddd-example/internal/testutils"
+)
+
+func TestLogout_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pool := testutils.GetGlobalPostgresPool(t)
+	redisClient := testutils.GetGlobalRedis(t).Connect(ctx, t)
+	fixtures := testfixtures.NewFixtures(pool)
+
+	uow := sharedPg.NewUnitOfWork(pool)
+	userRepo := userPg.NewUserRepo(pool, uow)
+	authRepo := authPg.NewAuthRepo(pool, uow)
+	refreshRepo := authPg.NewRefreshTokenRepo(pool)
+	hasher := crypto.NewArgon2PasswordHasher()
+
+	privKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	signingKey, _ := crypto.NewSigningKey(privKey)
+	issuer := crypto.NewTokenIssuer(signingKey, "test")
+	verifier := crypto.NewTokenVerifier(signingKey.VerificationKey())
+
+	denylist := authRedis.NewTokenDenylist(redisClient, application.AccessTokenTTL, time.Minute)
+	// another instance that hasn't cached anything yet
+	otherInstance := authRedis.NewTokenDenylist(redisClient, application.AccessTokenTTL, time.Minute)
+
+	sessions := application.NewSessionIssuer(issuer, refreshRepo, time.Hour)
+	login := application.NewLoginHandler(userRepo, authRepo, sessions, hasher)
+	refresh := application.NewRefreshHandler(sessions, refreshRepo, uow)
+	logout := sharedApp.WithUoW(application.NewLogoutHandler(denylist, refreshRepo), uow)
+	logoutAll := sharedApp.WithUoW(application.NewLogoutAllHandler(denylist, refreshRepo), uow)
+
+	startSession := func(t *testing.T) (context.Context, *crypto.AuthClaims, string) {
+		t.Helper()
+
+		user := fixtures.RandomUser(ctx, t)
+		hash, _ := crypto.HashPassword("password123", crypto.DefaultArgon2Params)
+		require.NoError(t, authRepo.Save(ctx, domain.NewUserAuth(user.ID(), hash)))
+
+		tokens, err := login.Handle(ctx, application.LoginCommand{
+			Email:    user.Email().String(),
+			Password: *secrecy.NewSecret("password123"),
+		})
+		require.NoError(t, err)
+
+		claims, err := verifier.Verify(tokens.AccessToken)
+		require.NoError(t, err)
+
+		userCtx := causation.WithMetadata(ctx, causation.Metadata{
+			UserID:         claims.UserID,
+			TokenID:        claims.ID,
+			TokenExpiresAt: claims.ExpiresAt.Time,
+		})
+
+		return userCtx, claims, tokens.RefreshToken
+	}
+
+	t.Run("logout revokes the access token and the refresh family", func(t *testing.T) {
+		userCtx, claims, refreshToken := startSession(t)
+
+		revoked, err := otherInstance.IsRevoked(ctx, claims.ID, claims.UserID, claims.IssueTime())
+		require.NoError(t, err)
+		assert.False(t, revoked)
+
+		_, err = logout.Handle(userCtx, application.LogoutCommand{RefreshToken: secrecy.NewSecret(refreshToken)})
+		require.NoError(t, err)
+
+		revoked, err = denylist.IsRevoked(ctx, claims.ID, claims.UserID, claims.IssueTime())
+		require.NoError(t, err)
+		assert.True(t, revoked)
+
+		_, err = refresh.Handle(ctx, application.RefreshCommand{RefreshToken: *secrecy.NewSecret(refreshToken)})
+		assert.ErrorIs(t, err, domain.ErrRefreshTokenReused)
+	})
+
+	t.Run("logout rejects refresh tokens of other users", func(t *testing.T) {
+		userCtx, _, _ := startSession(t)
+		_, _, otherRefreshToken := startSession(t)
+
+		_, err := logout.Handle(userCtx, application.LogoutCommand{RefreshToken: secrecy.NewSecret(otherRefreshToken)})
+		require.ErrorIs(t, err, domain.ErrInvalidRefreshToken)
+
+		_, err = refresh.Handle(ctx, application.RefreshCommand{RefreshToken: *secrecy.NewSecret(otherRefreshToken)})
+		assert.NoError(t, err)
+	})
+
+	t.Run("logout all revokes every session of the user", func(t *testing.T) {
+		userCtx, claims, refreshToken := startSession(t)
+
+		_, err := logoutAll.Handle(userCtx, sharedApp.Void{})
+		require.NoError(t, err)
+
+		// a different token of the same user that was never revoked individually
+		revoked, err := otherInstance.IsRevoked(ctx, "other-token-id", claims.UserID, claims.IssueTime())
+		require.NoError(t, err)
+		assert.True(t, revoked)
+
+		revoked, err = otherInstance.IsRevoked(ctx, "later-token-id", claims.UserID, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		assert.False(t, revoked)
+
+		_, err = refresh.Handle(ctx, application.RefreshCommand{RefreshToken: *secrecy.NewSecret(refreshToken)})
+		assert.ErrorIs(t, err, domain.ErrRefreshTokenReused)
+	})
+
+	t.Run("logout all spares tokens issued right after it", func(t *testing.T) {
+		userCtx, claims, _ := startSession(t)
+
+		_, err := logoutAll.Handle(userCtx, sharedApp.Void{})
+		require.NoError(t, err)
+
+		// most likely within the same second as the logout
+		token, err := issuer.Issue(claims.UserID, nil, time.Hour)
+		require.NoError(t, err)
+
+		newClaims, err := verifier.Verify(token)
+		require.NoError(t, err)
+
+		revoked, err := otherInstance.IsRevoked(ctx, newClaims.ID, newClaims.UserID, newClaims.IssueTime())
+		require.NoError(t, err)
+		assert.False(t, revoked)
+	})
+}
@@ -0,0 +1,61 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/negrel/secrecy"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/auth/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type ReauthenticateCommand struct {
+	Password secrecy.Secret[string]
+}
+
+type ReauthenticateResponse struct {
+	AccessToken string
+}
+
+// ReauthenticateHandler lets users without TOTP step up by re-entering their password,
+// so they can still perform privileged actions such as erasing their data.
+// Users with TOTP must verify a code instead, so a leaked password alone never steps up their session.
+type ReauthenticateHandler struct {
+	repo     domain.AuthRepository
+	sessions *SessionIssuer
+	hasher   domain.PasswordHasher
+}
+
+var _ application.RequestHandler[ReauthenticateCommand, ReauthenticateResponse] = (*ReauthenticateHandler)(nil)
+
+func NewReauthenticateHandler(repo domain.AuthRepository, sessions *SessionIssuer, hasher domain.PasswordHasher) *ReauthenticateHandler {
+	return &ReauthenticateHandler{repo: repo, sessions: sessions, hasher: hasher}
+}
+
+func (h *ReauthenticateHandler) Handle(ctx context.Context, cmd ReauthenticateCommand) (ReauthenticateResponse, error) {
+	userID := userDomain.UserID(causation.FromContext(ctx).UserID)
+
+	auth, err := h.repo.FindByUserID(ctx, userID)
+	if err != nil {
+		return ReauthenticateResponse{}, err
+	}
+
+	match, err := h.hasher.Compare(cmd.Password.ExposeSecret(), auth.PasswordHash())
+	if err != nil || !match {
+		return ReauthenticateResponse{}, domain.ErrInvalidCredentials
+	}
+
+	if auth.IsTOTPActive() {
+		return ReauthenticateResponse{}, domain.ErrReauthWithTOTP
+	}
+
+	access, err := h.sessions.Reauthenticated(userID, time.Now())
+	if err != nil {
+		return ReauthenticateResponse{}, err
+	}
+
+	return ReauthenticateResponse{AccessToken: access}, nil
+}
@@ -0,0 +1,46 @@
+package application
+
+import (
+	"context"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/auth/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type RecoverTOTPCommand struct{ Code string }
+
+type RecoverTOTPResponse = SessionTokens
+
+// RecoverTOTPHandler completes MFA with a recovery code instead of a TOTP code.
+// TOTP stays active, the user is expected to disable it and enroll again.
+type RecoverTOTPHandler struct {
+	repo     domain.AuthRepository
+	sessions *SessionIssuer
+}
+
+var _ application.RequestHandler[RecoverTOTPCommand, RecoverTOTPResponse] = (*RecoverTOTPHandler)(nil)
+
+func NewRecoverTOTPHandler(repo domain.AuthRepository, sessions *SessionIssuer) *RecoverTOTPHandler {
+	return &RecoverTOTPHandler{repo: repo, sessions: sessions}
+}
+
+func (h *RecoverTOTPHandler) Handle(ctx context.Context, cmd RecoverTOTPCommand) (RecoverTOTPResponse, error) {
+	userID := userDomain.UserID(causation.FromContext(ctx).UserID)
+
+	auth, err := h.repo.FindByUserIDForUpdate(ctx, userID)
+	if err != nil {
+		return RecoverTOTPResponse{}, err
+	}
+
+	if err := auth.UseRecoveryCode(cmd.Code); err != nil {
+		return RecoverTOTPResponse{}, err
+	}
+
+	if err := h.repo.Save(ctx, auth); err != nil {
+		return RecoverTOTPResponse{}, err
+	}
+
+	return h.sessions.Start(ctx, userID, true)
+}
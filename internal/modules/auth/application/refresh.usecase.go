@@ -0,0 +1,65 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/negrel/secrecy"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/auth/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+)
+
+type RefreshCommand struct {
+	RefreshToken secrecy.Secret[string]
+}
+
+type RefreshResponse = SessionTokens
+
+// RefreshHandler rotates a refresh token. Presenting a token that was already rotated
+// revokes its whole family, since either the client or an attacker holds a stolen copy.
+type RefreshHandler struct {
+	sessions    *SessionIssuer
+	refreshRepo domain.RefreshTokenRepository
+	uow         application.UnitOfWork
+}
+
+var _ application.RequestHandler[RefreshCommand, RefreshResponse] = (*RefreshHandler)(nil)
+
+func NewRefreshHandler(sessions *SessionIssuer, refreshRepo domain.RefreshTokenRepository, uow application.UnitOfWork) *RefreshHandler {
+	return &RefreshHandler{sessions: sessions, refreshRepo: refreshRepo, uow: uow}
+}
+
+func (h *RefreshHandler) Handle(ctx context.Context, cmd RefreshCommand) (RefreshResponse, error) {
+	var (
+		resp   RefreshResponse
+		reused bool
+	)
+
+	// the revocation has to commit even though the request fails, so the uow is run here
+	err := h.uow.Execute(ctx, func(ctx context.Context) error {
+		current, err := h.refreshRepo.FindByHash(ctx, domain.HashRefreshToken(cmd.RefreshToken.ExposeSecret()))
+		if err != nil {
+			return err
+		}
+
+		resp, err = h.sessions.Rotate(ctx, current)
+		if errors.Is(err, domain.ErrRefreshTokenReused) {
+			reused = true
+
+			return h.refreshRepo.RevokeFamily(ctx, current.FamilyID(), time.Now())
+		}
+
+		return err
+	})
+	if err != nil {
+		return RefreshResponse{}, err
+	}
+
+	if reused {
+		return RefreshResponse{}, domain.ErrRefreshTokenReused
+	}
+
+	return resp, nil
+}
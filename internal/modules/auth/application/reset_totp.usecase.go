@@ -0,0 +1,72 @@
+package application
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danicc097/todo-ddd-example/internal/apperrors"
+	"github.com/danicc097/todo-ddd-example/internal/modules/auth/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type ResetTOTPCommand struct {
+	UserID userDomain.UserID
+}
+
+// ResetTOTPHandler lets an administrator clear the TOTP enrollment of a locked out user,
+// who can then log in with their password and enroll again.
+// Existing sessions of the user are ended, since they may belong to whoever holds the lost device.
+type ResetTOTPHandler struct {
+	repo        domain.AuthRepository
+	refreshRepo domain.RefreshTokenRepository
+	denylist    TokenDenylist
+	admins      []uuid.UUID
+}
+
+var _ application.RequestHandler[ResetTOTPCommand, application.Void] = (*ResetTOTPHandler)(nil)
+
+func NewResetTOTPHandler(repo domain.AuthRepository, refreshRepo domain.RefreshTokenRepository, denylist TokenDenylist, admins []uuid.UUID) *ResetTOTPHandler {
+	return &ResetTOTPHandler{repo: repo, refreshRepo: refreshRepo, denylist: denylist, admins: admins}
+}
+
+func (h *ResetTOTPHandler) Handle(ctx context.Context, cmd ResetTOTPCommand) (application.Void, error) {
+	meta := causation.FromContext(ctx)
+
+	if !meta.MFAVerified {
+		return application.Void{}, apperrors.New(apperrors.MFARequired, "MFA required for this privileged action")
+	}
+
+	if !slices.Contains(h.admins, meta.UserID) {
+		return application.Void{}, domain.ErrNotAdmin
+	}
+
+	auth, err := h.repo.FindByUserIDForUpdate(ctx, cmd.UserID)
+	if err != nil {
+		return application.Void{}, err
+	}
+
+	if err := auth.ResetTOTP(userDomain.UserID(meta.UserID)); err != nil {
+		return application.Void{}, err
+	}
+
+	if err := h.repo.Save(ctx, auth); err != nil {
+		return application.Void{}, err
+	}
+
+	now := time.Now()
+
+	if err := h.refreshRepo.RevokeAllForUser(ctx, cmd.UserID, now); err != nil {
+		return application.Void{}, err
+	}
+
+	if err := h.denylist.RevokeUserTokens(ctx, cmd.UserID, now); err != nil {
+		return application.Void{}, err
+	}
+
+	return application.Void{}, nil
+}
@@ -0,0 +1,71 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/auth/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+)
+
+// AccessTokenTTL bounds how long a revoked access token has to be denied.
+const AccessTokenTTL = 15 * time.Minute
+
+type SessionTokens struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// SessionIssuer hands out the short-lived access token and the refresh token of a session.
+type SessionIssuer struct {
+	issuer      domain.TokenIssuer
+	refreshRepo domain.RefreshTokenRepository
+	refreshTTL  time.Duration
+}
+
+func NewSessionIssuer(issuer domain.TokenIssuer, refreshRepo domain.RefreshTokenRepository, refreshTTL time.Duration) *SessionIssuer {
+	return &SessionIssuer{issuer: issuer, refreshRepo: refreshRepo, refreshTTL: refreshTTL}
+}
+
+// Start begins a new refresh token family.
+func (s *SessionIssuer) Start(ctx context.Context, userID userDomain.UserID, mfaVerified bool) (SessionTokens, error) {
+	refresh, raw, err := domain.NewRefreshToken(userID, mfaVerified, time.Now(), s.refreshTTL)
+	if err != nil {
+		return SessionTokens{}, err
+	}
+
+	return s.issue(ctx, refresh, raw)
+}
+
+// Rotate consumes a refresh token and continues its family.
+func (s *SessionIssuer) Rotate(ctx context.Context, current *domain.RefreshToken) (SessionTokens, error) {
+	next, raw, err := current.Rotate(time.Now(), s.refreshTTL)
+	if err != nil {
+		return SessionTokens{}, err
+	}
+
+	if err := s.refreshRepo.Save(ctx, current); err != nil {
+		return SessionTokens{}, err
+	}
+
+	return s.issue(ctx, next, raw)
+}
+
+// Reauthenticated issues an access token for a user without TOTP who re-entered their password.
+// The refresh token of the session is kept, so the re-authentication lapses with the access token.
+func (s *SessionIssuer) Reauthenticated(userID userDomain.UserID, at time.Time) (string, error) {
+	return s.issuer.IssueReauthenticated(userID.UUID(), at, AccessTokenTTL)
+}
+
+func (s *SessionIssuer) issue(ctx context.Context, refresh *domain.RefreshToken, raw string) (SessionTokens, error) {
+	if err := s.refreshRepo.Save(ctx, refresh); err != nil {
+		return SessionTokens{}, err
+	}
+
+	access, err := s.issuer.Issue(refresh.UserID().UUID(), refresh.MFAVerifiedAt(), AccessTokenTTL)
+	if err != nil {
+		return SessionTokens{}, err
+	}
+
+	return SessionTokens{AccessToken: access, RefreshToken: raw}, nil
+}
@@ -2,9 +2,7 @@ package application
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"time"
 
 	"github.com/pquerna/otp/totp"
 
@@ -18,6 +16,28 @@ type TOTPGuard interface {
 	Consume(ctx context.Context, userID userDomain.UserID, code string) error
 }
 
+// totpChecker validates codes against the user's secret, accepting each code only once.
+type totpChecker struct {
+	guard     TOTPGuard
+	encryptor domain.Encryptor
+	masterKey []byte
+}
+
+func (c totpChecker) check(ctx context.Context, auth *domain.UserAuth, code string) error {
+	cipher, nonce := auth.TOTPCredentials()
+
+	secret, err := c.encryptor.Decrypt(cipher, nonce, c.masterKey)
+	if err != nil {
+		return err
+	}
+
+	if !totp.Validate(code, string(secret)) {
+		return domain.ErrInvalidOTP
+	}
+
+	return c.guard.Consume(ctx, auth.UserID(), code)
+}
+
 type InitiateTOTPHandler struct {
 	repo      domain.AuthRepository
 	encryptor domain.Encryptor
@@ -48,7 +68,9 @@ func (h *InitiateTOTPHandler) Handle(ctx context.Context, _ application.Void) (s
 		return "", err
 	}
 
-	auth.InitiateTOTP(cipher, nonce)
+	if err := auth.InitiateTOTP(cipher, nonce); err != nil {
+		return "", err
+	}
 
 	if err := h.repo.Save(ctx, auth); err != nil {
 		return "", err
@@ -60,19 +82,19 @@ func (h *InitiateTOTPHandler) Handle(ctx context.Context, _ application.Void) (s
 type VerifyTOTPCommand struct{ Code string }
 
 type VerifyTOTPResponse struct {
-	AccessToken string
+	SessionTokens
+	// RecoveryCodes are only returned once, when the code activates TOTP.
+	RecoveryCodes []string
 }
 
 type VerifyTOTPHandler struct {
-	repo      domain.AuthRepository
-	guard     TOTPGuard
-	issuer    domain.TokenIssuer
-	encryptor domain.Encryptor
-	masterKey []byte
+	repo     domain.AuthRepository
+	sessions *SessionIssuer
+	checker  totpChecker
 }
 
-func NewVerifyTOTPHandler(repo domain.AuthRepository, guard TOTPGuard, issuer domain.TokenIssuer, encryptor domain.Encryptor, masterKey []byte) *VerifyTOTPHandler {
-	return &VerifyTOTPHandler{repo: repo, guard: guard, issuer: issuer, encryptor: encryptor, masterKey: masterKey}
+func NewVerifyTOTPHandler(repo domain.AuthRepository, guard TOTPGuard, sessions *SessionIssuer, encryptor domain.Encryptor, masterKey []byte) *VerifyTOTPHandler {
+	return &VerifyTOTPHandler{repo: repo, sessions: sessions, checker: totpChecker{guard: guard, encryptor: encryptor, masterKey: masterKey}}
 }
 
 func (h *VerifyTOTPHandler) Handle(ctx context.Context, cmd VerifyTOTPCommand) (VerifyTOTPResponse, error) {
@@ -85,36 +107,31 @@ func (h *VerifyTOTPHandler) Handle(ctx context.Context, cmd VerifyTOTPCommand) (
 	}
 
 	if !auth.IsTOTPPending() && !auth.IsTOTPActive() {
-		return VerifyTOTPResponse{}, errors.New("TOTP not set up")
+		return VerifyTOTPResponse{}, domain.ErrTOTPNotSetUp
 	}
 
-	cipher, nonce := auth.TOTPCredentials()
-
-	secret, err := h.encryptor.Decrypt(cipher, nonce, h.masterKey)
-	if err != nil {
+	if err := h.checker.check(ctx, auth, cmd.Code); err != nil {
 		return VerifyTOTPResponse{}, err
 	}
 
-	if !totp.Validate(cmd.Code, string(secret)) {
-		return VerifyTOTPResponse{}, errors.New("invalid code")
-	}
-
-	if err := h.guard.Consume(ctx, userID, cmd.Code); err != nil {
-		return VerifyTOTPResponse{}, err
-	}
+	var recoveryCodes []string
 
 	if auth.IsTOTPPending() {
-		auth.ActivateTOTP()
+		recoveryCodes, err = auth.ActivateTOTP()
+		if err != nil {
+			return VerifyTOTPResponse{}, err
+		}
 
 		if err := h.repo.Save(ctx, auth); err != nil {
 			return VerifyTOTPResponse{}, err
 		}
 	}
 
-	token, err := h.issuer.Issue(userID.UUID(), true, 15*time.Minute)
+	// a new family, since refreshing the pre-MFA session must not grant the mfa claim
+	tokens, err := h.sessions.Start(ctx, userID, true)
 	if err != nil {
 		return VerifyTOTPResponse{}, err
 	}
 
-	return VerifyTOTPResponse{AccessToken: token}, nil
+	return VerifyTOTPResponse{SessionTokens: tokens, RecoveryCodes: recoveryCodes}, nil
 }
@@ -3,18 +3,21 @@ package application_test
 import (
 	"context"
 	"fmt"
-	"os"
+	"sync"
 	"testing"
+	"time"
 
-	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/negrel/secrecy"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/danicc097/todo-ddd-example/internal/infrastructure/crypto"
 	infraCrypto "github.com/danicc097/todo-ddd-example/internal/infrastructure/crypto"
 	"github.com/danicc097/todo-ddd-example/internal/modules/auth/application"
+	"github.com/danicc097/todo-ddd-example/internal/modules/auth/domain"
 	authAdapters "github.com/danicc097/todo-ddd-example/internal/modules/auth/infrastructure/adapters"
 	authPg "github.com/danicc097/todo-ddd-example/internal/modules/auth/infrastructure/postgres"
 	authRedis "github.com/danicc097/todo-ddd-example/internal/modules/auth/infrastructure/redis"
@@ -22,6 +25,7 @@ import (
 	sharedApp "github.com/danicc097/todo-ddd-example/internal/shared/application"
 	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
 	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+	"github.com/danicc097/todo-ddd-example/internal/testfixtures"
 	"github.com/danicc097/todo-ddd-example/internal/testutils"
 )
 
@@ -42,9 +46,10 @@ func TestTOTPFlow_Integration(t *testing.T) {
 	encryptor := authAdapters.NewAESGCMEncryptor()
 	appConfig := authAdapters.NewMessagingAppConfig()
 
-	privKeyBytes, _ := os.ReadFile("../../../../private.pem")
-	privKey, _ := jwt.ParseRSAPrivateKeyFromPEM(privKeyBytes)
-	tokenIssuer := crypto.NewTokenIssuer(privKey, "test")
+	signingKey, err := crypto.LoadSigningKey("../../../../private.pem")
+	require.NoError(t, err)
+
+	tokenIssuer := crypto.NewTokenIssuer(signingKey, "test")
 
 	uniqueEmail := fmt.Sprintf("auth-%s@example.com", uuid.New().String()[:8])
 
@@ -60,7 +65,10 @@ func TestTOTPFlow_Integration(t *testing.T) {
 	ctx = causation.WithMetadata(ctx, causation.Metadata{UserID: registerResp.ID.UUID()})
 
 	initiateHandler := application.NewInitiateTOTPHandler(authRepo, encryptor, appConfig, masterKey)
-	_ = application.NewVerifyTOTPHandler(authRepo, totpGuard, tokenIssuer, encryptor, masterKey)
+	sessions := application.NewSessionIssuer(tokenIssuer, authPg.NewRefreshTokenRepo(pool), time.Hour)
+	verifyHandler := sharedApp.WithUoW(application.NewVerifyTOTPHandler(authRepo, totpGuard, sessions, encryptor, masterKey), uow)
+	recoverHandler := sharedApp.WithUoW(application.NewRecoverTOTPHandler(authRepo, sessions), uow)
+	disableHandler := sharedApp.WithUoW(application.NewDisableTOTPHandler(authRepo, hasher, totpGuard, encryptor, masterKey), uow)
 
 	uri, err := initiateHandler.Handle(ctx, struct{}{})
 	require.NoError(t, err)
@@ -69,4 +77,132 @@ func TestTOTPFlow_Integration(t *testing.T) {
 	auth, err := authRepo.FindByUserID(ctx, registerResp.ID)
 	require.NoError(t, err)
 	assert.True(t, auth.IsTOTPPending())
+
+	key, err := otp.NewKeyFromURL(uri)
+	require.NoError(t, err)
+
+	code, err := totp.GenerateCode(key.Secret(), time.Now())
+	require.NoError(t, err)
+
+	verifyResp, err := verifyHandler.Handle(ctx, application.VerifyTOTPCommand{Code: code})
+	require.NoError(t, err)
+	assert.NotEmpty(t, verifyResp.AccessToken)
+	require.Len(t, verifyResp.RecoveryCodes, domain.RecoveryCodeCount)
+
+	t.Run("recovery codes complete MFA once", func(t *testing.T) {
+		resp, err := recoverHandler.Handle(ctx, application.RecoverTOTPCommand{Code: verifyResp.RecoveryCodes[0]})
+		require.NoError(t, err)
+		assert.NotEmpty(t, resp.AccessToken)
+
+		_, err = recoverHandler.Handle(ctx, application.RecoverTOTPCommand{Code: verifyResp.RecoveryCodes[0]})
+		assert.ErrorIs(t, err, domain.ErrInvalidRecoveryCode)
+	})
+
+	t.Run("concurrent uses of a recovery code succeed once", func(t *testing.T) {
+		const attempts = 5
+
+		errs := make([]error, attempts)
+
+		var wg sync.WaitGroup
+		wg.Add(attempts)
+
+		for i := range attempts {
+			go func() {
+				defer wg.Done()
+
+				_, errs[i] = recoverHandler.Handle(ctx, application.RecoverTOTPCommand{Code: verifyResp.RecoveryCodes[1]})
+			}()
+		}
+
+		wg.Wait()
+
+		succeeded := 0
+
+		for _, err := range errs {
+			if err == nil {
+				succeeded++
+				continue
+			}
+
+			require.ErrorIs(t, err, domain.ErrInvalidRecoveryCode)
+		}
+
+		assert.Equal(t, 1, succeeded)
+	})
+
+	t.Run("disable requires the password and a code", func(t *testing.T) {
+		// the previous code was consumed, the next step's code is still accepted
+		next, err := totp.GenerateCode(key.Secret(), time.Now().Add(30*time.Second))
+		require.NoError(t, err)
+
+		_, err = disableHandler.Handle(ctx, application.DisableTOTPCommand{Password: *secrecy.NewSecret("wrong"), Code: next})
+		require.ErrorIs(t, err, domain.ErrInvalidCredentials)
+
+		_, err = disableHandler.Handle(ctx, application.DisableTOTPCommand{Password: *secrecy.NewSecret("password123!"), Code: "000000"})
+		require.ErrorIs(t, err, domain.ErrInvalidOTP)
+
+		_, err = disableHandler.Handle(ctx, application.DisableTOTPCommand{Password: *secrecy.NewSecret("password123!"), Code: next})
+		require.NoError(t, err)
+
+		auth, err := authRepo.FindByUserID(ctx, registerResp.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.TOTPDisabled, auth.TOTPStatus())
+		assert.Empty(t, auth.RecoveryCodes())
+	})
+}
+
+func TestResetTOTP_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pool := testutils.GetGlobalPostgresPool(t)
+	redisClient := testutils.GetGlobalRedis(t).Connect(ctx, t)
+	fixtures := testfixtures.NewFixtures(pool)
+
+	uow := sharedPg.NewUnitOfWork(pool)
+	authRepo := authPg.NewAuthRepo(pool, uow)
+	refreshRepo := authPg.NewRefreshTokenRepo(pool)
+	denylist := authRedis.NewTokenDenylist(redisClient, application.AccessTokenTTL, time.Minute)
+
+	admin := fixtures.RandomUser(ctx, t)
+	user := fixtures.RandomUser(ctx, t)
+
+	auth := domain.NewUserAuth(user.ID(), "hash")
+	require.NoError(t, auth.InitiateTOTP([]byte("c"), []byte("n")))
+	_, err := auth.ActivateTOTP()
+	require.NoError(t, err)
+	require.NoError(t, authRepo.Save(ctx, auth))
+
+	reset := sharedApp.WithUoW(application.NewResetTOTPHandler(authRepo, refreshRepo, denylist, []uuid.UUID{admin.ID().UUID()}), uow)
+	cmd := application.ResetTOTPCommand{UserID: user.ID()}
+
+	t.Run("requires MFA", func(t *testing.T) {
+		adminCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: admin.ID().UUID()})
+
+		_, err := reset.Handle(adminCtx, cmd)
+		assert.ErrorContains(t, err, "MFA required")
+	})
+
+	t.Run("requires an administrator", func(t *testing.T) {
+		userCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: user.ID().UUID(), MFAVerified: true})
+
+		_, err := reset.Handle(userCtx, cmd)
+		assert.ErrorIs(t, err, domain.ErrNotAdmin)
+	})
+
+	t.Run("clears the enrollment and ends the user's sessions", func(t *testing.T) {
+		adminCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: admin.ID().UUID(), MFAVerified: true})
+		issuedAt := time.Now().Add(-time.Minute)
+
+		_, err := reset.Handle(adminCtx, cmd)
+		require.NoError(t, err)
+
+		auth, err := authRepo.FindByUserID(ctx, user.ID())
+		require.NoError(t, err)
+		assert.Equal(t, domain.TOTPDisabled, auth.TOTPStatus())
+
+		revoked, err := denylist.IsRevoked(ctx, "any-token", user.ID().UUID(), issuedAt)
+		require.NoError(t, err)
+		assert.True(t, revoked)
+	})
 }
@@ -2,6 +2,8 @@ package domain
 
 import (
 	"errors"
+	"slices"
+	"time"
 
 	"github.com/danicc097/todo-ddd-example/internal/apperrors"
 	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
@@ -12,6 +14,13 @@ var (
 	ErrInvalidOTP         = shared.NewDomainError(apperrors.Unauthorized, "invalid OTP code")
 	ErrAuthNotFound       = shared.NewDomainError(apperrors.NotFound, "auth record not found")
 	ErrInvalidCredentials = shared.NewDomainError(apperrors.Unauthorized, "invalid email or password")
+
+	ErrInvalidRecoveryCode = shared.NewDomainError(apperrors.Unauthorized, "invalid recovery code")
+	ErrTOTPNotActive       = shared.NewDomainError(apperrors.Conflict, "TOTP is not active")
+	ErrTOTPAlreadyActive   = shared.NewDomainError(apperrors.Conflict, "TOTP is already active, disable it before enrolling again")
+	ErrTOTPNotSetUp        = shared.NewDomainError(apperrors.Conflict, "TOTP is not set up")
+	ErrNotAdmin            = shared.NewDomainError(apperrors.Unauthorized, "only administrators can perform this action")
+	ErrReauthWithTOTP      = shared.NewDomainError(apperrors.Conflict, "TOTP is active, verify a TOTP code instead")
 )
 
 const (
@@ -27,6 +36,7 @@ type UserAuth struct {
 	totpStatus       string
 	totpSecretCipher []byte
 	totpSecretNonce  []byte
+	recoveryCodes    []string // hashes of the unused codes
 	passwordHash     string
 }
 
@@ -39,11 +49,12 @@ func NewUserAuth(userID userDomain.UserID, passwordHash string) *UserAuth {
 }
 
 type ReconstituteUserAuthArgs struct {
-	ID           userDomain.UserID
-	Status       string
-	Cipher       []byte
-	Nonce        []byte
-	PasswordHash string
+	ID            userDomain.UserID
+	Status        string
+	Cipher        []byte
+	Nonce         []byte
+	RecoveryCodes []string
+	PasswordHash  string
 }
 
 func ReconstituteUserAuth(args ReconstituteUserAuthArgs) *UserAuth {
@@ -52,6 +63,7 @@ func ReconstituteUserAuth(args ReconstituteUserAuthArgs) *UserAuth {
 		totpStatus:       args.Status,
 		totpSecretCipher: args.Cipher,
 		totpSecretNonce:  args.Nonce,
+		recoveryCodes:    args.RecoveryCodes,
 		passwordHash:     args.PasswordHash,
 	}
 }
@@ -62,19 +74,88 @@ func (a *UserAuth) IsTOTPActive() bool                { return a.totpStatus == T
 func (a *UserAuth) IsTOTPPending() bool               { return a.totpStatus == TOTPPending }
 func (a *UserAuth) TOTPCredentials() ([]byte, []byte) { return a.totpSecretCipher, a.totpSecretNonce }
 func (a *UserAuth) TOTPStatus() string                { return a.totpStatus }
+func (a *UserAuth) RecoveryCodes() []string           { return a.recoveryCodes }
+
+// InitiateTOTP starts an enrollment. An active TOTP has to be disabled or reset first,
+// so that a session without MFA can't replace the secret.
+func (a *UserAuth) InitiateTOTP(cipher, nonce []byte) error {
+	if a.totpStatus == TOTPActive {
+		return ErrTOTPAlreadyActive
+	}
 
-func (a *UserAuth) InitiateTOTP(cipher, nonce []byte) {
 	a.totpStatus = TOTPPending
 	a.totpSecretCipher = cipher
 	a.totpSecretNonce = nonce
+
+	return nil
 }
 
-func (a *UserAuth) ActivateTOTP() error {
+// ActivateTOTP completes the enrollment and returns a fresh set of one-time recovery codes.
+func (a *UserAuth) ActivateTOTP() ([]string, error) {
 	if a.totpStatus != TOTPPending {
-		return errors.New("cannot activate TOTP: not pending")
+		return nil, errors.New("cannot activate TOTP: not pending")
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
 	}
 
 	a.totpStatus = TOTPActive
+	a.recoveryCodes = hashes
+
+	a.RecordEvent(TOTPActivatedEvent{UserID: a.userID, Occurred: time.Now()})
+
+	return codes, nil
+}
+
+// UseRecoveryCode consumes one of the recovery codes in place of a TOTP code.
+func (a *UserAuth) UseRecoveryCode(code string) error {
+	if a.totpStatus != TOTPActive {
+		return ErrTOTPNotActive
+	}
+
+	i := slices.Index(a.recoveryCodes, HashRecoveryCode(code))
+	if i < 0 {
+		return ErrInvalidRecoveryCode
+	}
+
+	a.recoveryCodes = slices.Delete(slices.Clone(a.recoveryCodes), i, i+1)
+
+	a.RecordEvent(RecoveryCodeUsedEvent{UserID: a.userID, Remaining: len(a.recoveryCodes), Occurred: time.Now()})
 
 	return nil
 }
+
+// DisableTOTP turns TOTP off at the user's request. Proving possession of the factor is up to the caller.
+func (a *UserAuth) DisableTOTP() error {
+	if a.totpStatus != TOTPActive {
+		return ErrTOTPNotActive
+	}
+
+	a.clearTOTP()
+
+	a.RecordEvent(TOTPDisabledEvent{UserID: a.userID, Occurred: time.Now()})
+
+	return nil
+}
+
+// ResetTOTP turns TOTP off on behalf of a user who lost their authenticator and recovery codes.
+func (a *UserAuth) ResetTOTP(resetBy userDomain.UserID) error {
+	if a.totpStatus == TOTPDisabled {
+		return ErrTOTPNotSetUp
+	}
+
+	a.clearTOTP()
+
+	a.RecordEvent(TOTPResetEvent{UserID: a.userID, ResetBy: resetBy, Occurred: time.Now()})
+
+	return nil
+}
+
+func (a *UserAuth) clearTOTP() {
+	a.totpStatus = TOTPDisabled
+	a.totpSecretCipher = nil
+	a.totpSecretNonce = nil
+	a.recoveryCodes = nil
+}
@@ -1,6 +1,7 @@
 package domain_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -21,7 +22,7 @@ func TestUserAuth_TOTP(t *testing.T) {
 		cipher := []byte("cipher")
 		nonce := []byte("nonce")
 
-		auth.InitiateTOTP(cipher, nonce)
+		require.NoError(t, auth.InitiateTOTP(cipher, nonce))
 
 		assert.Equal(t, domain.TOTPPending, auth.TOTPStatus())
 		assert.True(t, auth.IsTOTPPending())
@@ -33,12 +34,17 @@ func TestUserAuth_TOTP(t *testing.T) {
 	t.Run("activate totp - success", func(t *testing.T) {
 		userID := userDomain.UserID(uuid.New())
 		auth := domain.NewUserAuth(userID, "hash")
-		auth.InitiateTOTP([]byte("c"), []byte("n"))
+		require.NoError(t, auth.InitiateTOTP([]byte("c"), []byte("n")))
 
-		err := auth.ActivateTOTP()
+		codes, err := auth.ActivateTOTP()
 		require.NoError(t, err)
 		assert.Equal(t, domain.TOTPActive, auth.TOTPStatus())
 		assert.True(t, auth.IsTOTPActive())
+		assert.Len(t, codes, domain.RecoveryCodeCount)
+		assert.Len(t, auth.RecoveryCodes(), domain.RecoveryCodeCount)
+		assert.NotContains(t, auth.RecoveryCodes(), codes[0], "only hashes are stored")
+		require.Len(t, auth.Events(), 1)
+		assert.IsType(t, domain.TOTPActivatedEvent{}, auth.Events()[0])
 	})
 
 	t.Run("activate totp - failure", func(t *testing.T) {
@@ -46,14 +52,123 @@ func TestUserAuth_TOTP(t *testing.T) {
 		auth := domain.NewUserAuth(userID, "hash")
 
 		// status is DISABLED
-		err := auth.ActivateTOTP()
+		_, err := auth.ActivateTOTP()
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "not pending")
 
 		// status is already ACTIVE
-		auth.InitiateTOTP([]byte("c"), []byte("n"))
-		_ = auth.ActivateTOTP()
-		err = auth.ActivateTOTP()
+		require.NoError(t, auth.InitiateTOTP([]byte("c"), []byte("n")))
+		_, _ = auth.ActivateTOTP()
+		_, err = auth.ActivateTOTP()
 		assert.Error(t, err)
+
+		// an active secret can't be replaced without disabling it first
+		assert.ErrorIs(t, auth.InitiateTOTP([]byte("c2"), []byte("n2")), domain.ErrTOTPAlreadyActive)
+	})
+}
+
+func activeAuth(t *testing.T) (*domain.UserAuth, []string) {
+	t.Helper()
+
+	auth := domain.NewUserAuth(userDomain.UserID(uuid.New()), "hash")
+	require.NoError(t, auth.InitiateTOTP([]byte("c"), []byte("n")))
+
+	codes, err := auth.ActivateTOTP()
+	require.NoError(t, err)
+
+	auth.ClearEvents()
+
+	return auth, codes
+}
+
+func TestUserAuth_RecoveryCodes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("codes are single use", func(t *testing.T) {
+		auth, codes := activeAuth(t)
+
+		require.NoError(t, auth.UseRecoveryCode(codes[0]))
+		assert.Len(t, auth.RecoveryCodes(), domain.RecoveryCodeCount-1)
+		assert.True(t, auth.IsTOTPActive())
+
+		require.Len(t, auth.Events(), 1)
+		evt, ok := auth.Events()[0].(domain.RecoveryCodeUsedEvent)
+		require.True(t, ok)
+		assert.Equal(t, domain.RecoveryCodeCount-1, evt.Remaining)
+
+		assert.ErrorIs(t, auth.UseRecoveryCode(codes[0]), domain.ErrInvalidRecoveryCode)
+	})
+
+	t.Run("codes ignore case and separators", func(t *testing.T) {
+		auth, codes := activeAuth(t)
+
+		require.NoError(t, auth.UseRecoveryCode(strings.ToLower(strings.ReplaceAll(codes[1], "-", " "))))
+	})
+
+	t.Run("unknown codes are rejected", func(t *testing.T) {
+		auth, _ := activeAuth(t)
+
+		assert.ErrorIs(t, auth.UseRecoveryCode("AAAA-BBBB-CCCC-DDDD"), domain.ErrInvalidRecoveryCode)
+		assert.Len(t, auth.RecoveryCodes(), domain.RecoveryCodeCount)
+	})
+
+	t.Run("codes require active TOTP", func(t *testing.T) {
+		auth := domain.NewUserAuth(userDomain.UserID(uuid.New()), "hash")
+
+		assert.ErrorIs(t, auth.UseRecoveryCode("AAAA-BBBB-CCCC-DDDD"), domain.ErrTOTPNotActive)
+	})
+}
+
+func TestUserAuth_DisableAndReset(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disable clears the enrollment", func(t *testing.T) {
+		auth, codes := activeAuth(t)
+
+		require.NoError(t, auth.DisableTOTP())
+		assert.Equal(t, domain.TOTPDisabled, auth.TOTPStatus())
+		assert.Empty(t, auth.RecoveryCodes())
+		c, n := auth.TOTPCredentials()
+		assert.Nil(t, c)
+		assert.Nil(t, n)
+		assert.IsType(t, domain.TOTPDisabledEvent{}, auth.Events()[0])
+
+		assert.ErrorIs(t, auth.UseRecoveryCode(codes[0]), domain.ErrTOTPNotActive)
+		assert.ErrorIs(t, auth.DisableTOTP(), domain.ErrTOTPNotActive)
+
+		// and allows enrolling again
+		require.NoError(t, auth.InitiateTOTP([]byte("c2"), []byte("n2")))
+	})
+
+	t.Run("disable requires active TOTP", func(t *testing.T) {
+		auth := domain.NewUserAuth(userDomain.UserID(uuid.New()), "hash")
+		require.NoError(t, auth.InitiateTOTP([]byte("c"), []byte("n")))
+
+		assert.ErrorIs(t, auth.DisableTOTP(), domain.ErrTOTPNotActive)
+	})
+
+	t.Run("reset records the administrator", func(t *testing.T) {
+		auth, _ := activeAuth(t)
+		admin := userDomain.UserID(uuid.New())
+
+		require.NoError(t, auth.ResetTOTP(admin))
+		assert.Equal(t, domain.TOTPDisabled, auth.TOTPStatus())
+		assert.Empty(t, auth.RecoveryCodes())
+
+		require.Len(t, auth.Events(), 1)
+		evt, ok := auth.Events()[0].(domain.TOTPResetEvent)
+		require.True(t, ok)
+		assert.Equal(t, admin, evt.ResetBy)
+		assert.Equal(t, auth.UserID(), evt.UserID)
+
+		assert.ErrorIs(t, auth.ResetTOTP(admin), domain.ErrTOTPNotSetUp)
+	})
+
+	t.Run("reset also clears a pending enrollment", func(t *testing.T) {
+		auth := domain.NewUserAuth(userDomain.UserID(uuid.New()), "hash")
+		require.NoError(t, auth.InitiateTOTP([]byte("c"), []byte("n")))
+
+		require.NoError(t, auth.ResetTOTP(userDomain.UserID(uuid.New())))
+		assert.Equal(t, domain.TOTPDisabled, auth.TOTPStatus())
 	})
 }
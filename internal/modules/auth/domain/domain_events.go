@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+type TOTPActivatedEvent struct {
+	UserID   userDomain.UserID
+	Occurred time.Time
+}
+
+func (e TOTPActivatedEvent) EventName() shared.EventType         { return shared.AuthTOTPActivated }
+func (e TOTPActivatedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TOTPActivatedEvent) AggregateID() uuid.UUID              { return e.UserID.UUID() }
+func (e TOTPActivatedEvent) AggregateType() shared.AggregateType { return shared.AggUser }
+
+type TOTPDisabledEvent struct {
+	UserID   userDomain.UserID
+	Occurred time.Time
+}
+
+func (e TOTPDisabledEvent) EventName() shared.EventType         { return shared.AuthTOTPDisabled }
+func (e TOTPDisabledEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TOTPDisabledEvent) AggregateID() uuid.UUID              { return e.UserID.UUID() }
+func (e TOTPDisabledEvent) AggregateType() shared.AggregateType { return shared.AggUser }
+
+type TOTPResetEvent struct {
+	UserID   userDomain.UserID
+	ResetBy  userDomain.UserID
+	Occurred time.Time
+}
+
+func (e TOTPResetEvent) EventName() shared.EventType         { return shared.AuthTOTPReset }
+func (e TOTPResetEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TOTPResetEvent) AggregateID() uuid.UUID              { return e.UserID.UUID() }
+func (e TOTPResetEvent) AggregateType() shared.AggregateType { return shared.AggUser }
+
+type RecoveryCodeUsedEvent struct {
+	UserID    userDomain.UserID
+	Remaining int
+	Occurred  time.Time
+}
+
+func (e RecoveryCodeUsedEvent) EventName() shared.EventType         { return shared.AuthRecoveryCodeUsed }
+func (e RecoveryCodeUsedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e RecoveryCodeUsedEvent) AggregateID() uuid.UUID              { return e.UserID.UUID() }
+func (e RecoveryCodeUsedEvent) AggregateType() shared.AggregateType { return shared.AggUser }
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+const (
+	// RecoveryCodeCount is how many recovery codes are issued when TOTP is activated.
+	RecoveryCodeCount = 10
+	recoveryCodeSize  = 10 // 80 bits, 16 base32 characters
+	recoveryCodeGroup = 4
+)
+
+// newRecoveryCodes returns raw codes formatted for display, and their hashes, which are the only part stored.
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, RecoveryCodeCount)
+	hashes := make([]string, RecoveryCodeCount)
+
+	for i := range codes {
+		b := make([]byte, recoveryCodeSize)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+
+		raw := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b)
+
+		groups := make([]string, 0, len(raw)/recoveryCodeGroup)
+		for j := 0; j < len(raw); j += recoveryCodeGroup {
+			groups = append(groups, raw[j:j+recoveryCodeGroup])
+		}
+
+		codes[i] = strings.Join(groups, "-")
+		hashes[i] = HashRecoveryCode(codes[i])
+	}
+
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode ignores case, dashes and spaces, so codes can be typed as displayed or not.
+// Codes carry enough entropy for a plain hash, like refresh tokens.
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danicc097/todo-ddd-example/internal/apperrors"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+var (
+	ErrInvalidRefreshToken = shared.NewDomainError(apperrors.Unauthorized, "invalid refresh token")
+	ErrRefreshTokenReused  = shared.NewDomainError(apperrors.Unauthorized, "refresh token reused, session revoked")
+)
+
+const refreshTokenSize = 32
+
+type RefreshTokenID = shared.ID[RefreshToken]
+
+// RefreshToken is a single-use opaque token that renews a session.
+// Tokens rotated from the same login share a family, so replaying a used
+// token can revoke every token derived from it.
+type RefreshToken struct {
+	id            RefreshTokenID
+	familyID      uuid.UUID
+	userID        userDomain.UserID
+	tokenHash     string
+	mfaVerifiedAt *time.Time
+	createdAt     time.Time
+	expiresAt     time.Time
+	rotatedAt     *time.Time
+	revokedAt     *time.Time
+}
+
+// NewRefreshToken starts a session family and returns the raw token, which is never stored.
+// A family started with MFA records now as the time of the verification.
+func NewRefreshToken(userID userDomain.UserID, mfaVerified bool, now time.Time, ttl time.Duration) (*RefreshToken, string, error) {
+	var mfaVerifiedAt *time.Time
+	if mfaVerified {
+		mfaVerifiedAt = &now
+	}
+
+	return newRefreshToken(uuid.New(), userID, mfaVerifiedAt, now, ttl)
+}
+
+func newRefreshToken(familyID uuid.UUID, userID userDomain.UserID, mfaVerifiedAt *time.Time, now time.Time, ttl time.Duration) (*RefreshToken, string, error) {
+	b := make([]byte, refreshTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return nil, "", fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+
+	raw := base64.RawURLEncoding.EncodeToString(b)
+
+	return &RefreshToken{
+		id:            shared.NewID[RefreshToken](),
+		familyID:      familyID,
+		userID:        userID,
+		tokenHash:     HashRefreshToken(raw),
+		mfaVerifiedAt: mfaVerifiedAt,
+		createdAt:     now,
+		expiresAt:     now.Add(ttl),
+	}, raw, nil
+}
+
+type ReconstituteRefreshTokenArgs struct {
+	ID            RefreshTokenID
+	FamilyID      uuid.UUID
+	UserID        userDomain.UserID
+	TokenHash     string
+	MFAVerifiedAt *time.Time
+	CreatedAt     time.Time
+	ExpiresAt     time.Time
+	RotatedAt     *time.Time
+	RevokedAt     *time.Time
+}
+
+func ReconstituteRefreshToken(args ReconstituteRefreshTokenArgs) *RefreshToken {
+	return &RefreshToken{
+		id:            args.ID,
+		familyID:      args.FamilyID,
+		userID:        args.UserID,
+		tokenHash:     args.TokenHash,
+		mfaVerifiedAt: args.MFAVerifiedAt,
+		createdAt:     args.CreatedAt,
+		expiresAt:     args.ExpiresAt,
+		rotatedAt:     args.RotatedAt,
+		revokedAt:     args.RevokedAt,
+	}
+}
+
+// HashRefreshToken returns the stored form of a raw token.
+// Tokens are random, so a plain SHA-256 is enough to make a leaked table useless.
+func HashRefreshToken(raw string) string {
+	h := sha256.Sum256([]byte(raw))
+
+	return hex.EncodeToString(h[:])
+}
+
+func (t *RefreshToken) ID() RefreshTokenID        { return t.id }
+func (t *RefreshToken) FamilyID() uuid.UUID       { return t.familyID }
+func (t *RefreshToken) UserID() userDomain.UserID { return t.userID }
+func (t *RefreshToken) TokenHash() string         { return t.tokenHash }
+func (t *RefreshToken) MFAVerified() bool         { return t.mfaVerifiedAt != nil }
+func (t *RefreshToken) MFAVerifiedAt() *time.Time { return t.mfaVerifiedAt }
+func (t *RefreshToken) CreatedAt() time.Time      { return t.createdAt }
+func (t *RefreshToken) ExpiresAt() time.Time      { return t.expiresAt }
+func (t *RefreshToken) RotatedAt() *time.Time     { return t.rotatedAt }
+func (t *RefreshToken) RevokedAt() *time.Time     { return t.revokedAt }
+
+// Rotate consumes the token and returns its successor in the same family, keeping the MFA state of the session.
+// A token that was already rotated or revoked is being replayed, and its family must be revoked.
+func (t *RefreshToken) Rotate(now time.Time, ttl time.Duration) (*RefreshToken, string, error) {
+	if t.rotatedAt != nil || t.revokedAt != nil {
+		return nil, "", ErrRefreshTokenReused
+	}
+
+	if !now.Before(t.expiresAt) {
+		return nil, "", ErrInvalidRefreshToken
+	}
+
+	t.rotatedAt = &now
+
+	return newRefreshToken(t.familyID, t.userID, t.mfaVerifiedAt, now, ttl)
+}
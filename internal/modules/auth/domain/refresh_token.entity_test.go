@@ -0,0 +1,63 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/auth/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+)
+
+func TestRefreshToken_Rotate(t *testing.T) {
+	t.Parallel()
+
+	userID := userDomain.UserID(uuid.New())
+	now := time.Now()
+
+	t.Run("stores only the hash", func(t *testing.T) {
+		token, raw, err := domain.NewRefreshToken(userID, false, now, time.Hour)
+		require.NoError(t, err)
+
+		assert.NotEmpty(t, raw)
+		assert.NotEqual(t, raw, token.TokenHash())
+		assert.Equal(t, domain.HashRefreshToken(raw), token.TokenHash())
+	})
+
+	t.Run("continues the family and keeps mfa", func(t *testing.T) {
+		token, raw, err := domain.NewRefreshToken(userID, true, now, time.Hour)
+		require.NoError(t, err)
+
+		next, nextRaw, err := token.Rotate(now.Add(time.Minute), time.Hour)
+		require.NoError(t, err)
+
+		assert.NotNil(t, token.RotatedAt())
+		assert.Equal(t, token.FamilyID(), next.FamilyID())
+		assert.True(t, next.MFAVerified())
+		require.NotNil(t, next.MFAVerifiedAt())
+		assert.Equal(t, now, *next.MFAVerifiedAt(), "rotation must not refresh the MFA time")
+		assert.NotEqual(t, raw, nextRaw)
+	})
+
+	t.Run("rotated token is reused", func(t *testing.T) {
+		token, _, err := domain.NewRefreshToken(userID, false, now, time.Hour)
+		require.NoError(t, err)
+
+		_, _, err = token.Rotate(now, time.Hour)
+		require.NoError(t, err)
+
+		_, _, err = token.Rotate(now, time.Hour)
+		assert.ErrorIs(t, err, domain.ErrRefreshTokenReused)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		token, _, err := domain.NewRefreshToken(userID, false, now, time.Hour)
+		require.NoError(t, err)
+
+		_, _, err = token.Rotate(now.Add(time.Hour), time.Hour)
+		assert.ErrorIs(t, err, domain.ErrInvalidRefreshToken)
+	})
+}
@@ -2,6 +2,9 @@ package domain
 
 import (
 	"context"
+	"time"
+
+	"github.com/google/uuid"
 
 	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
 )
@@ -9,5 +12,16 @@ import (
 //go:generate go tool gowrap gen -g -i AuthRepository -t ../../../../templates/opentelemetry.gotmpl -o ../infrastructure/postgres/auth_repository_tracing.gen.go
 type AuthRepository interface {
 	FindByUserID(ctx context.Context, userID userDomain.UserID) (*UserAuth, error)
+	// FindByUserIDForUpdate locks the credentials until the transaction ends, so concurrent changes to them can't both succeed.
+	FindByUserIDForUpdate(ctx context.Context, userID userDomain.UserID) (*UserAuth, error)
 	Save(ctx context.Context, auth *UserAuth) error
 }
+
+//go:generate go tool gowrap gen -g -i RefreshTokenRepository -t ../../../../templates/opentelemetry.gotmpl -o ../infrastructure/postgres/refresh_token_repository_tracing.gen.go
+type RefreshTokenRepository interface {
+	Save(ctx context.Context, token *RefreshToken) error
+	// FindByHash locks the token until the transaction ends, so concurrent rotations of the same token can't both succeed.
+	FindByHash(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	RevokeFamily(ctx context.Context, familyID uuid.UUID, now time.Time) error
+	RevokeAllForUser(ctx context.Context, userID userDomain.UserID, now time.Time) error
+}
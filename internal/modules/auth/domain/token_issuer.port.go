@@ -8,5 +8,8 @@ import (
 
 // TokenIssuer defineshow authentication tokens are issued.
 type TokenIssuer interface {
-	Issue(userID uuid.UUID, mfaVerified bool, duration time.Duration) (string, error)
+	// Issue signs an access token. mfaVerifiedAt is nil if the session didn't complete MFA.
+	Issue(userID uuid.UUID, mfaVerifiedAt *time.Time, duration time.Duration) (string, error)
+	// IssueReauthenticated signs an access token for a user without TOTP who just re-entered their password.
+	IssueReauthenticated(userID uuid.UUID, reauthenticatedAt time.Time, duration time.Duration) (string, error)
 }
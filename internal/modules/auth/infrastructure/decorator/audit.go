@@ -0,0 +1,76 @@
+package decorator
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	auditDomain "github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+	auditDecorator "github.com/danicc097/todo-ddd-example/internal/modules/audit/infrastructure/decorator"
+	"github.com/danicc097/todo-ddd-example/internal/modules/auth/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+var _ domain.AuthRepository = (*AuthAuditWrapper)(nil)
+
+// auditedAuth logs credentials under the user they belong to.
+type auditedAuth struct {
+	*domain.UserAuth
+}
+
+func (a auditedAuth) ID() userDomain.UserID { return a.UserID() }
+
+// AuthAuditWrapper logs credential changes. Secrets are redacted, so logs only show that they changed.
+type AuthAuditWrapper struct {
+	base    domain.AuthRepository
+	auditor *auditDecorator.AuditRepoDecorator[auditedAuth, userDomain.UserID]
+}
+
+func NewAuthAuditWrapper(base domain.AuthRepository, auditRepo auditDomain.AuditRepository) *AuthAuditWrapper {
+	extractor := func(a auditedAuth) map[string]any {
+		cipher, _ := a.TOTPCredentials()
+
+		return map[string]any{
+			"password_hash": a.PasswordHash(),
+			"totp_status":   a.TOTPStatus(),
+			"totp_secret":   cipher,
+			// the codes are hashed already, but their count is all the log needs
+			"recovery_codes_remaining": len(a.RecoveryCodes()),
+		}
+	}
+
+	return &AuthAuditWrapper{
+		base: base,
+		auditor: auditDecorator.NewAuditRepoDecorator(
+			auditRepo,
+			shared.AggUser,
+			extractor,
+			func(id userDomain.UserID) uuid.UUID { return id.UUID() },
+			nil,
+		).WithRedactedFields("password_hash", "totp_secret"),
+	}
+}
+
+func (w *AuthAuditWrapper) Save(ctx context.Context, auth *domain.UserAuth) error {
+	fetch := func(ctx context.Context, userID userDomain.UserID) (auditedAuth, error) {
+		prior, err := w.base.FindByUserID(ctx, userID)
+		return auditedAuth{prior}, err
+	}
+
+	return w.auditor.AuditSave(ctx, auditedAuth{auth}, auditDomain.OpUpsert, fetch, func(ctx context.Context, a auditedAuth) error {
+		return w.base.Save(ctx, a.UserAuth)
+	})
+}
+
+/*
+* Read methods bypass audit
+ */
+
+func (w *AuthAuditWrapper) FindByUserID(ctx context.Context, userID userDomain.UserID) (*domain.UserAuth, error) {
+	return w.base.FindByUserID(ctx, userID)
+}
+
+func (w *AuthAuditWrapper) FindByUserIDForUpdate(ctx context.Context, userID userDomain.UserID) (*domain.UserAuth, error) {
+	return w.base.FindByUserIDForUpdate(ctx, userID)
+}
@@ -7,6 +7,7 @@ import (
 
 	api "github.com/danicc097/todo-ddd-example/internal/generated/api"
 	"github.com/danicc097/todo-ddd-example/internal/modules/auth/application"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
 	sharedApp "github.com/danicc097/todo-ddd-example/internal/shared/application"
 	infraHttp "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/http"
 )
@@ -30,7 +31,43 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		Password: req.Password,
 	})
 	if ok {
-		c.JSON(http.StatusOK, api.LoginResponseBody{AccessToken: resp.AccessToken})
+		c.JSON(http.StatusOK, toLoginResponseBody(resp))
+	}
+}
+
+func (h *AuthHandler) RefreshSession(c *gin.Context) {
+	req, ok := infraHttp.BindJSON[api.RefreshSessionRequestBody](c)
+	if !ok {
+		return
+	}
+
+	resp, ok := infraHttp.Execute(c, h.uc.Refresh, application.RefreshCommand{RefreshToken: req.RefreshToken})
+	if ok {
+		c.JSON(http.StatusOK, toLoginResponseBody(resp))
+	}
+}
+
+func (h *AuthHandler) Logout(c *gin.Context) {
+	var cmd application.LogoutCommand
+
+	// the body is optional
+	if c.Request.ContentLength != 0 {
+		req, ok := infraHttp.BindJSON[api.LogoutRequestBody](c)
+		if !ok {
+			return
+		}
+
+		cmd.RefreshToken = req.RefreshToken
+	}
+
+	if _, ok := infraHttp.Execute(c, h.uc.Logout, cmd); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *AuthHandler) LogoutAll(c *gin.Context) {
+	if _, ok := infraHttp.Execute(c, h.uc.LogoutAll, sharedApp.Void{}); ok {
+		c.Status(http.StatusNoContent)
 	}
 }
 
@@ -65,6 +102,56 @@ func (h *AuthHandler) VerifyTOTP(c *gin.Context) {
 
 	resp, ok := infraHttp.Execute(c, h.uc.VerifyTOTP, application.VerifyTOTPCommand{Code: req.Code})
 	if ok {
-		c.JSON(http.StatusOK, api.LoginResponseBody{AccessToken: resp.AccessToken})
+		body := api.VerifyTOTPResponseBody{AccessToken: resp.AccessToken, RefreshToken: resp.RefreshToken}
+		if len(resp.RecoveryCodes) > 0 {
+			body.RecoveryCodes = &resp.RecoveryCodes
+		}
+
+		c.JSON(http.StatusOK, body)
+	}
+}
+
+func (h *AuthHandler) RecoverTOTP(c *gin.Context) {
+	req, ok := infraHttp.BindJSON[api.RecoverTOTPRequestBody](c)
+	if !ok {
+		return
 	}
+
+	resp, ok := infraHttp.Execute(c, h.uc.RecoverTOTP, application.RecoverTOTPCommand{Code: req.Code})
+	if ok {
+		c.JSON(http.StatusOK, toLoginResponseBody(resp))
+	}
+}
+
+func (h *AuthHandler) DisableTOTP(c *gin.Context) {
+	req, ok := infraHttp.BindJSON[api.DisableTOTPRequestBody](c)
+	if !ok {
+		return
+	}
+
+	if _, ok := infraHttp.Execute(c, h.uc.DisableTOTP, application.DisableTOTPCommand{Password: req.Password, Code: req.Code}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *AuthHandler) ResetUserTOTP(c *gin.Context, id userDomain.UserID) {
+	if _, ok := infraHttp.Execute(c, h.uc.ResetTOTP, application.ResetTOTPCommand{UserID: id}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *AuthHandler) Reauthenticate(c *gin.Context) {
+	req, ok := infraHttp.BindJSON[api.ReauthenticateRequestBody](c)
+	if !ok {
+		return
+	}
+
+	resp, ok := infraHttp.Execute(c, h.uc.Reauthenticate, application.ReauthenticateCommand{Password: req.Password})
+	if ok {
+		c.JSON(http.StatusOK, api.ReauthenticateResponseBody{AccessToken: resp.AccessToken})
+	}
+}
+
+func toLoginResponseBody(s application.SessionTokens) api.LoginResponseBody {
+	return api.LoginResponseBody{AccessToken: s.AccessToken, RefreshToken: s.RefreshToken}
 }
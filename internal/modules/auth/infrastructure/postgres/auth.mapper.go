@@ -1,11 +1,41 @@
 package postgres
 
 import (
+	"github.com/danicc097/todo-ddd-example/internal/modules/auth/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
 	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
 )
 
 type AuthMapper struct{}
 
+type TOTPEventDTO struct {
+	UserID       userDomain.UserID `json:"user_id"`
+	EventVersion int               `json:"event_version"`
+}
+
+type TOTPResetDTO struct {
+	UserID       userDomain.UserID `json:"user_id"`
+	ResetBy      userDomain.UserID `json:"reset_by"`
+	EventVersion int               `json:"event_version"`
+}
+
+type RecoveryCodeUsedDTO struct {
+	UserID       userDomain.UserID `json:"user_id"`
+	Remaining    int               `json:"remaining"`
+	EventVersion int               `json:"event_version"`
+}
+
 func (m *AuthMapper) MapEvent(e shared.DomainEvent) (shared.EventType, any, error) {
+	switch evt := e.(type) {
+	case domain.TOTPActivatedEvent:
+		return shared.AuthTOTPActivated, TOTPEventDTO{UserID: evt.UserID, EventVersion: 1}, nil
+	case domain.TOTPDisabledEvent:
+		return shared.AuthTOTPDisabled, TOTPEventDTO{UserID: evt.UserID, EventVersion: 1}, nil
+	case domain.TOTPResetEvent:
+		return shared.AuthTOTPReset, TOTPResetDTO{UserID: evt.UserID, ResetBy: evt.ResetBy, EventVersion: 1}, nil
+	case domain.RecoveryCodeUsedEvent:
+		return shared.AuthRecoveryCodeUsed, RecoveryCodeUsedDTO{UserID: evt.UserID, Remaining: evt.Remaining, EventVersion: 1}, nil
+	}
+
 	return "", nil, nil
 }
@@ -37,25 +37,43 @@ func (r *AuthRepo) getDB(ctx context.Context) db.DBTX {
 func (r *AuthRepo) FindByUserID(ctx context.Context, userID userDomain.UserID) (*domain.UserAuth, error) {
 	row, err := r.q.GetUserAuth(ctx, r.getDB(ctx), userID.UUID())
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, domain.ErrAuthNotFound
-		}
+		return nil, r.findError(userID, err)
+	}
+
+	return toUserAuth(userID, row), nil
+}
 
-		return nil, fmt.Errorf("failed to get auth for user %s: %w", userID, sharedPg.ParseDBError(err))
+func (r *AuthRepo) FindByUserIDForUpdate(ctx context.Context, userID userDomain.UserID) (*domain.UserAuth, error) {
+	row, err := r.q.GetUserAuthForUpdate(ctx, r.getDB(ctx), userID.UUID())
+	if err != nil {
+		return nil, r.findError(userID, err)
 	}
 
+	return toUserAuth(userID, row), nil
+}
+
+func (r *AuthRepo) findError(userID userDomain.UserID, err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.ErrAuthNotFound
+	}
+
+	return fmt.Errorf("failed to get auth for user %s: %w", userID, sharedPg.ParseDBError(err))
+}
+
+func toUserAuth(userID userDomain.UserID, row db.UserAuth) *domain.UserAuth {
 	passhash := ""
 	if row.PasswordHash != nil {
 		passhash = *row.PasswordHash
 	}
 
 	return domain.ReconstituteUserAuth(domain.ReconstituteUserAuthArgs{
-		ID:           userID,
-		Status:       row.TotpStatus,
-		Cipher:       row.TotpSecretCipher,
-		Nonce:        row.TotpSecretNonce,
-		PasswordHash: passhash,
-	}), nil
+		ID:            userID,
+		Status:        row.TotpStatus,
+		Cipher:        row.TotpSecretCipher,
+		Nonce:         row.TotpSecretNonce,
+		RecoveryCodes: row.TotpRecoveryCodes,
+		PasswordHash:  passhash,
+	})
 }
 
 func (r *AuthRepo) Save(ctx context.Context, auth *domain.UserAuth) error {
@@ -63,11 +81,12 @@ func (r *AuthRepo) Save(ctx context.Context, auth *domain.UserAuth) error {
 	pass := auth.PasswordHash()
 
 	err := r.q.UpsertUserAuth(ctx, r.getDB(ctx), db.UpsertUserAuthParams{
-		UserID:           auth.UserID().UUID(),
-		TotpStatus:       auth.TOTPStatus(),
-		TotpSecretCipher: cipher,
-		TotpSecretNonce:  nonce,
-		PasswordHash:     &pass,
+		UserID:            auth.UserID().UUID(),
+		TotpStatus:        auth.TOTPStatus(),
+		TotpSecretCipher:  cipher,
+		TotpSecretNonce:   nonce,
+		TotpRecoveryCodes: auth.RecoveryCodes(),
+		PasswordHash:      &pass,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to save auth for user %s: %w", auth.UserID(), sharedPg.ParseDBError(err))
@@ -67,6 +67,34 @@ func (_d AuthRepositoryWithTracing) FindByUserID(ctx context.Context, userID use
 	return _d.AuthRepository.FindByUserID(ctx, userID)
 }
 
+// FindByUserIDForUpdate implements AuthRepository
+func (_d AuthRepositoryWithTracing) FindByUserIDForUpdate(ctx context.Context, userID userDomain.UserID) (up1 *_sourceDomain.UserAuth, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "AuthRepository.FindByUserIDForUpdate", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "FindByUserIDForUpdate"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"userID": userID}, map[string]interface{}{
+				"up1": up1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.AuthRepository.FindByUserIDForUpdate(ctx, userID)
+}
+
 // Save implements AuthRepository
 func (_d AuthRepositoryWithTracing) Save(ctx context.Context, auth *_sourceDomain.UserAuth) (err error) {
 	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "AuthRepository.Save", trace.WithAttributes(
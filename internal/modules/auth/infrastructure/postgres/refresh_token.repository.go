@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/danicc097/todo-ddd-example/internal/generated/db"
+	infraDB "github.com/danicc097/todo-ddd-example/internal/infrastructure/db"
+	"github.com/danicc097/todo-ddd-example/internal/modules/auth/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+)
+
+type RefreshTokenRepo struct {
+	q    *db.Queries
+	pool *pgxpool.Pool
+}
+
+var _ domain.RefreshTokenRepository = (*RefreshTokenRepo)(nil)
+
+func NewRefreshTokenRepo(pool *pgxpool.Pool) *RefreshTokenRepo {
+	return &RefreshTokenRepo{q: db.New(), pool: pool}
+}
+
+func (r *RefreshTokenRepo) getDB(ctx context.Context) db.DBTX {
+	if tx := infraDB.ExtractTx(ctx); tx != nil {
+		return tx
+	}
+
+	return r.pool
+}
+
+func (r *RefreshTokenRepo) Save(ctx context.Context, t *domain.RefreshToken) error {
+	err := r.q.UpsertRefreshToken(ctx, r.getDB(ctx), db.UpsertRefreshTokenParams{
+		ID:            t.ID(),
+		FamilyID:      t.FamilyID(),
+		UserID:        t.UserID(),
+		TokenHash:     t.TokenHash(),
+		CreatedAt:     t.CreatedAt(),
+		ExpiresAt:     t.ExpiresAt(),
+		RotatedAt:     t.RotatedAt(),
+		RevokedAt:     t.RevokedAt(),
+		MfaVerifiedAt: t.MFAVerifiedAt(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save refresh token %s: %w", t.ID(), sharedPg.ParseDBError(err))
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepo) FindByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	row, err := r.q.GetRefreshTokenByHashForUpdate(ctx, r.getDB(ctx), tokenHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrInvalidRefreshToken
+		}
+
+		return nil, fmt.Errorf("failed to get refresh token: %w", sharedPg.ParseDBError(err))
+	}
+
+	return domain.ReconstituteRefreshToken(domain.ReconstituteRefreshTokenArgs{
+		ID:            row.ID,
+		FamilyID:      row.FamilyID,
+		UserID:        row.UserID,
+		TokenHash:     row.TokenHash,
+		MFAVerifiedAt: row.MfaVerifiedAt,
+		CreatedAt:     row.CreatedAt,
+		ExpiresAt:     row.ExpiresAt,
+		RotatedAt:     row.RotatedAt,
+		RevokedAt:     row.RevokedAt,
+	}), nil
+}
+
+func (r *RefreshTokenRepo) RevokeFamily(ctx context.Context, familyID uuid.UUID, now time.Time) error {
+	err := r.q.RevokeRefreshTokenFamily(ctx, r.getDB(ctx), db.RevokeRefreshTokenFamilyParams{
+		RevokedAt: &now,
+		FamilyID:  familyID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke refresh token family %s: %w", familyID, sharedPg.ParseDBError(err))
+	}
+
+	return nil
+}
+
+func (r *RefreshTokenRepo) RevokeAllForUser(ctx context.Context, userID userDomain.UserID, now time.Time) error {
+	err := r.q.RevokeUserRefreshTokens(ctx, r.getDB(ctx), db.RevokeUserRefreshTokensParams{
+		RevokedAt: &now,
+		UserID:    userID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke refresh tokens of user %s: %w", userID, sharedPg.ParseDBError(err))
+	}
+
+	return nil
+}
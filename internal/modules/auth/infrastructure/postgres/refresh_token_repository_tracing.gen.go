@@ -0,0 +1,153 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../../../../../templates/opentelemetry.gotmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package postgres
+
+import (
+	"context"
+	"time"
+
+	_sourceDomain "github.com/danicc097/todo-ddd-example/internal/modules/auth/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+
+	"go.opentelemetry.io/otel"
+	_codes "go.opentelemetry.io/otel/codes"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RefreshTokenRepositoryWithTracing implements RefreshTokenRepository interface instrumented with open telemetry spans
+type RefreshTokenRepositoryWithTracing struct {
+	_sourceDomain.RefreshTokenRepository
+	_instance      string
+	_spanDecorator func(span trace.Span, params, results map[string]interface{})
+}
+
+// NewRefreshTokenRepositoryWithTracing returns RefreshTokenRepositoryWithTracing
+func NewRefreshTokenRepositoryWithTracing(base _sourceDomain.RefreshTokenRepository, instance string, spanDecorator ...func(span trace.Span, params, results map[string]interface{})) RefreshTokenRepositoryWithTracing {
+	d := RefreshTokenRepositoryWithTracing{
+		RefreshTokenRepository: base,
+		_instance:              instance,
+	}
+
+	if len(spanDecorator) > 0 && spanDecorator[0] != nil {
+		d._spanDecorator = spanDecorator[0]
+	}
+
+	return d
+}
+
+// FindByHash implements RefreshTokenRepository
+func (_d RefreshTokenRepositoryWithTracing) FindByHash(ctx context.Context, tokenHash string) (rp1 *_sourceDomain.RefreshToken, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "RefreshTokenRepository.FindByHash", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "FindByHash"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":       ctx,
+				"tokenHash": tokenHash}, map[string]interface{}{
+				"rp1": rp1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.RefreshTokenRepository.FindByHash(ctx, tokenHash)
+}
+
+// RevokeAllForUser implements RefreshTokenRepository
+func (_d RefreshTokenRepositoryWithTracing) RevokeAllForUser(ctx context.Context, userID userDomain.UserID, now time.Time) (err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "RefreshTokenRepository.RevokeAllForUser", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "RevokeAllForUser"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"userID": userID,
+				"now":    now}, map[string]interface{}{
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.RefreshTokenRepository.RevokeAllForUser(ctx, userID, now)
+}
+
+// RevokeFamily implements RefreshTokenRepository
+func (_d RefreshTokenRepositoryWithTracing) RevokeFamily(ctx context.Context, familyID uuid.UUID, now time.Time) (err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "RefreshTokenRepository.RevokeFamily", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "RevokeFamily"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":      ctx,
+				"familyID": familyID,
+				"now":      now}, map[string]interface{}{
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.RefreshTokenRepository.RevokeFamily(ctx, familyID, now)
+}
+
+// Save implements RefreshTokenRepository
+func (_d RefreshTokenRepositoryWithTracing) Save(ctx context.Context, token *_sourceDomain.RefreshToken) (err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "RefreshTokenRepository.Save", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "Save"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":   ctx,
+				"token": token}, map[string]interface{}{
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.RefreshTokenRepository.Save(ctx, token)
+}
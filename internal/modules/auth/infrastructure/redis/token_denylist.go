@@ -0,0 +1,148 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/danicc097/todo-ddd-example/internal/infrastructure/cache"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+)
+
+const maxLocalEntries = 10_000
+
+type localEntry[T any] struct {
+	value     T
+	fetchedAt time.Time
+}
+
+// TokenDenylist stores revoked access tokens, and per-user cutoffs for tokens issued before a global logout.
+// Lookups are cached in process for localTTL to keep them off the request hot path,
+// so a revocation made through another instance may take that long to be enforced here.
+type TokenDenylist struct {
+	client   redis.UniversalClient
+	maxTTL   time.Duration
+	localTTL time.Duration
+
+	mu     sync.Mutex
+	tokens map[string]localEntry[bool]
+	users  map[uuid.UUID]localEntry[time.Time]
+}
+
+// NewTokenDenylist returns a denylist for access tokens that live at most maxTTL.
+func NewTokenDenylist(client redis.UniversalClient, maxTTL, localTTL time.Duration) *TokenDenylist {
+	return &TokenDenylist{
+		client:   client,
+		maxTTL:   maxTTL,
+		localTTL: localTTL,
+		tokens:   make(map[string]localEntry[bool]),
+		users:    make(map[uuid.UUID]localEntry[time.Time]),
+	}
+}
+
+func (d *TokenDenylist) RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+
+	if err := d.client.Set(ctx, cache.Keys.RevokedAccessToken(tokenID), "revoked", ttl).Err(); err != nil {
+		return fmt.Errorf("set revoked token: %w", err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	storeLocal(d.tokens, tokenID, true, d.localTTL)
+
+	return nil
+}
+
+func (d *TokenDenylist) RevokeUserTokens(ctx context.Context, userID userDomain.UserID, notBefore time.Time) error {
+	// issue times have millisecond precision, so the whole millisecond of the logout is revoked
+	notBefore = notBefore.Truncate(time.Millisecond)
+
+	if err := d.client.Set(ctx, cache.Keys.UserTokensNotBefore(userID), notBefore.UnixMilli(), d.maxTTL).Err(); err != nil {
+		return fmt.Errorf("set tokens not before: %w", err)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	storeLocal(d.users, userID.UUID(), notBefore, d.localTTL)
+
+	return nil
+}
+
+// IsRevoked reports whether a verified access token was revoked individually
+// or by a global logout of its user.
+func (d *TokenDenylist) IsRevoked(ctx context.Context, tokenID string, userID uuid.UUID, issuedAt time.Time) (bool, error) {
+	now := time.Now()
+
+	d.mu.Lock()
+	revoked, tokenOK := d.tokens[tokenID]
+	notBefore, userOK := d.users[userID]
+	d.mu.Unlock()
+
+	if tokenOK && userOK && now.Sub(revoked.fetchedAt) < d.localTTL && now.Sub(notBefore.fetchedAt) < d.localTTL {
+		return isRevoked(revoked.value, notBefore.value, issuedAt), nil
+	}
+
+	vals, err := d.client.MGet(ctx,
+		cache.Keys.RevokedAccessToken(tokenID),
+		cache.Keys.UserTokensNotBefore(userDomain.UserID(userID)),
+	).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return false, fmt.Errorf("mget token revocations: %w", err)
+	}
+
+	tokenRevoked := vals[0] != nil
+
+	var cutoff time.Time
+
+	if raw, ok := vals[1].(string); ok {
+		unixMilli, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return false, fmt.Errorf("parse tokens not before: %w", err)
+		}
+
+		cutoff = time.UnixMilli(unixMilli)
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	storeLocal(d.tokens, tokenID, tokenRevoked, d.localTTL)
+	storeLocal(d.users, userID, cutoff, d.localTTL)
+
+	return isRevoked(tokenRevoked, cutoff, issuedAt), nil
+}
+
+func isRevoked(tokenRevoked bool, notBefore, issuedAt time.Time) bool {
+	return tokenRevoked || (!notBefore.IsZero() && !issuedAt.After(notBefore))
+}
+
+// storeLocal keeps the local cache bounded, dropping stale entries first and everything if that isn't enough.
+func storeLocal[K comparable, V any](m map[K]localEntry[V], key K, value V, ttl time.Duration) {
+	now := time.Now()
+
+	if _, ok := m[key]; !ok && len(m) >= maxLocalEntries {
+		for k, e := range m {
+			if now.Sub(e.fetchedAt) >= ttl {
+				delete(m, k)
+			}
+		}
+
+		if len(m) >= maxLocalEntries {
+			clear(m)
+		}
+	}
+
+	m[key] = localEntry[V]{value: value, fetchedAt: now}
+}
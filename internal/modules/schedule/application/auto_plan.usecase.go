@@ -0,0 +1,122 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/schedule/domain"
+	todoDomain "github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type AutoPlanCommand struct {
+	Date domain.ScheduleDate
+}
+
+func (c *AutoPlanCommand) Validate() error {
+	if _, err := time.Parse(time.DateOnly, c.Date.String()); err != nil {
+		return fmt.Errorf("invalid date format: %w", err)
+	}
+
+	return nil
+}
+
+type AutoPlanResponse struct {
+	Planned []todoDomain.TodoID
+}
+
+// PlannableTodo is an open todo that can be planned.
+type PlannableTodo struct {
+	ID      todoDomain.TodoID
+	DueDate *time.Time
+}
+
+// PlannableTodoProvider lists the pending, unblocked todos a user can plan: their assignments,
+// and unassigned todos in their workspaces due before dueBefore.
+type PlannableTodoProvider interface {
+	Plannable(ctx context.Context, userID userDomain.UserID, dueBefore time.Time) ([]PlannableTodo, error)
+}
+
+type AutoPlanHandler struct {
+	repo     domain.ScheduleRepository
+	todoProv PlannableTodoProvider
+	tzProv   UserTimezoneProvider
+	capProv  UserCapacityProvider
+}
+
+var _ application.RequestHandler[AutoPlanCommand, AutoPlanResponse] = (*AutoPlanHandler)(nil)
+
+func NewAutoPlanHandler(
+	repo domain.ScheduleRepository,
+	todoProv PlannableTodoProvider,
+	tzProv UserTimezoneProvider,
+	capProv UserCapacityProvider,
+) *AutoPlanHandler {
+	return &AutoPlanHandler{
+		repo:     repo,
+		todoProv: todoProv,
+		tzProv:   tzProv,
+		capProv:  capProv,
+	}
+}
+
+// Handle fills the day with the caller's assignments and the unassigned todos due by the end
+// of it. Each todo costs what it was last committed with, or the default cost if it was never scheduled.
+func (h *AutoPlanHandler) Handle(ctx context.Context, cmd AutoPlanCommand) (AutoPlanResponse, error) {
+	meta := causation.FromContext(ctx)
+	userID := userDomain.UserID(meta.UserID)
+
+	loc, err := h.tzProv.Location(ctx, userID)
+	if err != nil {
+		return AutoPlanResponse{}, err
+	}
+
+	day, err := time.ParseInLocation(time.DateOnly, cmd.Date.String(), loc)
+	if err != nil {
+		return AutoPlanResponse{}, fmt.Errorf("invalid date format: %w", err)
+	}
+
+	todos, err := h.todoProv.Plannable(ctx, userID, day.AddDate(0, 0, 1))
+	if err != nil {
+		return AutoPlanResponse{}, err
+	}
+
+	ids := make([]todoDomain.TodoID, len(todos))
+	for i, t := range todos {
+		ids[i] = t.ID
+	}
+
+	costs, err := h.repo.LatestCosts(ctx, userID, ids)
+	if err != nil {
+		return AutoPlanResponse{}, err
+	}
+
+	candidates := make([]domain.PlanCandidate, len(todos))
+	for i, t := range todos {
+		cost, ok := costs[t.ID]
+		if !ok {
+			cost = domain.DefaultEnergyCost
+		}
+
+		candidates[i] = domain.NewPlanCandidate(t.ID, cost, t.DueDate)
+	}
+
+	s, err := findOrNewSchedule(ctx, h.repo, h.capProv, userID, cmd.Date)
+	if err != nil {
+		return AutoPlanResponse{}, err
+	}
+
+	planned, err := s.AutoPlan(candidates, time.Now())
+	if err != nil {
+		return AutoPlanResponse{}, err
+	}
+
+	if len(planned) == 0 {
+		return AutoPlanResponse{Planned: []todoDomain.TodoID{}}, nil
+	}
+
+	return AutoPlanResponse{Planned: planned}, h.repo.Save(ctx, s)
+}
@@ -18,7 +18,8 @@ import (
 type CommitTaskCommand struct {
 	TodoID uuid.UUID
 	Cost   int
-	Date   string
+	// Date defaults to today in the user's timezone when empty.
+	Date string
 }
 
 func (c *CommitTaskCommand) Validate() error {
@@ -26,6 +27,10 @@ func (c *CommitTaskCommand) Validate() error {
 		return err
 	}
 
+	if c.Date == "" {
+		return nil
+	}
+
 	if _, err := time.Parse(time.DateOnly, c.Date); err != nil {
 		return fmt.Errorf("invalid date format: %w", err)
 	}
@@ -35,9 +40,20 @@ func (c *CommitTaskCommand) Validate() error {
 
 type CommitTaskResponse struct{}
 
+type UserTimezoneProvider interface {
+	Location(ctx context.Context, userID userDomain.UserID) (*time.Location, error)
+}
+
+// UserCapacityProvider returns the capacity new schedules start with.
+type UserCapacityProvider interface {
+	DailyCapacity(ctx context.Context, userID userDomain.UserID) (int, error)
+}
+
 type CommitTaskHandler struct {
 	repo     domain.ScheduleRepository
 	todoRepo todoDomain.TodoRepository
+	tzProv   UserTimezoneProvider
+	capProv  UserCapacityProvider
 }
 
 var _ application.RequestHandler[CommitTaskCommand, CommitTaskResponse] = (*CommitTaskHandler)(nil)
@@ -45,10 +61,14 @@ var _ application.RequestHandler[CommitTaskCommand, CommitTaskResponse] = (*Comm
 func NewCommitTaskHandler(
 	repo domain.ScheduleRepository,
 	todoRepo todoDomain.TodoRepository,
+	tzProv UserTimezoneProvider,
+	capProv UserCapacityProvider,
 ) *CommitTaskHandler {
 	return &CommitTaskHandler{
 		repo:     repo,
 		todoRepo: todoRepo,
+		tzProv:   tzProv,
+		capProv:  capProv,
 	}
 }
 
@@ -58,6 +78,14 @@ func (h *CommitTaskHandler) Handle(ctx context.Context, cmd CommitTaskCommand) (
 
 	todoID := todoDomain.TodoID(cmd.TodoID)
 	date := domain.ScheduleDate(cmd.Date)
+	if date == "" {
+		loc, err := h.tzProv.Location(ctx, userID)
+		if err != nil {
+			return CommitTaskResponse{}, err
+		}
+
+		date = domain.NewScheduleDate(time.Now(), loc)
+	}
 
 	cost, _ := domain.NewEnergyCost(cmd.Cost)
 
@@ -66,13 +94,9 @@ func (h *CommitTaskHandler) Handle(ctx context.Context, cmd CommitTaskCommand) (
 		return CommitTaskResponse{}, err
 	}
 
-	s, err := h.repo.FindByUserAndDate(ctx, userID, date)
+	s, err := findOrNewSchedule(ctx, h.repo, h.capProv, userID, date)
 	if err != nil {
-		if errors.Is(err, domain.ErrScheduleNotFound) {
-			s, _ = domain.NewDailySchedule(userID, date, 10)
-		} else {
-			return CommitTaskResponse{}, err
-		}
+		return CommitTaskResponse{}, err
 	}
 
 	if err := s.CommitTask(todoID, cost); err != nil {
@@ -81,3 +105,24 @@ func (h *CommitTaskHandler) Handle(ctx context.Context, cmd CommitTaskCommand) (
 
 	return CommitTaskResponse{}, h.repo.Save(ctx, s)
 }
+
+// findOrNewSchedule returns the stored schedule or an unsaved one using the user's default capacity.
+func findOrNewSchedule(
+	ctx context.Context,
+	repo domain.ScheduleRepository,
+	capProv UserCapacityProvider,
+	userID userDomain.UserID,
+	date domain.ScheduleDate,
+) (*domain.DailySchedule, error) {
+	s, err := repo.FindByUserAndDate(ctx, userID, date)
+	if err == nil || !errors.Is(err, domain.ErrScheduleNotFound) {
+		return s, err
+	}
+
+	capacity, err := capProv.DailyCapacity(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewDailySchedule(userID, date, capacity)
+}
@@ -13,6 +13,8 @@ import (
 	schedulePg "github.com/danicc097/todo-ddd-example/internal/modules/schedule/infrastructure/postgres"
 	todoDomain "github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
 	todoPg "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/postgres"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	userAdapters "github.com/danicc097/todo-ddd-example/internal/modules/user/infrastructure/adapters"
 	sharedApp "github.com/danicc097/todo-ddd-example/internal/shared/application"
 	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
 	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
@@ -31,7 +33,7 @@ func TestCommitTaskHandler_Handle_Integration(t *testing.T) {
 	scheduleRepo := schedulePg.NewScheduleRepo(pool, uow)
 	todoRepo := todoPg.NewTodoRepo(pool, uow)
 
-	handler := sharedApp.NewDecoratorBuilder(application.NewCommitTaskHandler(scheduleRepo, todoRepo)).
+	handler := sharedApp.NewDecoratorBuilder(application.NewCommitTaskHandler(scheduleRepo, todoRepo, userAdapters.NewUserTimezoneProvider(fixtures.UserRepo), userAdapters.NewUserCapacityProvider(fixtures.UserRepo))).
 		WithValidation().
 		WithRetryOnConflict(3).
 		WithUoW(uow).
@@ -60,6 +62,28 @@ func TestCommitTaskHandler_Handle_Integration(t *testing.T) {
 		assert.Equal(t, 3, int(s.CommittedTasks()[todo.ID()]))
 	})
 
+	t.Run("defaults to today in the user's timezone", func(t *testing.T) {
+		user := fixtures.RandomUser(ctx, t)
+		tz, err := userDomain.NewUserTimezone("Pacific/Kiritimati") // UTC+14, usually a day ahead of the server
+		require.NoError(t, err)
+		user.SetTimezone(tz, time.Now())
+		require.NoError(t, fixtures.UserRepo.Save(ctx, user))
+
+		ws := fixtures.RandomWorkspace(ctx, t, user.ID())
+		todo := fixtures.RandomTodo(ctx, t, ws.ID())
+		userCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: user.ID().UUID()})
+
+		_, err = handler.Handle(userCtx, application.CommitTaskCommand{
+			TodoID: todo.ID().UUID(),
+			Cost:   2,
+		})
+		require.NoError(t, err)
+
+		s, err := scheduleRepo.FindByUserAndDate(ctx, user.ID(), domain.NewScheduleDate(time.Now(), tz.Location()))
+		require.NoError(t, err)
+		assert.Len(t, s.CommittedTasks(), 1)
+	})
+
 	t.Run("failure - capacity exceeded", func(t *testing.T) {
 		user := fixtures.RandomUser(ctx, t)
 		ws := fixtures.RandomWorkspace(ctx, t, user.ID())
@@ -92,7 +116,7 @@ func TestCommitTaskHandler_Handle_Concurrency(t *testing.T) {
 	scheduleRepo := schedulePg.NewScheduleRepo(pool, uow)
 	todoRepo := todoPg.NewTodoRepo(pool, uow)
 
-	handler := sharedApp.NewDecoratorBuilder(application.NewCommitTaskHandler(scheduleRepo, todoRepo)).
+	handler := sharedApp.NewDecoratorBuilder(application.NewCommitTaskHandler(scheduleRepo, todoRepo, userAdapters.NewUserTimezoneProvider(fixtures.UserRepo), userAdapters.NewUserCapacityProvider(fixtures.UserRepo))).
 		WithValidation().
 		WithRetryOnConflict(10).
 		WithUoW(uow).
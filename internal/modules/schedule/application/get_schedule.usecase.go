@@ -0,0 +1,74 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/schedule/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type GetScheduleQuery struct {
+	Date domain.ScheduleDate
+}
+
+func (q *GetScheduleQuery) Validate() error {
+	if _, err := time.Parse(time.DateOnly, q.Date.String()); err != nil {
+		return fmt.Errorf("invalid date format: %w", err)
+	}
+
+	return nil
+}
+
+type GetScheduleResponse struct {
+	Schedule ScheduleReadModel
+}
+
+type GetScheduleHandler struct {
+	repo    domain.ScheduleRepository
+	capProv UserCapacityProvider
+}
+
+var _ application.RequestHandler[GetScheduleQuery, GetScheduleResponse] = (*GetScheduleHandler)(nil)
+
+func NewGetScheduleHandler(repo domain.ScheduleRepository, capProv UserCapacityProvider) *GetScheduleHandler {
+	return &GetScheduleHandler{repo: repo, capProv: capProv}
+}
+
+// Handle returns the caller's schedule for the day, or an empty one with their default capacity.
+func (h *GetScheduleHandler) Handle(ctx context.Context, q GetScheduleQuery) (GetScheduleResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	s, err := findOrNewSchedule(ctx, h.repo, h.capProv, userDomain.UserID(meta.UserID), q.Date)
+	if err != nil {
+		return GetScheduleResponse{}, err
+	}
+
+	tasks := make([]ScheduledTaskReadModel, 0, len(s.CommittedTasks()))
+	for id, cost := range s.CommittedTasks() {
+		task := ScheduledTaskReadModel{TodoID: id, Cost: int(cost)}
+		if at, ok := s.CompletedTasks()[id]; ok {
+			task.CompletedAt = &at
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	slices.SortFunc(tasks, func(a, b ScheduledTaskReadModel) int {
+		return strings.Compare(a.TodoID.String(), b.TodoID.String())
+	})
+
+	return GetScheduleResponse{Schedule: ScheduleReadModel{
+		Date:        s.Date().String(),
+		MaxCapacity: s.MaxCapacity(),
+		Load:        s.Load(),
+		Completed:   s.CompletedLoad(),
+		Remaining:   s.RemainingLoad(),
+		Tasks:       tasks,
+	}}, nil
+}
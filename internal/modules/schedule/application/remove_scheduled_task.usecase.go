@@ -0,0 +1,53 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/schedule/domain"
+	todoDomain "github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type RemoveScheduledTaskCommand struct {
+	Date   domain.ScheduleDate
+	TodoID todoDomain.TodoID
+}
+
+func (c *RemoveScheduledTaskCommand) Validate() error {
+	if _, err := time.Parse(time.DateOnly, c.Date.String()); err != nil {
+		return fmt.Errorf("invalid date format: %w", err)
+	}
+
+	return nil
+}
+
+type RemoveScheduledTaskResponse struct{}
+
+type RemoveScheduledTaskHandler struct {
+	repo domain.ScheduleRepository
+}
+
+var _ application.RequestHandler[RemoveScheduledTaskCommand, RemoveScheduledTaskResponse] = (*RemoveScheduledTaskHandler)(nil)
+
+func NewRemoveScheduledTaskHandler(repo domain.ScheduleRepository) *RemoveScheduledTaskHandler {
+	return &RemoveScheduledTaskHandler{repo: repo}
+}
+
+func (h *RemoveScheduledTaskHandler) Handle(ctx context.Context, cmd RemoveScheduledTaskCommand) (RemoveScheduledTaskResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	s, err := h.repo.FindByUserAndDate(ctx, userDomain.UserID(meta.UserID), cmd.Date)
+	if err != nil {
+		return RemoveScheduledTaskResponse{}, err
+	}
+
+	if err := s.RemoveTask(cmd.TodoID); err != nil {
+		return RemoveScheduledTaskResponse{}, err
+	}
+
+	return RemoveScheduledTaskResponse{}, h.repo.Save(ctx, s)
+}
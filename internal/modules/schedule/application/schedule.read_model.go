@@ -0,0 +1,23 @@
+package application
+
+import (
+	"time"
+
+	todoDomain "github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+)
+
+type ScheduleReadModel struct {
+	Date        string
+	MaxCapacity int
+	Load        int
+	// Completed and Remaining split Load into done and pending energy.
+	Completed int
+	Remaining int
+	Tasks     []ScheduledTaskReadModel
+}
+
+type ScheduledTaskReadModel struct {
+	TodoID      todoDomain.TodoID
+	Cost        int
+	CompletedAt *time.Time
+}
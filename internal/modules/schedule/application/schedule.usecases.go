@@ -5,5 +5,9 @@ import (
 )
 
 type ScheduleUseCases struct {
-	CommitTask application.RequestHandler[CommitTaskCommand, CommitTaskResponse]
+	CommitTask  application.RequestHandler[CommitTaskCommand, CommitTaskResponse]
+	GetSchedule application.RequestHandler[GetScheduleQuery, GetScheduleResponse]
+	SetCapacity application.RequestHandler[SetScheduleCapacityCommand, SetScheduleCapacityResponse]
+	RemoveTask  application.RequestHandler[RemoveScheduledTaskCommand, RemoveScheduledTaskResponse]
+	AutoPlan    application.RequestHandler[AutoPlanCommand, AutoPlanResponse]
 }
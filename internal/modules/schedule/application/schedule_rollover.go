@@ -0,0 +1,150 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/schedule/domain"
+	todoDomain "github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+)
+
+const rolloverBatchSize = 500
+
+// ScheduleRollover carries unfinished tasks from past schedules to each user's current day.
+type ScheduleRollover struct {
+	repo     domain.ScheduleRepository
+	todoRepo todoDomain.TodoRepository
+	tzProv   UserTimezoneProvider
+	capProv  UserCapacityProvider
+	uow      application.UnitOfWork
+}
+
+func NewScheduleRollover(
+	repo domain.ScheduleRepository,
+	todoRepo todoDomain.TodoRepository,
+	tzProv UserTimezoneProvider,
+	capProv UserCapacityProvider,
+	uow application.UnitOfWork,
+) *ScheduleRollover {
+	return &ScheduleRollover{
+		repo:     repo,
+		todoRepo: todoRepo,
+		tzProv:   tzProv,
+		capProv:  capProv,
+		uow:      uow,
+	}
+}
+
+// RollOver processes schedules whose day has ended in the owner's timezone, each in its own
+// transaction. Failed schedules are logged and retried on the next run.
+// It returns the number of schedules rolled over.
+func (r *ScheduleRollover) RollOver(ctx context.Context, now time.Time) (int, error) {
+	// no timezone is more than a day ahead of UTC
+	before := domain.NewScheduleDate(now.AddDate(0, 0, 1), time.UTC)
+
+	var after *domain.DailySchedule
+
+	n := 0
+
+	for {
+		pending, err := r.repo.FindPendingRollover(ctx, before, after, rolloverBatchSize)
+		if err != nil {
+			return n, err
+		}
+
+		n += r.rollOverBatch(ctx, pending, now)
+
+		if len(pending) < rolloverBatchSize {
+			return n, nil
+		}
+
+		after = pending[len(pending)-1]
+	}
+}
+
+func (r *ScheduleRollover) rollOverBatch(ctx context.Context, pending []*domain.DailySchedule, now time.Time) int {
+	n := 0
+
+	for _, s := range pending {
+		loc, err := r.tzProv.Location(ctx, s.UserID())
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to get schedule owner timezone",
+				slog.String("user_id", s.UserID().String()),
+				slog.String("date", s.Date().String()),
+				slog.String("error", err.Error()))
+
+			continue
+		}
+
+		today := domain.NewScheduleDate(now, loc)
+		if s.Date() >= today {
+			continue
+		}
+
+		err = r.uow.Execute(ctx, func(ctx context.Context) error {
+			return r.rollOver(ctx, s.UserID(), s.Date(), today, now)
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to roll over schedule",
+				slog.String("user_id", s.UserID().String()),
+				slog.String("date", s.Date().String()),
+				slog.String("error", err.Error()))
+
+			continue
+		}
+
+		n++
+	}
+
+	return n
+}
+
+func (r *ScheduleRollover) rollOver(ctx context.Context, userID userDomain.UserID, date, today domain.ScheduleDate, now time.Time) error {
+	s, err := r.repo.FindByUserAndDate(ctx, userID, date)
+	if err != nil {
+		return err
+	}
+
+	unfinished := make([]domain.PlanCandidate, 0, len(s.CommittedTasks()))
+
+	for todoID, cost := range s.CommittedTasks() {
+		todo, err := r.todoRepo.FindByID(ctx, todoID)
+		if err != nil {
+			if errors.Is(err, todoDomain.ErrTodoNotFound) {
+				continue
+			}
+
+			return err
+		}
+
+		if todo.Status() != todoDomain.StatusPending {
+			continue
+		}
+
+		unfinished = append(unfinished, domain.NewPlanCandidate(todoID, cost, todo.DueDate()))
+	}
+
+	next, err := findOrNewSchedule(ctx, r.repo, r.capProv, userID, today)
+	if err != nil {
+		return err
+	}
+
+	if err := s.RollOverTo(next, unfinished, now); err != nil {
+		return err
+	}
+
+	if err := r.repo.Save(ctx, s); err != nil {
+		return err
+	}
+
+	// avoid creating empty schedules when everything was finished
+	if len(next.Events()) == 0 {
+		return nil
+	}
+
+	return r.repo.Save(ctx, next)
+}
@@ -0,0 +1,122 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/schedule/application"
+	"github.com/danicc097/todo-ddd-example/internal/modules/schedule/domain"
+	schedulePg "github.com/danicc097/todo-ddd-example/internal/modules/schedule/infrastructure/postgres"
+	todoDomain "github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	todoAdapters "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/adapters"
+	todoPg "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/postgres"
+	userAdapters "github.com/danicc097/todo-ddd-example/internal/modules/user/infrastructure/adapters"
+	sharedApp "github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+	"github.com/danicc097/todo-ddd-example/internal/testfixtures"
+	"github.com/danicc097/todo-ddd-example/internal/testutils"
+)
+
+func TestSchedulePlanning_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pool := testutils.GetGlobalPostgresPool(t)
+	fixtures := testfixtures.NewFixtures(pool)
+
+	uow := sharedPg.NewUnitOfWork(pool)
+	scheduleRepo := schedulePg.NewScheduleRepo(pool, uow)
+	todoRepo := todoPg.NewTodoRepo(pool, uow)
+	tzProv := userAdapters.NewUserTimezoneProvider(fixtures.UserRepo)
+	capProv := userAdapters.NewUserCapacityProvider(fixtures.UserRepo)
+	todoProv := todoAdapters.NewScheduleTodoProvider(todoPg.NewTodoQueryService(pool))
+
+	commit := sharedApp.WithUoW(application.NewCommitTaskHandler(scheduleRepo, todoRepo, tzProv, capProv), uow)
+	autoPlan := sharedApp.WithUoW(application.NewAutoPlanHandler(scheduleRepo, todoProv, tzProv, capProv), uow)
+	rollover := application.NewScheduleRollover(scheduleRepo, todoRepo, tzProv, capProv, uow)
+
+	t.Run("auto plans open assignments and unassigned due todos by due date", func(t *testing.T) {
+		user := fixtures.RandomUser(ctx, t)
+		ws := fixtures.RandomWorkspace(ctx, t, user.ID())
+		userCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: user.ID().UUID()})
+		now := time.Now()
+		due := now.Add(24 * time.Hour)
+
+		dated := fixtures.RandomTodo(ctx, t, ws.ID())
+		undated := fixtures.RandomTodo(ctx, t, ws.ID())
+		done := fixtures.RandomTodo(ctx, t, ws.ID())
+		require.NoError(t, dated.SetDueDate(&due, user.ID(), now))
+		require.NoError(t, done.Complete(user.ID(), now))
+
+		for _, todo := range []*todoDomain.Todo{dated, undated, done} {
+			require.NoError(t, todo.Assign(user.ID(), user.ID(), now))
+			require.NoError(t, todoRepo.Save(ctx, todo))
+		}
+
+		// the user's timezone defaults to UTC
+		startOfDay := now.UTC().Truncate(24 * time.Hour)
+		dueAfterDay := startOfDay.Add(48 * time.Hour)
+		dueToday := fixtures.RandomTodo(ctx, t, ws.ID())
+		dueLater := fixtures.RandomTodo(ctx, t, ws.ID())
+		require.NoError(t, dueToday.SetDueDate(&startOfDay, user.ID(), now))
+		require.NoError(t, dueLater.SetDueDate(&dueAfterDay, user.ID(), now))
+		require.NoError(t, todoRepo.Save(ctx, dueToday))
+		require.NoError(t, todoRepo.Save(ctx, dueLater))
+
+		date := domain.ScheduleDate(now.UTC().Format(time.DateOnly))
+
+		resp, err := autoPlan.Handle(userCtx, application.AutoPlanCommand{Date: date})
+		require.NoError(t, err)
+		assert.Equal(t, []todoDomain.TodoID{dueToday.ID(), dated.ID(), undated.ID()}, resp.Planned)
+
+		s, err := scheduleRepo.FindByUserAndDate(ctx, user.ID(), date)
+		require.NoError(t, err)
+		assert.Equal(t, domain.DefaultEnergyCost, s.CommittedTasks()[dated.ID()])
+	})
+
+	t.Run("carries unfinished tasks over to the next day", func(t *testing.T) {
+		user := fixtures.RandomUser(ctx, t)
+		ws := fixtures.RandomWorkspace(ctx, t, user.ID())
+		userCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: user.ID().UUID()})
+		systemCtx := causation.WithMetadata(ctx, causation.Metadata{IsSystemRequest: true})
+		// far in the past so other tests' schedules aren't due yet
+		past := domain.ScheduleDate("2000-01-01")
+		now := time.Date(2000, 1, 2, 12, 0, 0, 0, time.UTC)
+
+		pending := fixtures.RandomTodo(ctx, t, ws.ID())
+		done := fixtures.RandomTodo(ctx, t, ws.ID())
+
+		for _, todo := range []*todoDomain.Todo{pending, done} {
+			_, err := commit.Handle(userCtx, application.CommitTaskCommand{TodoID: todo.ID().UUID(), Cost: 2, Date: past.String()})
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, done.Complete(user.ID(), time.Now()))
+		require.NoError(t, todoRepo.Save(ctx, done))
+
+		n, err := rollover.RollOver(systemCtx, now)
+		require.NoError(t, err)
+		assert.Positive(t, n)
+
+		from, err := scheduleRepo.FindByUserAndDate(ctx, user.ID(), past)
+		require.NoError(t, err)
+		assert.NotNil(t, from.RolledOverAt())
+		assert.Equal(t, map[todoDomain.TodoID]domain.EnergyCost{done.ID(): 2}, from.CommittedTasks())
+
+		to, err := scheduleRepo.FindByUserAndDate(ctx, user.ID(), "2000-01-02")
+		require.NoError(t, err)
+		assert.Equal(t, map[todoDomain.TodoID]domain.EnergyCost{pending.ID(): 2}, to.CommittedTasks())
+
+		_, err = rollover.RollOver(systemCtx, now)
+		require.NoError(t, err)
+
+		again, err := scheduleRepo.FindByUserAndDate(ctx, user.ID(), past)
+		require.NoError(t, err)
+		assert.Equal(t, from.Version(), again.Version())
+	})
+}
@@ -0,0 +1,100 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/schedule/application"
+	"github.com/danicc097/todo-ddd-example/internal/modules/schedule/domain"
+	schedulePg "github.com/danicc097/todo-ddd-example/internal/modules/schedule/infrastructure/postgres"
+	todoPg "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/postgres"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	userAdapters "github.com/danicc097/todo-ddd-example/internal/modules/user/infrastructure/adapters"
+	sharedApp "github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+	"github.com/danicc097/todo-ddd-example/internal/testfixtures"
+	"github.com/danicc097/todo-ddd-example/internal/testutils"
+)
+
+func TestScheduleUseCases_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pool := testutils.GetGlobalPostgresPool(t)
+	fixtures := testfixtures.NewFixtures(pool)
+
+	uow := sharedPg.NewUnitOfWork(pool)
+	scheduleRepo := schedulePg.NewScheduleRepo(pool, uow)
+	todoRepo := todoPg.NewTodoRepo(pool, uow)
+	tzProv := userAdapters.NewUserTimezoneProvider(fixtures.UserRepo)
+	capProv := userAdapters.NewUserCapacityProvider(fixtures.UserRepo)
+
+	commit := sharedApp.WithUoW(application.NewCommitTaskHandler(scheduleRepo, todoRepo, tzProv, capProv), uow)
+	get := application.NewGetScheduleHandler(scheduleRepo, capProv)
+	setCapacity := sharedApp.WithUoW(application.NewSetScheduleCapacityHandler(scheduleRepo), uow)
+	remove := sharedApp.WithUoW(application.NewRemoveScheduledTaskHandler(scheduleRepo), uow)
+
+	t.Run("new schedules use the user's default capacity", func(t *testing.T) {
+		user := fixtures.RandomUser(ctx, t)
+		capacity, err := userDomain.NewDailyCapacity(4)
+		require.NoError(t, err)
+		user.SetDailyCapacity(capacity, time.Now())
+		require.NoError(t, fixtures.UserRepo.Save(ctx, user))
+
+		userCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: user.ID().UUID()})
+		date := domain.ScheduleDate(time.Now().Format(time.DateOnly))
+
+		empty, err := get.Handle(userCtx, application.GetScheduleQuery{Date: date})
+		require.NoError(t, err)
+		assert.Equal(t, 4, empty.Schedule.MaxCapacity)
+		assert.Empty(t, empty.Schedule.Tasks)
+
+		ws := fixtures.RandomWorkspace(ctx, t, user.ID())
+		todo := fixtures.RandomTodo(ctx, t, ws.ID())
+
+		_, err = commit.Handle(userCtx, application.CommitTaskCommand{TodoID: todo.ID().UUID(), Cost: 5, Date: date.String()})
+		require.ErrorIs(t, err, domain.ErrDailyCapacityExceeded)
+
+		_, err = commit.Handle(userCtx, application.CommitTaskCommand{TodoID: todo.ID().UUID(), Cost: 3, Date: date.String()})
+		require.NoError(t, err)
+
+		resp, err := get.Handle(userCtx, application.GetScheduleQuery{Date: date})
+		require.NoError(t, err)
+		assert.Equal(t, 3, resp.Schedule.Load)
+		require.Len(t, resp.Schedule.Tasks, 1)
+		assert.Equal(t, todo.ID(), resp.Schedule.Tasks[0].TodoID)
+	})
+
+	t.Run("overrides capacity and uncommits tasks", func(t *testing.T) {
+		user := fixtures.RandomUser(ctx, t)
+		ws := fixtures.RandomWorkspace(ctx, t, user.ID())
+		todo := fixtures.RandomTodo(ctx, t, ws.ID())
+		userCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: user.ID().UUID()})
+		date := domain.ScheduleDate(time.Now().AddDate(0, 0, 2).Format(time.DateOnly))
+
+		_, err := setCapacity.Handle(userCtx, application.SetScheduleCapacityCommand{Date: date, Capacity: 20})
+		require.NoError(t, err)
+
+		_, err = commit.Handle(userCtx, application.CommitTaskCommand{TodoID: todo.ID().UUID(), Cost: 5, Date: date.String()})
+		require.NoError(t, err)
+
+		_, err = setCapacity.Handle(userCtx, application.SetScheduleCapacityCommand{Date: date, Capacity: 4})
+		require.ErrorIs(t, err, domain.ErrCapacityBelowLoad)
+
+		_, err = remove.Handle(userCtx, application.RemoveScheduledTaskCommand{Date: date, TodoID: todo.ID()})
+		require.NoError(t, err)
+
+		s, err := scheduleRepo.FindByUserAndDate(ctx, user.ID(), date)
+		require.NoError(t, err)
+		assert.Equal(t, 20, s.MaxCapacity())
+		assert.Empty(t, s.CommittedTasks())
+
+		_, err = remove.Handle(userCtx, application.RemoveScheduledTaskCommand{Date: date, TodoID: todo.ID()})
+		require.ErrorIs(t, err, domain.ErrTaskNotScheduled)
+	})
+}
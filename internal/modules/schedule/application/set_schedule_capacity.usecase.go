@@ -0,0 +1,64 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/schedule/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type SetScheduleCapacityCommand struct {
+	Date     domain.ScheduleDate
+	Capacity int
+}
+
+func (c *SetScheduleCapacityCommand) Validate() error {
+	if c.Capacity <= 0 {
+		return domain.ErrInvalidCapacity
+	}
+
+	if _, err := time.Parse(time.DateOnly, c.Date.String()); err != nil {
+		return fmt.Errorf("invalid date format: %w", err)
+	}
+
+	return nil
+}
+
+type SetScheduleCapacityResponse struct{}
+
+type SetScheduleCapacityHandler struct {
+	repo domain.ScheduleRepository
+}
+
+var _ application.RequestHandler[SetScheduleCapacityCommand, SetScheduleCapacityResponse] = (*SetScheduleCapacityHandler)(nil)
+
+func NewSetScheduleCapacityHandler(repo domain.ScheduleRepository) *SetScheduleCapacityHandler {
+	return &SetScheduleCapacityHandler{repo: repo}
+}
+
+// Handle overrides the caller's capacity for a single day, creating the schedule if needed.
+func (h *SetScheduleCapacityHandler) Handle(ctx context.Context, cmd SetScheduleCapacityCommand) (SetScheduleCapacityResponse, error) {
+	meta := causation.FromContext(ctx)
+	userID := userDomain.UserID(meta.UserID)
+
+	s, err := h.repo.FindByUserAndDate(ctx, userID, cmd.Date)
+	if err != nil {
+		if !errors.Is(err, domain.ErrScheduleNotFound) {
+			return SetScheduleCapacityResponse{}, err
+		}
+
+		s, err = domain.NewDailySchedule(userID, cmd.Date, cmd.Capacity)
+		if err != nil {
+			return SetScheduleCapacityResponse{}, err
+		}
+	} else if err := s.SetCapacity(cmd.Capacity); err != nil {
+		return SetScheduleCapacityResponse{}, err
+	}
+
+	return SetScheduleCapacityResponse{}, h.repo.Save(ctx, s)
+}
@@ -0,0 +1,86 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/schedule/domain"
+	todoDomain "github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+type TodoCompletedEventPayload struct {
+	ID uuid.UUID `json:"id"`
+}
+
+// TodoCompletedEventHandler marks scheduled tasks done when their todo is completed.
+// A rolled over recurring todo only finishes its earliest pending occurrence.
+type TodoCompletedEventHandler struct {
+	repo domain.ScheduleRepository
+	uow  application.UnitOfWork
+}
+
+func NewTodoCompletedEventHandler(repo domain.ScheduleRepository, uow application.UnitOfWork) *TodoCompletedEventHandler {
+	return &TodoCompletedEventHandler{repo: repo, uow: uow}
+}
+
+func (h *TodoCompletedEventHandler) Handle(ctx context.Context, data []byte) error {
+	var envelope struct {
+		Event     string                    `json:"event"`
+		Timestamp time.Time                 `json:"timestamp"`
+		Data      TodoCompletedEventPayload `json:"data"`
+	}
+
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		if err := json.Unmarshal(data, &envelope.Data); err != nil {
+			return fmt.Errorf("failed to unmarshal TodoCompleted event: %w", err)
+		}
+	}
+
+	todoID := todoDomain.TodoID(envelope.Data.ID)
+	onlyNext := envelope.Event == string(shared.TodoRolledOver)
+
+	// the event time identifies the occurrence on redelivery, truncated to what postgres stores
+	at := envelope.Timestamp.Truncate(time.Microsecond)
+	if at.IsZero() {
+		at = time.Now().Truncate(time.Microsecond)
+	}
+
+	return h.uow.Execute(ctx, func(ctx context.Context) error {
+		schedules, err := h.repo.FindSchedulesByTodoID(ctx, todoID)
+		if err != nil {
+			return err
+		}
+
+		for _, s := range schedules {
+			if doneAt, ok := s.CompletedTasks()[todoID]; ok && doneAt.Equal(at) {
+				return nil
+			}
+		}
+
+		for _, s := range schedules {
+			if _, done := s.CompletedTasks()[todoID]; done {
+				continue
+			}
+
+			if err := s.CompleteTask(todoID, at); err != nil {
+				return err
+			}
+
+			if err := h.repo.Save(ctx, s); err != nil {
+				return err
+			}
+
+			if onlyNext {
+				return nil
+			}
+		}
+
+		return nil
+	})
+}
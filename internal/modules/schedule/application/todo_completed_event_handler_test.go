@@ -0,0 +1,98 @@
+package application_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/schedule/application"
+	"github.com/danicc097/todo-ddd-example/internal/modules/schedule/domain"
+	schedulePg "github.com/danicc097/todo-ddd-example/internal/modules/schedule/infrastructure/postgres"
+	todoPg "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/postgres"
+	userAdapters "github.com/danicc097/todo-ddd-example/internal/modules/user/infrastructure/adapters"
+	sharedApp "github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+	"github.com/danicc097/todo-ddd-example/internal/testfixtures"
+	"github.com/danicc097/todo-ddd-example/internal/testutils"
+)
+
+func TestTodoCompletedEventHandler_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pool := testutils.GetGlobalPostgresPool(t)
+	fixtures := testfixtures.NewFixtures(pool)
+
+	uow := sharedPg.NewUnitOfWork(pool)
+	scheduleRepo := schedulePg.NewScheduleRepo(pool, uow)
+	todoRepo := todoPg.NewTodoRepo(pool, uow)
+	tzProv := userAdapters.NewUserTimezoneProvider(fixtures.UserRepo)
+	capProv := userAdapters.NewUserCapacityProvider(fixtures.UserRepo)
+
+	commit := sharedApp.WithUoW(application.NewCommitTaskHandler(scheduleRepo, todoRepo, tzProv, capProv), uow)
+	get := application.NewGetScheduleHandler(scheduleRepo, capProv)
+	handler := application.NewTodoCompletedEventHandler(scheduleRepo, uow)
+
+	user := fixtures.RandomUser(ctx, t)
+	ws := fixtures.RandomWorkspace(ctx, t, user.ID())
+	userCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: user.ID().UUID()})
+	today := time.Now()
+	dates := []domain.ScheduleDate{
+		domain.ScheduleDate(today.Format(time.DateOnly)),
+		domain.ScheduleDate(today.AddDate(0, 0, 1).Format(time.DateOnly)),
+	}
+
+	event := func(t *testing.T, name shared.EventType, todoID any, at time.Time) []byte {
+		t.Helper()
+
+		b, err := json.Marshal(map[string]any{"event": name, "timestamp": at, "data": map[string]any{"id": todoID}})
+		require.NoError(t, err)
+
+		return b
+	}
+
+	t.Run("rolled over todos complete one occurrence per event", func(t *testing.T) {
+		todo := fixtures.RandomTodo(ctx, t, ws.ID())
+		for _, date := range dates {
+			_, err := commit.Handle(userCtx, application.CommitTaskCommand{TodoID: todo.ID().UUID(), Cost: 2, Date: date.String()})
+			require.NoError(t, err)
+		}
+
+		payload := event(t, shared.TodoRolledOver, todo.ID(), time.Now())
+		require.NoError(t, handler.Handle(ctx, payload))
+		require.NoError(t, handler.Handle(ctx, payload))
+
+		first, err := get.Handle(userCtx, application.GetScheduleQuery{Date: dates[0]})
+		require.NoError(t, err)
+		assert.Equal(t, 2, first.Schedule.Completed)
+		assert.Equal(t, 0, first.Schedule.Remaining)
+
+		second, err := get.Handle(userCtx, application.GetScheduleQuery{Date: dates[1]})
+		require.NoError(t, err)
+		assert.Equal(t, 0, second.Schedule.Completed)
+		require.Len(t, second.Schedule.Tasks, 1)
+		assert.Nil(t, second.Schedule.Tasks[0].CompletedAt)
+	})
+
+	t.Run("completed todos finish every occurrence", func(t *testing.T) {
+		todo := fixtures.RandomTodo(ctx, t, ws.ID())
+		for _, date := range dates {
+			_, err := commit.Handle(userCtx, application.CommitTaskCommand{TodoID: todo.ID().UUID(), Cost: 1, Date: date.String()})
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, handler.Handle(ctx, event(t, shared.TodoCompleted, todo.ID(), time.Now())))
+
+		for _, date := range dates {
+			s, err := scheduleRepo.FindByUserAndDate(ctx, user.ID(), date)
+			require.NoError(t, err)
+			assert.Contains(t, s.CompletedTasks(), todo.ID())
+		}
+	})
+}
@@ -42,7 +42,9 @@ func (h *TodoDeletedEventHandler) Handle(ctx context.Context, data []byte) error
 	}
 
 	for _, s := range schedules {
-		s.RemoveTask(todoID)
+		if err := s.RemoveTask(todoID); err != nil {
+			return err
+		}
 
 		if err := h.repo.Save(ctx, s); err != nil {
 			return err
@@ -33,3 +33,84 @@ func (e TaskCommittedToScheduleEvent) EventName() shared.EventType         { ret
 func (e TaskCommittedToScheduleEvent) OccurredAt() time.Time               { return e.Occurred }
 func (e TaskCommittedToScheduleEvent) AggregateID() uuid.UUID              { return e.UserID.UUID() } // good enough for routing
 func (e TaskCommittedToScheduleEvent) AggregateType() shared.AggregateType { return shared.AggSchedule }
+
+type TaskRemovedFromScheduleEvent struct {
+	UserID   userDomain.UserID
+	Date     ScheduleDate
+	TodoID   uuid.UUID
+	Occurred time.Time
+}
+
+func (e TaskRemovedFromScheduleEvent) EventName() shared.EventType         { return shared.TaskRemoved }
+func (e TaskRemovedFromScheduleEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TaskRemovedFromScheduleEvent) AggregateID() uuid.UUID              { return e.UserID.UUID() } // good enough for routing
+func (e TaskRemovedFromScheduleEvent) AggregateType() shared.AggregateType { return shared.AggSchedule }
+
+type CapacityChangedEvent struct {
+	UserID      userDomain.UserID
+	Date        ScheduleDate
+	MaxCapacity int
+	Occurred    time.Time
+}
+
+func (e CapacityChangedEvent) EventName() shared.EventType         { return shared.ScheduleCapacityChanged }
+func (e CapacityChangedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e CapacityChangedEvent) AggregateID() uuid.UUID              { return e.UserID.UUID() } // good enough for routing
+func (e CapacityChangedEvent) AggregateType() shared.AggregateType { return shared.AggSchedule }
+
+// TaskCarriedOverEvent is recorded on the destination day when an unfinished task moves to it.
+type TaskCarriedOverEvent struct {
+	UserID   userDomain.UserID
+	FromDate ScheduleDate
+	Date     ScheduleDate
+	TodoID   uuid.UUID
+	Cost     EnergyCost
+	Occurred time.Time
+}
+
+func (e TaskCarriedOverEvent) EventName() shared.EventType         { return shared.TaskCarriedOver }
+func (e TaskCarriedOverEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TaskCarriedOverEvent) AggregateID() uuid.UUID              { return e.UserID.UUID() } // good enough for routing
+func (e TaskCarriedOverEvent) AggregateType() shared.AggregateType { return shared.AggSchedule }
+
+// CarryOverSkippedEvent is recorded when an unfinished task stays behind because the next day is full.
+type CarryOverSkippedEvent struct {
+	UserID   userDomain.UserID
+	Date     ScheduleDate
+	NextDate ScheduleDate
+	TodoID   uuid.UUID
+	Cost     EnergyCost
+	Occurred time.Time
+}
+
+func (e CarryOverSkippedEvent) EventName() shared.EventType         { return shared.TaskCarryOverSkipped }
+func (e CarryOverSkippedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e CarryOverSkippedEvent) AggregateID() uuid.UUID              { return e.UserID.UUID() } // good enough for routing
+func (e CarryOverSkippedEvent) AggregateType() shared.AggregateType { return shared.AggSchedule }
+
+type TaskAutoPlannedEvent struct {
+	UserID   userDomain.UserID
+	Date     ScheduleDate
+	TodoID   uuid.UUID
+	Cost     EnergyCost
+	DueDate  *time.Time
+	Occurred time.Time
+}
+
+func (e TaskAutoPlannedEvent) EventName() shared.EventType         { return shared.TaskAutoPlanned }
+func (e TaskAutoPlannedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TaskAutoPlannedEvent) AggregateID() uuid.UUID              { return e.UserID.UUID() } // good enough for routing
+func (e TaskAutoPlannedEvent) AggregateType() shared.AggregateType { return shared.AggSchedule }
+
+type TaskCompletedEvent struct {
+	UserID   userDomain.UserID
+	Date     ScheduleDate
+	TodoID   uuid.UUID
+	Cost     EnergyCost
+	Occurred time.Time
+}
+
+func (e TaskCompletedEvent) EventName() shared.EventType         { return shared.TaskCompleted }
+func (e TaskCompletedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TaskCompletedEvent) AggregateID() uuid.UUID              { return e.UserID.UUID() } // good enough for routing
+func (e TaskCompletedEvent) AggregateType() shared.AggregateType { return shared.AggSchedule }
@@ -11,4 +11,9 @@ type ScheduleRepository interface {
 	Save(ctx context.Context, s *DailySchedule) error
 	FindByUserAndDate(ctx context.Context, userID userDomain.UserID, date ScheduleDate) (*DailySchedule, error)
 	FindSchedulesByTodoID(ctx context.Context, todoID todoDomain.TodoID) ([]*DailySchedule, error)
+	// FindPendingRollover returns schedules before the given day that still have tasks and weren't rolled over,
+	// ordered by date and user. Listing resumes after the given schedule, if any.
+	FindPendingRollover(ctx context.Context, before ScheduleDate, after *DailySchedule, limit int32) ([]*DailySchedule, error)
+	// LatestCosts returns the cost each todo was last committed with by the user.
+	LatestCosts(ctx context.Context, userID userDomain.UserID, todoIDs []todoDomain.TodoID) (map[todoDomain.TodoID]EnergyCost, error)
 }
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"cmp"
+	"slices"
 	"time"
 
 	"github.com/danicc097/todo-ddd-example/internal/apperrors"
@@ -14,6 +16,10 @@ var (
 	ErrInvalidEnergyCost     = shared.NewDomainError(apperrors.InvalidInput, "energy cost must be between 1 and 5")
 	ErrInvalidCapacity       = shared.NewDomainError(apperrors.InvalidInput, "capacity must be greater than 0")
 	ErrScheduleNotFound      = shared.NewDomainError(apperrors.NotFound, "schedule not found")
+	ErrTaskNotScheduled      = shared.NewDomainError(apperrors.NotFound, "task is not committed to this schedule")
+	ErrCapacityBelowLoad     = shared.NewDomainError(apperrors.Conflict, "capacity is below the energy already committed")
+	ErrAlreadyRolledOver     = shared.NewDomainError(apperrors.Conflict, "schedule was already rolled over")
+	ErrInvalidRollover       = shared.NewDomainError(apperrors.InvalidInput, "tasks can only roll over to a later day of the same user")
 	ErrConcurrentUpdate      = shared.ErrConcurrentUpdate
 )
 
@@ -21,6 +27,9 @@ var (
 // Higher values mean more effort is required.
 type EnergyCost int
 
+// DefaultEnergyCost is assumed for todos that were never committed before.
+const DefaultEnergyCost EnergyCost = 3
+
 func NewEnergyCost(val int) (EnergyCost, error) {
 	if val < 1 || val > 5 {
 		return 0, ErrInvalidEnergyCost
@@ -29,10 +38,12 @@ func NewEnergyCost(val int) (EnergyCost, error) {
 	return EnergyCost(val), nil
 }
 
+// ScheduleDate is a calendar day in the schedule owner's timezone.
 type ScheduleDate string
 
-func NewScheduleDate(t time.Time) ScheduleDate {
-	return ScheduleDate(t.Format(time.DateOnly))
+// NewScheduleDate returns the calendar day t falls on in loc.
+func NewScheduleDate(t time.Time, loc *time.Location) ScheduleDate {
+	return ScheduleDate(t.In(loc).Format(time.DateOnly))
 }
 
 func (d ScheduleDate) String() string { return string(d) }
@@ -45,6 +56,8 @@ type DailySchedule struct {
 	maxCapacity    int
 	version        int
 	committedTasks map[todoDomain.TodoID]EnergyCost
+	completedTasks map[todoDomain.TodoID]time.Time
+	rolledOverAt   *time.Time
 }
 
 func NewDailySchedule(userID userDomain.UserID, date ScheduleDate, maxCapacity int) (*DailySchedule, error) {
@@ -58,6 +71,7 @@ func NewDailySchedule(userID userDomain.UserID, date ScheduleDate, maxCapacity i
 		maxCapacity:    maxCapacity,
 		version:        0,
 		committedTasks: make(map[todoDomain.TodoID]EnergyCost),
+		completedTasks: make(map[todoDomain.TodoID]time.Time),
 	}
 
 	s.RecordEvent(DailyScheduleCreatedEvent{
@@ -76,25 +90,58 @@ type ReconstituteDailyScheduleArgs struct {
 	MaxCapacity    int
 	Version        int
 	CommittedTasks map[todoDomain.TodoID]EnergyCost
+	CompletedTasks map[todoDomain.TodoID]time.Time
+	RolledOverAt   *time.Time
 }
 
 func ReconstituteDailySchedule(args ReconstituteDailyScheduleArgs) *DailySchedule {
+	completedTasks := args.CompletedTasks
+	if completedTasks == nil {
+		completedTasks = make(map[todoDomain.TodoID]time.Time)
+	}
+
 	return &DailySchedule{
 		userID:         args.UserID,
 		date:           args.Date,
 		maxCapacity:    args.MaxCapacity,
 		version:        args.Version,
 		committedTasks: args.CommittedTasks,
+		completedTasks: completedTasks,
+		rolledOverAt:   args.RolledOverAt,
 	}
 }
 
-func (s *DailySchedule) CommitTask(todoID todoDomain.TodoID, cost EnergyCost) error {
-	currentLoad := 0
+// Load is the total energy committed to the day.
+func (s *DailySchedule) Load() int {
+	load := 0
 	for _, c := range s.committedTasks {
-		currentLoad += int(c)
+		load += int(c)
 	}
 
-	if currentLoad+int(cost) > s.maxCapacity {
+	return load
+}
+
+// CompletedLoad is the energy of tasks already done. It still counts towards Load.
+func (s *DailySchedule) CompletedLoad() int {
+	load := 0
+	for id := range s.completedTasks {
+		load += int(s.committedTasks[id])
+	}
+
+	return load
+}
+
+// RemainingLoad is the energy of tasks still pending.
+func (s *DailySchedule) RemainingLoad() int {
+	return s.Load() - s.CompletedLoad()
+}
+
+func (s *DailySchedule) fits(cost EnergyCost) bool {
+	return s.Load()+int(cost) <= s.maxCapacity
+}
+
+func (s *DailySchedule) CommitTask(todoID todoDomain.TodoID, cost EnergyCost) error {
+	if !s.fits(cost) {
 		return ErrDailyCapacityExceeded
 	}
 
@@ -110,8 +157,196 @@ func (s *DailySchedule) CommitTask(todoID todoDomain.TodoID, cost EnergyCost) er
 	return nil
 }
 
-func (s *DailySchedule) RemoveTask(todoID todoDomain.TodoID) {
+func (s *DailySchedule) RemoveTask(todoID todoDomain.TodoID) error {
+	if _, ok := s.committedTasks[todoID]; !ok {
+		return ErrTaskNotScheduled
+	}
+
 	delete(s.committedTasks, todoID)
+	delete(s.completedTasks, todoID)
+	s.RecordEvent(TaskRemovedFromScheduleEvent{
+		UserID:   s.userID,
+		Date:     s.date,
+		TodoID:   todoID.UUID(),
+		Occurred: time.Now(),
+	})
+
+	return nil
+}
+
+// CompleteTask marks a committed task as done. Completing it again is a no-op.
+func (s *DailySchedule) CompleteTask(todoID todoDomain.TodoID, at time.Time) error {
+	cost, ok := s.committedTasks[todoID]
+	if !ok {
+		return ErrTaskNotScheduled
+	}
+
+	if _, done := s.completedTasks[todoID]; done {
+		return nil
+	}
+
+	s.completedTasks[todoID] = at
+	s.RecordEvent(TaskCompletedEvent{
+		UserID:   s.userID,
+		Date:     s.date,
+		TodoID:   todoID.UUID(),
+		Cost:     cost,
+		Occurred: at,
+	})
+
+	return nil
+}
+
+// SetCapacity changes the day's capacity. It can't drop below what is already committed.
+func (s *DailySchedule) SetCapacity(capacity int) error {
+	if capacity <= 0 {
+		return ErrInvalidCapacity
+	}
+
+	if capacity < s.Load() {
+		return ErrCapacityBelowLoad
+	}
+
+	if capacity == s.maxCapacity {
+		return nil
+	}
+
+	s.maxCapacity = capacity
+	s.RecordEvent(CapacityChangedEvent{
+		UserID:      s.userID,
+		Date:        s.date,
+		MaxCapacity: capacity,
+		Occurred:    time.Now(),
+	})
+
+	return nil
+}
+
+// PlanCandidate is an unfinished todo that could be committed to a day.
+type PlanCandidate struct {
+	todoID  todoDomain.TodoID
+	cost    EnergyCost
+	dueDate *time.Time
+}
+
+func NewPlanCandidate(todoID todoDomain.TodoID, cost EnergyCost, dueDate *time.Time) PlanCandidate {
+	return PlanCandidate{todoID: todoID, cost: cost, dueDate: dueDate}
+}
+
+func (c PlanCandidate) TodoID() todoDomain.TodoID { return c.todoID }
+func (c PlanCandidate) Cost() EnergyCost          { return c.cost }
+func (c PlanCandidate) DueDate() *time.Time       { return c.dueDate }
+
+// rankCandidates orders by due date, undated last, then by cost so cheaper tasks
+// pack more work into the remaining capacity.
+func rankCandidates(candidates []PlanCandidate) []PlanCandidate {
+	ranked := slices.Clone(candidates)
+	slices.SortStableFunc(ranked, func(a, b PlanCandidate) int {
+		switch {
+		case a.dueDate == nil && b.dueDate != nil:
+			return 1
+		case a.dueDate != nil && b.dueDate == nil:
+			return -1
+		case a.dueDate != nil && !a.dueDate.Equal(*b.dueDate):
+			return a.dueDate.Compare(*b.dueDate)
+		}
+
+		return cmp.Compare(a.cost, b.cost)
+	})
+
+	return ranked
+}
+
+// AutoPlan commits the best ranked candidates that still fit, skipping those already
+// committed. It returns ErrDailyCapacityExceeded if candidates remain but none fit.
+func (s *DailySchedule) AutoPlan(candidates []PlanCandidate, now time.Time) ([]todoDomain.TodoID, error) {
+	var planned []todoDomain.TodoID
+
+	skipped := false
+
+	for _, c := range rankCandidates(candidates) {
+		if _, ok := s.committedTasks[c.todoID]; ok {
+			continue
+		}
+
+		if !s.fits(c.cost) {
+			skipped = true
+			continue
+		}
+
+		s.committedTasks[c.todoID] = c.cost
+		planned = append(planned, c.todoID)
+		s.RecordEvent(TaskAutoPlannedEvent{
+			UserID:   s.userID,
+			Date:     s.date,
+			TodoID:   c.todoID.UUID(),
+			Cost:     c.cost,
+			DueDate:  c.dueDate,
+			Occurred: now,
+		})
+	}
+
+	if len(planned) == 0 && skipped {
+		return nil, ErrDailyCapacityExceeded
+	}
+
+	return planned, nil
+}
+
+// RollOverTo moves unfinished tasks to a later day, best ranked first, as long as it has capacity.
+// Tasks that don't fit stay behind. The schedule is marked so it is only rolled over once.
+func (s *DailySchedule) RollOverTo(next *DailySchedule, unfinished []PlanCandidate, now time.Time) error {
+	if s.rolledOverAt != nil {
+		return ErrAlreadyRolledOver
+	}
+
+	if next.userID != s.userID || next.date <= s.date {
+		return ErrInvalidRollover
+	}
+
+	for _, c := range rankCandidates(unfinished) {
+		cost, ok := s.committedTasks[c.todoID]
+		if !ok {
+			continue
+		}
+
+		// recurring todos stay pending once an occurrence is done
+		if _, done := s.completedTasks[c.todoID]; done {
+			continue
+		}
+
+		_, alreadyNext := next.committedTasks[c.todoID]
+		if !alreadyNext && !next.fits(cost) {
+			s.RecordEvent(CarryOverSkippedEvent{
+				UserID:   s.userID,
+				Date:     s.date,
+				NextDate: next.date,
+				TodoID:   c.todoID.UUID(),
+				Cost:     cost,
+				Occurred: now,
+			})
+
+			continue
+		}
+
+		if !alreadyNext {
+			next.committedTasks[c.todoID] = cost
+		}
+
+		delete(s.committedTasks, c.todoID)
+		next.RecordEvent(TaskCarriedOverEvent{
+			UserID:   s.userID,
+			FromDate: s.date,
+			Date:     next.date,
+			TodoID:   c.todoID.UUID(),
+			Cost:     next.committedTasks[c.todoID],
+			Occurred: now,
+		})
+	}
+
+	s.rolledOverAt = &now
+
+	return nil
 }
 
 func (s *DailySchedule) UserID() userDomain.UserID                        { return s.userID }
@@ -119,3 +354,5 @@ func (s *DailySchedule) Date() ScheduleDate                               { retu
 func (s *DailySchedule) MaxCapacity() int                                 { return s.maxCapacity }
 func (s *DailySchedule) Version() int                                     { return s.version }
 func (s *DailySchedule) CommittedTasks() map[todoDomain.TodoID]EnergyCost { return s.committedTasks }
+func (s *DailySchedule) CompletedTasks() map[todoDomain.TodoID]time.Time  { return s.completedTasks }
+func (s *DailySchedule) RolledOverAt() *time.Time                         { return s.rolledOverAt }
@@ -16,7 +16,7 @@ func TestDailySchedule_CommitTask(t *testing.T) {
 	t.Parallel()
 
 	userID := userDomain.UserID(uuid.New())
-	date := NewScheduleDate(time.Now())
+	date := NewScheduleDate(time.Now(), time.UTC)
 
 	t.Run("should commit task within capacity", func(t *testing.T) {
 		s, _ := NewDailySchedule(userID, date, 10)
@@ -41,3 +41,183 @@ func TestDailySchedule_CommitTask(t *testing.T) {
 		assert.ErrorIs(t, err, ErrDailyCapacityExceeded)
 	})
 }
+
+func TestNewScheduleDate(t *testing.T) {
+	t.Parallel()
+
+	la, err := time.LoadLocation("America/Los_Angeles")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		at   time.Time
+		loc  *time.Location
+		want ScheduleDate
+	}{
+		{"utc", time.Date(2026, 1, 15, 7, 0, 0, 0, time.UTC), time.UTC, "2026-01-15"},
+		{"late evening behind utc", time.Date(2026, 1, 16, 7, 0, 0, 0, time.UTC), la, "2026-01-15"},
+		{"before spring forward", time.Date(2026, 3, 8, 7, 59, 0, 0, time.UTC), la, "2026-03-07"},
+		{"after spring forward", time.Date(2026, 3, 8, 8, 0, 0, 0, time.UTC), la, "2026-03-08"},
+		{"last hour before fall back", time.Date(2026, 11, 2, 7, 59, 0, 0, time.UTC), la, "2026-11-01"},
+		{"midnight after fall back", time.Date(2026, 11, 2, 8, 0, 0, 0, time.UTC), la, "2026-11-02"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewScheduleDate(tt.at, tt.loc))
+		})
+	}
+}
+
+func TestDailySchedule_RemoveTask(t *testing.T) {
+	t.Parallel()
+
+	s, _ := NewDailySchedule(userDomain.UserID(uuid.New()), NewScheduleDate(time.Now(), time.UTC), 10)
+	todoID := todoDomain.TodoID(uuid.New())
+	cost, _ := NewEnergyCost(2)
+	require.NoError(t, s.CommitTask(todoID, cost))
+	s.ClearEvents()
+
+	require.NoError(t, s.RemoveTask(todoID))
+	assert.Empty(t, s.CommittedTasks())
+	require.Len(t, s.Events(), 1)
+	assert.IsType(t, TaskRemovedFromScheduleEvent{}, s.Events()[0])
+
+	assert.ErrorIs(t, s.RemoveTask(todoID), ErrTaskNotScheduled)
+}
+
+func TestDailySchedule_SetCapacity(t *testing.T) {
+	t.Parallel()
+
+	s, _ := NewDailySchedule(userDomain.UserID(uuid.New()), NewScheduleDate(time.Now(), time.UTC), 10)
+	cost, _ := NewEnergyCost(4)
+	require.NoError(t, s.CommitTask(todoDomain.TodoID(uuid.New()), cost))
+	s.ClearEvents()
+
+	require.NoError(t, s.SetCapacity(10))
+	assert.Empty(t, s.Events())
+
+	assert.ErrorIs(t, s.SetCapacity(0), ErrInvalidCapacity)
+	assert.ErrorIs(t, s.SetCapacity(3), ErrCapacityBelowLoad)
+
+	require.NoError(t, s.SetCapacity(4))
+	assert.Equal(t, 4, s.MaxCapacity())
+	require.Len(t, s.Events(), 1)
+	assert.Equal(t, 4, s.Events()[0].(CapacityChangedEvent).MaxCapacity)
+}
+
+func TestDailySchedule_AutoPlan(t *testing.T) {
+	t.Parallel()
+
+	userID := userDomain.UserID(uuid.New())
+	date := NewScheduleDate(time.Now(), time.UTC)
+	now := time.Now()
+	soon, later := now.Add(24*time.Hour), now.Add(72*time.Hour)
+
+	candidate := func(cost int, due *time.Time) PlanCandidate {
+		c, _ := NewEnergyCost(cost)
+		return NewPlanCandidate(todoDomain.TodoID(uuid.New()), c, due)
+	}
+
+	t.Run("should plan by due date then cost within capacity", func(t *testing.T) {
+		s, _ := NewDailySchedule(userID, date, 6)
+		s.ClearEvents()
+		undated := candidate(1, nil)
+		dueLater := candidate(2, &later)
+		dueSoonCheap := candidate(2, &soon)
+		dueSoonCostly := candidate(3, &soon)
+
+		planned, err := s.AutoPlan([]PlanCandidate{undated, dueLater, dueSoonCostly, dueSoonCheap}, now)
+		require.NoError(t, err)
+		assert.Equal(t, []todoDomain.TodoID{dueSoonCheap.TodoID(), dueSoonCostly.TodoID(), undated.TodoID()}, planned)
+		assert.Equal(t, 6, s.Load())
+		require.Len(t, s.Events(), 3)
+		assert.IsType(t, TaskAutoPlannedEvent{}, s.Events()[0])
+	})
+
+	t.Run("should skip committed tasks and fail when nothing fits", func(t *testing.T) {
+		s, _ := NewDailySchedule(userID, date, 4)
+		committed := candidate(3, nil)
+		require.NoError(t, s.CommitTask(committed.TodoID(), committed.Cost()))
+
+		planned, err := s.AutoPlan([]PlanCandidate{committed}, now)
+		require.NoError(t, err)
+		assert.Empty(t, planned)
+
+		_, err = s.AutoPlan([]PlanCandidate{committed, candidate(2, nil)}, now)
+		assert.ErrorIs(t, err, ErrDailyCapacityExceeded)
+	})
+}
+
+func TestDailySchedule_RollOverTo(t *testing.T) {
+	t.Parallel()
+
+	userID := userDomain.UserID(uuid.New())
+	now := time.Now()
+	today := NewScheduleDate(now, time.UTC)
+	yesterday := NewScheduleDate(now.AddDate(0, 0, -1), time.UTC)
+	cost, _ := NewEnergyCost(3)
+
+	t.Run("should carry over what fits and keep the rest", func(t *testing.T) {
+		s, _ := NewDailySchedule(userID, yesterday, 10)
+		next, _ := NewDailySchedule(userID, today, 5)
+		a, b, done := todoDomain.TodoID(uuid.New()), todoDomain.TodoID(uuid.New()), todoDomain.TodoID(uuid.New())
+		require.NoError(t, s.CommitTask(a, cost))
+		require.NoError(t, s.CommitTask(b, cost))
+		require.NoError(t, s.CommitTask(done, cost))
+		s.ClearEvents()
+		next.ClearEvents()
+
+		require.NoError(t, s.RollOverTo(next, []PlanCandidate{NewPlanCandidate(a, cost, nil), NewPlanCandidate(b, cost, nil)}, now))
+
+		assert.Len(t, next.CommittedTasks(), 1)
+		assert.Len(t, s.CommittedTasks(), 2)
+		assert.Contains(t, s.CommittedTasks(), done)
+		require.Len(t, next.Events(), 1)
+		assert.IsType(t, TaskCarriedOverEvent{}, next.Events()[0])
+		require.Len(t, s.Events(), 1)
+		assert.IsType(t, CarryOverSkippedEvent{}, s.Events()[0])
+		assert.Equal(t, &now, s.RolledOverAt())
+
+		assert.ErrorIs(t, s.RollOverTo(next, nil, now), ErrAlreadyRolledOver)
+	})
+
+	t.Run("should only roll over to a later day of the same user", func(t *testing.T) {
+		s, _ := NewDailySchedule(userID, today, 10)
+		past, _ := NewDailySchedule(userID, yesterday, 10)
+		other, _ := NewDailySchedule(userDomain.UserID(uuid.New()), NewScheduleDate(now.AddDate(0, 0, 1), time.UTC), 10)
+
+		assert.ErrorIs(t, s.RollOverTo(past, nil, now), ErrInvalidRollover)
+		assert.ErrorIs(t, s.RollOverTo(other, nil, now), ErrInvalidRollover)
+	})
+}
+
+func TestDailySchedule_CompleteTask(t *testing.T) {
+	t.Parallel()
+
+	userID := userDomain.UserID(uuid.New())
+	now := time.Now()
+	s, _ := NewDailySchedule(userID, NewScheduleDate(now, time.UTC), 10)
+	next, _ := NewDailySchedule(userID, NewScheduleDate(now.AddDate(0, 0, 1), time.UTC), 10)
+	done, pending := todoDomain.TodoID(uuid.New()), todoDomain.TodoID(uuid.New())
+	cost, _ := NewEnergyCost(3)
+	require.NoError(t, s.CommitTask(done, cost))
+	require.NoError(t, s.CommitTask(pending, cost))
+	s.ClearEvents()
+
+	assert.ErrorIs(t, s.CompleteTask(todoDomain.TodoID(uuid.New()), now), ErrTaskNotScheduled)
+
+	require.NoError(t, s.CompleteTask(done, now))
+	require.NoError(t, s.CompleteTask(done, now.Add(time.Hour)))
+	require.Len(t, s.Events(), 1)
+	assert.IsType(t, TaskCompletedEvent{}, s.Events()[0])
+	assert.Equal(t, now, s.CompletedTasks()[done])
+	assert.Equal(t, 6, s.Load())
+	assert.Equal(t, 3, s.CompletedLoad())
+	assert.Equal(t, 3, s.RemainingLoad())
+
+	// done occurrences of recurring todos are never carried over
+	require.NoError(t, s.RollOverTo(next, []PlanCandidate{NewPlanCandidate(done, cost, nil), NewPlanCandidate(pending, cost, nil)}, now))
+	assert.Equal(t, map[todoDomain.TodoID]EnergyCost{pending: cost}, next.CommittedTasks())
+	assert.Contains(t, s.CommittedTasks(), done)
+}
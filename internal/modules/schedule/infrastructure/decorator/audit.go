@@ -0,0 +1,83 @@
+package decorator
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	auditDomain "github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+	auditDecorator "github.com/danicc097/todo-ddd-example/internal/modules/audit/infrastructure/decorator"
+	"github.com/danicc097/todo-ddd-example/internal/modules/schedule/domain"
+	todoDomain "github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+var _ domain.ScheduleRepository = (*ScheduleAuditWrapper)(nil)
+
+// auditedSchedule identifies a schedule by its owner, as its events do.
+// The date is part of the logged changes.
+type auditedSchedule struct {
+	*domain.DailySchedule
+}
+
+func (s auditedSchedule) ID() userDomain.UserID { return s.UserID() }
+
+// ScheduleAuditWrapper logs schedule changes. Schedules are personal, so their logs are unscoped.
+type ScheduleAuditWrapper struct {
+	base    domain.ScheduleRepository
+	auditor *auditDecorator.AuditRepoDecorator[auditedSchedule, userDomain.UserID]
+}
+
+func NewScheduleAuditWrapper(base domain.ScheduleRepository, auditRepo auditDomain.AuditRepository) *ScheduleAuditWrapper {
+	extractor := func(s auditedSchedule) map[string]any {
+		return map[string]any{
+			"date":         s.Date().String(),
+			"max_capacity": s.MaxCapacity(),
+			"tasks":        s.CommittedTasks(),
+			"completed":    s.CompletedTasks(),
+		}
+	}
+
+	return &ScheduleAuditWrapper{
+		base: base,
+		auditor: auditDecorator.NewAuditRepoDecorator(
+			auditRepo,
+			shared.AggSchedule,
+			extractor,
+			func(id userDomain.UserID) uuid.UUID { return id.UUID() },
+			nil,
+		),
+	}
+}
+
+func (w *ScheduleAuditWrapper) Save(ctx context.Context, s *domain.DailySchedule) error {
+	fetch := func(ctx context.Context, userID userDomain.UserID) (auditedSchedule, error) {
+		prior, err := w.base.FindByUserAndDate(ctx, userID, s.Date())
+		return auditedSchedule{prior}, err
+	}
+
+	return w.auditor.AuditSave(ctx, auditedSchedule{s}, auditDomain.OpUpsert, fetch, func(ctx context.Context, s auditedSchedule) error {
+		return w.base.Save(ctx, s.DailySchedule)
+	})
+}
+
+/*
+* Read methods bypass audit
+ */
+
+func (w *ScheduleAuditWrapper) FindByUserAndDate(ctx context.Context, userID userDomain.UserID, date domain.ScheduleDate) (*domain.DailySchedule, error) {
+	return w.base.FindByUserAndDate(ctx, userID, date)
+}
+
+func (w *ScheduleAuditWrapper) FindSchedulesByTodoID(ctx context.Context, todoID todoDomain.TodoID) ([]*domain.DailySchedule, error) {
+	return w.base.FindSchedulesByTodoID(ctx, todoID)
+}
+
+func (w *ScheduleAuditWrapper) FindPendingRollover(ctx context.Context, before domain.ScheduleDate, after *domain.DailySchedule, limit int32) ([]*domain.DailySchedule, error) {
+	return w.base.FindPendingRollover(ctx, before, after, limit)
+}
+
+func (w *ScheduleAuditWrapper) LatestCosts(ctx context.Context, userID userDomain.UserID, todoIDs []todoDomain.TodoID) (map[todoDomain.TodoID]domain.EnergyCost, error) {
+	return w.base.LatestCosts(ctx, userID, todoIDs)
+}
@@ -7,6 +7,8 @@ import (
 
 	api "github.com/danicc097/todo-ddd-example/internal/generated/api"
 	"github.com/danicc097/todo-ddd-example/internal/modules/schedule/application"
+	"github.com/danicc097/todo-ddd-example/internal/modules/schedule/domain"
+	todoDomain "github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
 	infraHttp "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/http"
 )
 
@@ -24,11 +26,69 @@ func (h *ScheduleHandler) CommitTask(c *gin.Context) {
 		return
 	}
 
-	if _, ok := infraHttp.Execute(c, h.uc.CommitTask, application.CommitTaskCommand{
+	cmd := application.CommitTaskCommand{
 		TodoID: req.TodoId,
 		Cost:   req.Cost,
-		Date:   req.Date.String(),
+	}
+	if req.Date != nil {
+		cmd.Date = req.Date.String()
+	}
+
+	if _, ok := infraHttp.Execute(c, h.uc.CommitTask, cmd); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *ScheduleHandler) GetSchedule(c *gin.Context, date api.ScheduleDate) {
+	resp, ok := infraHttp.Execute(c, h.uc.GetSchedule, application.GetScheduleQuery{
+		Date: domain.ScheduleDate(date.String()),
+	})
+	if !ok {
+		return
+	}
+
+	tasks := make([]api.ScheduledTask, len(resp.Schedule.Tasks))
+	for i, t := range resp.Schedule.Tasks {
+		tasks[i] = api.ScheduledTask{TodoId: t.TodoID, Cost: t.Cost, CompletedAt: t.CompletedAt}
+	}
+
+	c.JSON(http.StatusOK, api.Schedule{
+		Date:        date,
+		MaxCapacity: resp.Schedule.MaxCapacity,
+		Load:        resp.Schedule.Load,
+		Completed:   resp.Schedule.Completed,
+		Remaining:   resp.Schedule.Remaining,
+		Tasks:       tasks,
+	})
+}
+
+func (h *ScheduleHandler) UpdateScheduleCapacity(c *gin.Context, date api.ScheduleDate, params api.UpdateScheduleCapacityParams) {
+	req, ok := infraHttp.BindJSON[api.UpdateScheduleCapacityRequest](c)
+	if !ok {
+		return
+	}
+
+	if _, ok := infraHttp.Execute(c, h.uc.SetCapacity, application.SetScheduleCapacityCommand{
+		Date:     domain.ScheduleDate(date.String()),
+		Capacity: req.Capacity,
+	}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *ScheduleHandler) RemoveScheduledTask(c *gin.Context, date api.ScheduleDate, todoId todoDomain.TodoID, params api.RemoveScheduledTaskParams) {
+	if _, ok := infraHttp.Execute(c, h.uc.RemoveTask, application.RemoveScheduledTaskCommand{
+		Date:   domain.ScheduleDate(date.String()),
+		TodoID: todoId,
 	}); ok {
 		c.Status(http.StatusNoContent)
 	}
 }
+
+func (h *ScheduleHandler) AutoPlanSchedule(c *gin.Context, date api.ScheduleDate, params api.AutoPlanScheduleParams) {
+	if res, ok := infraHttp.Execute(c, h.uc.AutoPlan, application.AutoPlanCommand{
+		Date: domain.ScheduleDate(date.String()),
+	}); ok {
+		c.JSON(http.StatusOK, api.AutoPlanResult{Planned: res.Planned})
+	}
+}
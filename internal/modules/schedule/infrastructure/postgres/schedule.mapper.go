@@ -14,13 +14,19 @@ type ScheduleMapper struct{}
 
 func (m *ScheduleMapper) ToDomain(s db.DailySchedules, tasks []db.ScheduleTasks) *domain.DailySchedule {
 	committedTasks := make(map[todoDomain.TodoID]domain.EnergyCost)
+	completedTasks := make(map[todoDomain.TodoID]time.Time)
 
 	for _, t := range tasks {
 		cost, _ := domain.NewEnergyCost(int(t.EnergyCost))
 		committedTasks[todoDomain.TodoID(t.TodoID)] = cost
+
+		if t.CompletedAt != nil {
+			completedTasks[todoDomain.TodoID(t.TodoID)] = *t.CompletedAt
+		}
 	}
 
-	dateStr := s.Date.Format(time.DateOnly)
+	// dates are stored as UTC midnight, so read them back in UTC regardless of the session zone
+	dateStr := s.Date.UTC().Format(time.DateOnly)
 
 	return domain.ReconstituteDailySchedule(domain.ReconstituteDailyScheduleArgs{
 		UserID:         userDomain.UserID(s.UserID),
@@ -28,6 +34,8 @@ func (m *ScheduleMapper) ToDomain(s db.DailySchedules, tasks []db.ScheduleTasks)
 		MaxCapacity:    int(s.MaxCapacity),
 		Version:        int(s.Version),
 		CommittedTasks: committedTasks,
+		CompletedTasks: completedTasks,
+		RolledOverAt:   s.RolledOverAt,
 	})
 }
 
@@ -35,10 +43,11 @@ func (m *ScheduleMapper) ToPersistence(s *domain.DailySchedule) db.DailySchedule
 	t, _ := time.Parse(time.DateOnly, s.Date().String())
 
 	return db.DailySchedules{
-		UserID:      s.UserID().UUID(),
-		Date:        t,
-		MaxCapacity: int32(s.MaxCapacity()),
-		Version:     int32(s.Version()),
+		UserID:       s.UserID().UUID(),
+		Date:         t,
+		MaxCapacity:  int32(s.MaxCapacity()),
+		Version:      int32(s.Version()),
+		RolledOverAt: s.RolledOverAt(),
 	}
 }
 
@@ -55,6 +64,50 @@ type TaskCommittedDTO struct {
 	Cost   int               `json:"cost"`
 }
 
+type TaskRemovedDTO struct {
+	UserID userDomain.UserID `json:"user_id"`
+	Date   string            `json:"date"`
+	TodoID todoDomain.TodoID `json:"todo_id"`
+}
+
+type ScheduleCapacityChangedDTO struct {
+	UserID      userDomain.UserID `json:"user_id"`
+	Date        string            `json:"date"`
+	MaxCapacity int               `json:"max_capacity"`
+}
+
+type TaskCarriedOverDTO struct {
+	UserID   userDomain.UserID `json:"user_id"`
+	FromDate string            `json:"from_date"`
+	Date     string            `json:"date"`
+	TodoID   todoDomain.TodoID `json:"todo_id"`
+	Cost     int               `json:"cost"`
+}
+
+type TaskCarryOverSkippedDTO struct {
+	UserID   userDomain.UserID `json:"user_id"`
+	Date     string            `json:"date"`
+	NextDate string            `json:"next_date"`
+	TodoID   todoDomain.TodoID `json:"todo_id"`
+	Cost     int               `json:"cost"`
+	Reason   string            `json:"reason"`
+}
+
+type TaskAutoPlannedDTO struct {
+	UserID  userDomain.UserID `json:"user_id"`
+	Date    string            `json:"date"`
+	TodoID  todoDomain.TodoID `json:"todo_id"`
+	Cost    int               `json:"cost"`
+	DueDate *time.Time        `json:"due_date"`
+}
+
+type TaskCompletedDTO struct {
+	UserID userDomain.UserID `json:"user_id"`
+	Date   string            `json:"date"`
+	TodoID todoDomain.TodoID `json:"todo_id"`
+	Cost   int               `json:"cost"`
+}
+
 func (m *ScheduleMapper) MapEvent(e shared.DomainEvent) (shared.EventType, any, error) {
 	switch evt := e.(type) {
 	case domain.DailyScheduleCreatedEvent:
@@ -70,6 +123,50 @@ func (m *ScheduleMapper) MapEvent(e shared.DomainEvent) (shared.EventType, any,
 			TodoID: todoDomain.TodoID(evt.TodoID),
 			Cost:   int(evt.Cost),
 		}, nil
+	case domain.TaskRemovedFromScheduleEvent:
+		return shared.TaskRemoved, TaskRemovedDTO{
+			UserID: evt.UserID,
+			Date:   evt.Date.String(),
+			TodoID: todoDomain.TodoID(evt.TodoID),
+		}, nil
+	case domain.CapacityChangedEvent:
+		return shared.ScheduleCapacityChanged, ScheduleCapacityChangedDTO{
+			UserID:      evt.UserID,
+			Date:        evt.Date.String(),
+			MaxCapacity: evt.MaxCapacity,
+		}, nil
+	case domain.TaskCarriedOverEvent:
+		return shared.TaskCarriedOver, TaskCarriedOverDTO{
+			UserID:   evt.UserID,
+			FromDate: evt.FromDate.String(),
+			Date:     evt.Date.String(),
+			TodoID:   todoDomain.TodoID(evt.TodoID),
+			Cost:     int(evt.Cost),
+		}, nil
+	case domain.CarryOverSkippedEvent:
+		return shared.TaskCarryOverSkipped, TaskCarryOverSkippedDTO{
+			UserID:   evt.UserID,
+			Date:     evt.Date.String(),
+			NextDate: evt.NextDate.String(),
+			TodoID:   todoDomain.TodoID(evt.TodoID),
+			Cost:     int(evt.Cost),
+			Reason:   domain.ErrDailyCapacityExceeded.Error(),
+		}, nil
+	case domain.TaskCompletedEvent:
+		return shared.TaskCompleted, TaskCompletedDTO{
+			UserID: evt.UserID,
+			Date:   evt.Date.String(),
+			TodoID: todoDomain.TodoID(evt.TodoID),
+			Cost:   int(evt.Cost),
+		}, nil
+	case domain.TaskAutoPlannedEvent:
+		return shared.TaskAutoPlanned, TaskAutoPlannedDTO{
+			UserID:  evt.UserID,
+			Date:    evt.Date.String(),
+			TodoID:  todoDomain.TodoID(evt.TodoID),
+			Cost:    int(evt.Cost),
+			DueDate: evt.DueDate,
+		}, nil
 	}
 
 	return "", nil, nil
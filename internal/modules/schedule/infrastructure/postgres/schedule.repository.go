@@ -51,6 +51,7 @@ func (r *ScheduleRepo) Save(ctx context.Context, sched *domain.DailySchedule) er
 		UserID:         p.UserID,
 		Date:           p.Date,
 		MaxCapacity:    p.MaxCapacity,
+		RolledOverAt:   p.RolledOverAt,
 		CurrentVersion: int32(sched.Version()),
 	})
 	if err != nil {
@@ -94,6 +95,26 @@ func (r *ScheduleRepo) Save(ctx context.Context, sched *domain.DailySchedule) er
 		}
 	}
 
+	if len(sched.CompletedTasks()) > 0 {
+		completedIDs := make([]uuid.UUID, 0, len(sched.CompletedTasks()))
+		completedAts := make([]time.Time, 0, len(sched.CompletedTasks()))
+
+		for id, at := range sched.CompletedTasks() {
+			completedIDs = append(completedIDs, id.UUID())
+			completedAts = append(completedAts, at)
+		}
+
+		err = r.q.MarkScheduleTasksCompleted(ctx, dbtx, db.MarkScheduleTasksCompletedParams{
+			UserID:       p.UserID,
+			Date:         p.Date,
+			TodoIds:      completedIDs,
+			CompletedAts: completedAts,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to mark schedule tasks completed: %w", sharedPg.ParseDBError(err))
+		}
+	}
+
 	r.uow.Collect(ctx, r.mapper, sched)
 
 	return nil
@@ -152,3 +173,66 @@ func (r *ScheduleRepo) FindSchedulesByTodoID(ctx context.Context, todoID todoDom
 
 	return schedules, nil
 }
+
+func (r *ScheduleRepo) FindPendingRollover(ctx context.Context, before domain.ScheduleDate, after *domain.DailySchedule, limit int32) ([]*domain.DailySchedule, error) {
+	t, err := time.Parse(time.DateOnly, before.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid date format: %w", err)
+	}
+
+	params := db.ListSchedulesPendingRolloverParams{
+		Before: t,
+		Lim:    limit,
+	}
+
+	if after != nil {
+		afterDate, err := time.Parse(time.DateOnly, after.Date().String())
+		if err != nil {
+			return nil, fmt.Errorf("invalid date format: %w", err)
+		}
+
+		afterUserID := after.UserID().UUID()
+		params.AfterDate = &afterDate
+		params.AfterUserID = &afterUserID
+	}
+
+	rows, err := r.q.ListSchedulesPendingRollover(ctx, r.getDB(ctx), params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedules pending rollover: %w", sharedPg.ParseDBError(err))
+	}
+
+	schedules := make([]*domain.DailySchedule, 0, len(rows))
+	for _, row := range rows {
+		s, err := r.FindByUserAndDate(ctx, userDomain.UserID(row.UserID), domain.ScheduleDate(row.Date.UTC().Format(time.DateOnly)))
+		if err != nil {
+			return nil, err
+		}
+
+		schedules = append(schedules, s)
+	}
+
+	return schedules, nil
+}
+
+func (r *ScheduleRepo) LatestCosts(ctx context.Context, userID userDomain.UserID, todoIDs []todoDomain.TodoID) (map[todoDomain.TodoID]domain.EnergyCost, error) {
+	ids := make([]uuid.UUID, len(todoIDs))
+	for i, id := range todoIDs {
+		ids[i] = id.UUID()
+	}
+
+	rows, err := r.q.GetLatestTaskCosts(ctx, r.getDB(ctx), db.GetLatestTaskCostsParams{
+		UserID:  userID.UUID(),
+		TodoIds: ids,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest task costs: %w", sharedPg.ParseDBError(err))
+	}
+
+	costs := make(map[todoDomain.TodoID]domain.EnergyCost, len(rows))
+	for _, row := range rows {
+		cost, _ := domain.NewEnergyCost(int(row.EnergyCost))
+		costs[todoDomain.TodoID(row.TodoID)] = cost
+	}
+
+	return costs, nil
+}
@@ -0,0 +1,64 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/schedule/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+// RolloverWorker periodically carries unfinished tasks over to the next day.
+// Users cross midnight at different times, so it polls instead of running once a day.
+type RolloverWorker struct {
+	rollover *application.ScheduleRollover
+	interval time.Duration
+}
+
+func NewRolloverWorker(rollover *application.ScheduleRollover, interval time.Duration) *RolloverWorker {
+	return &RolloverWorker{
+		rollover: rollover,
+		interval: interval,
+	}
+}
+
+func (w *RolloverWorker) Start(ctx context.Context) {
+	slog.InfoContext(ctx, "Schedule rollover worker started")
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "Schedule rollover worker stopped")
+
+			return
+		case <-ticker.C:
+			w.run(context.WithoutCancel(ctx))
+		}
+	}
+}
+
+func (w *RolloverWorker) run(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, w.interval)
+	defer cancel()
+
+	ctx = causation.WithMetadata(ctx, causation.Metadata{
+		CorrelationID:   uuid.NewString(),
+		IsSystemRequest: true,
+	})
+
+	n, err := w.rollover.RollOver(ctx, time.Now())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to roll over schedules", slog.String("error", err.Error()))
+		return
+	}
+
+	if n > 0 {
+		slog.InfoContext(ctx, "rolled over schedules", slog.Int("count", n))
+	}
+}
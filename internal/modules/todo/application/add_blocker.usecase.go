@@ -0,0 +1,70 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type AddBlockerCommand struct {
+	TodoID    domain.TodoID
+	BlockerID domain.TodoID
+}
+
+type AddBlockerResponse struct{}
+
+type AddBlockerHandler struct {
+	repo   domain.TodoRepository
+	wsProv WorkspaceProvider
+	deps   *domain.DependencyService
+}
+
+var _ application.RequestHandler[AddBlockerCommand, AddBlockerResponse] = (*AddBlockerHandler)(nil)
+
+func NewAddBlockerHandler(repo domain.TodoRepository, wsProv WorkspaceProvider) *AddBlockerHandler {
+	return &AddBlockerHandler{repo: repo, wsProv: wsProv, deps: domain.NewDependencyService(repo)}
+}
+
+func (h *AddBlockerHandler) Handle(ctx context.Context, cmd AddBlockerCommand) (AddBlockerResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	todo, err := h.repo.FindByID(ctx, cmd.TodoID)
+	if err != nil {
+		return AddBlockerResponse{}, err
+	}
+
+	isMember, err := h.wsProv.IsMember(ctx, todo.WorkspaceID(), userDomain.UserID(meta.UserID))
+	if err != nil {
+		return AddBlockerResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return AddBlockerResponse{}, wsDomain.ErrNotOwner
+	}
+
+	if err := h.repo.LockDependencies(ctx, todo.WorkspaceID()); err != nil {
+		return AddBlockerResponse{}, err
+	}
+
+	// reload past any cache, since blockers may have changed while waiting for the lock
+	todo, err = h.repo.FindByIDForUpdate(ctx, cmd.TodoID)
+	if err != nil {
+		return AddBlockerResponse{}, err
+	}
+
+	blocker, err := h.repo.FindByID(ctx, cmd.BlockerID)
+	if err != nil {
+		return AddBlockerResponse{}, err
+	}
+
+	if err := h.deps.AddBlocker(ctx, todo, blocker, userDomain.UserID(meta.UserID), time.Now()); err != nil {
+		return AddBlockerResponse{}, err
+	}
+
+	return AddBlockerResponse{}, h.repo.Save(ctx, todo)
+}
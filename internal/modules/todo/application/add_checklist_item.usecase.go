@@ -0,0 +1,74 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+type AddChecklistItemCommand struct {
+	TodoID   domain.TodoID
+	Title    string
+	Required bool
+}
+
+func (c *AddChecklistItemCommand) Validate() error {
+	_, err := domain.NewTodoTitle(c.Title)
+
+	return err
+}
+
+type AddChecklistItemResponse struct {
+	ID domain.ChecklistItemID
+}
+
+type AddChecklistItemHandler struct {
+	repo   domain.TodoRepository
+	wsProv WorkspaceProvider
+}
+
+var _ application.RequestHandler[AddChecklistItemCommand, AddChecklistItemResponse] = (*AddChecklistItemHandler)(nil)
+
+func NewAddChecklistItemHandler(repo domain.TodoRepository, wsProv WorkspaceProvider) *AddChecklistItemHandler {
+	return &AddChecklistItemHandler{repo: repo, wsProv: wsProv}
+}
+
+func (h *AddChecklistItemHandler) Handle(ctx context.Context, cmd AddChecklistItemCommand) (AddChecklistItemResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	title, err := domain.NewTodoTitle(cmd.Title)
+	if err != nil {
+		return AddChecklistItemResponse{}, err
+	}
+
+	todo, err := h.repo.FindByID(ctx, cmd.TodoID)
+	if err != nil {
+		return AddChecklistItemResponse{}, err
+	}
+
+	isMember, err := h.wsProv.IsMember(ctx, todo.WorkspaceID(), userDomain.UserID(meta.UserID))
+	if err != nil {
+		return AddChecklistItemResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return AddChecklistItemResponse{}, wsDomain.ErrNotOwner
+	}
+
+	item := domain.NewChecklistItem(shared.NewID[domain.ChecklistItem](), title, cmd.Required)
+	if err := todo.AddChecklistItem(item, userDomain.UserID(meta.UserID), time.Now()); err != nil {
+		return AddChecklistItemResponse{}, err
+	}
+
+	if err := h.repo.Save(ctx, todo); err != nil {
+		return AddChecklistItemResponse{}, err
+	}
+
+	return AddChecklistItemResponse{ID: item.ID()}, nil
+}
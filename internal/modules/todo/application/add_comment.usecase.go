@@ -0,0 +1,89 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type AddCommentCommand struct {
+	TodoID domain.TodoID
+	Body   string
+}
+
+func (c *AddCommentCommand) Validate() error {
+	_, err := domain.NewCommentBody(c.Body)
+
+	return err
+}
+
+type AddCommentResponse struct {
+	ID domain.CommentID
+}
+
+type AddCommentHandler struct {
+	todoRepo    domain.TodoRepository
+	commentRepo domain.CommentRepository
+	wsProv      WorkspaceProvider
+}
+
+var _ application.RequestHandler[AddCommentCommand, AddCommentResponse] = (*AddCommentHandler)(nil)
+
+func NewAddCommentHandler(todoRepo domain.TodoRepository, commentRepo domain.CommentRepository, wsProv WorkspaceProvider) *AddCommentHandler {
+	return &AddCommentHandler{todoRepo: todoRepo, commentRepo: commentRepo, wsProv: wsProv}
+}
+
+func (h *AddCommentHandler) Handle(ctx context.Context, cmd AddCommentCommand) (AddCommentResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	body, err := domain.NewCommentBody(cmd.Body)
+	if err != nil {
+		return AddCommentResponse{}, err
+	}
+
+	todo, err := h.todoRepo.FindByID(ctx, cmd.TodoID)
+	if err != nil {
+		return AddCommentResponse{}, err
+	}
+
+	isMember, err := h.wsProv.IsMember(ctx, todo.WorkspaceID(), userDomain.UserID(meta.UserID))
+	if err != nil {
+		return AddCommentResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return AddCommentResponse{}, wsDomain.ErrNotOwner
+	}
+
+	if err := checkMentions(ctx, h.wsProv, todo.WorkspaceID(), body.Mentions()); err != nil {
+		return AddCommentResponse{}, err
+	}
+
+	comment := domain.NewComment(todo, userDomain.UserID(meta.UserID), body, time.Now())
+	if err := h.commentRepo.Save(ctx, comment); err != nil {
+		return AddCommentResponse{}, err
+	}
+
+	return AddCommentResponse{ID: comment.ID()}, nil
+}
+
+// checkMentions ensures every mentioned user can see the comment.
+func checkMentions(ctx context.Context, wsProv WorkspaceProvider, wsID wsDomain.WorkspaceID, mentions []userDomain.UserID) error {
+	for _, userID := range mentions {
+		isMember, err := wsProv.IsMember(ctx, wsID, userID)
+		if err != nil {
+			return err
+		}
+
+		if !isMember {
+			return domain.ErrMentionNotMember
+		}
+	}
+
+	return nil
+}
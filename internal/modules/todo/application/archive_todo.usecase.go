@@ -0,0 +1,53 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type ArchiveTodoCommand struct {
+	ID domain.TodoID
+}
+
+type ArchiveTodoResponse struct{}
+
+type ArchiveTodoHandler struct {
+	repo   domain.TodoRepository
+	wsProv WorkspaceProvider
+}
+
+var _ application.RequestHandler[ArchiveTodoCommand, ArchiveTodoResponse] = (*ArchiveTodoHandler)(nil)
+
+func NewArchiveTodoHandler(repo domain.TodoRepository, wsProv WorkspaceProvider) *ArchiveTodoHandler {
+	return &ArchiveTodoHandler{repo: repo, wsProv: wsProv}
+}
+
+func (h *ArchiveTodoHandler) Handle(ctx context.Context, cmd ArchiveTodoCommand) (ArchiveTodoResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	todo, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return ArchiveTodoResponse{}, err
+	}
+
+	isMember, err := h.wsProv.IsMember(ctx, todo.WorkspaceID(), userDomain.UserID(meta.UserID))
+	if err != nil {
+		return ArchiveTodoResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return ArchiveTodoResponse{}, wsDomain.ErrNotOwner
+	}
+
+	if err := todo.Archive(userDomain.UserID(meta.UserID), time.Now()); err != nil {
+		return ArchiveTodoResponse{}, err
+	}
+
+	return ArchiveTodoResponse{}, h.repo.Save(ctx, todo)
+}
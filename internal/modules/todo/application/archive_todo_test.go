@@ -0,0 +1,77 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/application"
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	todoPg "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/postgres"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	wsAdapters "github.com/danicc097/todo-ddd-example/internal/modules/workspace/infrastructure/adapters"
+	wsPg "github.com/danicc097/todo-ddd-example/internal/modules/workspace/infrastructure/postgres"
+	sharedApp "github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+	sharedDomain "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+	"github.com/danicc097/todo-ddd-example/internal/testfixtures"
+	"github.com/danicc097/todo-ddd-example/internal/testutils"
+)
+
+func TestArchiveTodoUseCase_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pool := testutils.GetGlobalPostgresPool(t)
+	fixtures := testfixtures.NewFixtures(pool)
+	uow := sharedPg.NewUnitOfWork(pool)
+	repo := todoPg.NewTodoRepo(pool, uow)
+	wsProv := wsAdapters.NewTodoWorkspaceProvider(wsPg.NewWorkspaceRepo(pool, uow))
+
+	archive := sharedApp.WithUoW(application.NewArchiveTodoHandler(repo, wsProv), uow)
+	unarchive := sharedApp.WithUoW(application.NewUnarchiveTodoHandler(repo, wsProv), uow)
+
+	t.Run("archives and restores", func(t *testing.T) {
+		user := fixtures.RandomUser(ctx, t)
+		ws := fixtures.RandomWorkspace(ctx, t, user.ID())
+		todo := fixtures.RandomTodo(ctx, t, ws.ID())
+
+		userCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: user.ID().UUID()})
+
+		_, err := archive.Handle(userCtx, application.ArchiveTodoCommand{ID: todo.ID()})
+		require.NoError(t, err)
+
+		found, err := repo.FindByID(ctx, todo.ID())
+		require.NoError(t, err)
+		assert.Equal(t, domain.StatusArchived, found.Status())
+
+		_, err = unarchive.Handle(userCtx, application.UnarchiveTodoCommand{ID: todo.ID()})
+		require.NoError(t, err)
+
+		found, err = repo.FindByID(ctx, todo.ID())
+		require.NoError(t, err)
+		assert.Equal(t, domain.StatusPending, found.Status())
+
+		var count int
+
+		err = pool.QueryRow(ctx, "SELECT COUNT(*) FROM outbox WHERE event_type = ANY($1) AND aggregate_id = $2",
+			[]string{string(sharedDomain.TodoArchived), string(sharedDomain.TodoReopened)}, todo.ID().UUID()).Scan(&count)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("fails for non-members", func(t *testing.T) {
+		owner := fixtures.RandomUser(ctx, t)
+		outsider := fixtures.RandomUser(ctx, t)
+		ws := fixtures.RandomWorkspace(ctx, t, owner.ID())
+		todo := fixtures.RandomTodo(ctx, t, ws.ID())
+
+		outsiderCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: outsider.ID().UUID()})
+
+		_, err := archive.Handle(outsiderCtx, application.ArchiveTodoCommand{ID: todo.ID()})
+		assert.ErrorIs(t, err, wsDomain.ErrNotOwner)
+	})
+}
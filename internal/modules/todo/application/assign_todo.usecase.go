@@ -0,0 +1,67 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type AssignTodoCommand struct {
+	TodoID     domain.TodoID
+	AssigneeID userDomain.UserID
+}
+
+type AssignTodoResponse struct{}
+
+type AssignTodoHandler struct {
+	repo   domain.TodoRepository
+	wsProv WorkspaceProvider
+}
+
+var _ application.RequestHandler[AssignTodoCommand, AssignTodoResponse] = (*AssignTodoHandler)(nil)
+
+func NewAssignTodoHandler(repo domain.TodoRepository, wsProv WorkspaceProvider) *AssignTodoHandler {
+	return &AssignTodoHandler{repo: repo, wsProv: wsProv}
+}
+
+func (h *AssignTodoHandler) Handle(ctx context.Context, cmd AssignTodoCommand) (AssignTodoResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	todo, err := h.repo.FindByID(ctx, cmd.TodoID)
+	if err != nil {
+		return AssignTodoResponse{}, err
+	}
+
+	isMember, err := h.wsProv.IsMember(ctx, todo.WorkspaceID(), userDomain.UserID(meta.UserID))
+	if err != nil {
+		return AssignTodoResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return AssignTodoResponse{}, wsDomain.ErrNotOwner
+	}
+
+	role, isAssigneeMember, err := h.wsProv.MemberRole(ctx, todo.WorkspaceID(), cmd.AssigneeID)
+	if err != nil {
+		return AssignTodoResponse{}, err
+	}
+
+	if !isAssigneeMember {
+		return AssignTodoResponse{}, domain.ErrAssigneeNotMember
+	}
+
+	if role == wsDomain.RoleGuest {
+		return AssignTodoResponse{}, domain.ErrGuestAssignee
+	}
+
+	if err := todo.Assign(cmd.AssigneeID, userDomain.UserID(meta.UserID), time.Now()); err != nil {
+		return AssignTodoResponse{}, err
+	}
+
+	return AssignTodoResponse{}, h.repo.Save(ctx, todo)
+}
@@ -0,0 +1,98 @@
+package application_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/application"
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	todoPg "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/postgres"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	wsAdapters "github.com/danicc097/todo-ddd-example/internal/modules/workspace/infrastructure/adapters"
+	wsPg "github.com/danicc097/todo-ddd-example/internal/modules/workspace/infrastructure/postgres"
+	sharedApp "github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+	"github.com/danicc097/todo-ddd-example/internal/testfixtures"
+	"github.com/danicc097/todo-ddd-example/internal/testutils"
+)
+
+func TestAssignTodoUseCase_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pool := testutils.GetGlobalPostgresPool(t)
+	fixtures := testfixtures.NewFixtures(pool)
+	uow := sharedPg.NewUnitOfWork(pool)
+	repo := todoPg.NewTodoRepo(pool, uow)
+	wsRepo := wsPg.NewWorkspaceRepo(pool, uow)
+	wsProv := wsAdapters.NewTodoWorkspaceProvider(wsRepo)
+
+	assign := sharedApp.WithUoW(application.NewAssignTodoHandler(repo, wsProv), uow)
+	assigned := application.NewGetAssignedTodosHandler(todoPg.NewTodoQueryService(pool))
+	memberRemoved := application.NewMemberRemovedEventHandler(repo, uow)
+
+	withMember := func(t *testing.T, role wsDomain.WorkspaceRole) (*wsDomain.Workspace, userDomain.UserID, context.Context) {
+		t.Helper()
+
+		owner := fixtures.RandomUser(ctx, t)
+		member := fixtures.RandomUser(ctx, t)
+		ws := fixtures.RandomWorkspace(ctx, t, owner.ID())
+		require.NoError(t, ws.AddMember(member.ID(), role))
+		require.NoError(t, wsRepo.Save(ctx, ws))
+
+		return ws, member.ID(), causation.WithMetadata(ctx, causation.Metadata{UserID: owner.ID().UUID()})
+	}
+
+	t.Run("assigns members and lists their todos", func(t *testing.T) {
+		ws, memberID, ownerCtx := withMember(t, wsDomain.RoleMember)
+		todo := fixtures.RandomTodo(ctx, t, ws.ID())
+
+		_, err := assign.Handle(ownerCtx, application.AssignTodoCommand{TodoID: todo.ID(), AssigneeID: memberID})
+		require.NoError(t, err)
+
+		memberCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: memberID.UUID()})
+		resp, err := assigned.Handle(memberCtx, application.GetAssignedTodosQuery{UserID: memberID, Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, resp.Todos, 1)
+		assert.Equal(t, todo.ID(), resp.Todos[0].ID)
+
+		_, err = assigned.Handle(ownerCtx, application.GetAssignedTodosQuery{UserID: memberID, Limit: 10})
+		assert.ErrorIs(t, err, userDomain.ErrNotSameUser)
+	})
+
+	t.Run("rejects guests and non-members", func(t *testing.T) {
+		ws, guestID, ownerCtx := withMember(t, wsDomain.RoleGuest)
+		todo := fixtures.RandomTodo(ctx, t, ws.ID())
+		outsider := fixtures.RandomUser(ctx, t)
+
+		_, err := assign.Handle(ownerCtx, application.AssignTodoCommand{TodoID: todo.ID(), AssigneeID: guestID})
+		require.ErrorIs(t, err, domain.ErrGuestAssignee)
+
+		_, err = assign.Handle(ownerCtx, application.AssignTodoCommand{TodoID: todo.ID(), AssigneeID: outsider.ID()})
+		require.ErrorIs(t, err, domain.ErrAssigneeNotMember)
+	})
+
+	t.Run("unassigns when the member is removed", func(t *testing.T) {
+		ws, memberID, ownerCtx := withMember(t, wsDomain.RoleMember)
+		todo := fixtures.RandomTodo(ctx, t, ws.ID())
+
+		_, err := assign.Handle(ownerCtx, application.AssignTodoCommand{TodoID: todo.ID(), AssigneeID: memberID})
+		require.NoError(t, err)
+
+		payload, err := json.Marshal(map[string]any{
+			"data": map[string]any{"workspace_id": ws.ID(), "user_id": memberID},
+		})
+		require.NoError(t, err)
+		require.NoError(t, memberRemoved.Handle(ctx, payload))
+
+		found, err := repo.FindByID(ctx, todo.ID())
+		require.NoError(t, err)
+		assert.Nil(t, found.AssigneeID())
+	})
+}
@@ -0,0 +1,62 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+)
+
+type BlockerResolvedEventPayload struct {
+	ID uuid.UUID `json:"id"`
+}
+
+// BlockerResolvedEventHandler releases the dependents of a todo that was completed or deleted.
+// Recurring todos roll over instead of completing, which also resolves the occurrence they blocked.
+type BlockerResolvedEventHandler struct {
+	repo domain.TodoRepository
+	uow  application.UnitOfWork
+}
+
+func NewBlockerResolvedEventHandler(repo domain.TodoRepository, uow application.UnitOfWork) *BlockerResolvedEventHandler {
+	return &BlockerResolvedEventHandler{repo: repo, uow: uow}
+}
+
+func (h *BlockerResolvedEventHandler) Handle(ctx context.Context, data []byte) error {
+	var envelope struct {
+		Data BlockerResolvedEventPayload `json:"data"`
+	}
+
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		if err := json.Unmarshal(data, &envelope.Data); err != nil {
+			return fmt.Errorf("failed to unmarshal blocker event: %w", err)
+		}
+	}
+
+	blockerID := domain.TodoID(envelope.Data.ID)
+
+	// run in a unit of work so the resulting todo.unblocked events reach the outbox
+	return h.uow.Execute(ctx, func(ctx context.Context) error {
+		dependents, err := h.repo.FindDependents(ctx, blockerID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+
+		for _, t := range dependents {
+			t.ResolveBlocker(blockerID, now)
+
+			if err := h.repo.Save(ctx, t); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
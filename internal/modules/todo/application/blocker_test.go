@@ -0,0 +1,104 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	infraRedis "github.com/danicc097/todo-ddd-example/internal/infrastructure/redis"
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/application"
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	todoDecorator "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/decorator"
+	todoPg "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/postgres"
+	todoRedis "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/redis"
+	wsAdapters "github.com/danicc097/todo-ddd-example/internal/modules/workspace/infrastructure/adapters"
+	wsPg "github.com/danicc097/todo-ddd-example/internal/modules/workspace/infrastructure/postgres"
+	sharedApp "github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+	"github.com/danicc097/todo-ddd-example/internal/testfixtures"
+	"github.com/danicc097/todo-ddd-example/internal/testutils"
+)
+
+func TestBlockerUseCases_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pool := testutils.GetGlobalPostgresPool(t)
+	rdb := testutils.GetGlobalRedis(t).Connect(ctx, t)
+	fixtures := testfixtures.NewFixtures(pool)
+	uow := sharedPg.NewUnitOfWork(pool)
+	baseRepo := todoPg.NewTodoRepo(pool, uow)
+	repo := todoDecorator.NewTodoRepositoryCache(baseRepo, infraRedis.NewCacheStore(rdb), 5*time.Minute, todoRedis.NewTodoCacheCodec())
+	wsProv := wsAdapters.NewTodoWorkspaceProvider(wsPg.NewWorkspaceRepo(pool, uow))
+
+	addBlocker := sharedApp.WithUoW(application.NewAddBlockerHandler(repo, wsProv), uow)
+	removeBlocker := sharedApp.WithUoW(application.NewRemoveBlockerHandler(repo, wsProv), uow)
+
+	user := fixtures.RandomUser(ctx, t)
+	ws := fixtures.RandomWorkspace(ctx, t, user.ID())
+	userCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: user.ID().UUID()})
+
+	t.Run("concurrent blockers cannot form a cycle", func(t *testing.T) {
+		a := fixtures.RandomTodo(ctx, t, ws.ID())
+		b := fixtures.RandomTodo(ctx, t, ws.ID())
+
+		errs := make(chan error, 2)
+
+		go func() {
+			_, err := addBlocker.Handle(userCtx, application.AddBlockerCommand{TodoID: a.ID(), BlockerID: b.ID()})
+			errs <- err
+		}()
+		go func() {
+			_, err := addBlocker.Handle(userCtx, application.AddBlockerCommand{TodoID: b.ID(), BlockerID: a.ID()})
+			errs <- err
+		}()
+
+		err1, err2 := <-errs, <-errs
+		assert.True(t, (err1 == nil) != (err2 == nil), "exactly one link should be added: %v, %v", err1, err2)
+
+		for _, err := range []error{err1, err2} {
+			if err != nil {
+				require.ErrorIs(t, err, domain.ErrDependencyCycle)
+			}
+		}
+	})
+
+	t.Run("concurrent changes to a cached todo keep each other's blockers", func(t *testing.T) {
+		todo := fixtures.RandomTodo(ctx, t, ws.ID())
+		removed := fixtures.RandomTodo(ctx, t, ws.ID())
+
+		_, err := addBlocker.Handle(userCtx, application.AddBlockerCommand{TodoID: todo.ID(), BlockerID: removed.ID()})
+		require.NoError(t, err)
+
+		// cache the aggregate that every handler starts from
+		_, err = repo.FindByID(ctx, todo.ID())
+		require.NoError(t, err)
+
+		blockers := []*domain.Todo{fixtures.RandomTodo(ctx, t, ws.ID()), fixtures.RandomTodo(ctx, t, ws.ID())}
+		errs := make(chan error, len(blockers)+1)
+
+		for _, blocker := range blockers {
+			go func() {
+				_, err := addBlocker.Handle(userCtx, application.AddBlockerCommand{TodoID: todo.ID(), BlockerID: blocker.ID()})
+				errs <- err
+			}()
+		}
+
+		go func() {
+			_, err := removeBlocker.Handle(userCtx, application.RemoveBlockerCommand{TodoID: todo.ID(), BlockerID: removed.ID()})
+			errs <- err
+		}()
+
+		for range len(blockers) + 1 {
+			require.NoError(t, <-errs)
+		}
+
+		found, err := baseRepo.FindByID(ctx, todo.ID())
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []domain.TodoID{blockers[0].ID(), blockers[1].ID()}, found.BlockedBy())
+	})
+}
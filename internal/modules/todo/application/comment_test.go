@@ -0,0 +1,97 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/application"
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	todoPg "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/postgres"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	wsAdapters "github.com/danicc097/todo-ddd-example/internal/modules/workspace/infrastructure/adapters"
+	wsPg "github.com/danicc097/todo-ddd-example/internal/modules/workspace/infrastructure/postgres"
+	sharedApp "github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+	"github.com/danicc097/todo-ddd-example/internal/testfixtures"
+	"github.com/danicc097/todo-ddd-example/internal/testutils"
+)
+
+func TestCommentUseCases_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pool := testutils.GetGlobalPostgresPool(t)
+	fixtures := testfixtures.NewFixtures(pool)
+	uow := sharedPg.NewUnitOfWork(pool)
+	todoRepo := todoPg.NewTodoRepo(pool, uow)
+	commentRepo := todoPg.NewCommentRepo(pool, uow)
+	wsRepo := wsPg.NewWorkspaceRepo(pool, uow)
+	wsProv := wsAdapters.NewTodoWorkspaceProvider(wsRepo)
+
+	add := sharedApp.WithUoW(application.NewAddCommentHandler(todoRepo, commentRepo, wsProv), uow)
+	edit := sharedApp.WithUoW(application.NewEditCommentHandler(commentRepo, wsProv), uow)
+	del := sharedApp.WithUoW(application.NewDeleteCommentHandler(commentRepo, wsProv), uow)
+	list := application.NewGetCommentsHandler(todoPg.NewTodoQueryService(pool), wsProv)
+
+	owner := fixtures.RandomUser(ctx, t)
+	member := fixtures.RandomUser(ctx, t)
+	outsider := fixtures.RandomUser(ctx, t)
+	ws := fixtures.RandomWorkspace(ctx, t, owner.ID())
+	require.NoError(t, ws.AddMember(member.ID(), wsDomain.RoleMember))
+	require.NoError(t, wsRepo.Save(ctx, ws))
+
+	ownerCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: owner.ID().UUID()})
+	memberCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: member.ID().UUID()})
+	outsiderCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: outsider.ID().UUID()})
+
+	t.Run("creates, edits, paginates and soft deletes", func(t *testing.T) {
+		todo := fixtures.RandomTodo(ctx, t, ws.ID())
+
+		first, err := add.Handle(ownerCtx, application.AddCommentCommand{TodoID: todo.ID(), Body: "hey @" + member.ID().String()})
+		require.NoError(t, err)
+
+		second, err := add.Handle(memberCtx, application.AddCommentCommand{TodoID: todo.ID(), Body: "on it"})
+		require.NoError(t, err)
+
+		_, err = edit.Handle(ownerCtx, application.EditCommentCommand{TodoID: todo.ID(), CommentID: first.ID, Body: "hey team"})
+		require.NoError(t, err)
+
+		page, err := list.Handle(memberCtx, application.GetCommentsQuery{TodoID: todo.ID(), Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, page.Comments, 1)
+		assert.Equal(t, first.ID, page.Comments[0].ID)
+		assert.Equal(t, "hey team", page.Comments[0].Body)
+		assert.Empty(t, page.Comments[0].Mentions)
+		assert.NotNil(t, page.Comments[0].EditedAt)
+
+		_, err = del.Handle(ownerCtx, application.DeleteCommentCommand{TodoID: todo.ID(), CommentID: second.ID})
+		require.ErrorIs(t, err, domain.ErrNotCommentAuthor)
+
+		_, err = del.Handle(memberCtx, application.DeleteCommentCommand{TodoID: todo.ID(), CommentID: second.ID})
+		require.NoError(t, err)
+
+		all, err := list.Handle(ownerCtx, application.GetCommentsQuery{TodoID: todo.ID(), Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, all.Comments, 1)
+
+		_, err = commentRepo.FindByID(ctx, second.ID)
+		require.ErrorIs(t, err, domain.ErrCommentNotFound)
+	})
+
+	t.Run("rejects outsiders and mentions of non-members", func(t *testing.T) {
+		todo := fixtures.RandomTodo(ctx, t, ws.ID())
+
+		_, err := add.Handle(outsiderCtx, application.AddCommentCommand{TodoID: todo.ID(), Body: "hi"})
+		require.ErrorIs(t, err, wsDomain.ErrNotOwner)
+
+		_, err = add.Handle(ownerCtx, application.AddCommentCommand{TodoID: todo.ID(), Body: "hi @" + outsider.ID().String()})
+		require.ErrorIs(t, err, domain.ErrMentionNotMember)
+
+		_, err = list.Handle(outsiderCtx, application.GetCommentsQuery{TodoID: todo.ID(), Limit: 10})
+		require.ErrorIs(t, err, wsDomain.ErrNotOwner)
+	})
+}
@@ -19,17 +19,24 @@ type CompleteTodoResponse struct{}
 
 type WorkspaceProvider interface {
 	IsMember(ctx context.Context, wsID wsDomain.WorkspaceID, userID userDomain.UserID) (bool, error)
+	// MemberRole returns false if the user is not a member of the workspace.
+	MemberRole(ctx context.Context, wsID wsDomain.WorkspaceID, userID userDomain.UserID) (wsDomain.WorkspaceRole, bool, error)
+}
+
+type UserTimezoneProvider interface {
+	Location(ctx context.Context, userID userDomain.UserID) (*time.Location, error)
 }
 
 type CompleteTodoHandler struct {
 	repo   domain.TodoRepository
 	wsProv WorkspaceProvider
+	tzProv UserTimezoneProvider
 }
 
 var _ application.RequestHandler[CompleteTodoCommand, CompleteTodoResponse] = (*CompleteTodoHandler)(nil)
 
-func NewCompleteTodoHandler(repo domain.TodoRepository, wsProv WorkspaceProvider) *CompleteTodoHandler {
-	return &CompleteTodoHandler{repo: repo, wsProv: wsProv}
+func NewCompleteTodoHandler(repo domain.TodoRepository, wsProv WorkspaceProvider, tzProv UserTimezoneProvider) *CompleteTodoHandler {
+	return &CompleteTodoHandler{repo: repo, wsProv: wsProv, tzProv: tzProv}
 }
 
 func (h *CompleteTodoHandler) Handle(ctx context.Context, cmd CompleteTodoCommand) (CompleteTodoResponse, error) {
@@ -49,7 +56,13 @@ func (h *CompleteTodoHandler) Handle(ctx context.Context, cmd CompleteTodoComman
 		return CompleteTodoResponse{}, wsDomain.ErrNotOwner
 	}
 
-	if err := todo.Complete(userDomain.UserID(meta.UserID), time.Now()); err != nil {
+	// rollover happens on the actor's calendar
+	loc, err := h.tzProv.Location(ctx, userDomain.UserID(meta.UserID))
+	if err != nil {
+		return CompleteTodoResponse{}, err
+	}
+
+	if err := todo.Complete(userDomain.UserID(meta.UserID), time.Now().In(loc)); err != nil {
 		return CompleteTodoResponse{}, err
 	}
 
@@ -11,6 +11,7 @@ import (
 	"github.com/danicc097/todo-ddd-example/internal/modules/todo/application"
 	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
 	todoPg "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/postgres"
+	userAdapters "github.com/danicc097/todo-ddd-example/internal/modules/user/infrastructure/adapters"
 	wsAdapters "github.com/danicc097/todo-ddd-example/internal/modules/workspace/infrastructure/adapters"
 	wsPg "github.com/danicc097/todo-ddd-example/internal/modules/workspace/infrastructure/postgres"
 	sharedApp "github.com/danicc097/todo-ddd-example/internal/shared/application"
@@ -33,7 +34,7 @@ func TestCompleteTodoUseCase_Integration(t *testing.T) {
 	wsProv := wsAdapters.NewTodoWorkspaceProvider(wsRepo)
 	uow = sharedPg.NewUnitOfWork(pool)
 
-	handler := sharedApp.WithUoW(application.NewCompleteTodoHandler(repo, wsProv), uow)
+	handler := sharedApp.WithUoW(application.NewCompleteTodoHandler(repo, wsProv, userAdapters.NewUserTimezoneProvider(fixtures.UserRepo)), uow)
 
 	t.Run("completes", func(t *testing.T) {
 		user := fixtures.RandomUser(ctx, t)
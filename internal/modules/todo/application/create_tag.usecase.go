@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"errors"
 
 	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
 	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
@@ -10,12 +11,22 @@ import (
 
 type CreateTagCommand struct {
 	Name        string
+	Color       *string
 	WorkspaceID wsDomain.WorkspaceID
 }
 
 func (c *CreateTagCommand) Validate() error {
-	_, err := domain.NewTagName(c.Name)
-	return err
+	if _, err := domain.NewTagName(c.Name); err != nil {
+		return err
+	}
+
+	if c.Color != nil {
+		if _, err := domain.NewTagColor(*c.Color); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 type CreateTagResponse struct {
@@ -35,7 +46,16 @@ func NewCreateTagHandler(repo domain.TagRepository) *CreateTagHandler {
 func (h *CreateTagHandler) Handle(ctx context.Context, cmd CreateTagCommand) (CreateTagResponse, error) {
 	tn, _ := domain.NewTagName(cmd.Name)
 
-	tag := domain.NewTag(tn, cmd.WorkspaceID)
+	color := domain.DefaultTagColor
+	if cmd.Color != nil {
+		color, _ = domain.NewTagColor(*cmd.Color)
+	}
+
+	if err := ensureTagNameFree(ctx, h.repo, cmd.WorkspaceID, tn, domain.TagID{}); err != nil {
+		return CreateTagResponse{}, err
+	}
+
+	tag := domain.NewTag(tn, color, cmd.WorkspaceID)
 
 	if err := h.repo.Save(ctx, tag); err != nil {
 		return CreateTagResponse{}, err
@@ -43,3 +63,22 @@ func (h *CreateTagHandler) Handle(ctx context.Context, cmd CreateTagCommand) (Cr
 
 	return CreateTagResponse{ID: tag.ID()}, nil
 }
+
+// ensureTagNameFree reports ErrTagNameTaken if another tag in the workspace,
+// other than self, already uses the name.
+func ensureTagNameFree(ctx context.Context, repo domain.TagRepository, wsID wsDomain.WorkspaceID, name domain.TagName, self domain.TagID) error {
+	existing, err := repo.FindByName(ctx, wsID, name.String())
+	if err != nil {
+		if errors.Is(err, domain.ErrTagNotFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	if existing.ID() != self {
+		return domain.ErrTagNameTaken
+	}
+
+	return nil
+}
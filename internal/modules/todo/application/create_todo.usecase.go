@@ -2,7 +2,6 @@ package application
 
 import (
 	"context"
-	"errors"
 	"time"
 
 	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
@@ -10,7 +9,6 @@ import (
 	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
 	"github.com/danicc097/todo-ddd-example/internal/shared/application"
 	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
-	"github.com/danicc097/todo-ddd-example/internal/utils/pointers"
 )
 
 type CreateTodoCommand struct {
@@ -20,6 +18,7 @@ type CreateTodoCommand struct {
 	DueDate            *time.Time
 	RecurrenceInterval *string
 	RecurrenceAmount   *int
+	RecurrenceRule     *string
 }
 
 func (c *CreateTodoCommand) Validate() error {
@@ -27,12 +26,13 @@ func (c *CreateTodoCommand) Validate() error {
 		return err
 	}
 
-	if c.RecurrenceInterval != nil && c.RecurrenceAmount != nil {
-		if _, err := domain.NewRecurrenceRule(*c.RecurrenceInterval, *c.RecurrenceAmount); err != nil {
-			return err
-		}
-	} else if c.RecurrenceInterval != nil || c.RecurrenceAmount != nil {
-		return errors.New("recurrence interval and amount must be provided together")
+	r, err := parseRecurrence(c.RecurrenceInterval, c.RecurrenceAmount, c.RecurrenceRule)
+	if err != nil {
+		return err
+	}
+
+	if r != nil && c.DueDate == nil {
+		return domain.ErrRecurrenceRequiresDueDate
 	}
 
 	return nil
@@ -72,14 +72,23 @@ func (h *CreateTodoHandler) Handle(ctx context.Context, cmd CreateTodoCommand) (
 		todo.AddTag(tagID)
 	}
 
+	actorID, now := userDomain.UserID(meta.UserID), time.Now()
+
 	if cmd.DueDate != nil {
-		todo.SetDueDate(cmd.DueDate)
+		if err := todo.SetDueDate(cmd.DueDate, actorID, now); err != nil {
+			return CreateTodoResponse{}, err
+		}
 	}
 
-	if cmd.RecurrenceInterval != nil && cmd.RecurrenceAmount != nil {
-		r, _ := domain.NewRecurrenceRule(*cmd.RecurrenceInterval, *cmd.RecurrenceAmount)
+	recurrence, err := parseRecurrence(cmd.RecurrenceInterval, cmd.RecurrenceAmount, cmd.RecurrenceRule)
+	if err != nil {
+		return CreateTodoResponse{}, err
+	}
 
-		todo.SetRecurrence(pointers.New(r))
+	if recurrence != nil {
+		if err := todo.SetRecurrence(recurrence, actorID, now); err != nil {
+			return CreateTodoResponse{}, err
+		}
 	}
 
 	if err := h.repo.Save(ctx, todo); err != nil {
@@ -0,0 +1,58 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type DeleteCommentCommand struct {
+	TodoID    domain.TodoID
+	CommentID domain.CommentID
+}
+
+type DeleteCommentResponse struct{}
+
+type DeleteCommentHandler struct {
+	repo   domain.CommentRepository
+	wsProv WorkspaceProvider
+}
+
+var _ application.RequestHandler[DeleteCommentCommand, DeleteCommentResponse] = (*DeleteCommentHandler)(nil)
+
+func NewDeleteCommentHandler(repo domain.CommentRepository, wsProv WorkspaceProvider) *DeleteCommentHandler {
+	return &DeleteCommentHandler{repo: repo, wsProv: wsProv}
+}
+
+func (h *DeleteCommentHandler) Handle(ctx context.Context, cmd DeleteCommentCommand) (DeleteCommentResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	comment, err := h.repo.FindByID(ctx, cmd.CommentID)
+	if err != nil {
+		return DeleteCommentResponse{}, err
+	}
+
+	if comment.TodoID() != cmd.TodoID {
+		return DeleteCommentResponse{}, domain.ErrCommentNotFound
+	}
+
+	isMember, err := h.wsProv.IsMember(ctx, comment.WorkspaceID(), userDomain.UserID(meta.UserID))
+	if err != nil {
+		return DeleteCommentResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return DeleteCommentResponse{}, wsDomain.ErrNotOwner
+	}
+
+	if err := comment.Delete(userDomain.UserID(meta.UserID), time.Now()); err != nil {
+		return DeleteCommentResponse{}, err
+	}
+
+	return DeleteCommentResponse{}, h.repo.Save(ctx, comment)
+}
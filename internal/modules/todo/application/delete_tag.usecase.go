@@ -0,0 +1,74 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type DeleteTagCommand struct {
+	WorkspaceID wsDomain.WorkspaceID
+	TagID       domain.TagID
+}
+
+type DeleteTagResponse struct{}
+
+type DeleteTagHandler struct {
+	tagRepo  domain.TagRepository
+	todoRepo domain.TodoRepository
+	wsProv   WorkspaceProvider
+}
+
+var _ application.RequestHandler[DeleteTagCommand, DeleteTagResponse] = (*DeleteTagHandler)(nil)
+
+func NewDeleteTagHandler(tagRepo domain.TagRepository, todoRepo domain.TodoRepository, wsProv WorkspaceProvider) *DeleteTagHandler {
+	return &DeleteTagHandler{tagRepo: tagRepo, todoRepo: todoRepo, wsProv: wsProv}
+}
+
+// Handle untags every todo before deleting the tag, so each todo records a
+// todo.tag_removed event instead of losing the link silently to the FK cascade.
+func (h *DeleteTagHandler) Handle(ctx context.Context, cmd DeleteTagCommand) (DeleteTagResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	isMember, err := h.wsProv.IsMember(ctx, cmd.WorkspaceID, userDomain.UserID(meta.UserID))
+	if err != nil {
+		return DeleteTagResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return DeleteTagResponse{}, wsDomain.ErrNotOwner
+	}
+
+	tag, err := h.tagRepo.FindByID(ctx, cmd.TagID)
+	if err != nil {
+		return DeleteTagResponse{}, err
+	}
+
+	if tag.WorkspaceID() != cmd.WorkspaceID {
+		return DeleteTagResponse{}, domain.ErrTagNotFound
+	}
+
+	todos, err := h.todoRepo.FindByTag(ctx, tag.ID())
+	if err != nil {
+		return DeleteTagResponse{}, err
+	}
+
+	now := time.Now()
+
+	for _, t := range todos {
+		if err := t.RemoveTag(tag.ID(), userDomain.UserID(meta.UserID), now); err != nil {
+			return DeleteTagResponse{}, err
+		}
+
+		if err := h.todoRepo.Save(ctx, t); err != nil {
+			return DeleteTagResponse{}, err
+		}
+	}
+
+	return DeleteTagResponse{}, h.tagRepo.Delete(ctx, tag.ID())
+}
@@ -0,0 +1,74 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type EditCommentCommand struct {
+	TodoID    domain.TodoID
+	CommentID domain.CommentID
+	Body      string
+}
+
+func (c *EditCommentCommand) Validate() error {
+	_, err := domain.NewCommentBody(c.Body)
+
+	return err
+}
+
+type EditCommentResponse struct{}
+
+type EditCommentHandler struct {
+	repo   domain.CommentRepository
+	wsProv WorkspaceProvider
+}
+
+var _ application.RequestHandler[EditCommentCommand, EditCommentResponse] = (*EditCommentHandler)(nil)
+
+func NewEditCommentHandler(repo domain.CommentRepository, wsProv WorkspaceProvider) *EditCommentHandler {
+	return &EditCommentHandler{repo: repo, wsProv: wsProv}
+}
+
+func (h *EditCommentHandler) Handle(ctx context.Context, cmd EditCommentCommand) (EditCommentResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	body, err := domain.NewCommentBody(cmd.Body)
+	if err != nil {
+		return EditCommentResponse{}, err
+	}
+
+	comment, err := h.repo.FindByID(ctx, cmd.CommentID)
+	if err != nil {
+		return EditCommentResponse{}, err
+	}
+
+	if comment.TodoID() != cmd.TodoID {
+		return EditCommentResponse{}, domain.ErrCommentNotFound
+	}
+
+	isMember, err := h.wsProv.IsMember(ctx, comment.WorkspaceID(), userDomain.UserID(meta.UserID))
+	if err != nil {
+		return EditCommentResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return EditCommentResponse{}, wsDomain.ErrNotOwner
+	}
+
+	if err := checkMentions(ctx, h.wsProv, comment.WorkspaceID(), body.Mentions()); err != nil {
+		return EditCommentResponse{}, err
+	}
+
+	if err := comment.Edit(body, userDomain.UserID(meta.UserID), time.Now()); err != nil {
+		return EditCommentResponse{}, err
+	}
+
+	return EditCommentResponse{}, h.repo.Save(ctx, comment)
+}
@@ -0,0 +1,63 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/apperrors"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+)
+
+var ErrInvalidFocusGrouping = apperrors.New(apperrors.InvalidInput, "unknown focus report grouping")
+
+type FocusReportGroupBy string
+
+const (
+	FocusGroupByTodo   FocusReportGroupBy = "todo"
+	FocusGroupByTag    FocusReportGroupBy = "tag"
+	FocusGroupByMember FocusReportGroupBy = "member"
+	FocusGroupByDay    FocusReportGroupBy = "day"
+)
+
+func (g FocusReportGroupBy) Valid() bool {
+	switch g {
+	case FocusGroupByTodo, FocusGroupByTag, FocusGroupByMember, FocusGroupByDay:
+		return true
+	}
+
+	return false
+}
+
+// FocusReportFilter selects finished sessions that started in [From, To).
+// Days are calendar days in Location.
+type FocusReportFilter struct {
+	From     time.Time
+	To       time.Time
+	Location *time.Location
+	GroupBy  FocusReportGroupBy
+	UserID   *userDomain.UserID
+}
+
+// Signature returns a canonical representation, so equivalent filters share cache entries.
+func (f FocusReportFilter) Signature() string {
+	userID := ""
+	if f.UserID != nil {
+		userID = f.UserID.String()
+	}
+
+	return fmt.Sprintf("from=%s;to=%s;tz=%s;group_by=%s;user=%s",
+		f.From.UTC().Format(time.RFC3339Nano),
+		f.To.UTC().Format(time.RFC3339Nano),
+		f.Location,
+		f.GroupBy,
+		userID,
+	)
+}
+
+//go:generate go tool gowrap gen -g -i FocusReportQueryService -t ../../../../templates/opentelemetry.gotmpl -o ../infrastructure/postgres/focus_report_query_service_tracing.gen.go
+type FocusReportQueryService interface {
+	// GetFocusReport aggregates a workspace's focus sessions.
+	GetFocusReport(ctx context.Context, wsID wsDomain.WorkspaceID, filter FocusReportFilter) (FocusReportReadModel, error)
+}
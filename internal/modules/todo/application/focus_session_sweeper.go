@@ -0,0 +1,70 @@
+package application
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+)
+
+const focusSweepBatchSize = 500
+
+// FocusSessionSweeper stops focus sessions that reached their planned duration or were left
+// running past maxDuration, e.g. because the client crashed.
+type FocusSessionSweeper struct {
+	repo        domain.TodoRepository
+	uow         application.UnitOfWork
+	maxDuration time.Duration
+}
+
+func NewFocusSessionSweeper(repo domain.TodoRepository, uow application.UnitOfWork, maxDuration time.Duration) *FocusSessionSweeper {
+	return &FocusSessionSweeper{
+		repo:        repo,
+		uow:         uow,
+		maxDuration: maxDuration,
+	}
+}
+
+// Sweep stops elapsed sessions, each todo in its own transaction. Failed todos are logged and
+// retried on the next run.
+// It returns the number of sessions stopped.
+func (s *FocusSessionSweeper) Sweep(ctx context.Context, now time.Time) (int, error) {
+	ids, err := s.repo.FindElapsedFocus(ctx, now, s.maxDuration, focusSweepBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+
+	for _, id := range ids {
+		stopped := false
+
+		err := s.uow.Execute(ctx, func(ctx context.Context) error {
+			todo, err := s.repo.FindByID(ctx, id)
+			if err != nil {
+				return err
+			}
+
+			if stopped = todo.ElapseFocus(now, s.maxDuration); !stopped {
+				return nil
+			}
+
+			return s.repo.Save(ctx, todo)
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to stop elapsed focus session",
+				slog.String("todo_id", id.String()),
+				slog.String("error", err.Error()))
+
+			continue
+		}
+
+		if stopped {
+			n++
+		}
+	}
+
+	return n, nil
+}
@@ -0,0 +1,73 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/application"
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	todoPg "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/postgres"
+	wsAdapters "github.com/danicc097/todo-ddd-example/internal/modules/workspace/infrastructure/adapters"
+	wsPg "github.com/danicc097/todo-ddd-example/internal/modules/workspace/infrastructure/postgres"
+	sharedApp "github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+	"github.com/danicc097/todo-ddd-example/internal/testfixtures"
+	"github.com/danicc097/todo-ddd-example/internal/testutils"
+)
+
+func TestFocusSessions_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pool := testutils.GetGlobalPostgresPool(t)
+	fixtures := testfixtures.NewFixtures(pool)
+	uow := sharedPg.NewUnitOfWork(pool)
+	todoRepo := todoPg.NewTodoRepo(pool, uow)
+	wsProv := wsAdapters.NewTodoWorkspaceProvider(wsPg.NewWorkspaceRepo(pool, uow))
+
+	start := sharedApp.WithUoW(application.NewStartFocusHandler(todoRepo, wsProv), uow)
+	sweeper := application.NewFocusSessionSweeper(todoRepo, uow, 4*time.Hour)
+
+	user := fixtures.RandomUser(ctx, t)
+	ws := fixtures.RandomWorkspace(ctx, t, user.ID())
+	userCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: user.ID().UUID()})
+
+	first := fixtures.RandomTodo(ctx, t, ws.ID())
+	second := fixtures.RandomTodo(ctx, t, ws.ID())
+
+	_, err := start.Handle(userCtx, application.StartFocusCommand{ID: first.ID(), PlannedDuration: 25 * time.Minute})
+	require.NoError(t, err)
+
+	t.Run("allows one active session per user", func(t *testing.T) {
+		_, err := start.Handle(userCtx, application.StartFocusCommand{ID: second.ID()})
+		require.ErrorIs(t, err, domain.ErrUserAlreadyFocusing)
+	})
+
+	t.Run("sweeps elapsed sessions", func(t *testing.T) {
+		_, err := pool.Exec(ctx, `UPDATE todo_focus_sessions SET start_time = start_time - interval '1 hour' WHERE todo_id = $1`, first.ID().UUID())
+		require.NoError(t, err)
+
+		n, err := sweeper.Sweep(ctx, time.Now())
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, n, 1)
+
+		todo, err := todoRepo.FindByID(ctx, first.ID())
+		require.NoError(t, err)
+		require.Len(t, todo.Sessions(), 1)
+		assert.Nil(t, todo.ActiveFocusSession())
+		assert.Equal(t, 25*time.Minute, todo.Sessions()[0].EndTime().Sub(todo.Sessions()[0].StartTime()))
+
+		var events int
+		err = pool.QueryRow(ctx, `SELECT count(*) FROM outbox WHERE aggregate_id = $1 AND event_type = 'todo.focus_elapsed'`, first.ID().UUID()).Scan(&events)
+		require.NoError(t, err)
+		assert.Equal(t, 1, events)
+
+		_, err = start.Handle(userCtx, application.StartFocusCommand{ID: second.ID()})
+		require.NoError(t, err)
+	})
+}
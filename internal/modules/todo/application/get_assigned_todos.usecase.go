@@ -0,0 +1,46 @@
+package application
+
+import (
+	"context"
+
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type GetAssignedTodosQuery struct {
+	UserID userDomain.UserID
+	Limit  int32
+	Offset int32
+}
+
+type GetAssignedTodosResponse struct {
+	Todos []TodoReadModel
+}
+
+type GetAssignedTodosHandler struct {
+	qs TodoQueryService
+}
+
+var _ application.RequestHandler[GetAssignedTodosQuery, GetAssignedTodosResponse] = (*GetAssignedTodosHandler)(nil)
+
+func NewGetAssignedTodosHandler(qs TodoQueryService) *GetAssignedTodosHandler {
+	return &GetAssignedTodosHandler{qs: qs}
+}
+
+// Handle lists a user's own assignments. Assignees are always members of the todo's
+// workspace, so no per-workspace check is needed.
+func (h *GetAssignedTodosHandler) Handle(ctx context.Context, q GetAssignedTodosQuery) (GetAssignedTodosResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	if userDomain.UserID(meta.UserID) != q.UserID && !meta.IsSystem() {
+		return GetAssignedTodosResponse{}, userDomain.ErrNotSameUser
+	}
+
+	todos, err := h.qs.ListAssignedTo(ctx, q.UserID, q.Limit, q.Offset)
+	if err != nil {
+		return GetAssignedTodosResponse{}, err
+	}
+
+	return GetAssignedTodosResponse{Todos: todos}, nil
+}
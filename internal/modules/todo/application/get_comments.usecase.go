@@ -0,0 +1,57 @@
+package application
+
+import (
+	"context"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type GetCommentsQuery struct {
+	TodoID domain.TodoID
+	Limit  int32
+	Offset int32
+}
+
+type GetCommentsResponse struct {
+	Comments []CommentReadModel
+}
+
+type GetCommentsHandler struct {
+	qs     TodoQueryService
+	wsProv WorkspaceProvider
+}
+
+var _ application.RequestHandler[GetCommentsQuery, GetCommentsResponse] = (*GetCommentsHandler)(nil)
+
+func NewGetCommentsHandler(qs TodoQueryService, wsProv WorkspaceProvider) *GetCommentsHandler {
+	return &GetCommentsHandler{qs: qs, wsProv: wsProv}
+}
+
+func (h *GetCommentsHandler) Handle(ctx context.Context, q GetCommentsQuery) (GetCommentsResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	todo, err := h.qs.GetByID(ctx, q.TodoID)
+	if err != nil {
+		return GetCommentsResponse{}, err
+	}
+
+	isMember, err := h.wsProv.IsMember(ctx, todo.WorkspaceID, userDomain.UserID(meta.UserID))
+	if err != nil {
+		return GetCommentsResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return GetCommentsResponse{}, wsDomain.ErrNotOwner
+	}
+
+	comments, err := h.qs.ListComments(ctx, q.TodoID, q.Limit, q.Offset)
+	if err != nil {
+		return GetCommentsResponse{}, err
+	}
+
+	return GetCommentsResponse{Comments: comments}, nil
+}
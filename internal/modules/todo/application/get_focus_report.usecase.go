@@ -0,0 +1,95 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/apperrors"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+const focusReportMaxDays = 366
+
+var ErrInvalidReportRange = apperrors.New(apperrors.InvalidInput, "report range must span 1 to 366 days")
+
+// GetFocusReportQuery covers the calendar days From to To, both inclusive, in the caller's timezone.
+type GetFocusReportQuery struct {
+	WorkspaceID wsDomain.WorkspaceID
+	From        time.Time
+	To          time.Time
+	// GroupBy defaults to days.
+	GroupBy FocusReportGroupBy
+	UserID  *userDomain.UserID
+}
+
+func (q *GetFocusReportQuery) Validate() error {
+	if q.GroupBy != "" && !q.GroupBy.Valid() {
+		return ErrInvalidFocusGrouping
+	}
+
+	days := int(q.To.Sub(q.From).Hours()/24) + 1
+	if days < 1 || days > focusReportMaxDays {
+		return ErrInvalidReportRange
+	}
+
+	return nil
+}
+
+type GetFocusReportResponse struct {
+	Report FocusReportReadModel
+}
+
+type GetFocusReportHandler struct {
+	qs     FocusReportQueryService
+	wsProv WorkspaceProvider
+	tzProv UserTimezoneProvider
+}
+
+var _ application.RequestHandler[GetFocusReportQuery, GetFocusReportResponse] = (*GetFocusReportHandler)(nil)
+
+func NewGetFocusReportHandler(qs FocusReportQueryService, wsProv WorkspaceProvider, tzProv UserTimezoneProvider) *GetFocusReportHandler {
+	return &GetFocusReportHandler{qs: qs, wsProv: wsProv, tzProv: tzProv}
+}
+
+func (h *GetFocusReportHandler) Handle(ctx context.Context, q GetFocusReportQuery) (GetFocusReportResponse, error) {
+	meta := causation.FromContext(ctx)
+	callerID := userDomain.UserID(meta.UserID)
+
+	isMember, err := h.wsProv.IsMember(ctx, q.WorkspaceID, callerID)
+	if err != nil {
+		return GetFocusReportResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return GetFocusReportResponse{}, wsDomain.ErrNotOwner
+	}
+
+	loc, err := h.tzProv.Location(ctx, callerID)
+	if err != nil {
+		return GetFocusReportResponse{}, err
+	}
+
+	groupBy := q.GroupBy
+	if groupBy == "" {
+		groupBy = FocusGroupByDay
+	}
+
+	from := time.Date(q.From.Year(), q.From.Month(), q.From.Day(), 0, 0, 0, 0, loc)
+	to := time.Date(q.To.Year(), q.To.Month(), q.To.Day()+1, 0, 0, 0, 0, loc)
+
+	report, err := h.qs.GetFocusReport(ctx, q.WorkspaceID, FocusReportFilter{
+		From:     from,
+		To:       to,
+		Location: loc,
+		GroupBy:  groupBy,
+		UserID:   q.UserID,
+	})
+	if err != nil {
+		return GetFocusReportResponse{}, err
+	}
+
+	return GetFocusReportResponse{Report: report}, nil
+}
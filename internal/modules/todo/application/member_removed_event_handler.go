@@ -0,0 +1,66 @@
+package application
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type MemberRemovedEventPayload struct {
+	WorkspaceID uuid.UUID `json:"workspace_id"`
+	UserID      uuid.UUID `json:"user_id"`
+}
+
+// MemberRemovedEventHandler unassigns the todos of a user who left a workspace.
+type MemberRemovedEventHandler struct {
+	repo domain.TodoRepository
+	uow  application.UnitOfWork
+}
+
+func NewMemberRemovedEventHandler(repo domain.TodoRepository, uow application.UnitOfWork) *MemberRemovedEventHandler {
+	return &MemberRemovedEventHandler{repo: repo, uow: uow}
+}
+
+func (h *MemberRemovedEventHandler) Handle(ctx context.Context, data []byte) error {
+	var envelope struct {
+		Data MemberRemovedEventPayload `json:"data"`
+	}
+
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		if err := json.Unmarshal(data, &envelope.Data); err != nil {
+			return fmt.Errorf("failed to unmarshal MemberRemoved event: %w", err)
+		}
+	}
+
+	wsID := wsDomain.WorkspaceID(envelope.Data.WorkspaceID)
+	userID := userDomain.UserID(envelope.Data.UserID)
+	actorID := userDomain.UserID(causation.FromContext(ctx).UserID)
+
+	return h.uow.Execute(ctx, func(ctx context.Context) error {
+		todos, err := h.repo.FindAssignedInWorkspace(ctx, wsID, userID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+
+		for _, t := range todos {
+			t.Unassign(actorID, now)
+
+			if err := h.repo.Save(ctx, t); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,64 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type RemoveBlockerCommand struct {
+	TodoID    domain.TodoID
+	BlockerID domain.TodoID
+}
+
+type RemoveBlockerResponse struct{}
+
+type RemoveBlockerHandler struct {
+	repo   domain.TodoRepository
+	wsProv WorkspaceProvider
+}
+
+var _ application.RequestHandler[RemoveBlockerCommand, RemoveBlockerResponse] = (*RemoveBlockerHandler)(nil)
+
+func NewRemoveBlockerHandler(repo domain.TodoRepository, wsProv WorkspaceProvider) *RemoveBlockerHandler {
+	return &RemoveBlockerHandler{repo: repo, wsProv: wsProv}
+}
+
+func (h *RemoveBlockerHandler) Handle(ctx context.Context, cmd RemoveBlockerCommand) (RemoveBlockerResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	todo, err := h.repo.FindByID(ctx, cmd.TodoID)
+	if err != nil {
+		return RemoveBlockerResponse{}, err
+	}
+
+	isMember, err := h.wsProv.IsMember(ctx, todo.WorkspaceID(), userDomain.UserID(meta.UserID))
+	if err != nil {
+		return RemoveBlockerResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return RemoveBlockerResponse{}, wsDomain.ErrNotOwner
+	}
+
+	if err := h.repo.LockDependencies(ctx, todo.WorkspaceID()); err != nil {
+		return RemoveBlockerResponse{}, err
+	}
+
+	// reload past any cache, so that blockers added concurrently are not dropped on save
+	todo, err = h.repo.FindByIDForUpdate(ctx, cmd.TodoID)
+	if err != nil {
+		return RemoveBlockerResponse{}, err
+	}
+
+	if err := todo.RemoveBlocker(cmd.BlockerID, userDomain.UserID(meta.UserID), time.Now()); err != nil {
+		return RemoveBlockerResponse{}, err
+	}
+
+	return RemoveBlockerResponse{}, h.repo.Save(ctx, todo)
+}
@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type RemoveChecklistItemCommand struct {
+	TodoID domain.TodoID
+	ItemID domain.ChecklistItemID
+}
+
+type RemoveChecklistItemResponse struct{}
+
+type RemoveChecklistItemHandler struct {
+	repo   domain.TodoRepository
+	wsProv WorkspaceProvider
+}
+
+var _ application.RequestHandler[RemoveChecklistItemCommand, RemoveChecklistItemResponse] = (*RemoveChecklistItemHandler)(nil)
+
+func NewRemoveChecklistItemHandler(repo domain.TodoRepository, wsProv WorkspaceProvider) *RemoveChecklistItemHandler {
+	return &RemoveChecklistItemHandler{repo: repo, wsProv: wsProv}
+}
+
+func (h *RemoveChecklistItemHandler) Handle(ctx context.Context, cmd RemoveChecklistItemCommand) (RemoveChecklistItemResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	todo, err := h.repo.FindByID(ctx, cmd.TodoID)
+	if err != nil {
+		return RemoveChecklistItemResponse{}, err
+	}
+
+	isMember, err := h.wsProv.IsMember(ctx, todo.WorkspaceID(), userDomain.UserID(meta.UserID))
+	if err != nil {
+		return RemoveChecklistItemResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return RemoveChecklistItemResponse{}, wsDomain.ErrNotOwner
+	}
+
+	if err := todo.RemoveChecklistItem(cmd.ItemID, userDomain.UserID(meta.UserID), time.Now()); err != nil {
+		return RemoveChecklistItemResponse{}, err
+	}
+
+	return RemoveChecklistItemResponse{}, h.repo.Save(ctx, todo)
+}
@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type RemoveTagFromTodoCommand struct {
+	TodoID domain.TodoID
+	TagID  domain.TagID
+}
+
+type RemoveTagFromTodoResponse struct{}
+
+type RemoveTagFromTodoHandler struct {
+	repo   domain.TodoRepository
+	wsProv WorkspaceProvider
+}
+
+var _ application.RequestHandler[RemoveTagFromTodoCommand, RemoveTagFromTodoResponse] = (*RemoveTagFromTodoHandler)(nil)
+
+func NewRemoveTagFromTodoHandler(repo domain.TodoRepository, wsProv WorkspaceProvider) *RemoveTagFromTodoHandler {
+	return &RemoveTagFromTodoHandler{repo: repo, wsProv: wsProv}
+}
+
+func (h *RemoveTagFromTodoHandler) Handle(ctx context.Context, cmd RemoveTagFromTodoCommand) (RemoveTagFromTodoResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	todo, err := h.repo.FindByID(ctx, cmd.TodoID)
+	if err != nil {
+		return RemoveTagFromTodoResponse{}, err
+	}
+
+	isMember, err := h.wsProv.IsMember(ctx, todo.WorkspaceID(), userDomain.UserID(meta.UserID))
+	if err != nil {
+		return RemoveTagFromTodoResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return RemoveTagFromTodoResponse{}, wsDomain.ErrNotOwner
+	}
+
+	if err := todo.RemoveTag(cmd.TagID, userDomain.UserID(meta.UserID), time.Now()); err != nil {
+		return RemoveTagFromTodoResponse{}, err
+	}
+
+	return RemoveTagFromTodoResponse{}, h.repo.Save(ctx, todo)
+}
@@ -0,0 +1,65 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type RenameTodoCommand struct {
+	ID    domain.TodoID
+	Title string
+}
+
+func (c *RenameTodoCommand) Validate() error {
+	_, err := domain.NewTodoTitle(c.Title)
+
+	return err
+}
+
+type RenameTodoResponse struct{}
+
+type RenameTodoHandler struct {
+	repo   domain.TodoRepository
+	wsProv WorkspaceProvider
+}
+
+var _ application.RequestHandler[RenameTodoCommand, RenameTodoResponse] = (*RenameTodoHandler)(nil)
+
+func NewRenameTodoHandler(repo domain.TodoRepository, wsProv WorkspaceProvider) *RenameTodoHandler {
+	return &RenameTodoHandler{repo: repo, wsProv: wsProv}
+}
+
+func (h *RenameTodoHandler) Handle(ctx context.Context, cmd RenameTodoCommand) (RenameTodoResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	title, err := domain.NewTodoTitle(cmd.Title)
+	if err != nil {
+		return RenameTodoResponse{}, err
+	}
+
+	todo, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return RenameTodoResponse{}, err
+	}
+
+	isMember, err := h.wsProv.IsMember(ctx, todo.WorkspaceID(), userDomain.UserID(meta.UserID))
+	if err != nil {
+		return RenameTodoResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return RenameTodoResponse{}, wsDomain.ErrNotOwner
+	}
+
+	if err := todo.Rename(title, userDomain.UserID(meta.UserID), time.Now()); err != nil {
+		return RenameTodoResponse{}, err
+	}
+
+	return RenameTodoResponse{}, h.repo.Save(ctx, todo)
+}
@@ -0,0 +1,53 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type ReopenTodoCommand struct {
+	ID domain.TodoID
+}
+
+type ReopenTodoResponse struct{}
+
+type ReopenTodoHandler struct {
+	repo   domain.TodoRepository
+	wsProv WorkspaceProvider
+}
+
+var _ application.RequestHandler[ReopenTodoCommand, ReopenTodoResponse] = (*ReopenTodoHandler)(nil)
+
+func NewReopenTodoHandler(repo domain.TodoRepository, wsProv WorkspaceProvider) *ReopenTodoHandler {
+	return &ReopenTodoHandler{repo: repo, wsProv: wsProv}
+}
+
+func (h *ReopenTodoHandler) Handle(ctx context.Context, cmd ReopenTodoCommand) (ReopenTodoResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	todo, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return ReopenTodoResponse{}, err
+	}
+
+	isMember, err := h.wsProv.IsMember(ctx, todo.WorkspaceID(), userDomain.UserID(meta.UserID))
+	if err != nil {
+		return ReopenTodoResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return ReopenTodoResponse{}, wsDomain.ErrNotOwner
+	}
+
+	if err := todo.Reopen(userDomain.UserID(meta.UserID), time.Now()); err != nil {
+		return ReopenTodoResponse{}, err
+	}
+
+	return ReopenTodoResponse{}, h.repo.Save(ctx, todo)
+}
@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type ReorderChecklistCommand struct {
+	TodoID  domain.TodoID
+	ItemIDs []domain.ChecklistItemID
+}
+
+type ReorderChecklistResponse struct{}
+
+type ReorderChecklistHandler struct {
+	repo   domain.TodoRepository
+	wsProv WorkspaceProvider
+}
+
+var _ application.RequestHandler[ReorderChecklistCommand, ReorderChecklistResponse] = (*ReorderChecklistHandler)(nil)
+
+func NewReorderChecklistHandler(repo domain.TodoRepository, wsProv WorkspaceProvider) *ReorderChecklistHandler {
+	return &ReorderChecklistHandler{repo: repo, wsProv: wsProv}
+}
+
+func (h *ReorderChecklistHandler) Handle(ctx context.Context, cmd ReorderChecklistCommand) (ReorderChecklistResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	todo, err := h.repo.FindByID(ctx, cmd.TodoID)
+	if err != nil {
+		return ReorderChecklistResponse{}, err
+	}
+
+	isMember, err := h.wsProv.IsMember(ctx, todo.WorkspaceID(), userDomain.UserID(meta.UserID))
+	if err != nil {
+		return ReorderChecklistResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return ReorderChecklistResponse{}, wsDomain.ErrNotOwner
+	}
+
+	if err := todo.ReorderChecklist(cmd.ItemIDs, userDomain.UserID(meta.UserID), time.Now()); err != nil {
+		return ReorderChecklistResponse{}, err
+	}
+
+	return ReorderChecklistResponse{}, h.repo.Save(ctx, todo)
+}
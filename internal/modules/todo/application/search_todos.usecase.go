@@ -0,0 +1,74 @@
+package application
+
+import (
+	"context"
+	"strings"
+
+	"github.com/danicc097/todo-ddd-example/internal/apperrors"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+const searchQueryMaxLen = 200
+
+type SearchTodosQuery struct {
+	WorkspaceID wsDomain.WorkspaceID
+	Query       string
+	Limit       int32
+	Offset      int32
+}
+
+var (
+	ErrSearchQueryEmpty   = apperrors.New(apperrors.InvalidInput, "search query cannot be empty")
+	ErrSearchQueryTooLong = apperrors.New(apperrors.InvalidInput, "search query is too long")
+)
+
+func (q *SearchTodosQuery) Validate() error {
+	query := strings.TrimSpace(q.Query)
+	if query == "" {
+		return ErrSearchQueryEmpty
+	}
+
+	if len(query) > searchQueryMaxLen {
+		return ErrSearchQueryTooLong
+	}
+
+	return nil
+}
+
+type SearchTodosResponse struct {
+	Results []TodoSearchResultReadModel
+}
+
+type SearchTodosHandler struct {
+	qs     TodoQueryService
+	wsProv WorkspaceProvider
+}
+
+var _ application.RequestHandler[SearchTodosQuery, SearchTodosResponse] = (*SearchTodosHandler)(nil)
+
+func NewSearchTodosHandler(qs TodoQueryService, wsProv WorkspaceProvider) *SearchTodosHandler {
+	return &SearchTodosHandler{qs: qs, wsProv: wsProv}
+}
+
+func (h *SearchTodosHandler) Handle(ctx context.Context, q SearchTodosQuery) (SearchTodosResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	isMember, err := h.wsProv.IsMember(ctx, q.WorkspaceID, userDomain.UserID(meta.UserID))
+	if err != nil {
+		return SearchTodosResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return SearchTodosResponse{}, wsDomain.ErrNotOwner
+	}
+
+	results, err := h.qs.Search(ctx, q.WorkspaceID, q.Query, q.Limit, q.Offset)
+	if err != nil {
+		return SearchTodosResponse{}, err
+	}
+
+	return SearchTodosResponse{Results: results}, nil
+}
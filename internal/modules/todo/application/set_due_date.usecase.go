@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type SetDueDateCommand struct {
+	ID      domain.TodoID
+	DueDate *time.Time
+}
+
+type SetDueDateResponse struct{}
+
+type SetDueDateHandler struct {
+	repo   domain.TodoRepository
+	wsProv WorkspaceProvider
+}
+
+var _ application.RequestHandler[SetDueDateCommand, SetDueDateResponse] = (*SetDueDateHandler)(nil)
+
+func NewSetDueDateHandler(repo domain.TodoRepository, wsProv WorkspaceProvider) *SetDueDateHandler {
+	return &SetDueDateHandler{repo: repo, wsProv: wsProv}
+}
+
+func (h *SetDueDateHandler) Handle(ctx context.Context, cmd SetDueDateCommand) (SetDueDateResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	todo, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return SetDueDateResponse{}, err
+	}
+
+	isMember, err := h.wsProv.IsMember(ctx, todo.WorkspaceID(), userDomain.UserID(meta.UserID))
+	if err != nil {
+		return SetDueDateResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return SetDueDateResponse{}, wsDomain.ErrNotOwner
+	}
+
+	if err := todo.SetDueDate(cmd.DueDate, userDomain.UserID(meta.UserID), time.Now()); err != nil {
+		return SetDueDateResponse{}, err
+	}
+
+	return SetDueDateResponse{}, h.repo.Save(ctx, todo)
+}
@@ -0,0 +1,97 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+	"github.com/danicc097/todo-ddd-example/internal/utils/pointers"
+)
+
+// SetRecurrenceCommand clears the recurrence when Interval, Amount and Rule are all nil.
+type SetRecurrenceCommand struct {
+	ID       domain.TodoID
+	Interval *string
+	Amount   *int
+	Rule     *string
+}
+
+func (c *SetRecurrenceCommand) Validate() error {
+	_, err := parseRecurrence(c.Interval, c.Amount, c.Rule)
+
+	return err
+}
+
+// parseRecurrence builds a rule from either an RRULE string or the interval and amount pair.
+// It returns nil if none are set.
+func parseRecurrence(interval *string, amount *int, rule *string) (*domain.RecurrenceRule, error) {
+	switch {
+	case rule != nil && (interval != nil || amount != nil):
+		return nil, errors.New("recurrence rule cannot be combined with interval and amount")
+	case rule != nil:
+		r, err := domain.ParseRecurrenceRule(*rule)
+		if err != nil {
+			return nil, err
+		}
+
+		return pointers.New(r), nil
+	case interval != nil && amount != nil:
+		r, err := domain.NewRecurrenceRule(*interval, *amount)
+		if err != nil {
+			return nil, err
+		}
+
+		return pointers.New(r), nil
+	case interval != nil || amount != nil:
+		return nil, errors.New("recurrence interval and amount must be provided together")
+	}
+
+	return nil, nil
+}
+
+type SetRecurrenceResponse struct{}
+
+type SetRecurrenceHandler struct {
+	repo   domain.TodoRepository
+	wsProv WorkspaceProvider
+}
+
+var _ application.RequestHandler[SetRecurrenceCommand, SetRecurrenceResponse] = (*SetRecurrenceHandler)(nil)
+
+func NewSetRecurrenceHandler(repo domain.TodoRepository, wsProv WorkspaceProvider) *SetRecurrenceHandler {
+	return &SetRecurrenceHandler{repo: repo, wsProv: wsProv}
+}
+
+func (h *SetRecurrenceHandler) Handle(ctx context.Context, cmd SetRecurrenceCommand) (SetRecurrenceResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	rule, err := parseRecurrence(cmd.Interval, cmd.Amount, cmd.Rule)
+	if err != nil {
+		return SetRecurrenceResponse{}, err
+	}
+
+	todo, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return SetRecurrenceResponse{}, err
+	}
+
+	isMember, err := h.wsProv.IsMember(ctx, todo.WorkspaceID(), userDomain.UserID(meta.UserID))
+	if err != nil {
+		return SetRecurrenceResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return SetRecurrenceResponse{}, wsDomain.ErrNotOwner
+	}
+
+	if err := todo.SetRecurrence(rule, userDomain.UserID(meta.UserID), time.Now()); err != nil {
+		return SetRecurrenceResponse{}, err
+	}
+
+	return SetRecurrenceResponse{}, h.repo.Save(ctx, todo)
+}
@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -14,6 +15,8 @@ import (
 
 type StartFocusCommand struct {
 	ID domain.TodoID
+	// PlannedDuration turns the session into a pomodoro when set.
+	PlannedDuration time.Duration
 }
 
 type StartFocusResponse struct{}
@@ -46,10 +49,22 @@ func (h *StartFocusHandler) Handle(ctx context.Context, cmd StartFocusCommand) (
 		return StartFocusResponse{}, wsDomain.ErrNotOwner
 	}
 
+	userID := userDomain.UserID(meta.UserID)
+
 	sessionID := domain.FocusSessionID(uuid.New())
-	if err := todo.StartFocus(userDomain.UserID(meta.UserID), sessionID); err != nil {
+	if err := todo.StartFocus(userID, sessionID, cmd.PlannedDuration); err != nil {
 		return StartFocusResponse{}, err
 	}
 
+	// a user can only focus on one todo at a time. The unique index catches concurrent starts.
+	focusing, err := h.repo.HasActiveFocusSession(ctx, userID)
+	if err != nil {
+		return StartFocusResponse{}, err
+	}
+
+	if focusing {
+		return StartFocusResponse{}, domain.ErrUserAlreadyFocusing
+	}
+
 	return StartFocusResponse{}, h.repo.Save(ctx, todo)
 }
@@ -151,7 +151,7 @@ func TestSystem_Integration(t *testing.T) {
 	wsProv := wsAdapters.NewTodoWorkspaceProvider(wsRepo)
 
 	createTodoHandler := sharedApp.WithUoW(application.NewCreateTodoHandler(cachedTodoRepo, wsProv), uow)
-	completeTodoHandler := sharedApp.WithUoW(application.NewCompleteTodoHandler(cachedTodoRepo, wsProv), uow)
+	completeTodoHandler := sharedApp.WithUoW(application.NewCompleteTodoHandler(cachedTodoRepo, wsProv, userAdapters.NewUserTimezoneProvider(env.fixtures.UserRepo)), uow)
 
 	t.Run("success commits db invalidates cache and publishes", func(t *testing.T) {
 		t.Parallel()
@@ -320,7 +320,8 @@ func TestSystem_Integration(t *testing.T) {
 		_, err := cachedTodoRepo.FindByID(testCtx, todo.ID())
 		require.NoError(t, err)
 
-		_, err = cachedQueryService.GetAllByWorkspace(testCtx, ws.ID(), 10, 0)
+		filter := application.TodoListFilter{Limit: 10}
+		_, err = cachedQueryService.GetAllByWorkspace(testCtx, ws.ID(), filter)
 		require.NoError(t, err)
 
 		rev, _ := env.rdb.Get(testCtx, cache.Keys.WorkspaceRevision(ws.ID())).Result()
@@ -329,7 +330,7 @@ func TestSystem_Integration(t *testing.T) {
 		}
 
 		entityRedisKey := cache.Keys.TodoAggregate(todo.ID())
-		collectionRedisKey := cache.Keys.TodoWorkspaceCollectionPaginated(ws.ID(), 10, 0, rev)
+		collectionRedisKey := cache.Keys.TodoWorkspaceCollectionQuery(ws.ID(), filter.Signature(), rev)
 
 		require.Eventually(t, func() bool {
 			return env.rdb.Exists(testCtx, entityRedisKey).Val() == 1 && env.rdb.Exists(testCtx, collectionRedisKey).Val() == 1
@@ -0,0 +1,106 @@
+package application_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/application"
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	todoPg "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/postgres"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	wsAdapters "github.com/danicc097/todo-ddd-example/internal/modules/workspace/infrastructure/adapters"
+	wsPg "github.com/danicc097/todo-ddd-example/internal/modules/workspace/infrastructure/postgres"
+	sharedApp "github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+	"github.com/danicc097/todo-ddd-example/internal/testfixtures"
+	"github.com/danicc097/todo-ddd-example/internal/testutils"
+)
+
+func TestTagUseCases_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pool := testutils.GetGlobalPostgresPool(t)
+	fixtures := testfixtures.NewFixtures(pool)
+	uow := sharedPg.NewUnitOfWork(pool)
+	todoRepo := todoPg.NewTodoRepo(pool, uow)
+	tagRepo := todoPg.NewTagRepo(pool)
+	wsProv := wsAdapters.NewTodoWorkspaceProvider(wsPg.NewWorkspaceRepo(pool, uow))
+
+	assign := sharedApp.WithUoW(application.NewAssignTagToTodoHandler(todoRepo, tagRepo), uow)
+	remove := sharedApp.WithUoW(application.NewRemoveTagFromTodoHandler(todoRepo, wsProv), uow)
+	update := sharedApp.WithUoW(application.NewUpdateTagHandler(tagRepo, wsProv), uow)
+	del := sharedApp.WithUoW(application.NewDeleteTagHandler(tagRepo, todoRepo, wsProv), uow)
+
+	owner := fixtures.RandomUser(ctx, t)
+	outsider := fixtures.RandomUser(ctx, t)
+	ws := fixtures.RandomWorkspace(ctx, t, owner.ID())
+
+	ownerCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: owner.ID().UUID()})
+	outsiderCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: outsider.ID().UUID()})
+
+	t.Run("removes a tag from a todo", func(t *testing.T) {
+		tag := fixtures.RandomTag(ctx, t, ws.ID())
+		todo := fixtures.RandomTodo(ctx, t, ws.ID())
+
+		_, err := assign.Handle(ownerCtx, application.AssignTagToTodoCommand{TodoID: todo.ID(), TagID: tag.ID()})
+		require.NoError(t, err)
+
+		_, err = remove.Handle(outsiderCtx, application.RemoveTagFromTodoCommand{TodoID: todo.ID(), TagID: tag.ID()})
+		require.ErrorIs(t, err, wsDomain.ErrNotOwner)
+
+		_, err = remove.Handle(ownerCtx, application.RemoveTagFromTodoCommand{TodoID: todo.ID(), TagID: tag.ID()})
+		require.NoError(t, err)
+
+		updated, err := todoRepo.FindByID(ctx, todo.ID())
+		require.NoError(t, err)
+		assert.NotContains(t, updated.Tags(), tag.ID())
+
+		_, err = remove.Handle(ownerCtx, application.RemoveTagFromTodoCommand{TodoID: todo.ID(), TagID: tag.ID()})
+		require.ErrorIs(t, err, domain.ErrTodoTagNotFound)
+	})
+
+	t.Run("renames and recolors respecting unique names", func(t *testing.T) {
+		tag := fixtures.RandomTag(ctx, t, ws.ID())
+		other := fixtures.RandomTag(ctx, t, ws.ID())
+
+		taken := other.Name().String()
+		_, err := update.Handle(ownerCtx, application.UpdateTagCommand{WorkspaceID: ws.ID(), TagID: tag.ID(), Name: &taken})
+		require.ErrorIs(t, err, domain.ErrTagNameTaken)
+
+		name, color := tag.Name().String()+"-new", "#EF4444"
+		_, err = update.Handle(ownerCtx, application.UpdateTagCommand{WorkspaceID: ws.ID(), TagID: tag.ID(), Name: &name, Color: &color})
+		require.NoError(t, err)
+
+		updated, err := tagRepo.FindByID(ctx, tag.ID())
+		require.NoError(t, err)
+		assert.Equal(t, name, updated.Name().String())
+		assert.Equal(t, "#ef4444", updated.Color().String())
+	})
+
+	t.Run("deletes a tag and untags its todos", func(t *testing.T) {
+		tag := fixtures.RandomTag(ctx, t, ws.ID())
+		todo := fixtures.RandomTodo(ctx, t, ws.ID())
+
+		_, err := assign.Handle(ownerCtx, application.AssignTagToTodoCommand{TodoID: todo.ID(), TagID: tag.ID()})
+		require.NoError(t, err)
+
+		otherWs := fixtures.RandomWorkspace(ctx, t, owner.ID())
+		_, err = del.Handle(ownerCtx, application.DeleteTagCommand{WorkspaceID: otherWs.ID(), TagID: tag.ID()})
+		require.ErrorIs(t, err, domain.ErrTagNotFound)
+
+		_, err = del.Handle(ownerCtx, application.DeleteTagCommand{WorkspaceID: ws.ID(), TagID: tag.ID()})
+		require.NoError(t, err)
+
+		_, err = tagRepo.FindByID(ctx, tag.ID())
+		require.ErrorIs(t, err, domain.ErrTagNotFound)
+
+		updated, err := todoRepo.FindByID(ctx, todo.ID())
+		require.NoError(t, err)
+		assert.Empty(t, updated.Tags())
+	})
+}
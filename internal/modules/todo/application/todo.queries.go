@@ -2,13 +2,140 @@ package application
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/danicc097/todo-ddd-example/internal/apperrors"
 	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
 	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
 )
 
+var ErrInvalidCursor = apperrors.New(apperrors.InvalidInput, "invalid pagination cursor")
+
+type TodoSortKey string
+
+const (
+	TodoSortCreatedAt TodoSortKey = "createdAt"
+	TodoSortDueDate   TodoSortKey = "dueDate"
+	TodoSortTitle     TodoSortKey = "title"
+)
+
+// TodoSort orders a listing. Ties are always broken by ID in the same direction.
+type TodoSort struct {
+	Key  TodoSortKey
+	Desc bool
+}
+
+var DefaultTodoSort = TodoSort{Key: TodoSortCreatedAt, Desc: true}
+
+// ParseTodoSort parses "key" or "-key" for descending order.
+func ParseTodoSort(s string) (TodoSort, error) {
+	if s == "" {
+		return DefaultTodoSort, nil
+	}
+
+	sort := TodoSort{Key: TodoSortKey(strings.TrimPrefix(s, "-")), Desc: strings.HasPrefix(s, "-")}
+	switch sort.Key {
+	case TodoSortCreatedAt, TodoSortDueDate, TodoSortTitle:
+		return sort, nil
+	}
+
+	return TodoSort{}, apperrors.New(apperrors.InvalidInput, fmt.Sprintf("unknown sort key %q", s))
+}
+
+func (s TodoSort) String() string {
+	if s.Desc {
+		return "-" + string(s.Key)
+	}
+
+	return string(s.Key)
+}
+
+// TodoListFilter narrows a workspace listing. Zero values disable each filter.
+type TodoListFilter struct {
+	Statuses  []domain.TodoStatus
+	TagIDs    []domain.TagID // todos having any of these tags
+	DueBefore *time.Time
+	DueAfter  *time.Time
+	Overdue   *bool
+	Recurring *bool
+	Sort      TodoSort
+	// Cursor is an opaque keyset token from a previous page. It takes precedence over Offset.
+	Cursor string
+	Limit  int32
+	Offset int32
+}
+
+// Signature returns a canonical representation, so equivalent filters share cache entries.
+func (f TodoListFilter) Signature() string {
+	statuses := make([]string, len(f.Statuses))
+	for i, s := range f.Statuses {
+		statuses[i] = string(s)
+	}
+
+	slices.Sort(statuses)
+
+	tagIDs := make([]string, len(f.TagIDs))
+	for i, id := range f.TagIDs {
+		tagIDs[i] = id.String()
+	}
+
+	slices.Sort(tagIDs)
+
+	sort := f.Sort
+	if sort.Key == "" {
+		sort = DefaultTodoSort
+	}
+
+	return fmt.Sprintf("status=%s;tags=%s;due_before=%s;due_after=%s;overdue=%s;recurring=%s;sort=%s;cursor=%s;limit=%d;offset=%d",
+		strings.Join(statuses, ","),
+		strings.Join(tagIDs, ","),
+		fmtTime(f.DueBefore),
+		fmtTime(f.DueAfter),
+		fmtBool(f.Overdue),
+		fmtBool(f.Recurring),
+		sort,
+		f.Cursor,
+		f.Limit,
+		f.Offset,
+	)
+}
+
+func fmtTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+func fmtBool(b *bool) string {
+	if b == nil {
+		return ""
+	}
+
+	return fmt.Sprint(*b)
+}
+
+type TodoPage struct {
+	Items []TodoReadModel
+	// NextCursor is empty on the last page.
+	NextCursor string
+}
+
 //go:generate go tool gowrap gen -g -i TodoQueryService -t ../../../../templates/opentelemetry.gotmpl -o ../infrastructure/postgres/todo_query_service_tracing.gen.go
 type TodoQueryService interface {
-	GetAllByWorkspace(ctx context.Context, wsID wsDomain.WorkspaceID, limit, offset int32) ([]TodoReadModel, error)
+	GetAllByWorkspace(ctx context.Context, wsID wsDomain.WorkspaceID, filter TodoListFilter) (TodoPage, error)
 	GetByID(ctx context.Context, id domain.TodoID) (*TodoReadModel, error)
+	// Search returns title matches ranked by relevance.
+	Search(ctx context.Context, wsID wsDomain.WorkspaceID, query string, limit, offset int32) ([]TodoSearchResultReadModel, error)
+	// ListAssignedTo returns todos assigned to a user across workspaces, soonest due first.
+	ListAssignedTo(ctx context.Context, userID userDomain.UserID, limit, offset int32) ([]TodoReadModel, error)
+	// ListUnassignedDue returns pending, unassigned todos due before dueBefore in the user's workspaces, soonest due first.
+	ListUnassignedDue(ctx context.Context, userID userDomain.UserID, dueBefore time.Time, limit, offset int32) ([]TodoReadModel, error)
+	// ListComments returns a todo's live comments, oldest first.
+	ListComments(ctx context.Context, todoID domain.TodoID, limit, offset int32) ([]CommentReadModel, error)
 }
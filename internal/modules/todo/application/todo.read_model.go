@@ -6,13 +6,23 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
 	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
 )
 
 type FocusSessionReadModel struct {
-	ID        uuid.UUID
-	StartTime time.Time
-	EndTime   *time.Time
+	ID              uuid.UUID
+	StartTime       time.Time
+	EndTime         *time.Time
+	PlannedDuration time.Duration // zero when unbounded
+}
+
+type ChecklistItemReadModel struct {
+	ID       domain.ChecklistItemID
+	Title    string
+	Done     bool
+	Required bool
+	Position int
 }
 
 type TodoReadModel struct {
@@ -24,5 +34,53 @@ type TodoReadModel struct {
 	DueDate            *time.Time
 	RecurrenceInterval *string
 	RecurrenceAmount   *int
+	RecurrenceRule     *string
+	LastCompletedAt    *time.Time
 	FocusSessions      []FocusSessionReadModel
+	ChecklistItems     []ChecklistItemReadModel
+	BlockedBy          []domain.TodoID
+	AssigneeID         *userDomain.UserID
+}
+
+// TodoSearchResultReadModel is a full-text match. Highlight is the HTML-escaped title with matched terms wrapped in <mark> tags.
+type TodoSearchResultReadModel struct {
+	ID          domain.TodoID
+	WorkspaceID wsDomain.WorkspaceID
+	Title       string
+	Status      string
+	CreatedAt   time.Time
+	DueDate     *time.Time
+	Rank        float32
+	Highlight   string
+}
+
+type CommentReadModel struct {
+	ID        domain.CommentID
+	TodoID    domain.TodoID
+	AuthorID  userDomain.UserID
+	Body      string
+	Mentions  []userDomain.UserID
+	CreatedAt time.Time
+	EditedAt  *time.Time
+}
+
+// FocusReportReadModel summarises focused time. Durations are in seconds.
+type FocusReportReadModel struct {
+	TotalSeconds          int64
+	SessionCount          int64
+	AverageSessionSeconds int64
+	// CurrentStreakDays counts consecutive days with focus up to the last day of the range,
+	// or the day before it.
+	CurrentStreakDays int
+	LongestStreakDays int
+	Groups            []FocusReportGroupReadModel
+}
+
+// FocusReportGroupReadModel is a todo, tag, member or day, identified by its ID or YYYY-MM-DD date.
+type FocusReportGroupReadModel struct {
+	Key                   string
+	Label                 string
+	TotalSeconds          int64
+	SessionCount          int64
+	AverageSessionSeconds int64
 }
@@ -5,10 +5,39 @@ import (
 )
 
 type TodoUseCases struct {
-	CreateTodo application.RequestHandler[CreateTodoCommand, CreateTodoResponse]
-	Complete   application.RequestHandler[CompleteTodoCommand, CompleteTodoResponse]
-	CreateTag  application.RequestHandler[CreateTagCommand, CreateTagResponse]
-	AssignTag  application.RequestHandler[AssignTagToTodoCommand, AssignTagToTodoResponse]
-	StartFocus application.RequestHandler[StartFocusCommand, StartFocusResponse]
-	StopFocus  application.RequestHandler[StopFocusCommand, StopFocusResponse]
+	CreateTodo    application.RequestHandler[CreateTodoCommand, CreateTodoResponse]
+	Complete      application.RequestHandler[CompleteTodoCommand, CompleteTodoResponse]
+	CreateTag     application.RequestHandler[CreateTagCommand, CreateTagResponse]
+	AssignTag     application.RequestHandler[AssignTagToTodoCommand, AssignTagToTodoResponse]
+	RemoveTag     application.RequestHandler[RemoveTagFromTodoCommand, RemoveTagFromTodoResponse]
+	UpdateTag     application.RequestHandler[UpdateTagCommand, UpdateTagResponse]
+	DeleteTag     application.RequestHandler[DeleteTagCommand, DeleteTagResponse]
+	StartFocus    application.RequestHandler[StartFocusCommand, StartFocusResponse]
+	StopFocus     application.RequestHandler[StopFocusCommand, StopFocusResponse]
+	Rename        application.RequestHandler[RenameTodoCommand, RenameTodoResponse]
+	Archive       application.RequestHandler[ArchiveTodoCommand, ArchiveTodoResponse]
+	Unarchive     application.RequestHandler[UnarchiveTodoCommand, UnarchiveTodoResponse]
+	Reopen        application.RequestHandler[ReopenTodoCommand, ReopenTodoResponse]
+	SetDueDate    application.RequestHandler[SetDueDateCommand, SetDueDateResponse]
+	SetRecurrence application.RequestHandler[SetRecurrenceCommand, SetRecurrenceResponse]
+	Search        application.RequestHandler[SearchTodosQuery, SearchTodosResponse]
+
+	AddChecklistItem    application.RequestHandler[AddChecklistItemCommand, AddChecklistItemResponse]
+	ToggleChecklistItem application.RequestHandler[ToggleChecklistItemCommand, ToggleChecklistItemResponse]
+	ReorderChecklist    application.RequestHandler[ReorderChecklistCommand, ReorderChecklistResponse]
+	RemoveChecklistItem application.RequestHandler[RemoveChecklistItemCommand, RemoveChecklistItemResponse]
+
+	AddBlocker    application.RequestHandler[AddBlockerCommand, AddBlockerResponse]
+	RemoveBlocker application.RequestHandler[RemoveBlockerCommand, RemoveBlockerResponse]
+
+	Assign           application.RequestHandler[AssignTodoCommand, AssignTodoResponse]
+	Unassign         application.RequestHandler[UnassignTodoCommand, UnassignTodoResponse]
+	GetAssignedTodos application.RequestHandler[GetAssignedTodosQuery, GetAssignedTodosResponse]
+
+	AddComment    application.RequestHandler[AddCommentCommand, AddCommentResponse]
+	EditComment   application.RequestHandler[EditCommentCommand, EditCommentResponse]
+	DeleteComment application.RequestHandler[DeleteCommentCommand, DeleteCommentResponse]
+	GetComments   application.RequestHandler[GetCommentsQuery, GetCommentsResponse]
+
+	GetFocusReport application.RequestHandler[GetFocusReportQuery, GetFocusReportResponse]
 }
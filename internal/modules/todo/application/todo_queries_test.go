@@ -0,0 +1,92 @@
+package application_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/application"
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+)
+
+func TestParseTodoSort(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input   string
+		want    application.TodoSort
+		wantErr bool
+	}{
+		{"", application.DefaultTodoSort, false},
+		{"dueDate", application.TodoSort{Key: application.TodoSortDueDate}, false},
+		{"-title", application.TodoSort{Key: application.TodoSortTitle, Desc: true}, false},
+		{"-status", application.TodoSort{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := application.ParseTodoSort(tt.input)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTodoListFilter_Signature(t *testing.T) {
+	t.Parallel()
+
+	tag1, tag2 := domain.TagID(uuid.New()), domain.TagID(uuid.New())
+	overdue := true
+
+	a := application.TodoListFilter{
+		Statuses: []domain.TodoStatus{domain.StatusPending, domain.StatusCompleted},
+		TagIDs:   []domain.TagID{tag1, tag2},
+		Overdue:  &overdue,
+		Limit:    20,
+	}
+	b := application.TodoListFilter{
+		Statuses: []domain.TodoStatus{domain.StatusCompleted, domain.StatusPending},
+		TagIDs:   []domain.TagID{tag2, tag1},
+		Overdue:  &overdue,
+		Sort:     application.DefaultTodoSort,
+		Limit:    20,
+	}
+
+	assert.Equal(t, a.Signature(), b.Signature(), "equivalent filters should share a signature")
+
+	b.Cursor = "abc"
+	assert.NotEqual(t, a.Signature(), b.Signature())
+
+	notOverdue := false
+	a.Overdue = &notOverdue
+	assert.NotEqual(t, a.Signature(), application.TodoListFilter{Limit: 20}.Signature(), "false and unset filters should differ")
+}
+
+func TestFocusReportFilter_Signature(t *testing.T) {
+	t.Parallel()
+
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	require.NoError(t, err)
+
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, madrid)
+	a := application.FocusReportFilter{From: from, To: from.AddDate(0, 0, 7), Location: madrid, GroupBy: application.FocusGroupByTag}
+	b := application.FocusReportFilter{From: from.UTC(), To: from.AddDate(0, 0, 7).UTC(), Location: madrid, GroupBy: application.FocusGroupByTag}
+
+	assert.Equal(t, a.Signature(), b.Signature())
+
+	b.Location = time.UTC
+	assert.NotEqual(t, a.Signature(), b.Signature())
+
+	userID := userDomain.UserID(uuid.New())
+	a.UserID = &userID
+	assert.NotEqual(t, a.Signature(), b.Signature())
+}
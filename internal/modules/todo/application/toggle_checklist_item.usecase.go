@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type ToggleChecklistItemCommand struct {
+	TodoID domain.TodoID
+	ItemID domain.ChecklistItemID
+}
+
+type ToggleChecklistItemResponse struct{}
+
+type ToggleChecklistItemHandler struct {
+	repo   domain.TodoRepository
+	wsProv WorkspaceProvider
+}
+
+var _ application.RequestHandler[ToggleChecklistItemCommand, ToggleChecklistItemResponse] = (*ToggleChecklistItemHandler)(nil)
+
+func NewToggleChecklistItemHandler(repo domain.TodoRepository, wsProv WorkspaceProvider) *ToggleChecklistItemHandler {
+	return &ToggleChecklistItemHandler{repo: repo, wsProv: wsProv}
+}
+
+func (h *ToggleChecklistItemHandler) Handle(ctx context.Context, cmd ToggleChecklistItemCommand) (ToggleChecklistItemResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	todo, err := h.repo.FindByID(ctx, cmd.TodoID)
+	if err != nil {
+		return ToggleChecklistItemResponse{}, err
+	}
+
+	isMember, err := h.wsProv.IsMember(ctx, todo.WorkspaceID(), userDomain.UserID(meta.UserID))
+	if err != nil {
+		return ToggleChecklistItemResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return ToggleChecklistItemResponse{}, wsDomain.ErrNotOwner
+	}
+
+	if err := todo.ToggleChecklistItem(cmd.ItemID, userDomain.UserID(meta.UserID), time.Now()); err != nil {
+		return ToggleChecklistItemResponse{}, err
+	}
+
+	return ToggleChecklistItemResponse{}, h.repo.Save(ctx, todo)
+}
@@ -0,0 +1,53 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type UnarchiveTodoCommand struct {
+	ID domain.TodoID
+}
+
+type UnarchiveTodoResponse struct{}
+
+type UnarchiveTodoHandler struct {
+	repo   domain.TodoRepository
+	wsProv WorkspaceProvider
+}
+
+var _ application.RequestHandler[UnarchiveTodoCommand, UnarchiveTodoResponse] = (*UnarchiveTodoHandler)(nil)
+
+func NewUnarchiveTodoHandler(repo domain.TodoRepository, wsProv WorkspaceProvider) *UnarchiveTodoHandler {
+	return &UnarchiveTodoHandler{repo: repo, wsProv: wsProv}
+}
+
+func (h *UnarchiveTodoHandler) Handle(ctx context.Context, cmd UnarchiveTodoCommand) (UnarchiveTodoResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	todo, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return UnarchiveTodoResponse{}, err
+	}
+
+	isMember, err := h.wsProv.IsMember(ctx, todo.WorkspaceID(), userDomain.UserID(meta.UserID))
+	if err != nil {
+		return UnarchiveTodoResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return UnarchiveTodoResponse{}, wsDomain.ErrNotOwner
+	}
+
+	if err := todo.Unarchive(userDomain.UserID(meta.UserID), time.Now()); err != nil {
+		return UnarchiveTodoResponse{}, err
+	}
+
+	return UnarchiveTodoResponse{}, h.repo.Save(ctx, todo)
+}
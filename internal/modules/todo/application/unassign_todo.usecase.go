@@ -0,0 +1,51 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type UnassignTodoCommand struct {
+	TodoID domain.TodoID
+}
+
+type UnassignTodoResponse struct{}
+
+type UnassignTodoHandler struct {
+	repo   domain.TodoRepository
+	wsProv WorkspaceProvider
+}
+
+var _ application.RequestHandler[UnassignTodoCommand, UnassignTodoResponse] = (*UnassignTodoHandler)(nil)
+
+func NewUnassignTodoHandler(repo domain.TodoRepository, wsProv WorkspaceProvider) *UnassignTodoHandler {
+	return &UnassignTodoHandler{repo: repo, wsProv: wsProv}
+}
+
+func (h *UnassignTodoHandler) Handle(ctx context.Context, cmd UnassignTodoCommand) (UnassignTodoResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	todo, err := h.repo.FindByID(ctx, cmd.TodoID)
+	if err != nil {
+		return UnassignTodoResponse{}, err
+	}
+
+	isMember, err := h.wsProv.IsMember(ctx, todo.WorkspaceID(), userDomain.UserID(meta.UserID))
+	if err != nil {
+		return UnassignTodoResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return UnassignTodoResponse{}, wsDomain.ErrNotOwner
+	}
+
+	todo.Unassign(userDomain.UserID(meta.UserID), time.Now())
+
+	return UnassignTodoResponse{}, h.repo.Save(ctx, todo)
+}
@@ -0,0 +1,89 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+// UpdateTagCommand renames and/or recolors a tag. Nil fields are left unchanged.
+type UpdateTagCommand struct {
+	WorkspaceID wsDomain.WorkspaceID
+	TagID       domain.TagID
+	Name        *string
+	Color       *string
+}
+
+func (c *UpdateTagCommand) Validate() error {
+	if c.Name != nil {
+		if _, err := domain.NewTagName(*c.Name); err != nil {
+			return err
+		}
+	}
+
+	if c.Color != nil {
+		if _, err := domain.NewTagColor(*c.Color); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type UpdateTagResponse struct{}
+
+type UpdateTagHandler struct {
+	repo   domain.TagRepository
+	wsProv WorkspaceProvider
+}
+
+var _ application.RequestHandler[UpdateTagCommand, UpdateTagResponse] = (*UpdateTagHandler)(nil)
+
+func NewUpdateTagHandler(repo domain.TagRepository, wsProv WorkspaceProvider) *UpdateTagHandler {
+	return &UpdateTagHandler{repo: repo, wsProv: wsProv}
+}
+
+func (h *UpdateTagHandler) Handle(ctx context.Context, cmd UpdateTagCommand) (UpdateTagResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	isMember, err := h.wsProv.IsMember(ctx, cmd.WorkspaceID, userDomain.UserID(meta.UserID))
+	if err != nil {
+		return UpdateTagResponse{}, err
+	}
+
+	if !isMember && !meta.IsSystem() {
+		return UpdateTagResponse{}, wsDomain.ErrNotOwner
+	}
+
+	tag, err := h.repo.FindByID(ctx, cmd.TagID)
+	if err != nil {
+		return UpdateTagResponse{}, err
+	}
+
+	if tag.WorkspaceID() != cmd.WorkspaceID {
+		return UpdateTagResponse{}, domain.ErrTagNotFound
+	}
+
+	now := time.Now()
+
+	if cmd.Name != nil {
+		name, _ := domain.NewTagName(*cmd.Name)
+		if err := ensureTagNameFree(ctx, h.repo, tag.WorkspaceID(), name, tag.ID()); err != nil {
+			return UpdateTagResponse{}, err
+		}
+
+		tag.Rename(name, now)
+	}
+
+	if cmd.Color != nil {
+		color, _ := domain.NewTagColor(*cmd.Color)
+		tag.Recolor(color, now)
+	}
+
+	return UpdateTagResponse{}, h.repo.Save(ctx, tag)
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"github.com/danicc097/todo-ddd-example/internal/apperrors"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+var (
+	ErrChecklistItemNotFound = shared.NewDomainError(apperrors.NotFound, "checklist item not found")
+	ErrChecklistItemExists   = shared.NewDomainError(apperrors.Conflict, "checklist item already exists")
+	ErrInvalidChecklistOrder = shared.NewDomainError(apperrors.InvalidInput, "checklist order must list every item exactly once")
+	ErrChecklistIncomplete   = shared.NewDomainError(apperrors.Unprocessable, "all required checklist items must be done")
+	ErrChecklistFull         = shared.NewDomainError(apperrors.Unprocessable, "checklist item limit reached")
+)
+
+// MaxChecklistItems bounds the size of the aggregate.
+const MaxChecklistItems = 100
+
+type ChecklistItemID = shared.ID[ChecklistItem]
+
+// ChecklistItem is a step of a todo. Its position is its index in the todo's checklist.
+type ChecklistItem struct {
+	id       ChecklistItemID
+	title    TodoTitle
+	done     bool
+	required bool
+}
+
+func NewChecklistItem(id ChecklistItemID, title TodoTitle, required bool) ChecklistItem {
+	return ChecklistItem{id: id, title: title, required: required}
+}
+
+type ReconstituteChecklistItemArgs struct {
+	ID       ChecklistItemID
+	Title    TodoTitle
+	Done     bool
+	Required bool
+}
+
+func ReconstituteChecklistItem(args ReconstituteChecklistItemArgs) ChecklistItem {
+	return ChecklistItem{id: args.ID, title: args.Title, done: args.Done, required: args.Required}
+}
+
+func (i ChecklistItem) ID() ChecklistItemID { return i.id }
+func (i ChecklistItem) Title() TodoTitle    { return i.title }
+func (i ChecklistItem) Done() bool          { return i.done }
+func (i ChecklistItem) Required() bool      { return i.required }
@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"slices"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/apperrors"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+var (
+	ErrCommentNotFound  = shared.NewDomainError(apperrors.NotFound, "comment not found")
+	ErrNotCommentAuthor = shared.NewDomainError(apperrors.Unauthorized, "only the author can modify a comment")
+)
+
+type CommentID = shared.ID[Comment]
+
+// Comment is a discussion message on a todo. It is its own aggregate so a todo's
+// history of comments never has to be loaded to change the todo.
+type Comment struct {
+	shared.AggregateRoot
+
+	id          CommentID
+	todoID      TodoID
+	workspaceID wsDomain.WorkspaceID
+	authorID    userDomain.UserID
+	body        CommentBody
+	createdAt   time.Time
+	editedAt    *time.Time
+	deletedAt   *time.Time
+}
+
+func NewComment(todo *Todo, authorID userDomain.UserID, body CommentBody, now time.Time) *Comment {
+	id := shared.NewID[Comment]()
+	c := &Comment{
+		id:          id,
+		todoID:      todo.ID(),
+		workspaceID: todo.WorkspaceID(),
+		authorID:    authorID,
+		body:        body,
+		createdAt:   now,
+	}
+
+	c.RecordEvent(CommentAddedEvent{
+		ID:       id,
+		TodoID:   c.todoID,
+		WsID:     c.workspaceID,
+		AuthorID: authorID,
+		Body:     body,
+		Occurred: now,
+	})
+
+	return c
+}
+
+type ReconstituteCommentArgs struct {
+	ID          CommentID
+	TodoID      TodoID
+	WorkspaceID wsDomain.WorkspaceID
+	AuthorID    userDomain.UserID
+	Body        CommentBody
+	CreatedAt   time.Time
+	EditedAt    *time.Time
+	DeletedAt   *time.Time
+}
+
+func ReconstituteComment(args ReconstituteCommentArgs) *Comment {
+	return &Comment{
+		id:          args.ID,
+		todoID:      args.TodoID,
+		workspaceID: args.WorkspaceID,
+		authorID:    args.AuthorID,
+		body:        args.Body,
+		createdAt:   args.CreatedAt,
+		editedAt:    args.EditedAt,
+		deletedAt:   args.DeletedAt,
+	}
+}
+
+func (c *Comment) ID() CommentID                     { return c.id }
+func (c *Comment) TodoID() TodoID                    { return c.todoID }
+func (c *Comment) WorkspaceID() wsDomain.WorkspaceID { return c.workspaceID }
+func (c *Comment) AuthorID() userDomain.UserID       { return c.authorID }
+func (c *Comment) Body() CommentBody                 { return c.body }
+func (c *Comment) CreatedAt() time.Time              { return c.createdAt }
+func (c *Comment) EditedAt() *time.Time              { return c.editedAt }
+func (c *Comment) DeletedAt() *time.Time             { return c.deletedAt }
+func (c *Comment) IsDeleted() bool                   { return c.deletedAt != nil }
+
+// Edit replaces the body. NewMentions on the event only lists users that were not
+// already mentioned, so they are notified once.
+func (c *Comment) Edit(body CommentBody, actorID userDomain.UserID, now time.Time) error {
+	if err := c.ensureEditable(actorID); err != nil {
+		return err
+	}
+
+	if c.body.String() == body.String() {
+		return nil
+	}
+
+	var newMentions []userDomain.UserID
+
+	for _, m := range body.Mentions() {
+		if !slices.Contains(c.body.Mentions(), m) {
+			newMentions = append(newMentions, m)
+		}
+	}
+
+	c.body = body
+	c.editedAt = &now
+
+	c.RecordEvent(CommentEditedEvent{
+		ID:          c.id,
+		TodoID:      c.todoID,
+		WsID:        c.workspaceID,
+		Body:        body,
+		NewMentions: newMentions,
+		Occurred:    now,
+		ActorID:     actorID,
+	})
+
+	return nil
+}
+
+// Delete soft deletes the comment.
+func (c *Comment) Delete(actorID userDomain.UserID, now time.Time) error {
+	if err := c.ensureEditable(actorID); err != nil {
+		return err
+	}
+
+	c.deletedAt = &now
+
+	c.RecordEvent(CommentDeletedEvent{
+		ID:       c.id,
+		TodoID:   c.todoID,
+		WsID:     c.workspaceID,
+		Occurred: now,
+		ActorID:  actorID,
+	})
+
+	return nil
+}
+
+func (c *Comment) ensureEditable(actorID userDomain.UserID) error {
+	if c.IsDeleted() {
+		return ErrCommentNotFound
+	}
+
+	if c.authorID != actorID {
+		return ErrNotCommentAuthor
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/danicc097/todo-ddd-example/internal/apperrors"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+var (
+	ErrCommentEmpty     = shared.NewDomainError(apperrors.InvalidInput, "comment cannot be empty")
+	ErrCommentTooLong   = shared.NewDomainError(apperrors.InvalidInput, "comment is too long")
+	ErrTooManyMentions  = shared.NewDomainError(apperrors.InvalidInput, "comment mentions too many users")
+	ErrMentionNotMember = shared.NewDomainError(apperrors.InvalidInput, "mentioned user is not a workspace member")
+)
+
+const (
+	commentMaxLen = 2000
+	// MaxMentions bounds the membership lookups a single comment can trigger.
+	MaxMentions = 20
+)
+
+var mentionPattern = regexp.MustCompile(`(?i)(?:^|[^\w@])@([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})\b`)
+
+// CommentBody is the text of a comment. Users are mentioned as "@<user id>",
+// leaving display names to clients.
+type CommentBody struct {
+	value    string
+	mentions []userDomain.UserID
+}
+
+func NewCommentBody(val string) (CommentBody, error) {
+	val = strings.TrimSpace(val)
+	if val == "" {
+		return CommentBody{}, ErrCommentEmpty
+	}
+
+	if len(val) > commentMaxLen {
+		return CommentBody{}, ErrCommentTooLong
+	}
+
+	mentions := parseMentions(val)
+	if len(mentions) > MaxMentions {
+		return CommentBody{}, ErrTooManyMentions
+	}
+
+	return CommentBody{value: val, mentions: mentions}, nil
+}
+
+// parseMentions returns the distinct mentioned users in order of appearance.
+func parseMentions(val string) []userDomain.UserID {
+	var mentions []userDomain.UserID
+
+	seen := make(map[uuid.UUID]bool)
+
+	for _, m := range mentionPattern.FindAllStringSubmatch(val, -1) {
+		id, err := uuid.Parse(m[1])
+		if err != nil || seen[id] {
+			continue
+		}
+
+		seen[id] = true
+		mentions = append(mentions, userDomain.UserID(id))
+	}
+
+	return mentions
+}
+
+func (b CommentBody) String() string { return b.value }
+
+// Mentions returns the distinct users mentioned in the body.
+func (b CommentBody) Mentions() []userDomain.UserID { return b.mentions }
@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+)
+
+func TestNewCommentBody(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := userDomain.UserID(uuid.New()), userDomain.UserID(uuid.New())
+
+	t.Run("should parse distinct mentions in order", func(t *testing.T) {
+		body, err := NewCommentBody(fmt.Sprintf(" @%s and @%s, ping @%s. mail@%s ", bob, alice, bob, uuid.New()))
+		require.NoError(t, err)
+		assert.Equal(t, []userDomain.UserID{bob, alice}, body.Mentions())
+		assert.False(t, strings.HasPrefix(body.String(), " "))
+	})
+
+	t.Run("should reject invalid bodies", func(t *testing.T) {
+		_, err := NewCommentBody("  ")
+		assert.ErrorIs(t, err, ErrCommentEmpty)
+
+		_, err = NewCommentBody(strings.Repeat("a", commentMaxLen+1))
+		assert.ErrorIs(t, err, ErrCommentTooLong)
+
+		var sb strings.Builder
+		for range MaxMentions + 1 {
+			sb.WriteString("@" + uuid.NewString() + " ")
+		}
+
+		_, err = NewCommentBody(sb.String())
+		assert.ErrorIs(t, err, ErrTooManyMentions)
+	})
+}
+
+func TestComment(t *testing.T) {
+	t.Parallel()
+
+	wsID := wsDomain.WorkspaceID(uuid.New())
+	authorID, otherID := userDomain.UserID(uuid.New()), userDomain.UserID(uuid.New())
+	now := time.Now()
+
+	newComment := func(text string) *Comment {
+		title, _ := NewTodoTitle("Discuss")
+		body, err := NewCommentBody(text)
+		require.NoError(t, err)
+
+		return NewComment(NewTodo(title, wsID), authorID, body, now)
+	}
+
+	t.Run("should record an added event", func(t *testing.T) {
+		c := newComment("hello")
+
+		require.Len(t, c.Events(), 1)
+		evt, ok := c.Events()[0].(CommentAddedEvent)
+		require.True(t, ok)
+		assert.Equal(t, c.TodoID(), evt.TodoID)
+		assert.Equal(t, wsID.UUID(), evt.WorkspaceID())
+	})
+
+	t.Run("should only report new mentions on edit", func(t *testing.T) {
+		c := newComment("hi @" + otherID.String())
+		c.ClearEvents()
+
+		third := userDomain.UserID(uuid.New())
+		body, _ := NewCommentBody(fmt.Sprintf("hi @%s and @%s", otherID, third))
+
+		require.NoError(t, c.Edit(body, authorID, now))
+		require.Len(t, c.Events(), 1)
+		assert.Equal(t, []userDomain.UserID{third}, c.Events()[0].(CommentEditedEvent).NewMentions)
+		assert.Equal(t, &now, c.EditedAt())
+
+		require.NoError(t, c.Edit(body, authorID, now))
+		assert.Len(t, c.Events(), 1)
+	})
+
+	t.Run("should only let the author modify it", func(t *testing.T) {
+		c := newComment("mine")
+		body, _ := NewCommentBody("yours")
+
+		assert.ErrorIs(t, c.Edit(body, otherID, now), ErrNotCommentAuthor)
+		assert.ErrorIs(t, c.Delete(otherID, now), ErrNotCommentAuthor)
+	})
+
+	t.Run("should soft delete once", func(t *testing.T) {
+		c := newComment("bye")
+		c.ClearEvents()
+
+		require.NoError(t, c.Delete(authorID, now))
+		assert.True(t, c.IsDeleted())
+		require.Len(t, c.Events(), 1)
+		assert.IsType(t, CommentDeletedEvent{}, c.Events()[0])
+
+		assert.ErrorIs(t, c.Delete(authorID, now), ErrCommentNotFound)
+	})
+}
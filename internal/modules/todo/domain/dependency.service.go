@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/apperrors"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+var (
+	ErrTodoBlocked           = shared.NewDomainError(apperrors.Unprocessable, "todo is blocked by unfinished todos")
+	ErrBlockerNotFound       = shared.NewDomainError(apperrors.NotFound, "blocker not found")
+	ErrBlockerExists         = shared.NewDomainError(apperrors.Conflict, "todo is already blocked by this todo")
+	ErrTooManyBlockers       = shared.NewDomainError(apperrors.Unprocessable, "blocker limit reached")
+	ErrSelfBlocking          = shared.NewDomainError(apperrors.InvalidInput, "todo cannot block itself")
+	ErrBlockerOtherWorkspace = shared.NewDomainError(apperrors.InvalidInput, "blocker must belong to the same workspace")
+	ErrBlockerNotPending     = shared.NewDomainError(apperrors.Unprocessable, "blocker must be pending")
+	ErrDependencyCycle       = shared.NewDomainError(apperrors.Conflict, "dependency would create a cycle")
+)
+
+// MaxBlockers bounds the number of unresolved blockers of a single todo.
+const MaxBlockers = 50
+
+// DependencyGraph exposes the unresolved blocked-by links between todos.
+type DependencyGraph interface {
+	FindBlockerIDs(ctx context.Context, id TodoID) ([]TodoID, error)
+}
+
+// DependencyService links todos while keeping the blocked-by graph acyclic.
+type DependencyService struct {
+	graph DependencyGraph
+}
+
+func NewDependencyService(graph DependencyGraph) *DependencyService {
+	return &DependencyService{graph: graph}
+}
+
+// AddBlocker makes todo wait on blocker. Both must be in the same workspace, and
+// blocker must not already depend on todo, directly or transitively.
+func (s *DependencyService) AddBlocker(ctx context.Context, todo, blocker *Todo, actorID userDomain.UserID, now time.Time) error {
+	if todo.ID() == blocker.ID() {
+		return ErrSelfBlocking
+	}
+
+	if todo.WorkspaceID() != blocker.WorkspaceID() {
+		return ErrBlockerOtherWorkspace
+	}
+
+	if todo.Status() != StatusPending {
+		return ErrInvalidStatus
+	}
+
+	if blocker.Status() != StatusPending {
+		return ErrBlockerNotPending
+	}
+
+	cyclic, err := s.reaches(ctx, blocker.ID(), todo.ID())
+	if err != nil {
+		return err
+	}
+
+	if cyclic {
+		return ErrDependencyCycle
+	}
+
+	return todo.addBlocker(blocker.ID(), actorID, now)
+}
+
+// reaches walks blocked-by links breadth-first from 'from' looking for target.
+func (s *DependencyService) reaches(ctx context.Context, from, target TodoID) (bool, error) {
+	visited := map[TodoID]bool{from: true}
+	queue := []TodoID{from}
+
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		blockers, err := s.graph.FindBlockerIDs(ctx, id)
+		if err != nil {
+			return false, err
+		}
+
+		for _, b := range blockers {
+			if b == target {
+				return true, nil
+			}
+
+			if !visited[b] {
+				visited[b] = true
+				queue = append(queue, b)
+			}
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+)
+
+// graphFromTodos serves blockers straight from in-memory aggregates.
+type graphFromTodos map[TodoID]*Todo
+
+func (g graphFromTodos) FindBlockerIDs(_ context.Context, id TodoID) ([]TodoID, error) {
+	if t, ok := g[id]; ok {
+		return t.BlockedBy(), nil
+	}
+
+	return nil, nil
+}
+
+func TestDependencyService_AddBlocker(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	wsID := wsDomain.WorkspaceID(uuid.New())
+	actorID := userDomain.UserID(uuid.New())
+	now := time.Now()
+
+	newTodo := func(name string) *Todo {
+		title, _ := NewTodoTitle(name)
+		return NewTodo(title, wsID)
+	}
+
+	t.Run("should block and record an event", func(t *testing.T) {
+		a, b := newTodo("A"), newTodo("B")
+		svc := NewDependencyService(graphFromTodos{a.ID(): a, b.ID(): b})
+		b.ClearEvents()
+
+		require.NoError(t, svc.AddBlocker(ctx, b, a, actorID, now))
+		assert.Equal(t, []TodoID{a.ID()}, b.BlockedBy())
+		assert.True(t, b.IsBlocked())
+		require.Len(t, b.Events(), 1)
+		assert.Equal(t, TodoBlockedEvent{
+			ID: b.ID(), WsID: wsID, BlockerID: a.ID(), Occurred: now, ActorID: actorID,
+		}, b.Events()[0])
+
+		assert.ErrorIs(t, svc.AddBlocker(ctx, b, a, actorID, now), ErrBlockerExists)
+	})
+
+	t.Run("should reject direct and transitive cycles", func(t *testing.T) {
+		a, b, c := newTodo("A"), newTodo("B"), newTodo("C")
+		svc := NewDependencyService(graphFromTodos{a.ID(): a, b.ID(): b, c.ID(): c})
+
+		require.NoError(t, svc.AddBlocker(ctx, b, a, actorID, now))
+		require.NoError(t, svc.AddBlocker(ctx, c, b, actorID, now))
+
+		assert.ErrorIs(t, svc.AddBlocker(ctx, a, b, actorID, now), ErrDependencyCycle)
+		assert.ErrorIs(t, svc.AddBlocker(ctx, a, c, actorID, now), ErrDependencyCycle)
+		assert.ErrorIs(t, svc.AddBlocker(ctx, a, a, actorID, now), ErrSelfBlocking)
+		assert.False(t, a.IsBlocked())
+
+		// diamonds are fine
+		require.NoError(t, svc.AddBlocker(ctx, c, a, actorID, now))
+	})
+
+	t.Run("should reject blockers from other workspaces or not pending", func(t *testing.T) {
+		a, b := newTodo("A"), newTodo("B")
+		title, _ := NewTodoTitle("Other")
+		other := NewTodo(title, wsDomain.WorkspaceID(uuid.New()))
+		svc := NewDependencyService(graphFromTodos{})
+
+		assert.ErrorIs(t, svc.AddBlocker(ctx, b, other, actorID, now), ErrBlockerOtherWorkspace)
+
+		require.NoError(t, a.Complete(actorID, now))
+		assert.ErrorIs(t, svc.AddBlocker(ctx, b, a, actorID, now), ErrBlockerNotPending)
+		assert.ErrorIs(t, svc.AddBlocker(ctx, a, b, actorID, now), ErrInvalidStatus)
+	})
+}
+
+func TestTodo_Blocked(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	wsID := wsDomain.WorkspaceID(uuid.New())
+	actorID := userDomain.UserID(uuid.New())
+	now := time.Now()
+
+	newTodo := func(name string) *Todo {
+		title, _ := NewTodoTitle(name)
+		return NewTodo(title, wsID)
+	}
+
+	t.Run("should refuse to complete or focus while blocked", func(t *testing.T) {
+		a, b := newTodo("A"), newTodo("B")
+		require.NoError(t, NewDependencyService(graphFromTodos{}).AddBlocker(ctx, b, a, actorID, now))
+
+		assert.ErrorIs(t, b.Complete(actorID, now), ErrTodoBlocked)
+		assert.ErrorIs(t, b.StartFocus(actorID, FocusSessionID(uuid.New()), 0), ErrTodoBlocked)
+		assert.Equal(t, StatusPending, b.Status())
+	})
+
+	t.Run("should unblock once the last blocker is resolved", func(t *testing.T) {
+		a, b, c := newTodo("A"), newTodo("B"), newTodo("C")
+		svc := NewDependencyService(graphFromTodos{})
+		require.NoError(t, svc.AddBlocker(ctx, c, a, actorID, now))
+		require.NoError(t, svc.AddBlocker(ctx, c, b, actorID, now))
+		c.ClearEvents()
+
+		c.ResolveBlocker(a.ID(), now)
+		assert.True(t, c.IsBlocked())
+		assert.Empty(t, c.Events())
+
+		c.ResolveBlocker(a.ID(), now)
+		assert.Empty(t, c.Events())
+
+		require.NoError(t, c.RemoveBlocker(b.ID(), actorID, now))
+		assert.False(t, c.IsBlocked())
+		require.Len(t, c.Events(), 2)
+		assert.IsType(t, TodoBlockerRemovedEvent{}, c.Events()[0])
+		assert.Equal(t, TodoUnblockedEvent{ID: c.ID(), WsID: wsID, Occurred: now}, c.Events()[1])
+
+		assert.ErrorIs(t, c.RemoveBlocker(b.ID(), actorID, now), ErrBlockerNotFound)
+		require.NoError(t, c.Complete(actorID, now))
+	})
+}
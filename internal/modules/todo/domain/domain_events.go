@@ -15,13 +15,36 @@ var (
 	_ shared.DomainEvent = (*TodoCompletedEvent)(nil)
 	_ shared.DomainEvent = (*TagAddedEvent)(nil)
 	_ shared.DomainEvent = (*TagCreatedEvent)(nil)
+	_ shared.DomainEvent = (*TagRemovedEvent)(nil)
+	_ shared.DomainEvent = (*TagRenamedEvent)(nil)
+	_ shared.DomainEvent = (*TagRecoloredEvent)(nil)
+	_ shared.DomainEvent = (*TagDeletedEvent)(nil)
 	_ shared.DomainEvent = (*TodoRolledOverEvent)(nil)
 	_ shared.DomainEvent = (*TodoDeletedEvent)(nil)
+	_ shared.DomainEvent = (*TodoRenamedEvent)(nil)
+	_ shared.DomainEvent = (*TodoArchivedEvent)(nil)
+	_ shared.DomainEvent = (*TodoReopenedEvent)(nil)
+	_ shared.DomainEvent = (*TodoDueDateChangedEvent)(nil)
+	_ shared.DomainEvent = (*TodoRecurrenceChangedEvent)(nil)
+	_ shared.DomainEvent = (*ChecklistItemAddedEvent)(nil)
+	_ shared.DomainEvent = (*ChecklistItemToggledEvent)(nil)
+	_ shared.DomainEvent = (*ChecklistReorderedEvent)(nil)
+	_ shared.DomainEvent = (*ChecklistItemRemovedEvent)(nil)
+	_ shared.DomainEvent = (*TodoBlockedEvent)(nil)
+	_ shared.DomainEvent = (*TodoBlockerRemovedEvent)(nil)
+	_ shared.DomainEvent = (*TodoUnblockedEvent)(nil)
+	_ shared.DomainEvent = (*TodoAssignedEvent)(nil)
+	_ shared.DomainEvent = (*TodoUnassignedEvent)(nil)
+	_ shared.DomainEvent = (*CommentAddedEvent)(nil)
+	_ shared.DomainEvent = (*CommentEditedEvent)(nil)
+	_ shared.DomainEvent = (*CommentDeletedEvent)(nil)
+	_ shared.DomainEvent = (*TodoFocusElapsedEvent)(nil)
 )
 
 type TagCreatedEvent struct {
 	ID       TagID
 	Name     TagName
+	Color    TagColor
 	WsID     wsDomain.WorkspaceID
 	Occurred time.Time
 }
@@ -32,6 +55,45 @@ func (e TagCreatedEvent) AggregateID() uuid.UUID              { return e.ID.UUID
 func (e TagCreatedEvent) AggregateType() shared.AggregateType { return shared.AggTag }
 func (e TagCreatedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
 
+type TagRenamedEvent struct {
+	ID       TagID
+	WsID     wsDomain.WorkspaceID
+	OldName  TagName
+	Name     TagName
+	Occurred time.Time
+}
+
+func (e TagRenamedEvent) EventName() shared.EventType         { return shared.TodoTagRenamed }
+func (e TagRenamedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TagRenamedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e TagRenamedEvent) AggregateType() shared.AggregateType { return shared.AggTag }
+func (e TagRenamedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
+type TagRecoloredEvent struct {
+	ID       TagID
+	WsID     wsDomain.WorkspaceID
+	Color    TagColor
+	Occurred time.Time
+}
+
+func (e TagRecoloredEvent) EventName() shared.EventType         { return shared.TodoTagRecolored }
+func (e TagRecoloredEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TagRecoloredEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e TagRecoloredEvent) AggregateType() shared.AggregateType { return shared.AggTag }
+func (e TagRecoloredEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
+type TagDeletedEvent struct {
+	ID       TagID
+	WsID     wsDomain.WorkspaceID
+	Occurred time.Time
+}
+
+func (e TagDeletedEvent) EventName() shared.EventType         { return shared.TodoTagDeleted }
+func (e TagDeletedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TagDeletedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e TagDeletedEvent) AggregateType() shared.AggregateType { return shared.AggTag }
+func (e TagDeletedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
 type TodoCreatedEvent struct {
 	ID        TodoID
 	WsID      wsDomain.WorkspaceID
@@ -76,6 +138,20 @@ func (e TagAddedEvent) AggregateID() uuid.UUID              { return e.TodoID.UU
 func (e TagAddedEvent) AggregateType() shared.AggregateType { return shared.AggTodo }
 func (e TagAddedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
 
+type TagRemovedEvent struct {
+	TodoID   TodoID
+	TagID    TagID
+	WsID     wsDomain.WorkspaceID
+	Occurred time.Time
+	ActorID  userDomain.UserID
+}
+
+func (e TagRemovedEvent) EventName() shared.EventType         { return shared.TodoTagRemoved }
+func (e TagRemovedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TagRemovedEvent) AggregateID() uuid.UUID              { return e.TodoID.UUID() }
+func (e TagRemovedEvent) AggregateType() shared.AggregateType { return shared.AggTodo }
+func (e TagRemovedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
 type TodoRolledOverEvent struct {
 	ID         TodoID
 	WsID       wsDomain.WorkspaceID
@@ -101,3 +177,272 @@ func (e TodoDeletedEvent) OccurredAt() time.Time               { return e.Occurr
 func (e TodoDeletedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
 func (e TodoDeletedEvent) AggregateType() shared.AggregateType { return shared.AggTodo }
 func (e TodoDeletedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
+type TodoRenamedEvent struct {
+	ID       TodoID
+	WsID     wsDomain.WorkspaceID
+	OldTitle TodoTitle
+	Title    TodoTitle
+	Occurred time.Time
+	ActorID  userDomain.UserID
+}
+
+func (e TodoRenamedEvent) EventName() shared.EventType         { return shared.TodoRenamed }
+func (e TodoRenamedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TodoRenamedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e TodoRenamedEvent) AggregateType() shared.AggregateType { return shared.AggTodo }
+func (e TodoRenamedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
+type TodoArchivedEvent struct {
+	ID       TodoID
+	WsID     wsDomain.WorkspaceID
+	Occurred time.Time
+	ActorID  userDomain.UserID
+}
+
+func (e TodoArchivedEvent) EventName() shared.EventType         { return shared.TodoArchived }
+func (e TodoArchivedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TodoArchivedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e TodoArchivedEvent) AggregateType() shared.AggregateType { return shared.AggTodo }
+func (e TodoArchivedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
+// TodoReopenedEvent is recorded when a completed or archived todo returns to pending.
+type TodoReopenedEvent struct {
+	ID             TodoID
+	WsID           wsDomain.WorkspaceID
+	PreviousStatus TodoStatus
+	Occurred       time.Time
+	ActorID        userDomain.UserID
+}
+
+func (e TodoReopenedEvent) EventName() shared.EventType         { return shared.TodoReopened }
+func (e TodoReopenedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TodoReopenedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e TodoReopenedEvent) AggregateType() shared.AggregateType { return shared.AggTodo }
+func (e TodoReopenedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
+type TodoDueDateChangedEvent struct {
+	ID       TodoID
+	WsID     wsDomain.WorkspaceID
+	DueDate  *time.Time
+	Occurred time.Time
+	ActorID  userDomain.UserID
+}
+
+func (e TodoDueDateChangedEvent) EventName() shared.EventType         { return shared.TodoDueDateChanged }
+func (e TodoDueDateChangedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TodoDueDateChangedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e TodoDueDateChangedEvent) AggregateType() shared.AggregateType { return shared.AggTodo }
+func (e TodoDueDateChangedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
+type TodoRecurrenceChangedEvent struct {
+	ID         TodoID
+	WsID       wsDomain.WorkspaceID
+	Recurrence *RecurrenceRule
+	Occurred   time.Time
+	ActorID    userDomain.UserID
+}
+
+func (e TodoRecurrenceChangedEvent) EventName() shared.EventType         { return shared.TodoRecurrenceChanged }
+func (e TodoRecurrenceChangedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TodoRecurrenceChangedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e TodoRecurrenceChangedEvent) AggregateType() shared.AggregateType { return shared.AggTodo }
+func (e TodoRecurrenceChangedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
+type ChecklistItemAddedEvent struct {
+	ID       TodoID
+	WsID     wsDomain.WorkspaceID
+	ItemID   ChecklistItemID
+	Title    TodoTitle
+	Required bool
+	Occurred time.Time
+	ActorID  userDomain.UserID
+}
+
+func (e ChecklistItemAddedEvent) EventName() shared.EventType         { return shared.TodoChecklistItemAdded }
+func (e ChecklistItemAddedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e ChecklistItemAddedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e ChecklistItemAddedEvent) AggregateType() shared.AggregateType { return shared.AggTodo }
+func (e ChecklistItemAddedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
+type ChecklistItemToggledEvent struct {
+	ID       TodoID
+	WsID     wsDomain.WorkspaceID
+	ItemID   ChecklistItemID
+	Done     bool
+	Occurred time.Time
+	ActorID  userDomain.UserID
+}
+
+func (e ChecklistItemToggledEvent) EventName() shared.EventType {
+	return shared.TodoChecklistItemToggled
+}
+func (e ChecklistItemToggledEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e ChecklistItemToggledEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e ChecklistItemToggledEvent) AggregateType() shared.AggregateType { return shared.AggTodo }
+func (e ChecklistItemToggledEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
+// ChecklistReorderedEvent carries the full new order of the checklist.
+type ChecklistReorderedEvent struct {
+	ID       TodoID
+	WsID     wsDomain.WorkspaceID
+	ItemIDs  []ChecklistItemID
+	Occurred time.Time
+	ActorID  userDomain.UserID
+}
+
+func (e ChecklistReorderedEvent) EventName() shared.EventType         { return shared.TodoChecklistReordered }
+func (e ChecklistReorderedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e ChecklistReorderedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e ChecklistReorderedEvent) AggregateType() shared.AggregateType { return shared.AggTodo }
+func (e ChecklistReorderedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
+type ChecklistItemRemovedEvent struct {
+	ID       TodoID
+	WsID     wsDomain.WorkspaceID
+	ItemID   ChecklistItemID
+	Occurred time.Time
+	ActorID  userDomain.UserID
+}
+
+func (e ChecklistItemRemovedEvent) EventName() shared.EventType {
+	return shared.TodoChecklistItemRemoved
+}
+func (e ChecklistItemRemovedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e ChecklistItemRemovedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e ChecklistItemRemovedEvent) AggregateType() shared.AggregateType { return shared.AggTodo }
+func (e ChecklistItemRemovedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
+type TodoBlockedEvent struct {
+	ID        TodoID
+	WsID      wsDomain.WorkspaceID
+	BlockerID TodoID
+	Occurred  time.Time
+	ActorID   userDomain.UserID
+}
+
+func (e TodoBlockedEvent) EventName() shared.EventType         { return shared.TodoBlocked }
+func (e TodoBlockedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TodoBlockedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e TodoBlockedEvent) AggregateType() shared.AggregateType { return shared.AggTodo }
+func (e TodoBlockedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
+type TodoBlockerRemovedEvent struct {
+	ID        TodoID
+	WsID      wsDomain.WorkspaceID
+	BlockerID TodoID
+	Occurred  time.Time
+	ActorID   userDomain.UserID
+}
+
+func (e TodoBlockerRemovedEvent) EventName() shared.EventType         { return shared.TodoBlockerRemoved }
+func (e TodoBlockerRemovedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TodoBlockerRemovedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e TodoBlockerRemovedEvent) AggregateType() shared.AggregateType { return shared.AggTodo }
+func (e TodoBlockerRemovedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
+// TodoUnblockedEvent is recorded once the last blocker of a todo is gone.
+type TodoUnblockedEvent struct {
+	ID       TodoID
+	WsID     wsDomain.WorkspaceID
+	Occurred time.Time
+}
+
+func (e TodoUnblockedEvent) EventName() shared.EventType         { return shared.TodoUnblocked }
+func (e TodoUnblockedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TodoUnblockedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e TodoUnblockedEvent) AggregateType() shared.AggregateType { return shared.AggTodo }
+func (e TodoUnblockedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
+type TodoAssignedEvent struct {
+	ID         TodoID
+	WsID       wsDomain.WorkspaceID
+	AssigneeID userDomain.UserID
+	Occurred   time.Time
+	ActorID    userDomain.UserID
+}
+
+func (e TodoAssignedEvent) EventName() shared.EventType         { return shared.TodoAssigned }
+func (e TodoAssignedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TodoAssignedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e TodoAssignedEvent) AggregateType() shared.AggregateType { return shared.AggTodo }
+func (e TodoAssignedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
+// TodoUnassignedEvent carries the assignee that was removed.
+type TodoUnassignedEvent struct {
+	ID         TodoID
+	WsID       wsDomain.WorkspaceID
+	AssigneeID userDomain.UserID
+	Occurred   time.Time
+	ActorID    userDomain.UserID
+}
+
+func (e TodoUnassignedEvent) EventName() shared.EventType         { return shared.TodoUnassigned }
+func (e TodoUnassignedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TodoUnassignedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e TodoUnassignedEvent) AggregateType() shared.AggregateType { return shared.AggTodo }
+func (e TodoUnassignedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
+// TodoFocusElapsedEvent is recorded when a focus session is stopped automatically.
+type TodoFocusElapsedEvent struct {
+	ID              TodoID
+	WsID            wsDomain.WorkspaceID
+	SessionID       FocusSessionID
+	UserID          userDomain.UserID
+	StartedAt       time.Time
+	PlannedDuration time.Duration
+	Reason          FocusEndReason
+	Occurred        time.Time
+}
+
+func (e TodoFocusElapsedEvent) EventName() shared.EventType         { return shared.TodoFocusElapsed }
+func (e TodoFocusElapsedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e TodoFocusElapsedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e TodoFocusElapsedEvent) AggregateType() shared.AggregateType { return shared.AggTodo }
+func (e TodoFocusElapsedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
+// CommentAddedEvent carries the body so subscribers can render and notify mentions.
+type CommentAddedEvent struct {
+	ID       CommentID
+	TodoID   TodoID
+	WsID     wsDomain.WorkspaceID
+	AuthorID userDomain.UserID
+	Body     CommentBody
+	Occurred time.Time
+}
+
+func (e CommentAddedEvent) EventName() shared.EventType         { return shared.TodoCommentAdded }
+func (e CommentAddedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e CommentAddedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e CommentAddedEvent) AggregateType() shared.AggregateType { return shared.AggComment }
+func (e CommentAddedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
+type CommentEditedEvent struct {
+	ID          CommentID
+	TodoID      TodoID
+	WsID        wsDomain.WorkspaceID
+	Body        CommentBody
+	NewMentions []userDomain.UserID
+	Occurred    time.Time
+	ActorID     userDomain.UserID
+}
+
+func (e CommentEditedEvent) EventName() shared.EventType         { return shared.TodoCommentEdited }
+func (e CommentEditedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e CommentEditedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e CommentEditedEvent) AggregateType() shared.AggregateType { return shared.AggComment }
+func (e CommentEditedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
+
+type CommentDeletedEvent struct {
+	ID       CommentID
+	TodoID   TodoID
+	WsID     wsDomain.WorkspaceID
+	Occurred time.Time
+	ActorID  userDomain.UserID
+}
+
+func (e CommentDeletedEvent) EventName() shared.EventType         { return shared.TodoCommentDeleted }
+func (e CommentDeletedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e CommentDeletedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e CommentDeletedEvent) AggregateType() shared.AggregateType { return shared.AggComment }
+func (e CommentDeletedEvent) WorkspaceID() uuid.UUID              { return e.WsID.UUID() }
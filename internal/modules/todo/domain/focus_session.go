@@ -15,6 +15,23 @@ var (
 	ErrNoActiveFocusSession       = shared.NewDomainError(apperrors.Unprocessable, "no active focus session found")
 	ErrCannotFocusOnCompletedTask = shared.NewDomainError(apperrors.Unprocessable, "cannot focus on a completed task")
 	ErrInvalidFocusStopTimeAfter  = shared.NewDomainError(apperrors.InvalidInput, "stop time must be after start time")
+	ErrInvalidFocusDuration       = shared.NewDomainError(apperrors.InvalidInput, "planned focus duration must be between 1 minute and 4 hours")
+	ErrUserAlreadyFocusing        = shared.NewDomainError(apperrors.Conflict, "user already has an active focus session")
+)
+
+const (
+	MinFocusPlannedDuration = 1 * time.Minute
+	MaxFocusPlannedDuration = 4 * time.Hour
+)
+
+// FocusEndReason tells why a session was stopped without the user asking.
+type FocusEndReason string
+
+const (
+	// FocusCompleted means the planned duration was reached.
+	FocusCompleted FocusEndReason = "completed"
+	// FocusExpired means an unplanned or overly long session hit the maximum allowed duration.
+	FocusExpired FocusEndReason = "expired"
 )
 
 type FocusSessionID uuid.UUID
@@ -26,25 +43,48 @@ type FocusSession struct {
 	userID    userDomain.UserID
 	startTime time.Time
 	endTime   *time.Time
+	planned   time.Duration // zero when unbounded
 }
 
-func NewFocusSession(id FocusSessionID, userID userDomain.UserID, start time.Time) FocusSession {
-	return FocusSession{id: id, userID: userID, startTime: start}
+// NewFocusSession starts a session. A zero planned duration leaves it open until stopped.
+func NewFocusSession(id FocusSessionID, userID userDomain.UserID, start time.Time, planned time.Duration) (FocusSession, error) {
+	if planned != 0 && (planned < MinFocusPlannedDuration || planned > MaxFocusPlannedDuration) {
+		return FocusSession{}, ErrInvalidFocusDuration
+	}
+
+	return FocusSession{id: id, userID: userID, startTime: start, planned: planned}, nil
 }
 
 type ReconstituteFocusSessionArgs struct {
-	ID        FocusSessionID
-	UserID    userDomain.UserID
-	StartTime time.Time
-	EndTime   *time.Time
+	ID              FocusSessionID
+	UserID          userDomain.UserID
+	StartTime       time.Time
+	EndTime         *time.Time
+	PlannedDuration time.Duration
 }
 
 func ReconstituteFocusSession(args ReconstituteFocusSessionArgs) FocusSession {
-	return FocusSession{id: args.ID, userID: args.UserID, startTime: args.StartTime, endTime: args.EndTime}
+	return FocusSession{
+		id:        args.ID,
+		userID:    args.UserID,
+		startTime: args.StartTime,
+		endTime:   args.EndTime,
+		planned:   args.PlannedDuration,
+	}
+}
+
+// ElapsesAt returns when the session ends by itself: at its planned end, capped at maxDuration.
+func (s FocusSession) ElapsesAt(maxDuration time.Duration) time.Time {
+	if s.planned > 0 && s.planned < maxDuration {
+		return s.startTime.Add(s.planned)
+	}
+
+	return s.startTime.Add(maxDuration)
 }
 
-func (s FocusSession) IsActive() bool            { return s.endTime == nil }
-func (s FocusSession) ID() FocusSessionID        { return s.id }
-func (s FocusSession) UserID() userDomain.UserID { return s.userID }
-func (s FocusSession) StartTime() time.Time      { return s.startTime }
-func (s FocusSession) EndTime() *time.Time       { return s.endTime }
+func (s FocusSession) IsActive() bool                 { return s.endTime == nil }
+func (s FocusSession) ID() FocusSessionID             { return s.id }
+func (s FocusSession) UserID() userDomain.UserID      { return s.userID }
+func (s FocusSession) StartTime() time.Time           { return s.startTime }
+func (s FocusSession) EndTime() *time.Time            { return s.endTime }
+func (s FocusSession) PlannedDuration() time.Duration { return s.planned }
@@ -1,6 +1,11 @@
 package domain
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/danicc097/todo-ddd-example/internal/apperrors"
@@ -10,8 +15,13 @@ import (
 var (
 	ErrCannotCompleteFutureOccurrence = shared.NewDomainError(apperrors.Unprocessable, "cannot complete future occurrence")
 	ErrInvalidRecurrenceRule          = shared.NewDomainError(apperrors.InvalidInput, "invalid recurrence rule")
+	ErrRecurrenceRequiresDueDate      = shared.NewDomainError(apperrors.InvalidInput, "recurrence requires a due date")
 )
 
+// maxRecurrencePeriods bounds the search for the next occurrence so that rules
+// which can never match (e.g. BYMONTHDAY=31 every other February) terminate.
+const maxRecurrencePeriods = 1000
+
 type RecurrenceInterval string
 
 const (
@@ -20,9 +30,38 @@ const (
 	Monthly RecurrenceInterval = "MONTHLY"
 )
 
+// WeekdayNum is a BYDAY entry, e.g. MO, 2TU or -1FR.
+// A zero ordinal matches every such weekday in the period.
+type WeekdayNum struct {
+	ordinal int
+	day     time.Weekday
+}
+
+func (d WeekdayNum) Ordinal() int      { return d.ordinal }
+func (d WeekdayNum) Day() time.Weekday { return d.day }
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// RecurrenceRule is a subset of RFC 5545 RRULE: FREQ (DAILY, WEEKLY, MONTHLY),
+// INTERVAL, BYDAY, BYMONTHDAY, BYSETPOS, COUNT and UNTIL, plus a TZID extension
+// holding the IANA timezone occurrences are evaluated in.
 type RecurrenceRule struct {
-	interval RecurrenceInterval
-	amount   int
+	interval   RecurrenceInterval
+	amount     int
+	byDay      []WeekdayNum
+	byMonthDay []int
+	bySetPos   []int
+	count      int
+	until      *time.Time
+	tz         string
 }
 
 func NewRecurrenceRule(interval string, amount int) (RecurrenceRule, error) {
@@ -39,7 +78,220 @@ func NewRecurrenceRule(interval string, amount int) (RecurrenceRule, error) {
 	return RecurrenceRule{}, ErrInvalidRecurrenceRule
 }
 
-func (r RecurrenceRule) CalculateNext(from time.Time) time.Time {
+// ParseRecurrenceRule parses an RRULE string such as
+// "FREQ=MONTHLY;BYDAY=TU;BYSETPOS=2;UNTIL=20270101T000000Z;TZID=Europe/Madrid".
+// The "RRULE:" prefix is optional.
+func ParseRecurrenceRule(s string) (RecurrenceRule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if s == "" {
+		return RecurrenceRule{}, ErrInvalidRecurrenceRule
+	}
+
+	r := RecurrenceRule{amount: 1}
+	seen := make(map[string]bool)
+
+	// a date-only UNTIL is resolved once TZID, which may come later, is known
+	var rawUntil string
+
+	for part := range strings.SplitSeq(s, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		key = strings.ToUpper(strings.TrimSpace(key))
+
+		if !ok || value == "" || seen[key] {
+			return RecurrenceRule{}, invalidRule("malformed part %q", part)
+		}
+
+		seen[key] = true
+
+		var err error
+
+		switch key {
+		case "FREQ":
+			r.interval = RecurrenceInterval(strings.ToUpper(value))
+		case "INTERVAL":
+			r.amount, err = strconv.Atoi(value)
+			if err == nil && r.amount <= 0 {
+				err = errors.New("must be positive")
+			}
+		case "COUNT":
+			r.count, err = strconv.Atoi(value)
+			if err == nil && r.count <= 0 {
+				err = errors.New("must be positive")
+			}
+		case "UNTIL":
+			rawUntil = value
+		case "BYDAY":
+			r.byDay, err = parseByDay(value)
+		case "BYMONTHDAY":
+			r.byMonthDay, err = parseIntList(value, 31)
+		case "BYSETPOS":
+			r.bySetPos, err = parseIntList(value, 366)
+		case "TZID":
+			// LoadLocation maps "Local" to the server zone
+			if value == "Local" {
+				err = fmt.Errorf("invalid timezone %q", value)
+			} else if _, err = time.LoadLocation(value); err == nil {
+				r.tz = value
+			}
+		default:
+			err = errors.New("unsupported part")
+		}
+
+		if err != nil {
+			return RecurrenceRule{}, fmt.Errorf("%w: %s: %w", ErrInvalidRecurrenceRule, key, err)
+		}
+	}
+
+	if rawUntil != "" {
+		loc := r.Location()
+		if loc == nil {
+			loc = time.UTC
+		}
+
+		until, err := parseUntil(rawUntil, loc)
+		if err != nil {
+			return RecurrenceRule{}, fmt.Errorf("%w: UNTIL: %w", ErrInvalidRecurrenceRule, err)
+		}
+
+		r.until = &until
+	}
+
+	if err := r.validate(); err != nil {
+		return RecurrenceRule{}, err
+	}
+
+	return r, nil
+}
+
+func (r RecurrenceRule) validate() error {
+	switch r.interval {
+	case Daily, Weekly, Monthly:
+	default:
+		return invalidRule("FREQ must be one of DAILY, WEEKLY, MONTHLY")
+	}
+
+	if r.count > 0 && r.until != nil {
+		return invalidRule("COUNT and UNTIL are mutually exclusive")
+	}
+
+	if len(r.bySetPos) > 0 && len(r.byDay) == 0 && len(r.byMonthDay) == 0 {
+		return invalidRule("BYSETPOS requires BYDAY or BYMONTHDAY")
+	}
+
+	if r.interval == Weekly && len(r.byMonthDay) > 0 {
+		return invalidRule("BYMONTHDAY is not allowed with FREQ=WEEKLY")
+	}
+
+	if r.interval != Monthly {
+		for _, d := range r.byDay {
+			if d.ordinal != 0 {
+				return invalidRule("BYDAY ordinals require FREQ=MONTHLY")
+			}
+		}
+	}
+
+	return nil
+}
+
+func invalidRule(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidRecurrenceRule, fmt.Sprintf(format, args...))
+}
+
+// parseUntil accepts UTC date-times and plain dates, the latter being inclusive
+// of the whole day in loc.
+func parseUntil(v string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", v); err == nil {
+		return t, nil
+	}
+
+	if t, err := time.ParseInLocation("20060102", v, loc); err == nil {
+		return t.AddDate(0, 0, 1).Add(-time.Second), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q", v)
+}
+
+func parseByDay(v string) ([]WeekdayNum, error) {
+	var days []WeekdayNum
+
+	for item := range strings.SplitSeq(strings.ToUpper(v), ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("invalid weekday %q", item)
+		}
+
+		day, ok := rruleWeekdays[item[len(item)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid weekday %q", item)
+		}
+
+		wd := WeekdayNum{day: day}
+
+		if prefix := item[:len(item)-2]; prefix != "" {
+			n, err := strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -5 || n > 5 {
+				return nil, fmt.Errorf("invalid weekday ordinal %q", item)
+			}
+
+			wd.ordinal = n
+		}
+
+		days = append(days, wd)
+	}
+
+	return days, nil
+}
+
+func parseIntList(v string, maxAbs int) ([]int, error) {
+	var out []int
+
+	for item := range strings.SplitSeq(v, ",") {
+		n, err := strconv.Atoi(item)
+		if err != nil || n == 0 || n < -maxAbs || n > maxAbs {
+			return nil, fmt.Errorf("invalid value %q", item)
+		}
+
+		out = append(out, n)
+	}
+
+	return out, nil
+}
+
+// CalculateNext returns the first occurrence strictly after from.
+// It returns false once UNTIL has passed or no occurrence could be found.
+func (r RecurrenceRule) CalculateNext(from time.Time) (time.Time, bool) {
+	if loc := r.Location(); loc != nil {
+		from = from.In(loc)
+	}
+
+	var next time.Time
+
+	if r.isSimple() {
+		next = r.simpleNext(from)
+	} else {
+		var ok bool
+		if next, ok = r.expandNext(from); !ok {
+			return time.Time{}, false
+		}
+	}
+
+	if r.until != nil && next.After(*r.until) {
+		return time.Time{}, false
+	}
+
+	return next, true
+}
+
+// IsExhausted reports whether COUNT allows no further occurrences after the given
+// number of completed ones.
+func (r RecurrenceRule) IsExhausted(completedOccurrences int) bool {
+	return r.count > 0 && completedOccurrences >= r.count
+}
+
+func (r RecurrenceRule) isSimple() bool {
+	return len(r.byDay) == 0 && len(r.byMonthDay) == 0
+}
+
+func (r RecurrenceRule) simpleNext(from time.Time) time.Time {
 	switch r.interval {
 	case Daily:
 		return from.AddDate(0, 0, r.amount)
@@ -57,5 +309,214 @@ func (r RecurrenceRule) CalculateNext(from time.Time) time.Time {
 	return from
 }
 
-func (r RecurrenceRule) Interval() string { return string(r.interval) }
-func (r RecurrenceRule) Amount() int      { return r.amount }
+// expandNext walks the periods (day, week or month) containing from, stepping by
+// INTERVAL, and returns the earliest expanded candidate after from.
+func (r RecurrenceRule) expandNext(from time.Time) (time.Time, bool) {
+	start := r.periodStart(from)
+
+	for i := range maxRecurrencePeriods {
+		period := r.shiftPeriod(start, i*r.amount)
+
+		for _, c := range r.expandPeriod(period, from) {
+			if c.After(from) {
+				return c, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (r RecurrenceRule) periodStart(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+
+	switch r.interval {
+	case Weekly:
+		// WKST=MO
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case Monthly:
+		return day.AddDate(0, 0, 1-day.Day())
+	}
+
+	return day
+}
+
+func (r RecurrenceRule) shiftPeriod(start time.Time, n int) time.Time {
+	switch r.interval {
+	case Weekly:
+		return start.AddDate(0, 0, 7*n)
+	case Monthly:
+		return start.AddDate(0, n, 0)
+	}
+
+	return start.AddDate(0, 0, n)
+}
+
+// expandPeriod returns the sorted candidates in the period, at the wall-clock time of ref.
+func (r RecurrenceRule) expandPeriod(period, ref time.Time) []time.Time {
+	var days []time.Time
+
+	switch r.interval {
+	case Daily:
+		days = []time.Time{period}
+	case Weekly:
+		for i := range 7 {
+			days = append(days, period.AddDate(0, 0, i))
+		}
+	case Monthly:
+		for d := period; d.Month() == period.Month(); d = d.AddDate(0, 0, 1) {
+			days = append(days, d)
+		}
+	}
+
+	candidates := make([]time.Time, 0, len(days))
+
+	for _, d := range days {
+		if r.matchesDay(d) {
+			candidates = append(candidates, time.Date(d.Year(), d.Month(), d.Day(),
+				ref.Hour(), ref.Minute(), ref.Second(), ref.Nanosecond(), d.Location()))
+		}
+	}
+
+	if len(r.bySetPos) == 0 {
+		return candidates
+	}
+
+	selected := make([]time.Time, 0, len(r.bySetPos))
+
+	for _, pos := range r.bySetPos {
+		idx := pos - 1
+		if pos < 0 {
+			idx = len(candidates) + pos
+		}
+
+		if idx >= 0 && idx < len(candidates) {
+			selected = append(selected, candidates[idx])
+		}
+	}
+
+	slices.SortFunc(selected, func(a, b time.Time) int { return a.Compare(b) })
+
+	return slices.CompactFunc(selected, time.Time.Equal)
+}
+
+func (r RecurrenceRule) matchesDay(d time.Time) bool {
+	if len(r.byMonthDay) > 0 {
+		lastDay := time.Date(d.Year(), d.Month()+1, 0, 0, 0, 0, 0, d.Location()).Day()
+
+		if !slices.ContainsFunc(r.byMonthDay, func(md int) bool {
+			return md == d.Day() || (md < 0 && lastDay+md+1 == d.Day())
+		}) {
+			return false
+		}
+	}
+
+	if len(r.byDay) > 0 {
+		return slices.ContainsFunc(r.byDay, func(wd WeekdayNum) bool {
+			return wd.day == d.Weekday() && (wd.ordinal == 0 || wd.ordinal == weekdayOrdinal(d, wd.ordinal < 0))
+		})
+	}
+
+	return true
+}
+
+// weekdayOrdinal returns the position of d's weekday within its month,
+// counting from the end (as a negative number) when fromEnd is set.
+func weekdayOrdinal(d time.Time, fromEnd bool) int {
+	if fromEnd {
+		lastDay := time.Date(d.Year(), d.Month()+1, 0, 0, 0, 0, 0, d.Location()).Day()
+		return -((lastDay-d.Day())/7 + 1)
+	}
+
+	return (d.Day()-1)/7 + 1
+}
+
+// Location returns the rule's timezone, or nil to use that of the due date.
+func (r RecurrenceRule) Location() *time.Location {
+	if r.tz == "" {
+		return nil
+	}
+
+	loc, err := time.LoadLocation(r.tz)
+	if err != nil {
+		return nil
+	}
+
+	return loc
+}
+
+// String renders the rule in RRULE syntax.
+func (r RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + string(r.interval)}
+
+	if r.amount > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.amount))
+	}
+
+	if len(r.byDay) > 0 {
+		days := make([]string, len(r.byDay))
+		for i, d := range r.byDay {
+			days[i] = d.String()
+		}
+
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+
+	if len(r.byMonthDay) > 0 {
+		parts = append(parts, "BYMONTHDAY="+joinInts(r.byMonthDay))
+	}
+
+	if len(r.bySetPos) > 0 {
+		parts = append(parts, "BYSETPOS="+joinInts(r.bySetPos))
+	}
+
+	if r.count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.count))
+	}
+
+	if r.until != nil {
+		parts = append(parts, "UNTIL="+r.until.UTC().Format("20060102T150405Z"))
+	}
+
+	if r.tz != "" {
+		parts = append(parts, "TZID="+r.tz)
+	}
+
+	return strings.Join(parts, ";")
+}
+
+func (d WeekdayNum) String() string {
+	for k, v := range rruleWeekdays {
+		if v == d.day {
+			if d.ordinal != 0 {
+				return strconv.Itoa(d.ordinal) + k
+			}
+
+			return k
+		}
+	}
+
+	return ""
+}
+
+func joinInts(ns []int) string {
+	s := make([]string, len(ns))
+	for i, n := range ns {
+		s[i] = strconv.Itoa(n)
+	}
+
+	return strings.Join(s, ",")
+}
+
+func (r RecurrenceRule) Equal(other RecurrenceRule) bool {
+	return r.String() == other.String()
+}
+
+func (r RecurrenceRule) Interval() string    { return string(r.interval) }
+func (r RecurrenceRule) Amount() int         { return r.amount }
+func (r RecurrenceRule) ByDay() []WeekdayNum { return r.byDay }
+func (r RecurrenceRule) ByMonthDay() []int   { return r.byMonthDay }
+func (r RecurrenceRule) BySetPos() []int     { return r.bySetPos }
+func (r RecurrenceRule) Count() int          { return r.count }
+func (r RecurrenceRule) Until() *time.Time   { return r.until }
+func (r RecurrenceRule) Timezone() string    { return r.tz }
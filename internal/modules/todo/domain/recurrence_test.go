@@ -0,0 +1,242 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+)
+
+func TestParseRecurrenceRule(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should round trip", func(t *testing.T) {
+		raw := "FREQ=MONTHLY;INTERVAL=2;BYDAY=MO,-1FR;BYSETPOS=1;UNTIL=20270101T000000Z;TZID=Europe/Madrid"
+
+		r, err := ParseRecurrenceRule("RRULE:" + raw)
+		require.NoError(t, err)
+		assert.Equal(t, raw, r.String())
+		assert.Equal(t, "MONTHLY", r.Interval())
+		assert.Equal(t, 2, r.Amount())
+	})
+
+	t.Run("should match legacy rules", func(t *testing.T) {
+		parsed, err := ParseRecurrenceRule("FREQ=WEEKLY;INTERVAL=3")
+		require.NoError(t, err)
+
+		legacy, _ := NewRecurrenceRule("WEEKLY", 3)
+		assert.True(t, legacy.Equal(parsed))
+	})
+
+	t.Run("should wrap parse errors once", func(t *testing.T) {
+		_, err := ParseRecurrenceRule("FREQ=DAILY;INTERVAL=0")
+		require.ErrorIs(t, err, ErrInvalidRecurrenceRule)
+		assert.Equal(t, "invalid recurrence rule: INTERVAL: must be positive", err.Error())
+	})
+
+	for _, raw := range []string{
+		"",
+		"FREQ=YEARLY",
+		"INTERVAL=2",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=2;UNTIL=20270101",
+		"FREQ=WEEKLY;BYDAY=2TU",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=MONTHLY;BYDAY=XX",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=DAILY;BYSETPOS=1",
+		"FREQ=DAILY;TZID=Mars/Olympus",
+		"FREQ=DAILY;TZID=Local",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=DAILY;BYHOUR=9",
+	} {
+		t.Run("should reject "+raw, func(t *testing.T) {
+			_, err := ParseRecurrenceRule(raw)
+			assert.ErrorIs(t, err, ErrInvalidRecurrenceRule)
+		})
+	}
+}
+
+func TestRecurrenceRule_CalculateNext(t *testing.T) {
+	t.Parallel()
+
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 9, 30, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name string
+		rule string
+		from time.Time
+		want time.Time
+	}{
+		{"every weekday skips the weekend", "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR", date(2026, 10, 16), date(2026, 10, 19)},
+		{"every weekday mid week", "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR", date(2026, 10, 13), date(2026, 10, 14)},
+		{"every other week on monday", "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO", date(2026, 10, 12), date(2026, 10, 26)},
+		{"second tuesday of the month", "FREQ=MONTHLY;BYDAY=2TU", date(2026, 10, 13), date(2026, 11, 10)},
+		{"last friday of the month", "FREQ=MONTHLY;BYDAY=-1FR", date(2026, 10, 1), date(2026, 10, 30)},
+		{"last weekday of the month", "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", date(2026, 10, 30), date(2026, 11, 30)},
+		{"last day of the month", "FREQ=MONTHLY;BYMONTHDAY=-1", date(2027, 1, 31), date(2027, 2, 28)},
+		{"15th of the month", "FREQ=MONTHLY;BYMONTHDAY=15", date(2026, 10, 16), date(2026, 11, 15)},
+		{"daily restricted to monday", "FREQ=DAILY;BYDAY=MO", date(2026, 10, 14), date(2026, 10, 19)},
+		{"simple monthly clamps to month end", "FREQ=MONTHLY", date(2027, 1, 31), date(2027, 2, 28)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ParseRecurrenceRule(tt.rule)
+			require.NoError(t, err)
+
+			got, ok := r.CalculateNext(tt.from)
+			require.True(t, ok)
+			assert.True(t, tt.want.Equal(got), "want %s, got %s", tt.want, got)
+		})
+	}
+
+	t.Run("should stop after UNTIL", func(t *testing.T) {
+		r, err := ParseRecurrenceRule("FREQ=DAILY;UNTIL=20270101")
+		require.NoError(t, err)
+
+		next, ok := r.CalculateNext(date(2026, 12, 31))
+		require.True(t, ok)
+		assert.True(t, date(2027, 1, 1).Equal(next))
+
+		_, ok = r.CalculateNext(next)
+		assert.False(t, ok)
+	})
+
+	t.Run("should end a date-only UNTIL in the rule timezone", func(t *testing.T) {
+		r, err := ParseRecurrenceRule("FREQ=DAILY;UNTIL=20270101;TZID=America/New_York")
+		require.NoError(t, err)
+
+		ny, _ := time.LoadLocation("America/New_York")
+		assert.True(t, time.Date(2027, 1, 1, 23, 59, 59, 0, ny).Equal(*r.Until()))
+
+		next, ok := r.CalculateNext(time.Date(2026, 12, 31, 20, 0, 0, 0, ny))
+		require.True(t, ok)
+		assert.Equal(t, 1, next.Day())
+		assert.Equal(t, 20, next.In(ny).Hour())
+	})
+
+	t.Run("should keep wall clock time across DST", func(t *testing.T) {
+		r, err := ParseRecurrenceRule("FREQ=DAILY;TZID=Europe/Madrid")
+		require.NoError(t, err)
+
+		madrid, _ := time.LoadLocation("Europe/Madrid")
+		from := time.Date(2026, 10, 24, 9, 0, 0, 0, madrid)
+
+		next, ok := r.CalculateNext(from.UTC())
+		require.True(t, ok)
+		assert.Equal(t, 9, next.In(madrid).Hour())
+		assert.Equal(t, 25*time.Hour, next.Sub(from))
+	})
+}
+
+func TestTodo_CompleteRecurringSeries(t *testing.T) {
+	t.Parallel()
+
+	title, _ := NewTodoTitle("Task")
+	wsID := wsDomain.WorkspaceID(uuid.New())
+	actorID := userDomain.UserID(uuid.New())
+	now := time.Now()
+
+	t.Run("should complete once COUNT is exhausted", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+		rule, err := ParseRecurrenceRule("FREQ=DAILY;COUNT=2")
+		require.NoError(t, err)
+
+		due := now.AddDate(0, 0, -2)
+		require.NoError(t, todo.SetDueDate(&due, actorID, now))
+		require.NoError(t, todo.SetRecurrence(&rule, actorID, now))
+
+		require.NoError(t, todo.Complete(actorID, now))
+		assert.Equal(t, StatusPending, todo.Status())
+		assert.Equal(t, 1, todo.CompletedOccurrences())
+
+		require.NoError(t, todo.Complete(actorID, now))
+		assert.Equal(t, StatusCompleted, todo.Status())
+	})
+
+	t.Run("should complete once UNTIL has passed", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+		rule, err := ParseRecurrenceRule("FREQ=WEEKLY;UNTIL=20200101")
+		require.NoError(t, err)
+
+		due := time.Date(2019, 12, 30, 0, 0, 0, 0, time.UTC)
+		require.NoError(t, todo.SetDueDate(&due, actorID, now))
+		require.NoError(t, todo.SetRecurrence(&rule, actorID, now))
+		todo.ClearEvents()
+
+		require.NoError(t, todo.Complete(actorID, now))
+		assert.Equal(t, StatusCompleted, todo.Status())
+		assert.IsType(t, TodoCompletedEvent{}, todo.Events()[0])
+	})
+}
+
+func TestTodo_CompleteRollsOverInActorTimezone(t *testing.T) {
+	t.Parallel()
+
+	title, _ := NewTodoTitle("Task")
+	wsID := wsDomain.WorkspaceID(uuid.New())
+	actorID := userDomain.UserID(uuid.New())
+
+	la, err := time.LoadLocation("America/Los_Angeles")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		rule    string
+		due     time.Time // as persisted, in UTC
+		loc     *time.Location
+		wantDue time.Time
+	}{
+		{
+			name:    "keeps wall clock across spring forward",
+			rule:    "FREQ=DAILY",
+			due:     time.Date(2026, 3, 7, 17, 0, 0, 0, time.UTC), // 09:00 PST
+			loc:     la,
+			wantDue: time.Date(2026, 3, 8, 16, 0, 0, 0, time.UTC), // 09:00 PDT
+		},
+		{
+			name:    "keeps wall clock across fall back",
+			rule:    "FREQ=DAILY",
+			due:     time.Date(2026, 10, 31, 16, 0, 0, 0, time.UTC), // 09:00 PDT
+			loc:     la,
+			wantDue: time.Date(2026, 11, 1, 17, 0, 0, 0, time.UTC), // 09:00 PST
+		},
+		{
+			name:    "weekday expansion uses the local calendar day",
+			rule:    "FREQ=WEEKLY;BYDAY=MO",
+			due:     time.Date(2026, 3, 3, 6, 0, 0, 0, time.UTC), // Mon 22:00 PST, already Tue in UTC
+			loc:     la,
+			wantDue: time.Date(2026, 3, 10, 5, 0, 0, 0, time.UTC), // Mon 22:00 PDT
+		},
+		{
+			name:    "rule TZID takes precedence over the actor zone",
+			rule:    "FREQ=DAILY;TZID=UTC",
+			due:     time.Date(2026, 3, 7, 17, 0, 0, 0, time.UTC),
+			loc:     la,
+			wantDue: time.Date(2026, 3, 8, 17, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			todo := NewTodo(title, wsID)
+			rule, err := ParseRecurrenceRule(tt.rule)
+			require.NoError(t, err)
+
+			require.NoError(t, todo.SetDueDate(&tt.due, actorID, tt.due))
+			require.NoError(t, todo.SetRecurrence(&rule, actorID, tt.due))
+
+			require.NoError(t, todo.Complete(actorID, tt.due.In(tt.loc)))
+			require.NotNil(t, todo.DueDate())
+			assert.True(t, tt.wantDue.Equal(*todo.DueDate()), "got %s, want %s", todo.DueDate().UTC(), tt.wantDue)
+		})
+	}
+}
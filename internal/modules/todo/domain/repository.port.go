@@ -2,7 +2,9 @@ package domain
 
 import (
 	"context"
+	"time"
 
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
 	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
 )
 
@@ -10,8 +12,23 @@ import (
 type TodoRepository interface {
 	Save(ctx context.Context, todo *Todo) error
 	FindByID(ctx context.Context, id TodoID) (*Todo, error)
+	// FindByIDForUpdate reads the todo from the database, bypassing any cache, and locks it until the transaction ends.
+	FindByIDForUpdate(ctx context.Context, id TodoID) (*Todo, error)
 	FindAllByWorkspace(ctx context.Context, wsID wsDomain.WorkspaceID) ([]*Todo, error)
 	Delete(ctx context.Context, id TodoID) error
+	// LockDependencies serializes blocked-by changes in the workspace until the transaction ends.
+	LockDependencies(ctx context.Context, wsID wsDomain.WorkspaceID) error
+	// FindBlockerIDs returns the unresolved blockers of a todo.
+	FindBlockerIDs(ctx context.Context, id TodoID) ([]TodoID, error)
+	// FindDependents returns the todos blocked by blockerID.
+	FindDependents(ctx context.Context, blockerID TodoID) ([]*Todo, error)
+	FindAssignedInWorkspace(ctx context.Context, wsID wsDomain.WorkspaceID, assigneeID userDomain.UserID) ([]*Todo, error)
+	// FindByTag returns the live todos having the tag.
+	FindByTag(ctx context.Context, tagID TagID) ([]*Todo, error)
+	// HasActiveFocusSession reports whether the user is focusing on any todo.
+	HasActiveFocusSession(ctx context.Context, userID userDomain.UserID) (bool, error)
+	// FindElapsedFocus returns todos whose active session has passed its planned duration or maxDuration.
+	FindElapsedFocus(ctx context.Context, now time.Time, maxDuration time.Duration, limit int32) ([]TodoID, error)
 }
 
 //go:generate go tool gowrap gen -g -i TagRepository -t ../../../../templates/opentelemetry.gotmpl -o ../infrastructure/postgres/tag_repository_tracing.gen.go
@@ -21,3 +38,10 @@ type TagRepository interface {
 	FindByName(ctx context.Context, workspaceID wsDomain.WorkspaceID, name string) (*Tag, error)
 	Delete(ctx context.Context, id TagID) error
 }
+
+//go:generate go tool gowrap gen -g -i CommentRepository -t ../../../../templates/opentelemetry.gotmpl -o ../infrastructure/postgres/comment_repository_tracing.gen.go
+type CommentRepository interface {
+	Save(ctx context.Context, comment *Comment) error
+	// FindByID returns ErrCommentNotFound for deleted comments.
+	FindByID(ctx context.Context, id CommentID) (*Comment, error)
+}
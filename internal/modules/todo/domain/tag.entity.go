@@ -8,7 +8,10 @@ import (
 	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
 )
 
-var ErrTagNotFound = shared.NewDomainError(apperrors.NotFound, "tag not found")
+var (
+	ErrTagNotFound  = shared.NewDomainError(apperrors.NotFound, "tag not found")
+	ErrTagNameTaken = shared.NewDomainError(apperrors.Conflict, "a tag with this name already exists in the workspace")
+)
 
 type TagID = shared.ID[Tag]
 
@@ -17,20 +20,23 @@ type Tag struct {
 
 	id          TagID
 	name        TagName
+	color       TagColor
 	workspaceID wsDomain.WorkspaceID
 }
 
-func NewTag(name TagName, workspaceID wsDomain.WorkspaceID) *Tag {
+func NewTag(name TagName, color TagColor, workspaceID wsDomain.WorkspaceID) *Tag {
 	id := shared.NewID[Tag]()
 	t := &Tag{
 		id:          id,
 		name:        name,
+		color:       color,
 		workspaceID: workspaceID,
 	}
 
 	t.RecordEvent(TagCreatedEvent{
 		ID:       id,
 		Name:     name,
+		Color:    color,
 		WsID:     workspaceID,
 		Occurred: time.Now(),
 	})
@@ -41,13 +47,57 @@ func NewTag(name TagName, workspaceID wsDomain.WorkspaceID) *Tag {
 type ReconstituteTagArgs struct {
 	ID          TagID
 	Name        TagName
+	Color       TagColor
 	WorkspaceID wsDomain.WorkspaceID
 }
 
 func ReconstituteTag(args ReconstituteTagArgs) *Tag {
-	return &Tag{id: args.ID, name: args.Name, workspaceID: args.WorkspaceID}
+	return &Tag{id: args.ID, name: args.Name, color: args.Color, workspaceID: args.WorkspaceID}
 }
 
 func (t *Tag) ID() TagID                         { return t.id }
 func (t *Tag) Name() TagName                     { return t.name }
+func (t *Tag) Color() TagColor                   { return t.color }
 func (t *Tag) WorkspaceID() wsDomain.WorkspaceID { return t.workspaceID }
+
+// Rename changes the name. Uniqueness within the workspace is checked by the caller,
+// since it needs the other tags.
+func (t *Tag) Rename(name TagName, now time.Time) {
+	if t.name == name {
+		return
+	}
+
+	oldName := t.name
+	t.name = name
+
+	t.RecordEvent(TagRenamedEvent{
+		ID:       t.id,
+		WsID:     t.workspaceID,
+		OldName:  oldName,
+		Name:     name,
+		Occurred: now,
+	})
+}
+
+func (t *Tag) Recolor(color TagColor, now time.Time) {
+	if t.color == color {
+		return
+	}
+
+	t.color = color
+
+	t.RecordEvent(TagRecoloredEvent{
+		ID:       t.id,
+		WsID:     t.workspaceID,
+		Color:    color,
+		Occurred: now,
+	})
+}
+
+func (t *Tag) Delete() {
+	t.RecordEvent(TagDeletedEvent{
+		ID:       t.id,
+		WsID:     t.workspaceID,
+		Occurred: time.Now(),
+	})
+}
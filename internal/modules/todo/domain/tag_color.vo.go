@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/danicc097/todo-ddd-example/internal/apperrors"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+var ErrInvalidTagColor = shared.NewDomainError(apperrors.InvalidInput, "tag color must be a hex color like #1f2937")
+
+var tagColorPattern = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+// DefaultTagColor is used for tags created without a color.
+var DefaultTagColor = TagColor{value: "#9ca3af"}
+
+// TagColor is a lowercase "#rrggbb" color.
+type TagColor struct {
+	value string
+}
+
+func NewTagColor(val string) (TagColor, error) {
+	val = strings.ToLower(strings.TrimSpace(val))
+	if !tagColorPattern.MatchString(val) {
+		return TagColor{}, ErrInvalidTagColor
+	}
+
+	return TagColor{value: val}, nil
+}
+
+func (c TagColor) String() string {
+	return c.value
+}
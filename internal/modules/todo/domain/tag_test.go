@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+)
+
+func TestNewTagColor(t *testing.T) {
+	t.Parallel()
+
+	color, err := NewTagColor(" #3B82F6 ")
+	require.NoError(t, err)
+	assert.Equal(t, "#3b82f6", color.String())
+
+	for _, in := range []string{"", "3b82f6", "#3b82f", "#3b82fg", "red"} {
+		_, err := NewTagColor(in)
+		assert.ErrorIs(t, err, ErrInvalidTagColor, in)
+	}
+}
+
+func TestTag(t *testing.T) {
+	t.Parallel()
+
+	wsID := wsDomain.WorkspaceID(uuid.New())
+	now := time.Now()
+
+	newTag := func() *Tag {
+		name, _ := NewTagName("work")
+		tag := NewTag(name, DefaultTagColor, wsID)
+		tag.ClearEvents()
+
+		return tag
+	}
+
+	t.Run("should rename and record event only on change", func(t *testing.T) {
+		tag := newTag()
+		name, _ := NewTagName("home")
+
+		tag.Rename(name, now)
+		tag.Rename(name, now)
+
+		require.Len(t, tag.Events(), 1)
+		evt, ok := tag.Events()[0].(TagRenamedEvent)
+		require.True(t, ok)
+		assert.Equal(t, "work", evt.OldName.String())
+		assert.Equal(t, name, tag.Name())
+	})
+
+	t.Run("should recolor and record event only on change", func(t *testing.T) {
+		tag := newTag()
+
+		tag.Recolor(DefaultTagColor, now)
+		assert.Empty(t, tag.Events())
+
+		color, _ := NewTagColor("#ef4444")
+		tag.Recolor(color, now)
+
+		require.Len(t, tag.Events(), 1)
+		assert.IsType(t, TagRecoloredEvent{}, tag.Events()[0])
+		assert.Equal(t, color, tag.Color())
+	})
+
+	t.Run("should record deletion", func(t *testing.T) {
+		tag := newTag()
+		tag.Delete()
+
+		require.Len(t, tag.Events(), 1)
+		assert.Equal(t, wsID.UUID(), tag.Events()[0].(TagDeletedEvent).WorkspaceID())
+	})
+}
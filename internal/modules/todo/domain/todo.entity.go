@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"slices"
 	"time"
 
 	"github.com/danicc097/todo-ddd-example/internal/apperrors"
@@ -9,7 +10,12 @@ import (
 	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
 )
 
-var ErrTodoNotFound = shared.NewDomainError(apperrors.NotFound, "todo not found")
+var (
+	ErrTodoNotFound      = shared.NewDomainError(apperrors.NotFound, "todo not found")
+	ErrAssigneeNotMember = shared.NewDomainError(apperrors.Unprocessable, "assignee must be a workspace member")
+	ErrGuestAssignee     = shared.NewDomainError(apperrors.Unprocessable, "guests cannot be assigned todos")
+	ErrTodoTagNotFound   = shared.NewDomainError(apperrors.NotFound, "todo does not have this tag")
+)
 
 type TodoID = shared.ID[Todo]
 
@@ -24,8 +30,13 @@ type Todo struct {
 	recurrence      *RecurrenceRule
 	lastCompletedAt *time.Time
 	sessions        []FocusSession
+	checklist       []ChecklistItem
+	blockedBy       []TodoID
+	assigneeID      *userDomain.UserID
 	tags            []TagID
 	createdAt       time.Time
+
+	completedOccurrences int
 }
 
 func NewTodo(title TodoTitle, workspaceID wsDomain.WorkspaceID) *Todo {
@@ -38,6 +49,8 @@ func NewTodo(title TodoTitle, workspaceID wsDomain.WorkspaceID) *Todo {
 		status:      StatusPending,
 		tags:        make([]TagID, 0),
 		sessions:    make([]FocusSession, 0),
+		checklist:   make([]ChecklistItem, 0),
+		blockedBy:   make([]TodoID, 0),
 		createdAt:   now,
 	}
 	t.RecordEvent(TodoCreatedEvent{
@@ -63,6 +76,11 @@ type ReconstituteTodoArgs struct {
 	Recurrence      *RecurrenceRule
 	LastCompletedAt *time.Time
 	Sessions        []FocusSession
+	Checklist       []ChecklistItem
+	BlockedBy       []TodoID
+	AssigneeID      *userDomain.UserID
+
+	CompletedOccurrences int
 }
 
 func ReconstituteTodo(args ReconstituteTodoArgs) *Todo {
@@ -77,38 +95,66 @@ func ReconstituteTodo(args ReconstituteTodoArgs) *Todo {
 		recurrence:      args.Recurrence,
 		lastCompletedAt: args.LastCompletedAt,
 		sessions:        args.Sessions,
+		checklist:       args.Checklist,
+		blockedBy:       args.BlockedBy,
+		assigneeID:      args.AssigneeID,
+
+		completedOccurrences: args.CompletedOccurrences,
 	}
 }
 
+// Complete marks the todo as done, or rolls it over to its next occurrence.
+// now should be in the actor's timezone, which defines the calendar used for rollover.
+// All required checklist items must be done; on rollover the checklist is reset.
 func (t *Todo) Complete(actorID userDomain.UserID, now time.Time) error {
 	if t.status == StatusArchived {
 		return ErrInvalidStatus
 	}
 
+	if t.IsBlocked() {
+		return ErrTodoBlocked
+	}
+
+	for _, item := range t.checklist {
+		if item.required && !item.done {
+			return ErrChecklistIncomplete
+		}
+	}
+
 	if t.recurrence != nil {
 		if t.dueDate != nil && t.dueDate.After(now) {
 			return ErrCannotCompleteFutureOccurrence
 		}
 
-		// calculate based on previous due date to retain cadence
+		// calculate based on previous due date to retain cadence, on the calendar of now's zone
+		// unless the rule pins its own TZID
 		baseDate := now
 		if t.dueDate != nil {
-			baseDate = *t.dueDate
+			baseDate = t.dueDate.In(now.Location())
 		}
 
-		nextDate := t.recurrence.CalculateNext(baseDate)
-		t.dueDate = &nextDate
+		t.completedOccurrences++
 		t.lastCompletedAt = &now
 
-		t.RecordEvent(TodoRolledOverEvent{
-			ID:         t.id,
-			WsID:       t.workspaceID,
-			NewDueDate: nextDate,
-			Occurred:   now,
-			ActorID:    actorID,
-		})
+		// once COUNT or UNTIL is exhausted the series ends and the todo completes for good
+		nextDate, ok := t.recurrence.CalculateNext(baseDate)
+		if ok && !t.recurrence.IsExhausted(t.completedOccurrences) {
+			t.dueDate = &nextDate
 
-		return nil
+			for i := range t.checklist {
+				t.checklist[i].done = false
+			}
+
+			t.RecordEvent(TodoRolledOverEvent{
+				ID:         t.id,
+				WsID:       t.workspaceID,
+				NewDueDate: nextDate,
+				Occurred:   now,
+				ActorID:    actorID,
+			})
+
+			return nil
+		}
 	}
 
 	t.status = StatusCompleted
@@ -126,18 +172,109 @@ func (t *Todo) Complete(actorID userDomain.UserID, now time.Time) error {
 	return nil
 }
 
-func (t *Todo) StartFocus(userID userDomain.UserID, sessionID FocusSessionID) error {
+func (t *Todo) Rename(title TodoTitle, actorID userDomain.UserID, now time.Time) error {
+	if t.status == StatusArchived {
+		return ErrInvalidStatus
+	}
+
+	if t.title == title {
+		return nil
+	}
+
+	oldTitle := t.title
+	t.title = title
+	t.RecordEvent(TodoRenamedEvent{
+		ID:       t.id,
+		WsID:     t.workspaceID,
+		OldTitle: oldTitle,
+		Title:    title,
+		Occurred: now,
+		ActorID:  actorID,
+	})
+
+	return nil
+}
+
+// Archive hides the todo from active work. Any running focus session is stopped.
+func (t *Todo) Archive(actorID userDomain.UserID, now time.Time) error {
+	if t.status == StatusArchived {
+		return ErrInvalidStatus
+	}
+
+	if t.ActiveFocusSession() != nil {
+		if err := t.StopFocus(now); err != nil {
+			return err
+		}
+	}
+
+	t.status = StatusArchived
+	t.RecordEvent(TodoArchivedEvent{
+		ID:       t.id,
+		WsID:     t.workspaceID,
+		Occurred: now,
+		ActorID:  actorID,
+	})
+
+	return nil
+}
+
+// Unarchive restores an archived todo as pending.
+func (t *Todo) Unarchive(actorID userDomain.UserID, now time.Time) error {
+	if t.status != StatusArchived {
+		return ErrInvalidStatus
+	}
+
+	t.reopen(actorID, now)
+
+	return nil
+}
+
+// Reopen moves a completed todo back to pending.
+func (t *Todo) Reopen(actorID userDomain.UserID, now time.Time) error {
+	if t.status != StatusCompleted {
+		return ErrInvalidStatus
+	}
+
+	t.reopen(actorID, now)
+
+	return nil
+}
+
+func (t *Todo) reopen(actorID userDomain.UserID, now time.Time) {
+	previous := t.status
+	t.status = StatusPending
+	t.RecordEvent(TodoReopenedEvent{
+		ID:             t.id,
+		WsID:           t.workspaceID,
+		PreviousStatus: previous,
+		Occurred:       now,
+		ActorID:        actorID,
+	})
+}
+
+// StartFocus opens a session for userID. A non-zero planned duration makes it a pomodoro that
+// is stopped automatically once elapsed.
+func (t *Todo) StartFocus(userID userDomain.UserID, sessionID FocusSessionID, planned time.Duration) error {
 	if t.status == StatusCompleted || t.status == StatusArchived {
 		return ErrCannotFocusOnCompletedTask
 	}
 
+	if t.IsBlocked() {
+		return ErrTodoBlocked
+	}
+
 	for _, s := range t.sessions {
 		if s.IsActive() {
 			return ErrFocusSessionAlreadyActive
 		}
 	}
 
-	t.sessions = append(t.sessions, NewFocusSession(sessionID, userID, time.Now()))
+	session, err := NewFocusSession(sessionID, userID, time.Now(), planned)
+	if err != nil {
+		return err
+	}
+
+	t.sessions = append(t.sessions, session)
 
 	return nil
 }
@@ -158,6 +295,44 @@ func (t *Todo) StopFocus(now time.Time) error {
 	return ErrNoActiveFocusSession
 }
 
+// ElapseFocus stops the active session once its planned duration or maxDuration has passed.
+// The session ends when it elapsed rather than at now, so late sweeps don't inflate focus time.
+// It reports whether a session was stopped.
+func (t *Todo) ElapseFocus(now time.Time, maxDuration time.Duration) bool {
+	for i, s := range t.sessions {
+		if !s.IsActive() {
+			continue
+		}
+
+		end := s.ElapsesAt(maxDuration)
+		if now.Before(end) {
+			return false
+		}
+
+		reason := FocusExpired
+		if s.planned > 0 && s.planned <= maxDuration {
+			reason = FocusCompleted
+		}
+
+		t.sessions[i].endTime = &end
+
+		t.RecordEvent(TodoFocusElapsedEvent{
+			ID:              t.id,
+			WsID:            t.workspaceID,
+			SessionID:       s.id,
+			UserID:          s.userID,
+			StartedAt:       s.startTime,
+			PlannedDuration: s.planned,
+			Reason:          reason,
+			Occurred:        end,
+		})
+
+		return true
+	}
+
+	return false
+}
+
 func (t *Todo) ActiveFocusSession() *FocusSession {
 	for _, s := range t.sessions {
 		if s.IsActive() {
@@ -168,7 +343,12 @@ func (t *Todo) ActiveFocusSession() *FocusSession {
 	return nil
 }
 
+// AddTag is a no-op if the todo already has the tag.
 func (t *Todo) AddTag(tagID TagID) {
+	if slices.Contains(t.tags, tagID) {
+		return
+	}
+
 	t.tags = append(t.tags, tagID)
 	t.RecordEvent(TagAddedEvent{
 		TodoID:   t.id,
@@ -178,6 +358,24 @@ func (t *Todo) AddTag(tagID TagID) {
 	})
 }
 
+func (t *Todo) RemoveTag(tagID TagID, actorID userDomain.UserID, now time.Time) error {
+	i := slices.Index(t.tags, tagID)
+	if i < 0 {
+		return ErrTodoTagNotFound
+	}
+
+	t.tags = slices.Delete(t.tags, i, i+1)
+	t.RecordEvent(TagRemovedEvent{
+		TodoID:   t.id,
+		TagID:    tagID,
+		WsID:     t.workspaceID,
+		Occurred: now,
+		ActorID:  actorID,
+	})
+
+	return nil
+}
+
 func (t *Todo) Delete() {
 	t.RecordEvent(TodoDeletedEvent{
 		ID:       t.id,
@@ -186,12 +384,303 @@ func (t *Todo) Delete() {
 	})
 }
 
-func (t *Todo) SetDueDate(d *time.Time) {
+// SetDueDate changes or clears the due date. Recurring todos always keep a due date
+// since it anchors the next occurrence.
+func (t *Todo) SetDueDate(d *time.Time, actorID userDomain.UserID, now time.Time) error {
+	if t.status == StatusArchived {
+		return ErrInvalidStatus
+	}
+
+	if d == nil && t.recurrence != nil {
+		return ErrRecurrenceRequiresDueDate
+	}
+
+	if sameTime(t.dueDate, d) {
+		return nil
+	}
+
 	t.dueDate = d
+	t.RecordEvent(TodoDueDateChangedEvent{
+		ID:       t.id,
+		WsID:     t.workspaceID,
+		DueDate:  d,
+		Occurred: now,
+		ActorID:  actorID,
+	})
+
+	return nil
 }
 
-func (t *Todo) SetRecurrence(r *RecurrenceRule) {
+// SetRecurrence changes or clears the recurrence rule. A due date must be set first.
+func (t *Todo) SetRecurrence(r *RecurrenceRule, actorID userDomain.UserID, now time.Time) error {
+	if t.status == StatusArchived {
+		return ErrInvalidStatus
+	}
+
+	if r != nil && t.dueDate == nil {
+		return ErrRecurrenceRequiresDueDate
+	}
+
+	if (t.recurrence == nil && r == nil) || (t.recurrence != nil && r != nil && t.recurrence.Equal(*r)) {
+		return nil
+	}
+
 	t.recurrence = r
+	t.completedOccurrences = 0
+	t.RecordEvent(TodoRecurrenceChangedEvent{
+		ID:         t.id,
+		WsID:       t.workspaceID,
+		Recurrence: r,
+		Occurred:   now,
+		ActorID:    actorID,
+	})
+
+	return nil
+}
+
+// Assign hands the todo to a workspace member. Membership is checked by the caller.
+func (t *Todo) Assign(assigneeID userDomain.UserID, actorID userDomain.UserID, now time.Time) error {
+	if t.status == StatusArchived {
+		return ErrInvalidStatus
+	}
+
+	if t.assigneeID != nil && *t.assigneeID == assigneeID {
+		return nil
+	}
+
+	t.assigneeID = &assigneeID
+	t.RecordEvent(TodoAssignedEvent{
+		ID:         t.id,
+		WsID:       t.workspaceID,
+		AssigneeID: assigneeID,
+		Occurred:   now,
+		ActorID:    actorID,
+	})
+
+	return nil
+}
+
+// Unassign clears the assignee, if any.
+func (t *Todo) Unassign(actorID userDomain.UserID, now time.Time) {
+	if t.assigneeID == nil {
+		return
+	}
+
+	previous := *t.assigneeID
+	t.assigneeID = nil
+	t.RecordEvent(TodoUnassignedEvent{
+		ID:         t.id,
+		WsID:       t.workspaceID,
+		AssigneeID: previous,
+		Occurred:   now,
+		ActorID:    actorID,
+	})
+}
+
+// AddChecklistItem appends a step to the checklist.
+func (t *Todo) AddChecklistItem(item ChecklistItem, actorID userDomain.UserID, now time.Time) error {
+	if t.status == StatusArchived {
+		return ErrInvalidStatus
+	}
+
+	if len(t.checklist) >= MaxChecklistItems {
+		return ErrChecklistFull
+	}
+
+	if t.checklistIndex(item.id) >= 0 {
+		return ErrChecklistItemExists
+	}
+
+	t.checklist = append(t.checklist, item)
+	t.RecordEvent(ChecklistItemAddedEvent{
+		ID:       t.id,
+		WsID:     t.workspaceID,
+		ItemID:   item.id,
+		Title:    item.title,
+		Required: item.required,
+		Occurred: now,
+		ActorID:  actorID,
+	})
+
+	return nil
+}
+
+// ToggleChecklistItem flips the done state of an item.
+func (t *Todo) ToggleChecklistItem(id ChecklistItemID, actorID userDomain.UserID, now time.Time) error {
+	if t.status == StatusArchived {
+		return ErrInvalidStatus
+	}
+
+	i := t.checklistIndex(id)
+	if i < 0 {
+		return ErrChecklistItemNotFound
+	}
+
+	t.checklist[i].done = !t.checklist[i].done
+	t.RecordEvent(ChecklistItemToggledEvent{
+		ID:       t.id,
+		WsID:     t.workspaceID,
+		ItemID:   id,
+		Done:     t.checklist[i].done,
+		Occurred: now,
+		ActorID:  actorID,
+	})
+
+	return nil
+}
+
+// ReorderChecklist rearranges the checklist. ids must contain every item exactly once.
+func (t *Todo) ReorderChecklist(ids []ChecklistItemID, actorID userDomain.UserID, now time.Time) error {
+	if t.status == StatusArchived {
+		return ErrInvalidStatus
+	}
+
+	if len(ids) != len(t.checklist) {
+		return ErrInvalidChecklistOrder
+	}
+
+	reordered := make([]ChecklistItem, 0, len(ids))
+	seen := make(map[ChecklistItemID]bool, len(ids))
+
+	for _, id := range ids {
+		i := t.checklistIndex(id)
+		if i < 0 || seen[id] {
+			return ErrInvalidChecklistOrder
+		}
+
+		seen[id] = true
+		reordered = append(reordered, t.checklist[i])
+	}
+
+	t.checklist = reordered
+	t.RecordEvent(ChecklistReorderedEvent{
+		ID:       t.id,
+		WsID:     t.workspaceID,
+		ItemIDs:  ids,
+		Occurred: now,
+		ActorID:  actorID,
+	})
+
+	return nil
+}
+
+func (t *Todo) RemoveChecklistItem(id ChecklistItemID, actorID userDomain.UserID, now time.Time) error {
+	if t.status == StatusArchived {
+		return ErrInvalidStatus
+	}
+
+	i := t.checklistIndex(id)
+	if i < 0 {
+		return ErrChecklistItemNotFound
+	}
+
+	t.checklist = append(t.checklist[:i], t.checklist[i+1:]...)
+	t.RecordEvent(ChecklistItemRemovedEvent{
+		ID:       t.id,
+		WsID:     t.workspaceID,
+		ItemID:   id,
+		Occurred: now,
+		ActorID:  actorID,
+	})
+
+	return nil
+}
+
+// addBlocker links a blocker without any graph checks. Use DependencyService.AddBlocker.
+func (t *Todo) addBlocker(blockerID TodoID, actorID userDomain.UserID, now time.Time) error {
+	if t.blockerIndex(blockerID) >= 0 {
+		return ErrBlockerExists
+	}
+
+	if len(t.blockedBy) >= MaxBlockers {
+		return ErrTooManyBlockers
+	}
+
+	t.blockedBy = append(t.blockedBy, blockerID)
+	t.RecordEvent(TodoBlockedEvent{
+		ID:        t.id,
+		WsID:      t.workspaceID,
+		BlockerID: blockerID,
+		Occurred:  now,
+		ActorID:   actorID,
+	})
+
+	return nil
+}
+
+// RemoveBlocker unlinks a blocker on request of a user.
+func (t *Todo) RemoveBlocker(blockerID TodoID, actorID userDomain.UserID, now time.Time) error {
+	i := t.blockerIndex(blockerID)
+	if i < 0 {
+		return ErrBlockerNotFound
+	}
+
+	t.blockedBy = append(t.blockedBy[:i], t.blockedBy[i+1:]...)
+	t.RecordEvent(TodoBlockerRemovedEvent{
+		ID:        t.id,
+		WsID:      t.workspaceID,
+		BlockerID: blockerID,
+		Occurred:  now,
+		ActorID:   actorID,
+	})
+	t.recordUnblocked(now)
+
+	return nil
+}
+
+// ResolveBlocker drops a blocker that was completed or deleted. It is a no-op
+// if the todo was not blocked by it.
+func (t *Todo) ResolveBlocker(blockerID TodoID, now time.Time) {
+	i := t.blockerIndex(blockerID)
+	if i < 0 {
+		return
+	}
+
+	t.blockedBy = append(t.blockedBy[:i], t.blockedBy[i+1:]...)
+	t.recordUnblocked(now)
+}
+
+func (t *Todo) recordUnblocked(now time.Time) {
+	if t.IsBlocked() {
+		return
+	}
+
+	t.RecordEvent(TodoUnblockedEvent{
+		ID:       t.id,
+		WsID:     t.workspaceID,
+		Occurred: now,
+	})
+}
+
+func (t *Todo) blockerIndex(id TodoID) int {
+	for i, b := range t.blockedBy {
+		if b == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// IsBlocked reports whether any blocker is still unresolved.
+func (t *Todo) IsBlocked() bool { return len(t.blockedBy) > 0 }
+
+func (t *Todo) checklistIndex(id ChecklistItemID) int {
+	for i, item := range t.checklist {
+		if item.id == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Equal(*b)
 }
 
 func (t *Todo) ID() TodoID                        { return t.id }
@@ -204,6 +693,12 @@ func (t *Todo) DueDate() *time.Time               { return t.dueDate }
 func (t *Todo) Recurrence() *RecurrenceRule       { return t.recurrence }
 func (t *Todo) LastCompletedAt() *time.Time       { return t.lastCompletedAt }
 func (t *Todo) Sessions() []FocusSession          { return t.sessions }
+func (t *Todo) Checklist() []ChecklistItem        { return t.checklist }
+func (t *Todo) BlockedBy() []TodoID               { return t.blockedBy }
+func (t *Todo) AssigneeID() *userDomain.UserID    { return t.assigneeID }
+
+// CompletedOccurrences counts completions of the current recurrence rule.
+func (t *Todo) CompletedOccurrences() int { return t.completedOccurrences }
 
 // NOTE: entity should not know how it's serialized to the outside world (apis, messaging...)
 // func (t *Todo) MarshalJSON() ([]byte, error) {
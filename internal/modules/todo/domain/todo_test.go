@@ -47,10 +47,10 @@ func TestTodo_Complete(t *testing.T) {
 	t.Run("should rollover if recurrence is set", func(t *testing.T) {
 		todo := NewTodo(title, wsID)
 		rule, _ := NewRecurrenceRule("DAILY", 1)
-		todo.SetRecurrence(&rule)
 
 		dueDate := now.AddDate(0, 0, -1) // yesterday
-		todo.SetDueDate(&dueDate)
+		require.NoError(t, todo.SetDueDate(&dueDate, actorID, now))
+		require.NoError(t, todo.SetRecurrence(&rule, actorID, now))
 
 		require.NoError(t, todo.Complete(actorID, now))
 		assert.Equal(t, StatusPending, todo.Status())
@@ -71,7 +71,7 @@ func TestTodo_Focus(t *testing.T) {
 		todo := NewTodo(title, wsID)
 		sessionID := FocusSessionID(uuid.New())
 
-		err := todo.StartFocus(userID, sessionID)
+		err := todo.StartFocus(userID, sessionID, 0)
 		assert.NoError(t, err)
 		assert.NotNil(t, todo.ActiveFocusSession())
 
@@ -85,10 +85,347 @@ func TestTodo_Focus(t *testing.T) {
 	t.Run("should fail if already focusing", func(t *testing.T) {
 		todo := NewTodo(title, wsID)
 		sessionID1 := FocusSessionID(uuid.New())
-		_ = todo.StartFocus(userID, sessionID1)
+		_ = todo.StartFocus(userID, sessionID1, 0)
 
 		sessionID2 := FocusSessionID(uuid.New())
-		err := todo.StartFocus(userID, sessionID2)
+		err := todo.StartFocus(userID, sessionID2, 0)
 		assert.ErrorIs(t, err, ErrFocusSessionAlreadyActive)
 	})
+
+	t.Run("should reject invalid planned durations", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+
+		assert.ErrorIs(t, todo.StartFocus(userID, FocusSessionID(uuid.New()), 30*time.Second), ErrInvalidFocusDuration)
+		assert.ErrorIs(t, todo.StartFocus(userID, FocusSessionID(uuid.New()), 5*time.Hour), ErrInvalidFocusDuration)
+		assert.Nil(t, todo.ActiveFocusSession())
+	})
+
+	t.Run("should elapse at the planned end", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+		todo.ClearEvents()
+		require.NoError(t, todo.StartFocus(userID, FocusSessionID(uuid.New()), 25*time.Minute))
+
+		start := todo.ActiveFocusSession().StartTime()
+
+		assert.False(t, todo.ElapseFocus(start.Add(24*time.Minute), time.Hour))
+		assert.True(t, todo.ElapseFocus(start.Add(40*time.Minute), time.Hour))
+		assert.Nil(t, todo.ActiveFocusSession())
+		assert.Equal(t, start.Add(25*time.Minute), *todo.Sessions()[0].EndTime())
+
+		require.Len(t, todo.Events(), 1)
+		evt, ok := todo.Events()[0].(TodoFocusElapsedEvent)
+		require.True(t, ok)
+		assert.Equal(t, FocusCompleted, evt.Reason)
+		assert.Equal(t, userID, evt.UserID)
+	})
+
+	t.Run("should expire sessions past the maximum", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+		todo.ClearEvents()
+		require.NoError(t, todo.StartFocus(userID, FocusSessionID(uuid.New()), 0))
+
+		start := todo.ActiveFocusSession().StartTime()
+
+		assert.True(t, todo.ElapseFocus(start.Add(3*time.Hour), 2*time.Hour))
+		assert.Equal(t, start.Add(2*time.Hour), *todo.Sessions()[0].EndTime())
+		require.Len(t, todo.Events(), 1)
+		assert.Equal(t, FocusExpired, todo.Events()[0].(TodoFocusElapsedEvent).Reason)
+
+		assert.False(t, todo.ElapseFocus(start.Add(4*time.Hour), 2*time.Hour))
+	})
+}
+
+func TestTodo_Rename(t *testing.T) {
+	t.Parallel()
+
+	title, _ := NewTodoTitle("Task")
+	newTitle, _ := NewTodoTitle("Renamed task")
+	wsID := wsDomain.WorkspaceID(uuid.New())
+	actorID := userDomain.UserID(uuid.New())
+	now := time.Now()
+
+	t.Run("should rename and record event", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+		todo.ClearEvents()
+
+		require.NoError(t, todo.Rename(newTitle, actorID, now))
+		assert.Equal(t, newTitle, todo.Title())
+		require.Len(t, todo.Events(), 1)
+
+		evt, ok := todo.Events()[0].(TodoRenamedEvent)
+		require.True(t, ok)
+		assert.Equal(t, title, evt.OldTitle)
+		assert.Equal(t, newTitle, evt.Title)
+	})
+
+	t.Run("should be a no-op for the same title", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+		todo.ClearEvents()
+
+		require.NoError(t, todo.Rename(title, actorID, now))
+		assert.Empty(t, todo.Events())
+	})
+
+	t.Run("should fail if archived", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+		require.NoError(t, todo.Archive(actorID, now))
+
+		assert.ErrorIs(t, todo.Rename(newTitle, actorID, now), ErrInvalidStatus)
+	})
+}
+
+func TestTodo_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	title, _ := NewTodoTitle("Task")
+	wsID := wsDomain.WorkspaceID(uuid.New())
+	actorID := userDomain.UserID(uuid.New())
+	now := time.Now()
+
+	t.Run("should archive and unarchive", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+
+		require.NoError(t, todo.Archive(actorID, now))
+		assert.Equal(t, StatusArchived, todo.Status())
+		assert.ErrorIs(t, todo.Archive(actorID, now), ErrInvalidStatus)
+
+		require.NoError(t, todo.Unarchive(actorID, now))
+		assert.Equal(t, StatusPending, todo.Status())
+		assert.ErrorIs(t, todo.Unarchive(actorID, now), ErrInvalidStatus)
+	})
+
+	t.Run("should stop active focus session on archive", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+		require.NoError(t, todo.StartFocus(actorID, FocusSessionID(uuid.New()), 0))
+
+		require.NoError(t, todo.Archive(actorID, time.Now().Add(time.Minute)))
+		assert.Nil(t, todo.ActiveFocusSession())
+	})
+
+	t.Run("should reopen completed todo", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+		assert.ErrorIs(t, todo.Reopen(actorID, now), ErrInvalidStatus)
+
+		require.NoError(t, todo.Complete(actorID, now))
+		todo.ClearEvents()
+
+		require.NoError(t, todo.Reopen(actorID, now))
+		assert.Equal(t, StatusPending, todo.Status())
+
+		evt, ok := todo.Events()[0].(TodoReopenedEvent)
+		require.True(t, ok)
+		assert.Equal(t, StatusCompleted, evt.PreviousStatus)
+	})
+}
+
+func TestTodo_Schedule(t *testing.T) {
+	t.Parallel()
+
+	title, _ := NewTodoTitle("Task")
+	wsID := wsDomain.WorkspaceID(uuid.New())
+	actorID := userDomain.UserID(uuid.New())
+	now := time.Now()
+	rule, _ := NewRecurrenceRule("WEEKLY", 1)
+
+	t.Run("should reject recurrence without due date", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+
+		assert.ErrorIs(t, todo.SetRecurrence(&rule, actorID, now), ErrRecurrenceRequiresDueDate)
+		assert.Nil(t, todo.Recurrence())
+	})
+
+	t.Run("should not clear due date of recurring todo", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+		require.NoError(t, todo.SetDueDate(&now, actorID, now))
+		require.NoError(t, todo.SetRecurrence(&rule, actorID, now))
+
+		assert.ErrorIs(t, todo.SetDueDate(nil, actorID, now), ErrRecurrenceRequiresDueDate)
+
+		require.NoError(t, todo.SetRecurrence(nil, actorID, now))
+		require.NoError(t, todo.SetDueDate(nil, actorID, now))
+		assert.Nil(t, todo.DueDate())
+	})
+
+	t.Run("should record events only on change", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+		todo.ClearEvents()
+
+		require.NoError(t, todo.SetDueDate(&now, actorID, now))
+		require.NoError(t, todo.SetDueDate(&now, actorID, now))
+		require.NoError(t, todo.SetRecurrence(&rule, actorID, now))
+		require.NoError(t, todo.SetRecurrence(&rule, actorID, now))
+
+		require.Len(t, todo.Events(), 2)
+		assert.IsType(t, TodoDueDateChangedEvent{}, todo.Events()[0])
+		assert.IsType(t, TodoRecurrenceChangedEvent{}, todo.Events()[1])
+	})
+}
+
+func TestTodo_Checklist(t *testing.T) {
+	t.Parallel()
+
+	title, _ := NewTodoTitle("Task")
+	wsID := wsDomain.WorkspaceID(uuid.New())
+	actorID := userDomain.UserID(uuid.New())
+	now := time.Now()
+
+	newItem := func(name string, required bool) ChecklistItem {
+		itemTitle, _ := NewTodoTitle(name)
+		return NewChecklistItem(ChecklistItemID(uuid.New()), itemTitle, required)
+	}
+
+	t.Run("should add, toggle and remove items with events", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+		todo.ClearEvents()
+
+		item := newItem("Step", true)
+		require.NoError(t, todo.AddChecklistItem(item, actorID, now))
+		assert.ErrorIs(t, todo.AddChecklistItem(item, actorID, now), ErrChecklistItemExists)
+
+		require.NoError(t, todo.ToggleChecklistItem(item.ID(), actorID, now))
+		assert.True(t, todo.Checklist()[0].Done())
+
+		require.NoError(t, todo.RemoveChecklistItem(item.ID(), actorID, now))
+		assert.Empty(t, todo.Checklist())
+		assert.ErrorIs(t, todo.RemoveChecklistItem(item.ID(), actorID, now), ErrChecklistItemNotFound)
+
+		require.Len(t, todo.Events(), 3)
+		assert.IsType(t, ChecklistItemAddedEvent{}, todo.Events()[0])
+		assert.Equal(t, ChecklistItemToggledEvent{
+			ID: todo.ID(), WsID: wsID, ItemID: item.ID(), Done: true, Occurred: now, ActorID: actorID,
+		}, todo.Events()[1])
+		assert.IsType(t, ChecklistItemRemovedEvent{}, todo.Events()[2])
+	})
+
+	t.Run("should reorder with a full permutation only", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+		a, b, c := newItem("A", true), newItem("B", true), newItem("C", true)
+
+		for _, it := range []ChecklistItem{a, b, c} {
+			require.NoError(t, todo.AddChecklistItem(it, actorID, now))
+		}
+
+		assert.ErrorIs(t, todo.ReorderChecklist([]ChecklistItemID{c.ID(), a.ID()}, actorID, now), ErrInvalidChecklistOrder)
+		assert.ErrorIs(t, todo.ReorderChecklist([]ChecklistItemID{c.ID(), a.ID(), a.ID()}, actorID, now), ErrInvalidChecklistOrder)
+
+		require.NoError(t, todo.ReorderChecklist([]ChecklistItemID{c.ID(), a.ID(), b.ID()}, actorID, now))
+
+		got := make([]ChecklistItemID, 0, 3)
+		for _, it := range todo.Checklist() {
+			got = append(got, it.ID())
+		}
+
+		assert.Equal(t, []ChecklistItemID{c.ID(), a.ID(), b.ID()}, got)
+	})
+
+	t.Run("should only complete once required items are done", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+		required, optional := newItem("Required", true), newItem("Optional", false)
+		require.NoError(t, todo.AddChecklistItem(required, actorID, now))
+		require.NoError(t, todo.AddChecklistItem(optional, actorID, now))
+
+		assert.ErrorIs(t, todo.Complete(actorID, now), ErrChecklistIncomplete)
+
+		require.NoError(t, todo.ToggleChecklistItem(required.ID(), actorID, now))
+		require.NoError(t, todo.Complete(actorID, now))
+		assert.Equal(t, StatusCompleted, todo.Status())
+	})
+
+	t.Run("should reset checklist on rollover", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+		rule, _ := NewRecurrenceRule("DAILY", 1)
+		due := now.AddDate(0, 0, -1)
+		require.NoError(t, todo.SetDueDate(&due, actorID, now))
+		require.NoError(t, todo.SetRecurrence(&rule, actorID, now))
+
+		item := newItem("Step", true)
+		require.NoError(t, todo.AddChecklistItem(item, actorID, now))
+		require.NoError(t, todo.ToggleChecklistItem(item.ID(), actorID, now))
+
+		require.NoError(t, todo.Complete(actorID, now))
+		assert.Equal(t, StatusPending, todo.Status())
+		assert.False(t, todo.Checklist()[0].Done())
+	})
+
+	t.Run("should fail if archived", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+		require.NoError(t, todo.Archive(actorID, now))
+
+		assert.ErrorIs(t, todo.AddChecklistItem(newItem("Step", true), actorID, now), ErrInvalidStatus)
+	})
+}
+
+func TestTodo_Assign(t *testing.T) {
+	t.Parallel()
+
+	title, _ := NewTodoTitle("Task")
+	wsID := wsDomain.WorkspaceID(uuid.New())
+	actorID := userDomain.UserID(uuid.New())
+	assigneeID := userDomain.UserID(uuid.New())
+	now := time.Now()
+
+	t.Run("should assign and unassign with events", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+		todo.ClearEvents()
+
+		require.NoError(t, todo.Assign(assigneeID, actorID, now))
+		require.NoError(t, todo.Assign(assigneeID, actorID, now))
+		require.NotNil(t, todo.AssigneeID())
+		assert.Equal(t, assigneeID, *todo.AssigneeID())
+
+		todo.Unassign(actorID, now)
+		todo.Unassign(actorID, now)
+		assert.Nil(t, todo.AssigneeID())
+
+		require.Len(t, todo.Events(), 2)
+		assert.IsType(t, TodoAssignedEvent{}, todo.Events()[0])
+		assert.Equal(t, TodoUnassignedEvent{
+			ID: todo.ID(), WsID: wsID, AssigneeID: assigneeID, Occurred: now, ActorID: actorID,
+		}, todo.Events()[1])
+	})
+
+	t.Run("should not assign archived todos", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+		require.NoError(t, todo.Archive(actorID, now))
+
+		assert.ErrorIs(t, todo.Assign(assigneeID, actorID, now), ErrInvalidStatus)
+	})
+}
+
+func TestTodo_Tags(t *testing.T) {
+	t.Parallel()
+
+	title, _ := NewTodoTitle("Task")
+	wsID := wsDomain.WorkspaceID(uuid.New())
+	actorID := userDomain.UserID(uuid.New())
+	tagID := TagID(uuid.New())
+	now := time.Now()
+
+	t.Run("should not add the same tag twice", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+		todo.ClearEvents()
+
+		todo.AddTag(tagID)
+		todo.AddTag(tagID)
+
+		assert.Equal(t, []TagID{tagID}, todo.Tags())
+		assert.Len(t, todo.Events(), 1)
+	})
+
+	t.Run("should remove a tag with event", func(t *testing.T) {
+		todo := NewTodo(title, wsID)
+		todo.AddTag(tagID)
+		todo.ClearEvents()
+
+		require.NoError(t, todo.RemoveTag(tagID, actorID, now))
+		assert.Empty(t, todo.Tags())
+		require.Len(t, todo.Events(), 1)
+
+		evt, ok := todo.Events()[0].(TagRemovedEvent)
+		require.True(t, ok)
+		assert.Equal(t, tagID, evt.TagID)
+		assert.Equal(t, actorID, evt.ActorID)
+
+		assert.ErrorIs(t, todo.RemoveTag(tagID, actorID, now), ErrTodoTagNotFound)
+	})
 }
@@ -0,0 +1,65 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	scheduleApp "github.com/danicc097/todo-ddd-example/internal/modules/schedule/application"
+	todoApp "github.com/danicc097/todo-ddd-example/internal/modules/todo/application"
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+)
+
+const plannablePageSize = 100
+
+type ScheduleTodoProvider struct {
+	Query todoApp.TodoQueryService
+}
+
+var _ scheduleApp.PlannableTodoProvider = (*ScheduleTodoProvider)(nil)
+
+func NewScheduleTodoProvider(qs todoApp.TodoQueryService) *ScheduleTodoProvider {
+	return &ScheduleTodoProvider{Query: qs}
+}
+
+// Plannable skips completed, archived and blocked todos.
+func (p *ScheduleTodoProvider) Plannable(ctx context.Context, userID userDomain.UserID, dueBefore time.Time) ([]scheduleApp.PlannableTodo, error) {
+	assigned, err := p.collect(func(offset int32) ([]todoApp.TodoReadModel, error) {
+		return p.Query.ListAssignedTo(ctx, userID, plannablePageSize, offset)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	due, err := p.collect(func(offset int32) ([]todoApp.TodoReadModel, error) {
+		return p.Query.ListUnassignedDue(ctx, userID, dueBefore, plannablePageSize, offset)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append(assigned, due...), nil
+}
+
+func (p *ScheduleTodoProvider) collect(list func(offset int32) ([]todoApp.TodoReadModel, error)) ([]scheduleApp.PlannableTodo, error) {
+	var open []scheduleApp.PlannableTodo
+
+	for offset := int32(0); ; offset += plannablePageSize {
+		todos, err := list(offset)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range todos {
+			if t.Status != domain.StatusPending.String() || len(t.BlockedBy) > 0 {
+				continue
+			}
+
+			open = append(open, scheduleApp.PlannableTodo{ID: t.ID, DueDate: t.DueDate})
+		}
+
+		if len(todos) < plannablePageSize {
+			return open, nil
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package decorator
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	auditDomain "github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+	auditDecorator "github.com/danicc097/todo-ddd-example/internal/modules/audit/infrastructure/decorator"
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+var (
+	_ domain.TodoRepository = (*TodoAuditWrapper)(nil)
+	_ domain.TagRepository  = (*TagAuditWrapper)(nil)
+)
+
+type TodoAuditWrapper struct {
+	base    domain.TodoRepository
+	auditor *auditDecorator.AuditRepoDecorator[*domain.Todo, domain.TodoID]
+}
+
+func NewTodoAuditWrapper(base domain.TodoRepository, auditRepo auditDomain.AuditRepository) *TodoAuditWrapper {
+	extractor := func(t *domain.Todo) map[string]any {
+		var recurrence *string
+		if t.Recurrence() != nil {
+			r := t.Recurrence().String()
+			recurrence = &r
+		}
+
+		return map[string]any{
+			"title":       t.Title().String(),
+			"status":      t.Status().String(),
+			"due_date":    t.DueDate(),
+			"recurrence":  recurrence,
+			"assignee_id": t.AssigneeID(),
+			"tags":        t.Tags(),
+			"blocked_by":  t.BlockedBy(),
+		}
+	}
+
+	return &TodoAuditWrapper{
+		base: base,
+		auditor: auditDecorator.NewAuditRepoDecorator(
+			auditRepo,
+			shared.AggTodo,
+			extractor,
+			func(id domain.TodoID) uuid.UUID { return id.UUID() },
+			func(t *domain.Todo) *uuid.UUID {
+				id := t.WorkspaceID().UUID()
+				return &id
+			},
+		),
+	}
+}
+
+func (w *TodoAuditWrapper) Save(ctx context.Context, todo *domain.Todo) error {
+	return w.auditor.AuditSave(ctx, todo, auditDomain.OpUpsert, w.base.FindByID, w.base.Save)
+}
+
+func (w *TodoAuditWrapper) Delete(ctx context.Context, id domain.TodoID) error {
+	return w.auditor.AuditDelete(ctx, id, w.base.FindByID, w.base.Delete)
+}
+
+/*
+* Read methods bypass audit
+ */
+
+func (w *TodoAuditWrapper) FindByID(ctx context.Context, id domain.TodoID) (*domain.Todo, error) {
+	return w.base.FindByID(ctx, id)
+}
+
+func (w *TodoAuditWrapper) FindByIDForUpdate(ctx context.Context, id domain.TodoID) (*domain.Todo, error) {
+	return w.base.FindByIDForUpdate(ctx, id)
+}
+
+func (w *TodoAuditWrapper) FindAllByWorkspace(ctx context.Context, wsID wsDomain.WorkspaceID) ([]*domain.Todo, error) {
+	return w.base.FindAllByWorkspace(ctx, wsID)
+}
+
+func (w *TodoAuditWrapper) LockDependencies(ctx context.Context, wsID wsDomain.WorkspaceID) error {
+	return w.base.LockDependencies(ctx, wsID)
+}
+
+func (w *TodoAuditWrapper) FindBlockerIDs(ctx context.Context, id domain.TodoID) ([]domain.TodoID, error) {
+	return w.base.FindBlockerIDs(ctx, id)
+}
+
+func (w *TodoAuditWrapper) FindDependents(ctx context.Context, blockerID domain.TodoID) ([]*domain.Todo, error) {
+	return w.base.FindDependents(ctx, blockerID)
+}
+
+func (w *TodoAuditWrapper) FindAssignedInWorkspace(ctx context.Context, wsID wsDomain.WorkspaceID, assigneeID userDomain.UserID) ([]*domain.Todo, error) {
+	return w.base.FindAssignedInWorkspace(ctx, wsID, assigneeID)
+}
+
+func (w *TodoAuditWrapper) FindByTag(ctx context.Context, tagID domain.TagID) ([]*domain.Todo, error) {
+	return w.base.FindByTag(ctx, tagID)
+}
+
+func (w *TodoAuditWrapper) HasActiveFocusSession(ctx context.Context, userID userDomain.UserID) (bool, error) {
+	return w.base.HasActiveFocusSession(ctx, userID)
+}
+
+func (w *TodoAuditWrapper) FindElapsedFocus(ctx context.Context, now time.Time, maxDuration time.Duration, limit int32) ([]domain.TodoID, error) {
+	return w.base.FindElapsedFocus(ctx, now, maxDuration, limit)
+}
+
+type TagAuditWrapper struct {
+	base    domain.TagRepository
+	auditor *auditDecorator.AuditRepoDecorator[*domain.Tag, domain.TagID]
+}
+
+func NewTagAuditWrapper(base domain.TagRepository, auditRepo auditDomain.AuditRepository) *TagAuditWrapper {
+	extractor := func(t *domain.Tag) map[string]any {
+		return map[string]any{
+			"name":  t.Name().String(),
+			"color": t.Color().String(),
+		}
+	}
+
+	return &TagAuditWrapper{
+		base: base,
+		auditor: auditDecorator.NewAuditRepoDecorator(
+			auditRepo,
+			shared.AggTag,
+			extractor,
+			func(id domain.TagID) uuid.UUID { return id.UUID() },
+			func(t *domain.Tag) *uuid.UUID {
+				id := t.WorkspaceID().UUID()
+				return &id
+			},
+		),
+	}
+}
+
+func (w *TagAuditWrapper) Save(ctx context.Context, tag *domain.Tag) error {
+	return w.auditor.AuditSave(ctx, tag, auditDomain.OpUpsert, w.base.FindByID, w.base.Save)
+}
+
+func (w *TagAuditWrapper) Delete(ctx context.Context, id domain.TagID) error {
+	return w.auditor.AuditDelete(ctx, id, w.base.FindByID, w.base.Delete)
+}
+
+/*
+* Read methods bypass audit
+ */
+
+func (w *TagAuditWrapper) FindByID(ctx context.Context, id domain.TagID) (*domain.Tag, error) {
+	return w.base.FindByID(ctx, id)
+}
+
+func (w *TagAuditWrapper) FindByName(ctx context.Context, workspaceID wsDomain.WorkspaceID, name string) (*domain.Tag, error) {
+	return w.base.FindByName(ctx, workspaceID, name)
+}
@@ -0,0 +1,50 @@
+package decorator
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/infrastructure/cache"
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/application"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+)
+
+type focusReportQueryServiceCache struct {
+	base  application.FocusReportQueryService
+	store cache.Store
+	ttl   time.Duration
+}
+
+func NewFocusReportQueryServiceCache(
+	base application.FocusReportQueryService,
+	store cache.Store,
+	ttl time.Duration,
+) application.FocusReportQueryService {
+	return &focusReportQueryServiceCache{
+		base:  base,
+		store: store,
+		ttl:   ttl,
+	}
+}
+
+// GetFocusReport is keyed by the workspace revision, which changes whenever a todo
+// and therefore its focus sessions are saved.
+func (s *focusReportQueryServiceCache) GetFocusReport(
+	ctx context.Context,
+	wsID wsDomain.WorkspaceID,
+	filter application.FocusReportFilter,
+) (application.FocusReportReadModel, error) {
+	revisionBytes, _ := s.store.Get(ctx, cache.Keys.WorkspaceRevision(wsID))
+
+	revision := string(revisionBytes)
+	if revision == "" {
+		revision = "0"
+	}
+
+	key := cache.Keys.FocusReport(wsID, filter.Signature(), revision)
+	tag := cache.Keys.WorkspaceTag(wsID)
+
+	return cache.GetOrFetch(ctx, s.store, key, s.ttl, cache.NewMsgpackCodec[application.FocusReportReadModel](), func(ctx context.Context) (application.FocusReportReadModel, error) {
+		return s.base.GetFocusReport(ctx, wsID, filter)
+	}, tag)
+}
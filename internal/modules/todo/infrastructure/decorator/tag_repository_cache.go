@@ -52,6 +52,7 @@ func (r *tagRepositoryCache) FindByID(ctx context.Context, id domain.TagID) (*do
 	})
 }
 
+// FindByName is not cached: it backs uniqueness checks, and renames would leave stale name keys.
 func (r *tagRepositoryCache) FindByName(ctx context.Context, workspaceID wsDomain.WorkspaceID, name string) (*domain.Tag, error) {
 	return r.base.FindByName(ctx, workspaceID, name)
 }
@@ -69,6 +70,8 @@ func (r *tagRepositoryCache) Delete(ctx context.Context, id domain.TagID) error
 	db.AfterCommit(ctx, func(ctx context.Context) {
 		_ = r.store.Delete(ctx, cache.Keys.Tag(id))
 		_ = r.store.Invalidate(ctx, cache.Keys.WorkspaceTag(tag.WorkspaceID()))
+		// todo_tags rows cascade, so tag filters and listing ETags change too
+		_, _ = r.store.Incr(ctx, cache.Keys.WorkspaceRevision(tag.WorkspaceID()))
 	})
 
 	return nil
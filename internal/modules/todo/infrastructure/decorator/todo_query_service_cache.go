@@ -7,6 +7,7 @@ import (
 	"github.com/danicc097/todo-ddd-example/internal/infrastructure/cache"
 	"github.com/danicc097/todo-ddd-example/internal/modules/todo/application"
 	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
 	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
 )
 
@@ -31,7 +32,12 @@ func NewTodoQueryServiceCache(
 	}
 }
 
-func (s *todoQueryServiceCache) GetAllByWorkspace(ctx context.Context, wsID wsDomain.WorkspaceID, limit, offset int32) ([]application.TodoReadModel, error) {
+func (s *todoQueryServiceCache) GetAllByWorkspace(ctx context.Context, wsID wsDomain.WorkspaceID, filter application.TodoListFilter) (application.TodoPage, error) {
+	// overdue depends on the current time, which the workspace revision does not track
+	if filter.Overdue != nil {
+		return s.base.GetAllByWorkspace(ctx, wsID, filter)
+	}
+
 	revisionBytes, _ := s.store.Get(ctx, cache.Keys.WorkspaceRevision(wsID))
 
 	revision := string(revisionBytes)
@@ -39,11 +45,11 @@ func (s *todoQueryServiceCache) GetAllByWorkspace(ctx context.Context, wsID wsDo
 		revision = "0"
 	}
 
-	key := cache.Keys.TodoWorkspaceCollectionPaginated(wsID, limit, offset, revision)
+	key := cache.Keys.TodoWorkspaceCollectionQuery(wsID, filter.Signature(), revision)
 	tag := cache.Keys.WorkspaceTag(wsID)
 
-	return cache.GetOrFetch(ctx, s.store, key, s.ttl, cache.NewCollectionCodec[application.TodoReadModel](), func(ctx context.Context) ([]application.TodoReadModel, error) {
-		return s.base.GetAllByWorkspace(ctx, wsID, limit, offset)
+	return cache.GetOrFetch(ctx, s.store, key, s.ttl, cache.NewMsgpackCodec[application.TodoPage](), func(ctx context.Context) (application.TodoPage, error) {
+		return s.base.GetAllByWorkspace(ctx, wsID, filter)
 	}, tag)
 }
 
@@ -54,3 +60,23 @@ func (s *todoQueryServiceCache) GetByID(ctx context.Context, id domain.TodoID) (
 		return s.base.GetByID(ctx, id)
 	})
 }
+
+// Search is not cached: free-text queries rarely repeat.
+func (s *todoQueryServiceCache) Search(ctx context.Context, wsID wsDomain.WorkspaceID, query string, limit, offset int32) ([]application.TodoSearchResultReadModel, error) {
+	return s.base.Search(ctx, wsID, query, limit, offset)
+}
+
+// ListAssignedTo is not cached: it spans workspaces, so no single revision key invalidates it.
+func (s *todoQueryServiceCache) ListAssignedTo(ctx context.Context, userID userDomain.UserID, limit, offset int32) ([]application.TodoReadModel, error) {
+	return s.base.ListAssignedTo(ctx, userID, limit, offset)
+}
+
+// ListUnassignedDue is not cached: it spans workspaces, so no single revision key invalidates it.
+func (s *todoQueryServiceCache) ListUnassignedDue(ctx context.Context, userID userDomain.UserID, dueBefore time.Time, limit, offset int32) ([]application.TodoReadModel, error) {
+	return s.base.ListUnassignedDue(ctx, userID, dueBefore, limit, offset)
+}
+
+// ListComments is not cached: comments are not part of the workspace revision key.
+func (s *todoQueryServiceCache) ListComments(ctx context.Context, todoID domain.TodoID, limit, offset int32) ([]application.CommentReadModel, error) {
+	return s.base.ListComments(ctx, todoID, limit, offset)
+}
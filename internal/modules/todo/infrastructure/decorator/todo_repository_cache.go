@@ -7,6 +7,7 @@ import (
 	"github.com/danicc097/todo-ddd-example/internal/infrastructure/cache"
 	"github.com/danicc097/todo-ddd-example/internal/infrastructure/db"
 	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
 	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
 )
 
@@ -53,6 +54,11 @@ func (r *todoRepositoryCache) FindByID(ctx context.Context, id domain.TodoID) (*
 	})
 }
 
+// FindByIDForUpdate is not cached since entries are only invalidated after commit and may be stale inside a transaction.
+func (r *todoRepositoryCache) FindByIDForUpdate(ctx context.Context, id domain.TodoID) (*domain.Todo, error) {
+	return r.base.FindByIDForUpdate(ctx, id)
+}
+
 func (r *todoRepositoryCache) FindAllByWorkspace(ctx context.Context, wsID wsDomain.WorkspaceID) ([]*domain.Todo, error) {
 	revisionBytes, _ := r.store.Get(ctx, cache.Keys.WorkspaceRevision(wsID))
 
@@ -87,3 +93,34 @@ func (r *todoRepositoryCache) Delete(ctx context.Context, id domain.TodoID) erro
 
 	return nil
 }
+
+// LockDependencies has nothing to cache, the lock is held by the database transaction.
+func (r *todoRepositoryCache) LockDependencies(ctx context.Context, wsID wsDomain.WorkspaceID) error {
+	return r.base.LockDependencies(ctx, wsID)
+}
+
+// FindBlockerIDs is not cached since cycle detection needs the current graph.
+func (r *todoRepositoryCache) FindBlockerIDs(ctx context.Context, id domain.TodoID) ([]domain.TodoID, error) {
+	return r.base.FindBlockerIDs(ctx, id)
+}
+
+func (r *todoRepositoryCache) FindDependents(ctx context.Context, blockerID domain.TodoID) ([]*domain.Todo, error) {
+	return r.base.FindDependents(ctx, blockerID)
+}
+
+func (r *todoRepositoryCache) FindAssignedInWorkspace(ctx context.Context, wsID wsDomain.WorkspaceID, assigneeID userDomain.UserID) ([]*domain.Todo, error) {
+	return r.base.FindAssignedInWorkspace(ctx, wsID, assigneeID)
+}
+
+func (r *todoRepositoryCache) FindByTag(ctx context.Context, tagID domain.TagID) ([]*domain.Todo, error) {
+	return r.base.FindByTag(ctx, tagID)
+}
+
+// HasActiveFocusSession is not cached since it guards starting a new session.
+func (r *todoRepositoryCache) HasActiveFocusSession(ctx context.Context, userID userDomain.UserID) (bool, error) {
+	return r.base.HasActiveFocusSession(ctx, userID)
+}
+
+func (r *todoRepositoryCache) FindElapsedFocus(ctx context.Context, now time.Time, maxDuration time.Duration, limit int32) ([]domain.TodoID, error) {
+	return r.base.FindElapsedFocus(ctx, now, maxDuration, limit)
+}
@@ -3,6 +3,7 @@ package http
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/redis/go-redis/v9"
@@ -12,6 +13,7 @@ import (
 	"github.com/danicc097/todo-ddd-example/internal/modules/todo/application"
 	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
 	"github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/ws"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
 	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
 	infraHttp "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/http"
 )
@@ -53,6 +55,7 @@ func (h *TodoHandler) CreateTodo(c *gin.Context, id wsDomain.WorkspaceID, params
 		DueDate:            req.DueDate,
 		RecurrenceInterval: (*string)(req.RecurrenceInterval),
 		RecurrenceAmount:   req.RecurrenceAmount,
+		RecurrenceRule:     req.RecurrenceRule,
 	})
 	if ok {
 		c.JSON(http.StatusCreated, api.IdResponse{Id: resp.ID.UUID()})
@@ -60,40 +63,196 @@ func (h *TodoHandler) CreateTodo(c *gin.Context, id wsDomain.WorkspaceID, params
 }
 
 func (h *TodoHandler) GetWorkspaceTodos(c *gin.Context, id wsDomain.WorkspaceID, params api.GetWorkspaceTodosParams) {
-	revision, err := h.redis.Get(c.Request.Context(), cache.Keys.WorkspaceRevision(id)).Result()
-	if err == nil {
-		etag := fmt.Sprintf(`"W/%s"`, revision)
+	filter, err := todoListFilterFromParams(params)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
-		if c.Request.Header.Get("If-None-Match") == etag {
-			c.AbortWithStatus(http.StatusNotModified)
-			return
+	// overdue depends on the current time, which the workspace revision does not track
+	if filter.Overdue == nil {
+		revision, err := h.redis.Get(c.Request.Context(), cache.Keys.WorkspaceRevision(id)).Result()
+		if err == nil {
+			etag := fmt.Sprintf(`"W/%s"`, revision)
+
+			if c.Request.Header.Get("If-None-Match") == etag {
+				c.AbortWithStatus(http.StatusNotModified)
+				return
+			}
+
+			c.Header("ETag", etag)
 		}
+	}
 
-		c.Header("ETag", etag)
+	page, err := h.queryService.GetAllByWorkspace(c.Request.Context(), id, filter)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if page.NextCursor != "" {
+		c.Header("X-Next-Cursor", page.NextCursor)
+	}
+
+	apiTodos := make([]api.Todo, len(page.Items))
+	for i, t := range page.Items {
+		apiTodos[i] = h.mapReadModelToAPI(t)
+	}
+
+	c.JSON(http.StatusOK, apiTodos)
+}
+
+func todoListFilterFromParams(params api.GetWorkspaceTodosParams) (application.TodoListFilter, error) {
+	filter := application.TodoListFilter{
+		Limit:     int32(infraHttp.DefaultPaginationLimit),
+		DueBefore: params.DueBefore,
+		DueAfter:  params.DueAfter,
+		Overdue:   params.Overdue,
+		Recurring: params.Recurring,
 	}
 
-	limit := infraHttp.DefaultPaginationLimit
 	if params.Limit != nil {
-		limit = *params.Limit
+		filter.Limit = int32(*params.Limit)
 	}
 
-	offset := 0
 	if params.Offset != nil {
-		offset = *params.Offset
+		filter.Offset = int32(*params.Offset)
 	}
 
-	todos, err := h.queryService.GetAllByWorkspace(c.Request.Context(), id, int32(limit), int32(offset))
-	if err != nil {
-		c.Error(err)
+	if params.Cursor != nil {
+		filter.Cursor = *params.Cursor
+	}
+
+	if params.Status != nil {
+		for _, st := range *params.Status {
+			filter.Statuses = append(filter.Statuses, domain.TodoStatus(st))
+		}
+	}
+
+	if params.TagIds != nil {
+		for _, id := range *params.TagIds {
+			filter.TagIDs = append(filter.TagIDs, domain.TagID(id))
+		}
+	}
+
+	var sort string
+	if params.Sort != nil {
+		sort = string(*params.Sort)
+	}
+
+	var err error
+
+	filter.Sort, err = application.ParseTodoSort(sort)
+
+	return filter, err
+}
+
+func (h *TodoHandler) SearchWorkspaceTodos(c *gin.Context, id wsDomain.WorkspaceID, params api.SearchWorkspaceTodosParams) {
+	query := application.SearchTodosQuery{
+		WorkspaceID: id,
+		Query:       params.Q,
+		Limit:       int32(infraHttp.DefaultPaginationLimit),
+	}
+
+	if params.Limit != nil {
+		query.Limit = int32(*params.Limit)
+	}
+
+	if params.Offset != nil {
+		query.Offset = int32(*params.Offset)
+	}
+
+	resp, ok := infraHttp.Execute(c, h.uc.Search, query)
+	if !ok {
 		return
 	}
 
-	apiTodos := make([]api.Todo, len(todos))
-	for i, t := range todos {
-		apiTodos[i] = h.mapReadModelToAPI(t)
+	results := make([]api.TodoSearchResult, len(resp.Results))
+	for i, r := range resp.Results {
+		results[i] = api.TodoSearchResult{
+			Id:          r.ID,
+			WorkspaceId: r.WorkspaceID,
+			Title:       r.Title,
+			Status:      api.TodoStatus(r.Status),
+			CreatedAt:   r.CreatedAt,
+			DueDate:     r.DueDate,
+			Rank:        r.Rank,
+			Highlight:   r.Highlight,
+		}
 	}
 
-	c.JSON(http.StatusOK, apiTodos)
+	c.JSON(http.StatusOK, results)
+}
+
+func (h *TodoHandler) GetWorkspaceFocusReport(c *gin.Context, id wsDomain.WorkspaceID, params api.GetWorkspaceFocusReportParams) {
+	query := application.GetFocusReportQuery{
+		WorkspaceID: id,
+		From:        params.From.Time,
+		To:          params.To.Time,
+		GroupBy:     application.FocusGroupByDay,
+	}
+
+	if params.GroupBy != nil {
+		query.GroupBy = application.FocusReportGroupBy(*params.GroupBy)
+	}
+
+	if params.UserID != nil {
+		userID := userDomain.UserID(*params.UserID)
+		query.UserID = &userID
+	}
+
+	resp, ok := infraHttp.Execute(c, h.uc.GetFocusReport, query)
+	if !ok {
+		return
+	}
+
+	groups := make([]api.FocusReportGroup, len(resp.Report.Groups))
+	for i, g := range resp.Report.Groups {
+		groups[i] = api.FocusReportGroup{
+			Key:                   g.Key,
+			Label:                 g.Label,
+			TotalSeconds:          g.TotalSeconds,
+			SessionCount:          g.SessionCount,
+			AverageSessionSeconds: g.AverageSessionSeconds,
+		}
+	}
+
+	c.JSON(http.StatusOK, api.FocusReport{
+		GroupBy:               api.FocusReportGroupBy(query.GroupBy),
+		TotalSeconds:          resp.Report.TotalSeconds,
+		SessionCount:          resp.Report.SessionCount,
+		AverageSessionSeconds: resp.Report.AverageSessionSeconds,
+		CurrentStreakDays:     resp.Report.CurrentStreakDays,
+		LongestStreakDays:     resp.Report.LongestStreakDays,
+		Groups:                groups,
+	})
+}
+
+func (h *TodoHandler) GetUserAssignedTodos(c *gin.Context, id userDomain.UserID, params api.GetUserAssignedTodosParams) {
+	query := application.GetAssignedTodosQuery{
+		UserID: id,
+		Limit:  int32(infraHttp.DefaultPaginationLimit),
+	}
+
+	if params.Limit != nil {
+		query.Limit = int32(*params.Limit)
+	}
+
+	if params.Offset != nil {
+		query.Offset = int32(*params.Offset)
+	}
+
+	resp, ok := infraHttp.Execute(c, h.uc.GetAssignedTodos, query)
+	if !ok {
+		return
+	}
+
+	todos := make([]api.Todo, len(resp.Todos))
+	for i, t := range resp.Todos {
+		todos[i] = h.mapReadModelToAPI(t)
+	}
+
+	c.JSON(http.StatusOK, todos)
 }
 
 func (h *TodoHandler) GetTodoByID(c *gin.Context, id domain.TodoID) {
@@ -109,13 +268,34 @@ func (h *TodoHandler) GetTodoByID(c *gin.Context, id domain.TodoID) {
 func (h *TodoHandler) mapReadModelToAPI(t application.TodoReadModel) api.Todo {
 	sessions := make([]api.FocusSession, len(t.FocusSessions))
 	for i, s := range t.FocusSessions {
+		var planned *int
+		if s.PlannedDuration > 0 {
+			secs := int(s.PlannedDuration / time.Second)
+			planned = &secs
+		}
+
 		sessions[i] = api.FocusSession{
-			Id:        s.ID,
-			StartTime: s.StartTime,
-			EndTime:   s.EndTime,
+			Id:                     s.ID,
+			StartTime:              s.StartTime,
+			EndTime:                s.EndTime,
+			PlannedDurationSeconds: planned,
+		}
+	}
+
+	checklist := make([]api.ChecklistItem, len(t.ChecklistItems))
+	for i, it := range t.ChecklistItems {
+		checklist[i] = api.ChecklistItem{
+			Id:       it.ID,
+			Title:    it.Title,
+			Done:     it.Done,
+			Required: it.Required,
+			Position: it.Position,
 		}
 	}
 
+	blockedBy := make([]domain.TodoID, len(t.BlockedBy))
+	copy(blockedBy, t.BlockedBy)
+
 	return api.Todo{
 		CompletionLogs:     nil,
 		Id:                 t.ID,
@@ -126,7 +306,12 @@ func (h *TodoHandler) mapReadModelToAPI(t application.TodoReadModel) api.Todo {
 		DueDate:            t.DueDate,
 		RecurrenceInterval: (*api.RecurrenceInterval)(t.RecurrenceInterval),
 		RecurrenceAmount:   t.RecurrenceAmount,
+		RecurrenceRule:     t.RecurrenceRule,
+		LastCompletedAt:    t.LastCompletedAt,
 		FocusSessions:      &sessions,
+		ChecklistItems:     &checklist,
+		BlockedBy:          &blockedBy,
+		AssigneeId:         t.AssigneeID,
 	}
 }
 
@@ -136,6 +321,72 @@ func (h *TodoHandler) CompleteTodo(c *gin.Context, id domain.TodoID, params api.
 	}
 }
 
+func (h *TodoHandler) UpdateTodo(c *gin.Context, id domain.TodoID, params api.UpdateTodoParams) {
+	req, ok := infraHttp.BindJSON[api.UpdateTodoRequest](c)
+	if !ok {
+		return
+	}
+
+	if req.Title != nil {
+		if _, ok := infraHttp.Execute(c, h.uc.Rename, application.RenameTodoCommand{
+			ID:    id,
+			Title: *req.Title,
+		}); !ok {
+			return
+		}
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *TodoHandler) SetTodoDueDate(c *gin.Context, id domain.TodoID, params api.SetTodoDueDateParams) {
+	req, ok := infraHttp.BindJSON[api.SetTodoDueDateRequest](c)
+	if !ok {
+		return
+	}
+
+	if _, ok := infraHttp.Execute(c, h.uc.SetDueDate, application.SetDueDateCommand{
+		ID:      id,
+		DueDate: req.DueDate,
+	}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *TodoHandler) SetTodoRecurrence(c *gin.Context, id domain.TodoID, params api.SetTodoRecurrenceParams) {
+	req, ok := infraHttp.BindJSON[api.SetTodoRecurrenceRequest](c)
+	if !ok {
+		return
+	}
+
+	if _, ok := infraHttp.Execute(c, h.uc.SetRecurrence, application.SetRecurrenceCommand{
+		ID:       id,
+		Interval: (*string)(req.RecurrenceInterval),
+		Amount:   req.RecurrenceAmount,
+		Rule:     req.RecurrenceRule,
+	}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *TodoHandler) ArchiveTodo(c *gin.Context, id domain.TodoID, params api.ArchiveTodoParams) {
+	if _, ok := infraHttp.Execute(c, h.uc.Archive, application.ArchiveTodoCommand{ID: id}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *TodoHandler) UnarchiveTodo(c *gin.Context, id domain.TodoID, params api.UnarchiveTodoParams) {
+	if _, ok := infraHttp.Execute(c, h.uc.Unarchive, application.UnarchiveTodoCommand{ID: id}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *TodoHandler) ReopenTodo(c *gin.Context, id domain.TodoID, params api.ReopenTodoParams) {
+	if _, ok := infraHttp.Execute(c, h.uc.Reopen, application.ReopenTodoCommand{ID: id}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
 func (h *TodoHandler) AssignTagToTodo(c *gin.Context, id domain.TodoID, params api.AssignTagToTodoParams) {
 	req, ok := infraHttp.BindJSON[api.AssignTagToTodoRequest](c)
 	if !ok {
@@ -150,8 +401,201 @@ func (h *TodoHandler) AssignTagToTodo(c *gin.Context, id domain.TodoID, params a
 	}
 }
 
+func (h *TodoHandler) RemoveTagFromTodo(c *gin.Context, id domain.TodoID, tagId domain.TagID, params api.RemoveTagFromTodoParams) {
+	if _, ok := infraHttp.Execute(c, h.uc.RemoveTag, application.RemoveTagFromTodoCommand{
+		TodoID: id,
+		TagID:  tagId,
+	}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *TodoHandler) AddChecklistItem(c *gin.Context, id domain.TodoID, params api.AddChecklistItemParams) {
+	req, ok := infraHttp.BindJSON[api.AddChecklistItemRequest](c)
+	if !ok {
+		return
+	}
+
+	required := true
+	if req.Required != nil {
+		required = *req.Required
+	}
+
+	resp, ok := infraHttp.Execute(c, h.uc.AddChecklistItem, application.AddChecklistItemCommand{
+		TodoID:   id,
+		Title:    req.Title,
+		Required: required,
+	})
+	if ok {
+		c.JSON(http.StatusCreated, api.IdResponse{Id: resp.ID.UUID()})
+	}
+}
+
+func (h *TodoHandler) ToggleChecklistItem(c *gin.Context, id domain.TodoID, itemID domain.ChecklistItemID, params api.ToggleChecklistItemParams) {
+	if _, ok := infraHttp.Execute(c, h.uc.ToggleChecklistItem, application.ToggleChecklistItemCommand{
+		TodoID: id,
+		ItemID: itemID,
+	}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *TodoHandler) ReorderChecklist(c *gin.Context, id domain.TodoID) {
+	req, ok := infraHttp.BindJSON[api.ReorderChecklistRequest](c)
+	if !ok {
+		return
+	}
+
+	if _, ok := infraHttp.Execute(c, h.uc.ReorderChecklist, application.ReorderChecklistCommand{
+		TodoID:  id,
+		ItemIDs: req.ItemIds,
+	}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *TodoHandler) RemoveChecklistItem(c *gin.Context, id domain.TodoID, itemID domain.ChecklistItemID) {
+	if _, ok := infraHttp.Execute(c, h.uc.RemoveChecklistItem, application.RemoveChecklistItemCommand{
+		TodoID: id,
+		ItemID: itemID,
+	}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *TodoHandler) AddTodoBlocker(c *gin.Context, id domain.TodoID) {
+	req, ok := infraHttp.BindJSON[api.AddTodoBlockerRequest](c)
+	if !ok {
+		return
+	}
+
+	if _, ok := infraHttp.Execute(c, h.uc.AddBlocker, application.AddBlockerCommand{
+		TodoID:    id,
+		BlockerID: req.BlockerId,
+	}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *TodoHandler) RemoveTodoBlocker(c *gin.Context, id domain.TodoID, blockerID domain.TodoID) {
+	if _, ok := infraHttp.Execute(c, h.uc.RemoveBlocker, application.RemoveBlockerCommand{
+		TodoID:    id,
+		BlockerID: blockerID,
+	}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *TodoHandler) AssignTodo(c *gin.Context, id domain.TodoID) {
+	req, ok := infraHttp.BindJSON[api.AssignTodoRequest](c)
+	if !ok {
+		return
+	}
+
+	if _, ok := infraHttp.Execute(c, h.uc.Assign, application.AssignTodoCommand{
+		TodoID:     id,
+		AssigneeID: req.AssigneeId,
+	}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *TodoHandler) UnassignTodo(c *gin.Context, id domain.TodoID) {
+	if _, ok := infraHttp.Execute(c, h.uc.Unassign, application.UnassignTodoCommand{TodoID: id}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *TodoHandler) GetTodoComments(c *gin.Context, id domain.TodoID, params api.GetTodoCommentsParams) {
+	query := application.GetCommentsQuery{
+		TodoID: id,
+		Limit:  int32(infraHttp.DefaultPaginationLimit),
+	}
+
+	if params.Limit != nil {
+		query.Limit = int32(*params.Limit)
+	}
+
+	if params.Offset != nil {
+		query.Offset = int32(*params.Offset)
+	}
+
+	resp, ok := infraHttp.Execute(c, h.uc.GetComments, query)
+	if !ok {
+		return
+	}
+
+	comments := make([]api.Comment, len(resp.Comments))
+	for i, cm := range resp.Comments {
+		comments[i] = api.Comment{
+			Id:        cm.ID,
+			TodoId:    cm.TodoID,
+			AuthorId:  cm.AuthorID,
+			Body:      cm.Body,
+			Mentions:  cm.Mentions,
+			CreatedAt: cm.CreatedAt,
+			EditedAt:  cm.EditedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, comments)
+}
+
+func (h *TodoHandler) CreateTodoComment(c *gin.Context, id domain.TodoID) {
+	req, ok := infraHttp.BindJSON[api.CommentRequest](c)
+	if !ok {
+		return
+	}
+
+	resp, ok := infraHttp.Execute(c, h.uc.AddComment, application.AddCommentCommand{
+		TodoID: id,
+		Body:   req.Body,
+	})
+	if ok {
+		c.JSON(http.StatusCreated, api.IdResponse{Id: resp.ID.UUID()})
+	}
+}
+
+func (h *TodoHandler) UpdateTodoComment(c *gin.Context, id domain.TodoID, commentID domain.CommentID) {
+	req, ok := infraHttp.BindJSON[api.CommentRequest](c)
+	if !ok {
+		return
+	}
+
+	if _, ok := infraHttp.Execute(c, h.uc.EditComment, application.EditCommentCommand{
+		TodoID:    id,
+		CommentID: commentID,
+		Body:      req.Body,
+	}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *TodoHandler) DeleteTodoComment(c *gin.Context, id domain.TodoID, commentID domain.CommentID) {
+	if _, ok := infraHttp.Execute(c, h.uc.DeleteComment, application.DeleteCommentCommand{
+		TodoID:    id,
+		CommentID: commentID,
+	}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
 func (h *TodoHandler) StartFocus(c *gin.Context, id domain.TodoID) {
-	if _, ok := infraHttp.Execute(c, h.uc.StartFocus, application.StartFocusCommand{ID: id}); ok {
+	cmd := application.StartFocusCommand{ID: id}
+
+	// the body is optional
+	if c.Request.ContentLength != 0 {
+		req, ok := infraHttp.BindJSON[api.StartFocusRequest](c)
+		if !ok {
+			return
+		}
+
+		if req.PlannedDurationSeconds != nil {
+			cmd.PlannedDuration = time.Duration(*req.PlannedDurationSeconds) * time.Second
+		}
+	}
+
+	if _, ok := infraHttp.Execute(c, h.uc.StartFocus, cmd); ok {
 		c.Status(http.StatusNoContent)
 	}
 }
@@ -170,9 +614,35 @@ func (h *TodoHandler) CreateTag(c *gin.Context, id wsDomain.WorkspaceID, params
 
 	resp, ok := infraHttp.Execute(c, h.uc.CreateTag, application.CreateTagCommand{
 		Name:        req.Name,
+		Color:       req.Color,
 		WorkspaceID: id,
 	})
 	if ok {
 		c.JSON(http.StatusCreated, api.IdResponse{Id: resp.ID.UUID()})
 	}
 }
+
+func (h *TodoHandler) UpdateTag(c *gin.Context, id wsDomain.WorkspaceID, tagId domain.TagID, params api.UpdateTagParams) {
+	req, ok := infraHttp.BindJSON[api.UpdateTagRequest](c)
+	if !ok {
+		return
+	}
+
+	if _, ok := infraHttp.Execute(c, h.uc.UpdateTag, application.UpdateTagCommand{
+		WorkspaceID: id,
+		TagID:       tagId,
+		Name:        req.Name,
+		Color:       req.Color,
+	}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *TodoHandler) DeleteTag(c *gin.Context, id wsDomain.WorkspaceID, tagId domain.TagID, params api.DeleteTagParams) {
+	if _, ok := infraHttp.Execute(c, h.uc.DeleteTag, application.DeleteTagCommand{
+		WorkspaceID: id,
+		TagID:       tagId,
+	}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
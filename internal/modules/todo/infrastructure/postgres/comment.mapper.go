@@ -0,0 +1,126 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danicc097/todo-ddd-example/internal/generated/db"
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+type CommentMapper struct{}
+
+func (m *CommentMapper) ToDomain(row db.GetTodoCommentByIDRow) *domain.Comment {
+	body, _ := domain.NewCommentBody(row.Body)
+
+	return domain.ReconstituteComment(domain.ReconstituteCommentArgs{
+		ID:          row.ID,
+		TodoID:      row.TodoID,
+		WorkspaceID: row.WorkspaceID,
+		AuthorID:    row.AuthorID,
+		Body:        body,
+		CreatedAt:   row.CreatedAt,
+		EditedAt:    row.EditedAt,
+	})
+}
+
+// ToPersistence maps Domain to the primary table struct.
+func (m *CommentMapper) ToPersistence(c *domain.Comment) db.TodoComments {
+	mentions := make([]uuid.UUID, len(c.Body().Mentions()))
+	for i, id := range c.Body().Mentions() {
+		mentions[i] = id.UUID()
+	}
+
+	return db.TodoComments{
+		ID:        c.ID(),
+		TodoID:    c.TodoID(),
+		AuthorID:  c.AuthorID(),
+		Body:      c.Body().String(),
+		Mentions:  mentions,
+		CreatedAt: c.CreatedAt(),
+		EditedAt:  c.EditedAt(),
+		DeletedAt: c.DeletedAt(),
+	}
+}
+
+type CommentOutboxDTO struct {
+	ID           domain.CommentID     `json:"id"`
+	TodoID       domain.TodoID        `json:"todo_id"`
+	WorkspaceID  wsDomain.WorkspaceID `json:"workspace_id"`
+	AuthorID     userDomain.UserID    `json:"author_id"`
+	Body         string               `json:"body"`
+	Mentions     []userDomain.UserID  `json:"mentions"`
+	CreatedAt    time.Time            `json:"created_at"`
+	EventVersion int                  `json:"event_version"`
+}
+
+type CommentEditedOutboxDTO struct {
+	ID           domain.CommentID     `json:"id"`
+	TodoID       domain.TodoID        `json:"todo_id"`
+	WorkspaceID  wsDomain.WorkspaceID `json:"workspace_id"`
+	Body         string               `json:"body"`
+	NewMentions  []userDomain.UserID  `json:"new_mentions"`
+	ActorID      userDomain.UserID    `json:"actor_id"`
+	EventVersion int                  `json:"event_version"`
+}
+
+type CommentDeletedOutboxDTO struct {
+	ID           domain.CommentID     `json:"id"`
+	TodoID       domain.TodoID        `json:"todo_id"`
+	WorkspaceID  wsDomain.WorkspaceID `json:"workspace_id"`
+	ActorID      userDomain.UserID    `json:"actor_id"`
+	EventVersion int                  `json:"event_version"`
+}
+
+func (m *CommentMapper) MapEvent(e shared.DomainEvent) (shared.EventType, any, error) {
+	var payload any
+
+	switch evt := e.(type) {
+	case domain.CommentAddedEvent:
+		payload = CommentOutboxDTO{
+			ID:           evt.ID,
+			TodoID:       evt.TodoID,
+			WorkspaceID:  evt.WsID,
+			AuthorID:     evt.AuthorID,
+			Body:         evt.Body.String(),
+			Mentions:     nonNilUserIDs(evt.Body.Mentions()),
+			CreatedAt:    evt.Occurred,
+			EventVersion: 1,
+		}
+	case domain.CommentEditedEvent:
+		payload = CommentEditedOutboxDTO{
+			ID:           evt.ID,
+			TodoID:       evt.TodoID,
+			WorkspaceID:  evt.WsID,
+			Body:         evt.Body.String(),
+			NewMentions:  nonNilUserIDs(evt.NewMentions),
+			ActorID:      evt.ActorID,
+			EventVersion: 1,
+		}
+	case domain.CommentDeletedEvent:
+		payload = CommentDeletedOutboxDTO{
+			ID:           evt.ID,
+			TodoID:       evt.TodoID,
+			WorkspaceID:  evt.WsID,
+			ActorID:      evt.ActorID,
+			EventVersion: 1,
+		}
+	default:
+		return "", nil, nil
+	}
+
+	return e.EventName(), payload, nil
+}
+
+// nonNilUserIDs keeps empty lists as [] rather than null in payloads.
+func nonNilUserIDs(ids []userDomain.UserID) []userDomain.UserID {
+	if ids == nil {
+		return []userDomain.UserID{}
+	}
+
+	return ids
+}
@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/danicc097/todo-ddd-example/internal/generated/db"
+	infraDB "github.com/danicc097/todo-ddd-example/internal/infrastructure/db"
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+)
+
+type CommentRepo struct {
+	q      *db.Queries
+	pool   *pgxpool.Pool
+	mapper *CommentMapper
+	uow    application.UnitOfWork
+}
+
+func NewCommentRepo(pool *pgxpool.Pool, uow application.UnitOfWork) *CommentRepo {
+	return &CommentRepo{
+		q:      db.New(),
+		pool:   pool,
+		mapper: &CommentMapper{},
+		uow:    uow,
+	}
+}
+
+func (r *CommentRepo) getDB(ctx context.Context) db.DBTX {
+	if tx := infraDB.ExtractTx(ctx); tx != nil {
+		return tx
+	}
+
+	return r.pool
+}
+
+func (r *CommentRepo) Save(ctx context.Context, c *domain.Comment) error {
+	p := r.mapper.ToPersistence(c)
+
+	if err := r.q.UpsertTodoComment(ctx, r.getDB(ctx), db.UpsertTodoCommentParams(p)); err != nil {
+		return fmt.Errorf("failed to upsert comment %s: %w", c.ID(), sharedPg.ParseDBError(err))
+	}
+
+	r.uow.Collect(ctx, r.mapper, c)
+
+	return nil
+}
+
+func (r *CommentRepo) FindByID(ctx context.Context, id domain.CommentID) (*domain.Comment, error) {
+	row, err := r.q.GetTodoCommentByID(ctx, r.getDB(ctx), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrCommentNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get comment %s: %w", id, sharedPg.ParseDBError(err))
+	}
+
+	return r.mapper.ToDomain(row), nil
+}
@@ -0,0 +1,94 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../../../../../templates/opentelemetry.gotmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package postgres
+
+import (
+	"context"
+
+	_sourceDomain "github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	"go.opentelemetry.io/otel/attribute"
+
+	"go.opentelemetry.io/otel"
+	_codes "go.opentelemetry.io/otel/codes"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CommentRepositoryWithTracing implements CommentRepository interface instrumented with open telemetry spans
+type CommentRepositoryWithTracing struct {
+	_sourceDomain.CommentRepository
+	_instance      string
+	_spanDecorator func(span trace.Span, params, results map[string]interface{})
+}
+
+// NewCommentRepositoryWithTracing returns CommentRepositoryWithTracing
+func NewCommentRepositoryWithTracing(base _sourceDomain.CommentRepository, instance string, spanDecorator ...func(span trace.Span, params, results map[string]interface{})) CommentRepositoryWithTracing {
+	d := CommentRepositoryWithTracing{
+		CommentRepository: base,
+		_instance:         instance,
+	}
+
+	if len(spanDecorator) > 0 && spanDecorator[0] != nil {
+		d._spanDecorator = spanDecorator[0]
+	}
+
+	return d
+}
+
+// FindByID implements CommentRepository
+func (_d CommentRepositoryWithTracing) FindByID(ctx context.Context, id _sourceDomain.CommentID) (cp1 *_sourceDomain.Comment, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "CommentRepository.FindByID", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "FindByID"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx": ctx,
+				"id":  id}, map[string]interface{}{
+				"cp1": cp1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.CommentRepository.FindByID(ctx, id)
+}
+
+// Save implements CommentRepository
+func (_d CommentRepositoryWithTracing) Save(ctx context.Context, comment *_sourceDomain.Comment) (err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "CommentRepository.Save", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "Save"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":     ctx,
+				"comment": comment}, map[string]interface{}{
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.CommentRepository.Save(ctx, comment)
+}
@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/danicc097/todo-ddd-example/internal/generated/db"
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/application"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+)
+
+type focusReportQueryService struct {
+	q    *db.Queries
+	pool *pgxpool.Pool
+}
+
+func NewFocusReportQueryService(pool *pgxpool.Pool) application.FocusReportQueryService {
+	return &focusReportQueryService{
+		q:    db.New(),
+		pool: pool,
+	}
+}
+
+func (s *focusReportQueryService) GetFocusReport(ctx context.Context, wsID wsDomain.WorkspaceID, filter application.FocusReportFilter) (application.FocusReportReadModel, error) {
+	var userID *uuid.UUID
+	if filter.UserID != nil {
+		id := filter.UserID.UUID()
+		userID = &id
+	}
+
+	tz := filter.Location.String()
+
+	totals, err := s.q.GetFocusTotals(ctx, s.pool, db.GetFocusTotalsParams{
+		WorkspaceID: wsID,
+		FromTime:    filter.From,
+		ToTime:      filter.To,
+		UserID:      userID,
+	})
+	if err != nil {
+		return application.FocusReportReadModel{}, fmt.Errorf("failed to get focus totals: %w", sharedPg.ParseDBError(err))
+	}
+
+	streaks, err := s.q.GetFocusStreaks(ctx, s.pool, db.GetFocusStreaksParams{
+		Tz:          tz,
+		ToTime:      filter.To,
+		WorkspaceID: wsID,
+		FromTime:    filter.From,
+		UserID:      userID,
+	})
+	if err != nil {
+		return application.FocusReportReadModel{}, fmt.Errorf("failed to get focus streaks: %w", sharedPg.ParseDBError(err))
+	}
+
+	groups, err := s.groups(ctx, wsID, filter, tz, userID)
+	if err != nil {
+		return application.FocusReportReadModel{}, err
+	}
+
+	return application.FocusReportReadModel{
+		TotalSeconds:          totals.TotalSeconds,
+		SessionCount:          totals.SessionCount,
+		AverageSessionSeconds: average(totals.TotalSeconds, totals.SessionCount),
+		CurrentStreakDays:     int(streaks.CurrentStreak),
+		LongestStreakDays:     int(streaks.LongestStreak),
+		Groups:                groups,
+	}, nil
+}
+
+func (s *focusReportQueryService) groups(
+	ctx context.Context,
+	wsID wsDomain.WorkspaceID,
+	filter application.FocusReportFilter,
+	tz string,
+	userID *uuid.UUID,
+) ([]application.FocusReportGroupReadModel, error) {
+	var (
+		groups []application.FocusReportGroupReadModel
+		err    error
+	)
+
+	switch filter.GroupBy {
+	case application.FocusGroupByTodo:
+		var rows []db.GetFocusByTodoRow
+
+		rows, err = s.q.GetFocusByTodo(ctx, s.pool, db.GetFocusByTodoParams{WorkspaceID: wsID, FromTime: filter.From, ToTime: filter.To, UserID: userID})
+		for _, r := range rows {
+			groups = append(groups, focusGroup(r.Key, r.Label, r.TotalSeconds, r.SessionCount))
+		}
+	case application.FocusGroupByTag:
+		var rows []db.GetFocusByTagRow
+
+		rows, err = s.q.GetFocusByTag(ctx, s.pool, db.GetFocusByTagParams{WorkspaceID: wsID, FromTime: filter.From, ToTime: filter.To, UserID: userID})
+		for _, r := range rows {
+			groups = append(groups, focusGroup(r.Key, r.Label, r.TotalSeconds, r.SessionCount))
+		}
+	case application.FocusGroupByMember:
+		var rows []db.GetFocusByMemberRow
+
+		rows, err = s.q.GetFocusByMember(ctx, s.pool, db.GetFocusByMemberParams{WorkspaceID: wsID, FromTime: filter.From, ToTime: filter.To, UserID: userID})
+		for _, r := range rows {
+			groups = append(groups, focusGroup(r.Key, r.Label, r.TotalSeconds, r.SessionCount))
+		}
+	case application.FocusGroupByDay:
+		var rows []db.GetFocusByDayRow
+
+		rows, err = s.q.GetFocusByDay(ctx, s.pool, db.GetFocusByDayParams{Tz: tz, WorkspaceID: wsID, FromTime: filter.From, ToTime: filter.To, UserID: userID})
+		for _, r := range rows {
+			groups = append(groups, focusGroup(r.Key, r.Key, r.TotalSeconds, r.SessionCount))
+		}
+	default:
+		return nil, application.ErrInvalidFocusGrouping
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to group focus sessions by %s: %w", filter.GroupBy, sharedPg.ParseDBError(err))
+	}
+
+	if groups == nil {
+		groups = []application.FocusReportGroupReadModel{}
+	}
+
+	return groups, nil
+}
+
+func focusGroup(key, label string, total, count int64) application.FocusReportGroupReadModel {
+	return application.FocusReportGroupReadModel{
+		Key:                   key,
+		Label:                 label,
+		TotalSeconds:          total,
+		SessionCount:          count,
+		AverageSessionSeconds: average(total, count),
+	}
+}
+
+func average(total, count int64) int64 {
+	if count == 0 {
+		return 0
+	}
+
+	return total / count
+}
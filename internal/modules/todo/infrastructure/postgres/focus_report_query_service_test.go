@@ -0,0 +1,114 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/application"
+	todoPg "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/postgres"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"github.com/danicc097/todo-ddd-example/internal/testfixtures"
+	"github.com/danicc097/todo-ddd-example/internal/testutils"
+)
+
+func TestFocusReportQueryService_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pool := testutils.GetGlobalPostgresPool(t)
+	fixtures := testfixtures.NewFixtures(pool)
+	qs := todoPg.NewFocusReportQueryService(pool)
+
+	alice := fixtures.RandomUser(ctx, t)
+	bob := fixtures.RandomUser(ctx, t)
+	ws := fixtures.RandomWorkspace(ctx, t, alice.ID())
+	tag := fixtures.RandomTag(ctx, t, ws.ID())
+	tagged := fixtures.RandomTodo(ctx, t, ws.ID())
+	untagged := fixtures.RandomTodo(ctx, t, ws.ID())
+
+	_, err := pool.Exec(ctx, `INSERT INTO todo_tags (todo_id, tag_id) VALUES ($1, $2)`, tagged.ID().UUID(), tag.ID().UUID())
+	require.NoError(t, err)
+
+	day := func(d, h, m int) time.Time { return time.Date(2024, 3, d, h, m, 0, 0, time.UTC) }
+	session := func(todoID uuid.UUID, userID userDomain.UserID, start time.Time, end *time.Time) {
+		_, err := pool.Exec(ctx, `INSERT INTO todo_focus_sessions (id, todo_id, user_id, start_time, end_time) VALUES ($1, $2, $3, $4, $5)`,
+			uuid.New(), todoID, userID.UUID(), start, end)
+		require.NoError(t, err)
+	}
+	ptr := func(t time.Time) *time.Time { return &t }
+
+	session(tagged.ID().UUID(), alice.ID(), day(1, 10, 0), ptr(day(1, 10, 30)))
+	session(tagged.ID().UUID(), alice.ID(), day(2, 10, 0), ptr(day(2, 11, 0)))
+	session(untagged.ID().UUID(), bob.ID(), day(2, 12, 0), ptr(day(2, 12, 15)))
+	session(untagged.ID().UUID(), bob.ID(), day(3, 9, 0), nil)
+	session(tagged.ID().UUID(), alice.ID(), day(4, 8, 0), ptr(day(4, 8, 10)))
+	session(tagged.ID().UUID(), alice.ID(), day(5, 8, 0), ptr(day(5, 9, 0)))
+
+	// sessions on deleted todos are left out of every report
+	deleted := fixtures.RandomTodo(ctx, t, ws.ID())
+	_, err = pool.Exec(ctx, `INSERT INTO todo_tags (todo_id, tag_id) VALUES ($1, $2)`, deleted.ID().UUID(), tag.ID().UUID())
+	require.NoError(t, err)
+	session(deleted.ID().UUID(), bob.ID(), day(4, 12, 0), ptr(day(4, 13, 0)))
+	_, err = pool.Exec(ctx, `UPDATE todos SET deleted_at = NOW() WHERE id = $1`, deleted.ID().UUID())
+	require.NoError(t, err)
+
+	filter := func(groupBy application.FocusReportGroupBy) application.FocusReportFilter {
+		return application.FocusReportFilter{From: day(1, 0, 0), To: day(5, 0, 0), Location: time.UTC, GroupBy: groupBy}
+	}
+
+	t.Run("totals and streaks by day", func(t *testing.T) {
+		report, err := qs.GetFocusReport(ctx, ws.ID(), filter(application.FocusGroupByDay))
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(6900), report.TotalSeconds)
+		assert.Equal(t, int64(4), report.SessionCount)
+		assert.Equal(t, int64(1725), report.AverageSessionSeconds)
+		assert.Equal(t, 2, report.LongestStreakDays)
+		assert.Equal(t, 1, report.CurrentStreakDays)
+
+		keys := make([]string, len(report.Groups))
+		for i, g := range report.Groups {
+			keys[i] = g.Key
+		}
+
+		assert.Equal(t, []string{"2024-03-01", "2024-03-02", "2024-03-04"}, keys)
+		assert.Equal(t, int64(4500), report.Groups[1].TotalSeconds)
+	})
+
+	t.Run("groups by todo, tag and member", func(t *testing.T) {
+		byTodo, err := qs.GetFocusReport(ctx, ws.ID(), filter(application.FocusGroupByTodo))
+		require.NoError(t, err)
+		require.Len(t, byTodo.Groups, 2)
+		assert.Equal(t, tagged.ID().String(), byTodo.Groups[0].Key)
+		assert.Equal(t, int64(6000), byTodo.Groups[0].TotalSeconds)
+		assert.Equal(t, int64(2000), byTodo.Groups[0].AverageSessionSeconds)
+
+		byTag, err := qs.GetFocusReport(ctx, ws.ID(), filter(application.FocusGroupByTag))
+		require.NoError(t, err)
+		require.Len(t, byTag.Groups, 1)
+		assert.Equal(t, tag.Name().String(), byTag.Groups[0].Label)
+
+		byMember, err := qs.GetFocusReport(ctx, ws.ID(), filter(application.FocusGroupByMember))
+		require.NoError(t, err)
+		require.Len(t, byMember.Groups, 2)
+		assert.Equal(t, alice.ID().String(), byMember.Groups[0].Key)
+		assert.Equal(t, int64(900), byMember.Groups[1].TotalSeconds)
+	})
+
+	t.Run("filters by member", func(t *testing.T) {
+		f := filter(application.FocusGroupByDay)
+		bobID := bob.ID()
+		f.UserID = &bobID
+
+		report, err := qs.GetFocusReport(ctx, ws.ID(), f)
+		require.NoError(t, err)
+		assert.Equal(t, int64(900), report.TotalSeconds)
+		assert.Equal(t, 1, report.LongestStreakDays)
+		assert.Equal(t, 0, report.CurrentStreakDays)
+	})
+}
@@ -0,0 +1,69 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../../../../../templates/opentelemetry.gotmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package postgres
+
+import (
+	"context"
+
+	_sourceApplication "github.com/danicc097/todo-ddd-example/internal/modules/todo/application"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+	"go.opentelemetry.io/otel/attribute"
+
+	"go.opentelemetry.io/otel"
+	_codes "go.opentelemetry.io/otel/codes"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// FocusReportQueryServiceWithTracing implements FocusReportQueryService interface instrumented with open telemetry spans
+type FocusReportQueryServiceWithTracing struct {
+	_sourceApplication.FocusReportQueryService
+	_instance      string
+	_spanDecorator func(span trace.Span, params, results map[string]interface{})
+}
+
+// NewFocusReportQueryServiceWithTracing returns FocusReportQueryServiceWithTracing
+func NewFocusReportQueryServiceWithTracing(base _sourceApplication.FocusReportQueryService, instance string, spanDecorator ...func(span trace.Span, params, results map[string]interface{})) FocusReportQueryServiceWithTracing {
+	d := FocusReportQueryServiceWithTracing{
+		FocusReportQueryService: base,
+		_instance:               instance,
+	}
+
+	if len(spanDecorator) > 0 && spanDecorator[0] != nil {
+		d._spanDecorator = spanDecorator[0]
+	}
+
+	return d
+}
+
+// GetFocusReport implements FocusReportQueryService
+func (_d FocusReportQueryServiceWithTracing) GetFocusReport(ctx context.Context, wsID wsDomain.WorkspaceID, filter _sourceApplication.FocusReportFilter) (f1 _sourceApplication.FocusReportReadModel, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "FocusReportQueryService.GetFocusReport", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "GetFocusReport"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"wsID":   wsID,
+				"filter": filter}, map[string]interface{}{
+				"f1":  f1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.FocusReportQueryService.GetFocusReport(ctx, wsID, filter)
+}
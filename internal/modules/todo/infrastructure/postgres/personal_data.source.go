@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/danicc097/todo-ddd-example/internal/generated/db"
+	userApp "github.com/danicc097/todo-ddd-example/internal/modules/user/application"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+)
+
+type personalDataSource struct {
+	section string
+	collect func(ctx context.Context, userID userDomain.UserID) (any, error)
+}
+
+func (s *personalDataSource) Section() string { return s.section }
+
+func (s *personalDataSource) Collect(ctx context.Context, userID userDomain.UserID) (any, error) {
+	return s.collect(ctx, userID)
+}
+
+// NewPersonalDataSources exports the todos a user was assigned, completed, commented on or focused on,
+// along with their comments and focus sessions.
+func NewPersonalDataSources(pool *pgxpool.Pool) []userApp.PersonalDataSource {
+	q := db.New()
+
+	return []userApp.PersonalDataSource{
+		&personalDataSource{
+			section: "todos",
+			collect: func(ctx context.Context, userID userDomain.UserID) (any, error) {
+				rows, err := q.ListTodosTouchedByUser(ctx, pool, userID.UUID())
+				if err != nil {
+					return nil, fmt.Errorf("failed to list todos of user %s: %w", userID, sharedPg.ParseDBError(err))
+				}
+
+				return rows, nil
+			},
+		},
+		&personalDataSource{
+			section: "comments",
+			collect: func(ctx context.Context, userID userDomain.UserID) (any, error) {
+				rows, err := q.ListUserComments(ctx, pool, userID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to list comments of user %s: %w", userID, sharedPg.ParseDBError(err))
+				}
+
+				return rows, nil
+			},
+		},
+		&personalDataSource{
+			section: "focus_sessions",
+			collect: func(ctx context.Context, userID userDomain.UserID) (any, error) {
+				rows, err := q.ListUserFocusSessions(ctx, pool, userID.UUID())
+				if err != nil {
+					return nil, fmt.Errorf("failed to list focus sessions of user %s: %w", userID, sharedPg.ParseDBError(err))
+				}
+
+				return rows, nil
+			},
+		},
+	}
+}
@@ -11,10 +11,12 @@ type TagMapper struct{}
 
 func (m *TagMapper) ToDomain(row db.Tags) *domain.Tag {
 	name, _ := domain.NewTagName(row.Name)
+	color, _ := domain.NewTagColor(row.Color)
 
 	return domain.ReconstituteTag(domain.ReconstituteTagArgs{
 		ID:          row.ID,
 		Name:        name,
+		Color:       color,
 		WorkspaceID: row.WorkspaceID,
 	})
 }
@@ -25,25 +27,75 @@ func (m *TagMapper) ToPersistence(t *domain.Tag) db.Tags {
 		ID:          t.ID(),
 		Name:        t.Name().String(),
 		WorkspaceID: t.WorkspaceID(),
+		Color:       t.Color().String(),
 	}
 }
 
 type TagCreatedDTO struct {
 	ID           domain.TagID         `json:"id"`
 	Name         string               `json:"name"`
+	Color        string               `json:"color"`
+	WorkspaceID  wsDomain.WorkspaceID `json:"workspace_id"`
+	EventVersion int                  `json:"event_version"`
+}
+
+type TagRenamedDTO struct {
+	ID           domain.TagID         `json:"id"`
+	OldName      string               `json:"old_name"`
+	Name         string               `json:"name"`
+	WorkspaceID  wsDomain.WorkspaceID `json:"workspace_id"`
+	EventVersion int                  `json:"event_version"`
+}
+
+type TagRecoloredDTO struct {
+	ID           domain.TagID         `json:"id"`
+	Color        string               `json:"color"`
+	WorkspaceID  wsDomain.WorkspaceID `json:"workspace_id"`
+	EventVersion int                  `json:"event_version"`
+}
+
+type TagDeletedDTO struct {
+	ID           domain.TagID         `json:"id"`
 	WorkspaceID  wsDomain.WorkspaceID `json:"workspace_id"`
 	EventVersion int                  `json:"event_version"`
 }
 
 func (m *TagMapper) MapEvent(e shared.DomainEvent) (shared.EventType, any, error) {
-	if evt, ok := e.(domain.TagCreatedEvent); ok {
-		return shared.TodoTagCreated, TagCreatedDTO{
+	var payload any
+
+	switch evt := e.(type) {
+	case domain.TagCreatedEvent:
+		payload = TagCreatedDTO{
+			ID:           evt.ID,
+			Name:         evt.Name.String(),
+			Color:        evt.Color.String(),
+			WorkspaceID:  evt.WsID,
+			EventVersion: 1,
+		}
+	case domain.TagRenamedEvent:
+		payload = TagRenamedDTO{
 			ID:           evt.ID,
+			OldName:      evt.OldName.String(),
 			Name:         evt.Name.String(),
 			WorkspaceID:  evt.WsID,
 			EventVersion: 1,
-		}, nil
+		}
+	case domain.TagRecoloredEvent:
+		payload = TagRecoloredDTO{
+			ID:           evt.ID,
+			Color:        evt.Color.String(),
+			WorkspaceID:  evt.WsID,
+			EventVersion: 1,
+		}
+	case domain.TagDeletedEvent:
+		payload = TagDeletedDTO{
+			ID:           evt.ID,
+			WorkspaceID:  evt.WsID,
+			EventVersion: 1,
+		}
+	default:
+		return "", nil, nil
 	}
 
-	return "", nil, nil
+	return e.EventName(), payload, nil
 }
@@ -41,8 +41,7 @@ func (r *TagRepo) Save(ctx context.Context, t *domain.Tag) error {
 	dbtx := r.getDB(ctx)
 	p := r.mapper.ToPersistence(t)
 
-	_, err := r.q.CreateTag(ctx, dbtx, db.CreateTagParams(p))
-	if err != nil {
+	if err := r.q.UpsertTag(ctx, dbtx, db.UpsertTagParams(p)); err != nil {
 		return fmt.Errorf("failed to save tag %s: %w", t.ID(), sharedPg.ParseDBError(err))
 	}
 
@@ -78,12 +77,21 @@ func (r *TagRepo) FindByName(ctx context.Context, workspaceID wsDomain.Workspace
 	return r.mapper.ToDomain(row), nil
 }
 
+// Delete removes the tag. Links to todos go with it, so callers that need
+// todo.tag_removed events must untag the todos first.
 func (r *TagRepo) Delete(ctx context.Context, id domain.TagID) error {
 	dbtx := r.getDB(ctx)
 
+	t, err := r.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
 	if err := r.q.DeleteTag(ctx, dbtx, id); err != nil {
 		return fmt.Errorf("failed to delete tag %s: %w", id, sharedPg.ParseDBError(err))
 	}
 
-	return nil
+	t.Delete()
+
+	return sharedPg.SaveDomainEvents(ctx, r.q, dbtx, r.mapper, t)
 }
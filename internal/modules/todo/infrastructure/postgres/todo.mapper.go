@@ -28,8 +28,9 @@ func (m *TodoMapper) ToDomain(row db.GetTodoAggregateByIDRow) *domain.Todo {
 		tagIDs[i] = domain.TagID(id)
 	}
 
-	recurrence := m.mapRecurrence(row.RecurrenceInterval, row.RecurrenceAmount)
+	recurrence := m.mapRecurrence(row.RecurrenceRule, row.RecurrenceInterval, row.RecurrenceAmount)
 	sessions := m.mapFocusSessionsDomain(row.FocusSessions)
+	checklist := m.mapChecklistItemsDomain(row.ChecklistItems)
 
 	return domain.ReconstituteTodo(domain.ReconstituteTodoArgs{
 		ID:              row.ID,
@@ -42,6 +43,11 @@ func (m *TodoMapper) ToDomain(row db.GetTodoAggregateByIDRow) *domain.Todo {
 		Recurrence:      recurrence,
 		LastCompletedAt: row.LastCompletedAt,
 		Sessions:        sessions,
+		Checklist:       checklist,
+		BlockedBy:       m.mapBlockedBy(row.BlockedBy),
+		AssigneeID:      row.AssigneeID,
+
+		CompletedOccurrences: int(row.RecurrenceOccurrences),
 	})
 }
 
@@ -53,8 +59,9 @@ func (m *TodoMapper) ListRowToDomain(row db.ListTodosByWorkspaceIDRow) *domain.T
 		tagIDs[i] = domain.TagID(id)
 	}
 
-	recurrence := m.mapRecurrence(row.RecurrenceInterval, row.RecurrenceAmount)
+	recurrence := m.mapRecurrence(row.RecurrenceRule, row.RecurrenceInterval, row.RecurrenceAmount)
 	sessions := m.mapFocusSessionsDomain(row.FocusSessions)
+	checklist := m.mapChecklistItemsDomain(row.ChecklistItems)
 
 	return domain.ReconstituteTodo(domain.ReconstituteTodoArgs{
 		ID:              row.ID,
@@ -67,10 +74,31 @@ func (m *TodoMapper) ListRowToDomain(row db.ListTodosByWorkspaceIDRow) *domain.T
 		Recurrence:      recurrence,
 		LastCompletedAt: row.LastCompletedAt,
 		Sessions:        sessions,
+		Checklist:       checklist,
+		BlockedBy:       m.mapBlockedBy(row.BlockedBy),
+		AssigneeID:      row.AssigneeID,
+
+		CompletedOccurrences: int(row.RecurrenceOccurrences),
 	})
 }
 
-func (m *TodoMapper) mapRecurrence(interval *string, amount *int32) *domain.RecurrenceRule {
+func (m *TodoMapper) mapBlockedBy(ids []uuid.UUID) []domain.TodoID {
+	blockedBy := make([]domain.TodoID, len(ids))
+	for i, id := range ids {
+		blockedBy[i] = domain.TodoID(id)
+	}
+
+	return blockedBy
+}
+
+// mapRecurrence prefers the full RRULE, falling back to the legacy interval columns.
+func (m *TodoMapper) mapRecurrence(rule, interval *string, amount *int32) *domain.RecurrenceRule {
+	if rule != nil {
+		if r, err := domain.ParseRecurrenceRule(*rule); err == nil {
+			return &r
+		}
+	}
+
 	if interval != nil && amount != nil {
 		r, _ := domain.NewRecurrenceRule(*interval, int(*amount))
 		return &r
@@ -79,10 +107,10 @@ func (m *TodoMapper) mapRecurrence(interval *string, amount *int32) *domain.Recu
 	return nil
 }
 
-func (m *TodoMapper) mapFocusSessions(raw any) []application.FocusSessionReadModel {
-	var sessions []application.FocusSessionReadModel
+// unmarshalJSONAgg decodes a json_agg column into dst, returning false if it is empty or malformed.
+func unmarshalJSONAgg(raw any, dst any) bool {
 	if raw == nil {
-		return sessions
+		return false
 	}
 
 	var (
@@ -99,67 +127,139 @@ func (m *TodoMapper) mapFocusSessions(raw any) []application.FocusSessionReadMod
 		b, err = json.Marshal(v)
 	}
 
-	if err == nil && len(b) > 0 {
-		var rawSessions []struct {
-			ID        uuid.UUID  `json:"id"`
-			UserID    uuid.UUID  `json:"user_id"`
-			StartTime time.Time  `json:"start_time"`
-			EndTime   *time.Time `json:"end_time"`
-		}
-		if err := json.Unmarshal(b, &rawSessions); err == nil {
-			for _, s := range rawSessions {
-				sessions = append(sessions, application.FocusSessionReadModel{
-					ID:        s.ID,
-					StartTime: s.StartTime,
-					EndTime:   s.EndTime,
-				})
-			}
-		}
+	if err != nil || len(b) == 0 {
+		return false
+	}
+
+	return json.Unmarshal(b, dst) == nil
+}
+
+type focusSessionRow struct {
+	ID                     uuid.UUID  `json:"id"`
+	UserID                 uuid.UUID  `json:"user_id"`
+	StartTime              time.Time  `json:"start_time"`
+	EndTime                *time.Time `json:"end_time"`
+	PlannedDurationSeconds *int       `json:"planned_duration_seconds"`
+}
+
+func (r focusSessionRow) plannedDuration() time.Duration {
+	if r.PlannedDurationSeconds == nil {
+		return 0
+	}
+
+	return time.Duration(*r.PlannedDurationSeconds) * time.Second
+}
+
+// plannedSeconds maps unbounded sessions to null.
+func plannedSeconds(d time.Duration) *int {
+	if d == 0 {
+		return nil
+	}
+
+	secs := int(d / time.Second)
+
+	return &secs
+}
+
+type checklistItemRow struct {
+	ID       uuid.UUID `json:"id"`
+	Title    string    `json:"title"`
+	Done     bool      `json:"done"`
+	Required bool      `json:"required"`
+	Position int       `json:"position"`
+}
+
+func (m *TodoMapper) mapFocusSessions(raw any) []application.FocusSessionReadModel {
+	var (
+		sessions    []application.FocusSessionReadModel
+		rawSessions []focusSessionRow
+	)
+
+	if !unmarshalJSONAgg(raw, &rawSessions) {
+		return sessions
+	}
+
+	for _, s := range rawSessions {
+		sessions = append(sessions, application.FocusSessionReadModel{
+			ID:              s.ID,
+			StartTime:       s.StartTime,
+			EndTime:         s.EndTime,
+			PlannedDuration: s.plannedDuration(),
+		})
 	}
 
 	return sessions
 }
 
 func (m *TodoMapper) mapFocusSessionsDomain(raw any) []domain.FocusSession {
-	var sessions []domain.FocusSession
-	if raw == nil {
+	var (
+		sessions    []domain.FocusSession
+		rawSessions []focusSessionRow
+	)
+
+	if !unmarshalJSONAgg(raw, &rawSessions) {
 		return sessions
 	}
 
+	for _, s := range rawSessions {
+		sessions = append(sessions, domain.ReconstituteFocusSession(domain.ReconstituteFocusSessionArgs{
+			ID:              domain.FocusSessionID(s.ID),
+			UserID:          userDomain.UserID(s.UserID),
+			StartTime:       s.StartTime,
+			EndTime:         s.EndTime,
+			PlannedDuration: s.plannedDuration(),
+		}))
+	}
+
+	return sessions
+}
+
+// mapChecklistItems expects rows ordered by position.
+func (m *TodoMapper) mapChecklistItems(raw any) []application.ChecklistItemReadModel {
 	var (
-		b   []byte
-		err error
+		items    []application.ChecklistItemReadModel
+		rawItems []checklistItemRow
 	)
 
-	switch v := raw.(type) {
-	case []byte:
-		b = v
-	case string:
-		b = []byte(v)
-	default:
-		b, err = json.Marshal(v)
+	if !unmarshalJSONAgg(raw, &rawItems) {
+		return items
 	}
 
-	if err == nil && len(b) > 0 {
-		var rawSessions []struct {
-			ID        uuid.UUID  `json:"id"`
-			UserID    uuid.UUID  `json:"user_id"`
-			StartTime time.Time  `json:"start_time"`
-			EndTime   *time.Time `json:"end_time"`
-		}
-		if err := json.Unmarshal(b, &rawSessions); err == nil {
-			for _, s := range rawSessions {
-				sessions = append(sessions, domain.ReconstituteFocusSession(domain.ReconstituteFocusSessionArgs{
-					ID:        domain.FocusSessionID(s.ID),
-					UserID:    userDomain.UserID(s.UserID),
-					StartTime: s.StartTime,
-					EndTime:   s.EndTime,
-				}))
-			}
-		}
+	for _, it := range rawItems {
+		items = append(items, application.ChecklistItemReadModel{
+			ID:       domain.ChecklistItemID(it.ID),
+			Title:    it.Title,
+			Done:     it.Done,
+			Required: it.Required,
+			Position: it.Position,
+		})
 	}
 
-	return sessions
+	return items
+}
+
+// mapChecklistItemsDomain expects rows ordered by position.
+func (m *TodoMapper) mapChecklistItemsDomain(raw any) []domain.ChecklistItem {
+	var (
+		items    []domain.ChecklistItem
+		rawItems []checklistItemRow
+	)
+
+	if !unmarshalJSONAgg(raw, &rawItems) {
+		return items
+	}
+
+	for _, it := range rawItems {
+		title, _ := domain.NewTodoTitle(it.Title)
+		items = append(items, domain.ReconstituteChecklistItem(domain.ReconstituteChecklistItemArgs{
+			ID:       domain.ChecklistItemID(it.ID),
+			Title:    title,
+			Done:     it.Done,
+			Required: it.Required,
+		}))
+	}
+
+	return items
 }
 
 // ToPersistence maps Domain to the primary table struct.
@@ -167,13 +267,16 @@ func (m *TodoMapper) ToPersistence(t *domain.Todo) db.Todos {
 	var (
 		rInterval *string
 		rAmount   *int32
+		rRule     *string
 	)
 
 	if t.Recurrence() != nil {
 		interval := t.Recurrence().Interval()
 		amount := int32(t.Recurrence().Amount())
+		rule := t.Recurrence().String()
 		rInterval = &interval
 		rAmount = &amount
+		rRule = &rule
 	}
 
 	return db.Todos{
@@ -186,6 +289,10 @@ func (m *TodoMapper) ToPersistence(t *domain.Todo) db.Todos {
 		RecurrenceInterval: rInterval,
 		RecurrenceAmount:   rAmount,
 		LastCompletedAt:    t.LastCompletedAt(),
+		RecurrenceRule:     rRule,
+
+		RecurrenceOccurrences: int32(t.CompletedOccurrences()),
+		AssigneeID:            t.AssigneeID(),
 	}
 }
 
@@ -217,12 +324,109 @@ type TagAddedOutboxDTO struct {
 	EventVersion int                  `json:"event_version"`
 }
 
+type TagRemovedOutboxDTO struct {
+	TodoID       domain.TodoID        `json:"todo_id"`
+	TagID        domain.TagID         `json:"tag_id"`
+	WorkspaceID  wsDomain.WorkspaceID `json:"workspace_id"`
+	ActorID      userDomain.UserID    `json:"actor_id"`
+	EventVersion int                  `json:"event_version"`
+}
+
+type TodoFocusElapsedOutboxDTO struct {
+	ID                     domain.TodoID        `json:"id"`
+	WorkspaceID            wsDomain.WorkspaceID `json:"workspace_id"`
+	SessionID              uuid.UUID            `json:"session_id"`
+	UserID                 userDomain.UserID    `json:"user_id"`
+	StartTime              time.Time            `json:"start_time"`
+	EndTime                time.Time            `json:"end_time"`
+	PlannedDurationSeconds *int                 `json:"planned_duration_seconds"`
+	Reason                 string               `json:"reason"`
+	EventVersion           int                  `json:"event_version"`
+}
+
 type TodoDeletedOutboxDTO struct {
 	ID           domain.TodoID        `json:"id"`
 	WorkspaceID  wsDomain.WorkspaceID `json:"workspace_id"`
 	EventVersion int                  `json:"event_version"`
 }
 
+type TodoRenamedOutboxDTO struct {
+	ID           domain.TodoID        `json:"id"`
+	WorkspaceID  wsDomain.WorkspaceID `json:"workspace_id"`
+	OldTitle     string               `json:"old_title"`
+	Title        string               `json:"title"`
+	ActorID      userDomain.UserID    `json:"actor_id"`
+	EventVersion int                  `json:"event_version"`
+}
+
+type TodoStatusChangedOutboxDTO struct {
+	ID             domain.TodoID        `json:"id"`
+	WorkspaceID    wsDomain.WorkspaceID `json:"workspace_id"`
+	Status         string               `json:"status"`
+	PreviousStatus string               `json:"previous_status,omitempty"`
+	ActorID        userDomain.UserID    `json:"actor_id"`
+	EventVersion   int                  `json:"event_version"`
+}
+
+type TodoDueDateChangedOutboxDTO struct {
+	ID           domain.TodoID        `json:"id"`
+	WorkspaceID  wsDomain.WorkspaceID `json:"workspace_id"`
+	DueDate      *time.Time           `json:"due_date"`
+	ActorID      userDomain.UserID    `json:"actor_id"`
+	EventVersion int                  `json:"event_version"`
+}
+
+type TodoRecurrenceChangedOutboxDTO struct {
+	ID                 domain.TodoID        `json:"id"`
+	WorkspaceID        wsDomain.WorkspaceID `json:"workspace_id"`
+	RecurrenceInterval *string              `json:"recurrence_interval"`
+	RecurrenceAmount   *int                 `json:"recurrence_amount"`
+	RecurrenceRule     *string              `json:"recurrence_rule"`
+	ActorID            userDomain.UserID    `json:"actor_id"`
+	EventVersion       int                  `json:"event_version"`
+}
+
+type ChecklistItemOutboxDTO struct {
+	ID           domain.TodoID          `json:"id"`
+	WorkspaceID  wsDomain.WorkspaceID   `json:"workspace_id"`
+	ItemID       domain.ChecklistItemID `json:"item_id"`
+	Title        string                 `json:"title,omitempty"`
+	Required     *bool                  `json:"required,omitempty"`
+	Done         *bool                  `json:"done,omitempty"`
+	ActorID      userDomain.UserID      `json:"actor_id"`
+	EventVersion int                    `json:"event_version"`
+}
+
+type ChecklistReorderedOutboxDTO struct {
+	ID           domain.TodoID            `json:"id"`
+	WorkspaceID  wsDomain.WorkspaceID     `json:"workspace_id"`
+	ItemIDs      []domain.ChecklistItemID `json:"item_ids"`
+	ActorID      userDomain.UserID        `json:"actor_id"`
+	EventVersion int                      `json:"event_version"`
+}
+
+type TodoDependencyOutboxDTO struct {
+	ID           domain.TodoID        `json:"id"`
+	WorkspaceID  wsDomain.WorkspaceID `json:"workspace_id"`
+	BlockerID    domain.TodoID        `json:"blocker_id"`
+	ActorID      userDomain.UserID    `json:"actor_id"`
+	EventVersion int                  `json:"event_version"`
+}
+
+type TodoUnblockedOutboxDTO struct {
+	ID           domain.TodoID        `json:"id"`
+	WorkspaceID  wsDomain.WorkspaceID `json:"workspace_id"`
+	EventVersion int                  `json:"event_version"`
+}
+
+type TodoAssignmentOutboxDTO struct {
+	ID           domain.TodoID        `json:"id"`
+	WorkspaceID  wsDomain.WorkspaceID `json:"workspace_id"`
+	AssigneeID   userDomain.UserID    `json:"assignee_id"`
+	ActorID      userDomain.UserID    `json:"actor_id"`
+	EventVersion int                  `json:"event_version"`
+}
+
 func (m *TodoMapper) MapEvent(e shared.DomainEvent) (shared.EventType, any, error) {
 	var payload any
 
@@ -259,6 +463,129 @@ func (m *TodoMapper) MapEvent(e shared.DomainEvent) (shared.EventType, any, erro
 			WorkspaceID:  evt.WsID,
 			EventVersion: 1,
 		}
+	case domain.TodoRenamedEvent:
+		payload = TodoRenamedOutboxDTO{
+			ID:           evt.ID,
+			WorkspaceID:  evt.WsID,
+			OldTitle:     evt.OldTitle.String(),
+			Title:        evt.Title.String(),
+			ActorID:      evt.ActorID,
+			EventVersion: 1,
+		}
+	case domain.TodoArchivedEvent:
+		payload = TodoStatusChangedOutboxDTO{
+			ID:           evt.ID,
+			WorkspaceID:  evt.WsID,
+			Status:       domain.StatusArchived.String(),
+			ActorID:      evt.ActorID,
+			EventVersion: 1,
+		}
+	case domain.TodoReopenedEvent:
+		payload = TodoStatusChangedOutboxDTO{
+			ID:             evt.ID,
+			WorkspaceID:    evt.WsID,
+			Status:         domain.StatusPending.String(),
+			PreviousStatus: evt.PreviousStatus.String(),
+			ActorID:        evt.ActorID,
+			EventVersion:   1,
+		}
+	case domain.TodoDueDateChangedEvent:
+		payload = TodoDueDateChangedOutboxDTO{
+			ID:           evt.ID,
+			WorkspaceID:  evt.WsID,
+			DueDate:      evt.DueDate,
+			ActorID:      evt.ActorID,
+			EventVersion: 1,
+		}
+	case domain.TodoRecurrenceChangedEvent:
+		dto := TodoRecurrenceChangedOutboxDTO{
+			ID:           evt.ID,
+			WorkspaceID:  evt.WsID,
+			ActorID:      evt.ActorID,
+			EventVersion: 1,
+		}
+
+		if evt.Recurrence != nil {
+			interval, amount, rule := evt.Recurrence.Interval(), evt.Recurrence.Amount(), evt.Recurrence.String()
+			dto.RecurrenceInterval = &interval
+			dto.RecurrenceAmount = &amount
+			dto.RecurrenceRule = &rule
+		}
+
+		payload = dto
+	case domain.ChecklistItemAddedEvent:
+		payload = ChecklistItemOutboxDTO{
+			ID:           evt.ID,
+			WorkspaceID:  evt.WsID,
+			ItemID:       evt.ItemID,
+			Title:        evt.Title.String(),
+			Required:     &evt.Required,
+			ActorID:      evt.ActorID,
+			EventVersion: 1,
+		}
+	case domain.ChecklistItemToggledEvent:
+		payload = ChecklistItemOutboxDTO{
+			ID:           evt.ID,
+			WorkspaceID:  evt.WsID,
+			ItemID:       evt.ItemID,
+			Done:         &evt.Done,
+			ActorID:      evt.ActorID,
+			EventVersion: 1,
+		}
+	case domain.ChecklistItemRemovedEvent:
+		payload = ChecklistItemOutboxDTO{
+			ID:           evt.ID,
+			WorkspaceID:  evt.WsID,
+			ItemID:       evt.ItemID,
+			ActorID:      evt.ActorID,
+			EventVersion: 1,
+		}
+	case domain.ChecklistReorderedEvent:
+		payload = ChecklistReorderedOutboxDTO{
+			ID:           evt.ID,
+			WorkspaceID:  evt.WsID,
+			ItemIDs:      evt.ItemIDs,
+			ActorID:      evt.ActorID,
+			EventVersion: 1,
+		}
+	case domain.TodoBlockedEvent:
+		payload = TodoDependencyOutboxDTO{
+			ID:           evt.ID,
+			WorkspaceID:  evt.WsID,
+			BlockerID:    evt.BlockerID,
+			ActorID:      evt.ActorID,
+			EventVersion: 1,
+		}
+	case domain.TodoBlockerRemovedEvent:
+		payload = TodoDependencyOutboxDTO{
+			ID:           evt.ID,
+			WorkspaceID:  evt.WsID,
+			BlockerID:    evt.BlockerID,
+			ActorID:      evt.ActorID,
+			EventVersion: 1,
+		}
+	case domain.TodoUnblockedEvent:
+		payload = TodoUnblockedOutboxDTO{
+			ID:           evt.ID,
+			WorkspaceID:  evt.WsID,
+			EventVersion: 1,
+		}
+	case domain.TodoAssignedEvent:
+		payload = TodoAssignmentOutboxDTO{
+			ID:           evt.ID,
+			WorkspaceID:  evt.WsID,
+			AssigneeID:   evt.AssigneeID,
+			ActorID:      evt.ActorID,
+			EventVersion: 1,
+		}
+	case domain.TodoUnassignedEvent:
+		payload = TodoAssignmentOutboxDTO{
+			ID:           evt.ID,
+			WorkspaceID:  evt.WsID,
+			AssigneeID:   evt.AssigneeID,
+			ActorID:      evt.ActorID,
+			EventVersion: 1,
+		}
 	case domain.TagAddedEvent:
 		payload = TagAddedOutboxDTO{
 			TodoID:       evt.TodoID,
@@ -266,6 +593,26 @@ func (m *TodoMapper) MapEvent(e shared.DomainEvent) (shared.EventType, any, erro
 			WorkspaceID:  evt.WsID,
 			EventVersion: 1,
 		}
+	case domain.TagRemovedEvent:
+		payload = TagRemovedOutboxDTO{
+			TodoID:       evt.TodoID,
+			TagID:        evt.TagID,
+			WorkspaceID:  evt.WsID,
+			ActorID:      evt.ActorID,
+			EventVersion: 1,
+		}
+	case domain.TodoFocusElapsedEvent:
+		payload = TodoFocusElapsedOutboxDTO{
+			ID:                     evt.ID,
+			WorkspaceID:            evt.WsID,
+			SessionID:              evt.SessionID.UUID(),
+			UserID:                 evt.UserID,
+			StartTime:              evt.StartedAt,
+			EndTime:                evt.Occurred,
+			PlannedDurationSeconds: plannedSeconds(evt.PlannedDuration),
+			Reason:                 string(evt.Reason),
+			EventVersion:           1,
+		}
 	default:
 		return "", nil, nil
 	}
@@ -2,7 +2,12 @@ package postgres
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -10,6 +15,7 @@ import (
 	"github.com/danicc097/todo-ddd-example/internal/generated/db"
 	"github.com/danicc097/todo-ddd-example/internal/modules/todo/application"
 	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
 	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
 )
 
@@ -27,19 +33,61 @@ func NewTodoQueryService(pool *pgxpool.Pool) application.TodoQueryService {
 	}
 }
 
-func (s *todoQueryService) GetAllByWorkspace(ctx context.Context, wsID wsDomain.WorkspaceID, limit, offset int32) ([]application.TodoReadModel, error) {
-	rows, err := s.q.ListTodosByWorkspaceID(ctx, s.pool, db.ListTodosByWorkspaceIDParams{
+func (s *todoQueryService) GetAllByWorkspace(ctx context.Context, wsID wsDomain.WorkspaceID, filter application.TodoListFilter) (application.TodoPage, error) {
+	sort := filter.Sort
+	if sort.Key == "" {
+		sort = application.DefaultTodoSort
+	}
+
+	params := db.ListTodosByWorkspaceIDParams{
 		WorkspaceID: wsID,
-		Limit:       limit,
-		Offset:      offset,
-	})
+		DueBefore:   filter.DueBefore,
+		DueAfter:    filter.DueAfter,
+		Overdue:     filter.Overdue,
+		Recurring:   filter.Recurring,
+		SortKey:     sortColumns[sort.Key],
+		SortDesc:    sort.Desc,
+		// fetch one extra row to know whether there is a next page
+		Lim: filter.Limit + 1,
+		Off: filter.Offset,
+	}
+
+	for _, st := range filter.Statuses {
+		params.Statuses = append(params.Statuses, st.String())
+	}
+
+	for _, id := range filter.TagIDs {
+		params.TagIds = append(params.TagIds, id.UUID())
+	}
+
+	if filter.Cursor != "" {
+		cur, err := decodeTodoCursor(filter.Cursor)
+		if err != nil || cur.Sort != sort.String() {
+			return application.TodoPage{}, application.ErrInvalidCursor
+		}
+
+		params.CursorID = &cur.ID
+		params.CursorTime = cur.Time
+		params.CursorText = cur.Text
+		params.Off = 0
+	}
+
+	rows, err := s.q.ListTodosByWorkspaceID(ctx, s.pool, params)
 	if err != nil {
-		return nil, err
+		return application.TodoPage{}, err
 	}
 
-	todos := make([]application.TodoReadModel, len(rows))
+	var page application.TodoPage
+
+	if len(rows) > int(filter.Limit) {
+		rows = rows[:filter.Limit]
+		last := rows[len(rows)-1]
+		page.NextCursor = encodeTodoCursor(sort, last.ID.UUID(), last.CreatedAt, last.DueDate, last.Title)
+	}
+
+	page.Items = make([]application.TodoReadModel, len(rows))
 	for i, r := range rows {
-		todos[i] = application.TodoReadModel{
+		page.Items[i] = application.TodoReadModel{
 			ID:                 r.ID,
 			WorkspaceID:        r.WorkspaceID,
 			Title:              r.Title,
@@ -48,11 +96,67 @@ func (s *todoQueryService) GetAllByWorkspace(ctx context.Context, wsID wsDomain.
 			DueDate:            r.DueDate,
 			RecurrenceInterval: r.RecurrenceInterval,
 			RecurrenceAmount:   mInt(r.RecurrenceAmount),
+			RecurrenceRule:     r.RecurrenceRule,
+			LastCompletedAt:    r.LastCompletedAt,
 			FocusSessions:      s.mapper.mapFocusSessions(r.FocusSessions),
+			ChecklistItems:     s.mapper.mapChecklistItems(r.ChecklistItems),
+			BlockedBy:          s.mapper.mapBlockedBy(r.BlockedBy),
+			AssigneeID:         r.AssigneeID,
 		}
 	}
 
-	return todos, nil
+	return page, nil
+}
+
+var sortColumns = map[application.TodoSortKey]string{
+	application.TodoSortCreatedAt: "created_at",
+	application.TodoSortDueDate:   "due_date",
+	application.TodoSortTitle:     "title",
+}
+
+// todoCursor is the keyset position of the last row of a page. Time holds timestamp sort keys
+// (nil for a missing due date) and Text holds the title.
+type todoCursor struct {
+	Sort string     `json:"s"`
+	ID   uuid.UUID  `json:"id"`
+	Time *time.Time `json:"t,omitempty"`
+	Text *string    `json:"x,omitempty"`
+}
+
+func encodeTodoCursor(sort application.TodoSort, id uuid.UUID, createdAt time.Time, dueDate *time.Time, title string) string {
+	cur := todoCursor{Sort: sort.String(), ID: id}
+
+	switch sort.Key {
+	case application.TodoSortCreatedAt:
+		cur.Time = &createdAt
+	case application.TodoSortDueDate:
+		cur.Time = dueDate
+	case application.TodoSortTitle:
+		cur.Text = &title
+	}
+
+	b, _ := json.Marshal(cur)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeTodoCursor(s string) (todoCursor, error) {
+	var cur todoCursor
+
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return cur, err
+	}
+
+	if err := json.Unmarshal(b, &cur); err != nil {
+		return cur, err
+	}
+
+	if cur.ID == uuid.Nil {
+		return cur, errors.New("missing cursor id")
+	}
+
+	return cur, nil
 }
 
 func (s *todoQueryService) GetByID(ctx context.Context, id domain.TodoID) (*application.TodoReadModel, error) {
@@ -74,10 +178,141 @@ func (s *todoQueryService) GetByID(ctx context.Context, id domain.TodoID) (*appl
 		DueDate:            row.DueDate,
 		RecurrenceInterval: row.RecurrenceInterval,
 		RecurrenceAmount:   mInt(row.RecurrenceAmount),
+		RecurrenceRule:     row.RecurrenceRule,
+		LastCompletedAt:    row.LastCompletedAt,
 		FocusSessions:      s.mapper.mapFocusSessions(row.FocusSessions),
+		ChecklistItems:     s.mapper.mapChecklistItems(row.ChecklistItems),
+		BlockedBy:          s.mapper.mapBlockedBy(row.BlockedBy),
+		AssigneeID:         row.AssigneeID,
 	}, nil
 }
 
+func (s *todoQueryService) ListAssignedTo(ctx context.Context, userID userDomain.UserID, limit, offset int32) ([]application.TodoReadModel, error) {
+	rows, err := s.q.ListTodosByAssigneeID(ctx, s.pool, db.ListTodosByAssigneeIDParams{
+		AssigneeID: &userID,
+		Lim:        limit,
+		Off:        offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	todos := make([]application.TodoReadModel, len(rows))
+	for i, r := range rows {
+		todos[i] = application.TodoReadModel{
+			ID:                 r.ID,
+			WorkspaceID:        r.WorkspaceID,
+			Title:              r.Title,
+			Status:             r.Status,
+			CreatedAt:          r.CreatedAt,
+			DueDate:            r.DueDate,
+			RecurrenceInterval: r.RecurrenceInterval,
+			RecurrenceAmount:   mInt(r.RecurrenceAmount),
+			RecurrenceRule:     r.RecurrenceRule,
+			LastCompletedAt:    r.LastCompletedAt,
+			FocusSessions:      s.mapper.mapFocusSessions(r.FocusSessions),
+			ChecklistItems:     s.mapper.mapChecklistItems(r.ChecklistItems),
+			BlockedBy:          s.mapper.mapBlockedBy(r.BlockedBy),
+			AssigneeID:         r.AssigneeID,
+		}
+	}
+
+	return todos, nil
+}
+
+func (s *todoQueryService) ListUnassignedDue(ctx context.Context, userID userDomain.UserID, dueBefore time.Time, limit, offset int32) ([]application.TodoReadModel, error) {
+	rows, err := s.q.ListUnassignedTodosDueForMember(ctx, s.pool, db.ListUnassignedTodosDueForMemberParams{
+		UserID:    userID.UUID(),
+		DueBefore: dueBefore,
+		Lim:       limit,
+		Off:       offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	todos := make([]application.TodoReadModel, len(rows))
+	for i, r := range rows {
+		todos[i] = application.TodoReadModel{
+			ID:                 r.ID,
+			WorkspaceID:        r.WorkspaceID,
+			Title:              r.Title,
+			Status:             r.Status,
+			CreatedAt:          r.CreatedAt,
+			DueDate:            r.DueDate,
+			RecurrenceInterval: r.RecurrenceInterval,
+			RecurrenceAmount:   mInt(r.RecurrenceAmount),
+			RecurrenceRule:     r.RecurrenceRule,
+			LastCompletedAt:    r.LastCompletedAt,
+			FocusSessions:      s.mapper.mapFocusSessions(r.FocusSessions),
+			ChecklistItems:     s.mapper.mapChecklistItems(r.ChecklistItems),
+			BlockedBy:          s.mapper.mapBlockedBy(r.BlockedBy),
+			AssigneeID:         r.AssigneeID,
+		}
+	}
+
+	return todos, nil
+}
+
+func (s *todoQueryService) ListComments(ctx context.Context, todoID domain.TodoID, limit, offset int32) ([]application.CommentReadModel, error) {
+	rows, err := s.q.ListTodoComments(ctx, s.pool, db.ListTodoCommentsParams{
+		TodoID: todoID,
+		Lim:    limit,
+		Off:    offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]application.CommentReadModel, len(rows))
+	for i, r := range rows {
+		mentions := make([]userDomain.UserID, len(r.Mentions))
+		for j, id := range r.Mentions {
+			mentions[j] = userDomain.UserID(id)
+		}
+
+		comments[i] = application.CommentReadModel{
+			ID:        r.ID,
+			TodoID:    r.TodoID,
+			AuthorID:  r.AuthorID,
+			Body:      r.Body,
+			Mentions:  mentions,
+			CreatedAt: r.CreatedAt,
+			EditedAt:  r.EditedAt,
+		}
+	}
+
+	return comments, nil
+}
+
+func (s *todoQueryService) Search(ctx context.Context, wsID wsDomain.WorkspaceID, query string, limit, offset int32) ([]application.TodoSearchResultReadModel, error) {
+	rows, err := s.q.SearchTodosByWorkspaceID(ctx, s.pool, db.SearchTodosByWorkspaceIDParams{
+		WorkspaceID: wsID,
+		Query:       query,
+		Lim:         limit,
+		Off:         offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]application.TodoSearchResultReadModel, len(rows))
+	for i, r := range rows {
+		results[i] = application.TodoSearchResultReadModel{
+			ID:          r.ID,
+			WorkspaceID: r.WorkspaceID,
+			Title:       r.Title,
+			Status:      r.Status,
+			CreatedAt:   r.CreatedAt,
+			DueDate:     r.DueDate,
+			Rank:        r.Rank,
+			Highlight:   r.Highlight,
+		}
+	}
+
+	return results, nil
+}
+
 func mInt(i *int32) *int {
 	if i == nil {
 		return nil
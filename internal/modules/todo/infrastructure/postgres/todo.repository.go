@@ -14,6 +14,7 @@ import (
 	"github.com/danicc097/todo-ddd-example/internal/generated/db"
 	infraDB "github.com/danicc097/todo-ddd-example/internal/infrastructure/db"
 	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
 	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
 	"github.com/danicc097/todo-ddd-example/internal/shared/application"
 	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
@@ -57,6 +58,10 @@ func (r *TodoRepo) Save(ctx context.Context, todo *domain.Todo) error {
 		RecurrenceInterval: p.RecurrenceInterval,
 		RecurrenceAmount:   p.RecurrenceAmount,
 		LastCompletedAt:    p.LastCompletedAt,
+		RecurrenceRule:     p.RecurrenceRule,
+
+		RecurrenceOccurrences: p.RecurrenceOccurrences,
+		AssigneeID:            p.AssigneeID,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to upsert todo %s: %w", todo.ID(), sharedPg.ParseDBError(err))
@@ -95,6 +100,7 @@ func (r *TodoRepo) Save(ctx context.Context, todo *domain.Todo) error {
 	userIDs := make([]uuid.UUID, 0, len(todo.Sessions()))
 	startTimes := make([]time.Time, 0, len(todo.Sessions()))
 	endTimes := make([]time.Time, 0, len(todo.Sessions()))
+	plannedSeconds := make([]int32, 0, len(todo.Sessions()))
 
 	for _, s := range todo.Sessions() {
 		sessionIDs = append(sessionIDs, s.ID().UUID())
@@ -107,6 +113,8 @@ func (r *TodoRepo) Save(ctx context.Context, todo *domain.Todo) error {
 		} else {
 			endTimes = append(endTimes, time.Time{})
 		}
+
+		plannedSeconds = append(plannedSeconds, int32(s.PlannedDuration()/time.Second))
 	}
 
 	err = r.q.RemoveMissingFocusSessionsFromTodo(ctx, dbtx, db.RemoveMissingFocusSessionsFromTodoParams{
@@ -119,22 +127,109 @@ func (r *TodoRepo) Save(ctx context.Context, todo *domain.Todo) error {
 
 	if len(sessionIDs) > 0 {
 		err = r.q.BulkUpsertFocusSessions(ctx, dbtx, db.BulkUpsertFocusSessionsParams{
-			Ids:        sessionIDs,
-			TodoIds:    todoIDs,
-			UserIds:    userIDs,
-			StartTimes: startTimes,
-			EndTimes:   endTimes,
+			Ids:                     sessionIDs,
+			TodoIds:                 todoIDs,
+			UserIds:                 userIDs,
+			StartTimes:              startTimes,
+			EndTimes:                endTimes,
+			PlannedDurationsSeconds: plannedSeconds,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to bulk upsert focus sessions for todo %s: %w", todo.ID(), sharedPg.ParseDBError(err))
 		}
 	}
 
+	if err := r.saveChecklist(ctx, dbtx, todo); err != nil {
+		return err
+	}
+
+	if err := r.saveBlockers(ctx, dbtx, todo); err != nil {
+		return err
+	}
+
 	r.uow.Collect(ctx, r.mapper, todo)
 
 	return nil
 }
 
+// saveChecklist syncs checklist items, storing each item's index as its position.
+func (r *TodoRepo) saveChecklist(ctx context.Context, dbtx db.DBTX, todo *domain.Todo) error {
+	items := todo.Checklist()
+	ids := make([]uuid.UUID, len(items))
+	todoIDs := make([]uuid.UUID, len(items))
+	titles := make([]string, len(items))
+	dones := make([]bool, len(items))
+	requireds := make([]bool, len(items))
+	positions := make([]int32, len(items))
+
+	for i, it := range items {
+		ids[i] = it.ID().UUID()
+		todoIDs[i] = todo.ID().UUID()
+		titles[i] = it.Title().String()
+		dones[i] = it.Done()
+		requireds[i] = it.Required()
+		positions[i] = int32(i)
+	}
+
+	err := r.q.RemoveMissingChecklistItemsFromTodo(ctx, dbtx, db.RemoveMissingChecklistItemsFromTodoParams{
+		TodoID:  todo.ID().UUID(),
+		ItemIds: ids,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to sync checklist items for todo %s: %w", todo.ID(), sharedPg.ParseDBError(err))
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	err = r.q.BulkUpsertChecklistItems(ctx, dbtx, db.BulkUpsertChecklistItemsParams{
+		Ids:       ids,
+		TodoIds:   todoIDs,
+		Titles:    titles,
+		Dones:     dones,
+		Requireds: requireds,
+		Positions: positions,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to bulk upsert checklist items for todo %s: %w", todo.ID(), sharedPg.ParseDBError(err))
+	}
+
+	return nil
+}
+
+func (r *TodoRepo) saveBlockers(ctx context.Context, dbtx db.DBTX, todo *domain.Todo) error {
+	blockerIDs := make([]uuid.UUID, len(todo.BlockedBy()))
+	todoIDs := make([]uuid.UUID, len(blockerIDs))
+
+	for i, id := range todo.BlockedBy() {
+		blockerIDs[i] = id.UUID()
+		todoIDs[i] = todo.ID().UUID()
+	}
+
+	err := r.q.RemoveMissingTodoDependencies(ctx, dbtx, db.RemoveMissingTodoDependenciesParams{
+		TodoID:       todo.ID(),
+		BlockedByIds: blockerIDs,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to sync blockers for todo %s: %w", todo.ID(), sharedPg.ParseDBError(err))
+	}
+
+	if len(blockerIDs) == 0 {
+		return nil
+	}
+
+	err = r.q.BulkAddTodoDependencies(ctx, dbtx, db.BulkAddTodoDependenciesParams{
+		TodoIds:      todoIDs,
+		BlockedByIds: blockerIDs,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add blockers to todo %s: %w", todo.ID(), sharedPg.ParseDBError(err))
+	}
+
+	return nil
+}
+
 func (r *TodoRepo) FindByID(ctx context.Context, id domain.TodoID) (*domain.Todo, error) {
 	row, err := r.q.GetTodoAggregateByID(ctx, r.getDB(ctx), id)
 	if err != nil {
@@ -148,11 +243,21 @@ func (r *TodoRepo) FindByID(ctx context.Context, id domain.TodoID) (*domain.Todo
 	return r.mapper.ToDomain(row), nil
 }
 
+func (r *TodoRepo) FindByIDForUpdate(ctx context.Context, id domain.TodoID) (*domain.Todo, error) {
+	if err := r.q.LockTodo(ctx, r.getDB(ctx), id); err != nil {
+		return nil, fmt.Errorf("failed to lock todo %s: %w", id, sharedPg.ParseDBError(err))
+	}
+
+	return r.FindByID(ctx, id)
+}
+
 func (r *TodoRepo) FindAllByWorkspace(ctx context.Context, wsID wsDomain.WorkspaceID) ([]*domain.Todo, error) {
 	rows, err := r.q.ListTodosByWorkspaceID(ctx, r.getDB(ctx), db.ListTodosByWorkspaceIDParams{
 		WorkspaceID: wsID,
-		Limit:       math.MaxInt32,
-		Offset:      0,
+		SortKey:     "created_at",
+		SortDesc:    true,
+		Lim:         math.MaxInt32,
+		Off:         0,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list todos for workspace %s: %w", wsID, sharedPg.ParseDBError(err))
@@ -184,3 +289,92 @@ func (r *TodoRepo) Delete(ctx context.Context, id domain.TodoID) error {
 
 	return nil
 }
+
+func (r *TodoRepo) LockDependencies(ctx context.Context, wsID wsDomain.WorkspaceID) error {
+	if err := r.q.LockTodoDependencies(ctx, r.getDB(ctx), wsID.UUID()); err != nil {
+		return fmt.Errorf("failed to lock dependencies of workspace %s: %w", wsID, sharedPg.ParseDBError(err))
+	}
+
+	return nil
+}
+
+func (r *TodoRepo) FindBlockerIDs(ctx context.Context, id domain.TodoID) ([]domain.TodoID, error) {
+	ids, err := r.q.ListTodoBlockerIDs(ctx, r.getDB(ctx), id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list blockers of todo %s: %w", id, sharedPg.ParseDBError(err))
+	}
+
+	return ids, nil
+}
+
+func (r *TodoRepo) FindDependents(ctx context.Context, blockerID domain.TodoID) ([]*domain.Todo, error) {
+	ids, err := r.q.ListTodoDependentIDs(ctx, r.getDB(ctx), blockerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list dependents of todo %s: %w", blockerID, sharedPg.ParseDBError(err))
+	}
+
+	return r.findAll(ctx, ids)
+}
+
+func (r *TodoRepo) FindAssignedInWorkspace(ctx context.Context, wsID wsDomain.WorkspaceID, assigneeID userDomain.UserID) ([]*domain.Todo, error) {
+	ids, err := r.q.ListTodoIDsAssignedInWorkspace(ctx, r.getDB(ctx), db.ListTodoIDsAssignedInWorkspaceParams{
+		WorkspaceID: wsID,
+		AssigneeID:  &assigneeID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list todos assigned to %s in workspace %s: %w", assigneeID, wsID, sharedPg.ParseDBError(err))
+	}
+
+	return r.findAll(ctx, ids)
+}
+
+func (r *TodoRepo) FindByTag(ctx context.Context, tagID domain.TagID) ([]*domain.Todo, error) {
+	ids, err := r.q.ListTodoIDsByTagID(ctx, r.getDB(ctx), tagID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list todos tagged %s: %w", tagID, sharedPg.ParseDBError(err))
+	}
+
+	return r.findAll(ctx, ids)
+}
+
+func (r *TodoRepo) HasActiveFocusSession(ctx context.Context, userID userDomain.UserID) (bool, error) {
+	active, err := r.q.HasActiveFocusSession(ctx, r.getDB(ctx), userID.UUID())
+	if err != nil {
+		return false, fmt.Errorf("failed to check active focus sessions of user %s: %w", userID, sharedPg.ParseDBError(err))
+	}
+
+	return active, nil
+}
+
+func (r *TodoRepo) FindElapsedFocus(ctx context.Context, now time.Time, maxDuration time.Duration, limit int32) ([]domain.TodoID, error) {
+	rows, err := r.q.ListElapsedFocusTodoIDs(ctx, r.getDB(ctx), db.ListElapsedFocusTodoIDsParams{
+		MaxSeconds: int32(maxDuration / time.Second),
+		Now:        now,
+		Lim:        limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list elapsed focus sessions: %w", sharedPg.ParseDBError(err))
+	}
+
+	ids := make([]domain.TodoID, len(rows))
+	for i, id := range rows {
+		ids[i] = domain.TodoID(id)
+	}
+
+	return ids, nil
+}
+
+func (r *TodoRepo) findAll(ctx context.Context, ids []domain.TodoID) ([]*domain.Todo, error) {
+	todos := make([]*domain.Todo, 0, len(ids))
+
+	for _, id := range ids {
+		t, err := r.FindByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		todos = append(todos, t)
+	}
+
+	return todos, nil
+}
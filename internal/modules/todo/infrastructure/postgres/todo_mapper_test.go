@@ -10,6 +10,7 @@ import (
 
 	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
 	"github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/postgres"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
 	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
 	sharedDomain "github.com/danicc097/todo-ddd-example/internal/shared/domain"
 )
@@ -68,4 +69,157 @@ func TestTodoMapper_MapEvent(t *testing.T) {
 		assert.Equal(t, domain.TodoID(todoID), payload.TodoID)
 		assert.Equal(t, domain.TagID(tagID), payload.TagID)
 	})
+
+	t.Run("TodoReopenedEvent", func(t *testing.T) {
+		id := uuid.New()
+
+		evt := domain.TodoReopenedEvent{
+			ID:             domain.TodoID(id),
+			WsID:           wsDomain.WorkspaceID(uuid.New()),
+			PreviousStatus: domain.StatusArchived,
+			Occurred:       time.Now(),
+		}
+
+		name, data, err := mapper.MapEvent(evt)
+		require.NoError(t, err)
+		assert.Equal(t, sharedDomain.TodoReopened, name)
+
+		payload := data.(postgres.TodoStatusChangedOutboxDTO)
+
+		assert.Equal(t, domain.TodoID(id), payload.ID)
+		assert.Equal(t, "PENDING", payload.Status)
+		assert.Equal(t, "ARCHIVED", payload.PreviousStatus)
+	})
+
+	t.Run("TodoRecurrenceChangedEvent", func(t *testing.T) {
+		rule, _ := domain.NewRecurrenceRule("DAILY", 2)
+
+		evt := domain.TodoRecurrenceChangedEvent{
+			ID:         domain.TodoID(uuid.New()),
+			WsID:       wsDomain.WorkspaceID(uuid.New()),
+			Recurrence: &rule,
+			Occurred:   time.Now(),
+		}
+
+		name, data, err := mapper.MapEvent(evt)
+		require.NoError(t, err)
+		assert.Equal(t, sharedDomain.TodoRecurrenceChanged, name)
+
+		payload := data.(postgres.TodoRecurrenceChangedOutboxDTO)
+
+		require.NotNil(t, payload.RecurrenceInterval)
+		assert.Equal(t, "DAILY", *payload.RecurrenceInterval)
+		assert.Equal(t, 2, *payload.RecurrenceAmount)
+	})
+
+	t.Run("ChecklistItemToggledEvent", func(t *testing.T) {
+		evt := domain.ChecklistItemToggledEvent{
+			ID:       domain.TodoID(uuid.New()),
+			WsID:     wsDomain.WorkspaceID(uuid.New()),
+			ItemID:   domain.ChecklistItemID(uuid.New()),
+			Done:     true,
+			Occurred: time.Now(),
+		}
+
+		name, data, err := mapper.MapEvent(evt)
+		require.NoError(t, err)
+		assert.Equal(t, sharedDomain.TodoChecklistItemToggled, name)
+
+		payload := data.(postgres.ChecklistItemOutboxDTO)
+
+		assert.Equal(t, evt.ItemID, payload.ItemID)
+		require.NotNil(t, payload.Done)
+		assert.True(t, *payload.Done)
+		assert.Nil(t, payload.Required)
+	})
+
+	t.Run("TodoUnblockedEvent", func(t *testing.T) {
+		evt := domain.TodoUnblockedEvent{
+			ID:       domain.TodoID(uuid.New()),
+			WsID:     wsDomain.WorkspaceID(uuid.New()),
+			Occurred: time.Now(),
+		}
+
+		name, data, err := mapper.MapEvent(evt)
+		require.NoError(t, err)
+		assert.Equal(t, sharedDomain.TodoUnblocked, name)
+
+		payload := data.(postgres.TodoUnblockedOutboxDTO)
+
+		assert.Equal(t, evt.ID, payload.ID)
+		assert.Equal(t, evt.WsID, payload.WorkspaceID)
+	})
+
+	t.Run("TodoAssignedEvent", func(t *testing.T) {
+		evt := domain.TodoAssignedEvent{
+			ID:         domain.TodoID(uuid.New()),
+			WsID:       wsDomain.WorkspaceID(uuid.New()),
+			AssigneeID: userDomain.UserID(uuid.New()),
+			Occurred:   time.Now(),
+			ActorID:    userDomain.UserID(uuid.New()),
+		}
+
+		name, data, err := mapper.MapEvent(evt)
+		require.NoError(t, err)
+		assert.Equal(t, sharedDomain.TodoAssigned, name)
+
+		payload := data.(postgres.TodoAssignmentOutboxDTO)
+
+		assert.Equal(t, evt.AssigneeID, payload.AssigneeID)
+		assert.Equal(t, evt.ActorID, payload.ActorID)
+	})
+}
+
+func TestCommentMapper_MapEvent(t *testing.T) {
+	t.Parallel()
+
+	mapper := &postgres.CommentMapper{}
+	mentioned := userDomain.UserID(uuid.New())
+	body, err := domain.NewCommentBody("ping @" + mentioned.String())
+	require.NoError(t, err)
+
+	evt := domain.CommentAddedEvent{
+		ID:       domain.CommentID(uuid.New()),
+		TodoID:   domain.TodoID(uuid.New()),
+		WsID:     wsDomain.WorkspaceID(uuid.New()),
+		AuthorID: userDomain.UserID(uuid.New()),
+		Body:     body,
+		Occurred: time.Now(),
+	}
+
+	name, data, err := mapper.MapEvent(evt)
+	require.NoError(t, err)
+	assert.Equal(t, sharedDomain.TodoCommentAdded, name)
+
+	payload := data.(postgres.CommentOutboxDTO)
+	assert.Equal(t, evt.TodoID, payload.TodoID)
+	assert.Equal(t, evt.WsID, payload.WorkspaceID)
+	assert.Equal(t, []userDomain.UserID{mentioned}, payload.Mentions)
+	assert.Equal(t, 1, payload.EventVersion)
+}
+
+func TestTagMapper_MapEvent(t *testing.T) {
+	t.Parallel()
+
+	mapper := &postgres.TagMapper{}
+	oldName, _ := domain.NewTagName("work")
+	newName, _ := domain.NewTagName("office")
+
+	evt := domain.TagRenamedEvent{
+		ID:       domain.TagID(uuid.New()),
+		WsID:     wsDomain.WorkspaceID(uuid.New()),
+		OldName:  oldName,
+		Name:     newName,
+		Occurred: time.Now(),
+	}
+
+	name, data, err := mapper.MapEvent(evt)
+	require.NoError(t, err)
+	assert.Equal(t, sharedDomain.TodoTagRenamed, name)
+
+	payload := data.(postgres.TagRenamedDTO)
+	assert.Equal(t, "work", payload.OldName)
+	assert.Equal(t, "office", payload.Name)
+	assert.Equal(t, evt.WsID, payload.WorkspaceID)
+	assert.Equal(t, 1, payload.EventVersion)
 }
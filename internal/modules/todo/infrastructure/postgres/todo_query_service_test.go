@@ -0,0 +1,181 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/application"
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	todoPg "github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/postgres"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+	"github.com/danicc097/todo-ddd-example/internal/testfixtures"
+	"github.com/danicc097/todo-ddd-example/internal/testutils"
+)
+
+func TestTodoQueryService_GetAllByWorkspace_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pool := testutils.GetGlobalPostgresPool(t)
+	fixtures := testfixtures.NewFixtures(pool)
+	uow := sharedPg.NewUnitOfWork(pool)
+	repo := todoPg.NewTodoRepo(pool, uow)
+	qs := todoPg.NewTodoQueryService(pool)
+
+	user := fixtures.RandomUser(ctx, t)
+	ws := fixtures.RandomWorkspace(ctx, t, user.ID())
+	tag := fixtures.RandomTag(ctx, t, ws.ID())
+	actorID := userDomain.UserID(user.ID())
+	now := time.Now()
+
+	past, future := now.Add(-48*time.Hour), now.Add(48*time.Hour)
+	rule, _ := domain.NewRecurrenceRule("DAILY", 1)
+
+	overdue := mustCreateTodo(t, "b overdue", ws.ID())
+	require.NoError(t, overdue.SetDueDate(&past, actorID, now))
+	overdue.AddTag(tag.ID())
+
+	upcoming := mustCreateTodo(t, "c upcoming", ws.ID())
+	require.NoError(t, upcoming.SetDueDate(&future, actorID, now))
+	require.NoError(t, upcoming.SetRecurrence(&rule, actorID, now))
+
+	done := mustCreateTodo(t, "a done", ws.ID())
+	require.NoError(t, done.Complete(actorID, now))
+
+	for _, td := range []*domain.Todo{overdue, upcoming, done} {
+		require.NoError(t, repo.Save(ctx, td))
+	}
+
+	ids := func(p application.TodoPage) []domain.TodoID {
+		res := make([]domain.TodoID, len(p.Items))
+		for i, it := range p.Items {
+			res[i] = it.ID
+		}
+
+		return res
+	}
+	yes, no := true, false
+
+	tests := []struct {
+		name   string
+		filter application.TodoListFilter
+		want   []domain.TodoID
+	}{
+		{"status", application.TodoListFilter{Statuses: []domain.TodoStatus{domain.StatusCompleted}}, []domain.TodoID{done.ID()}},
+		{"tags", application.TodoListFilter{TagIDs: []domain.TagID{tag.ID()}}, []domain.TodoID{overdue.ID()}},
+		{"due before", application.TodoListFilter{DueBefore: &now}, []domain.TodoID{overdue.ID()}},
+		{"due after", application.TodoListFilter{DueAfter: &now}, []domain.TodoID{upcoming.ID()}},
+		{"overdue", application.TodoListFilter{Overdue: &yes}, []domain.TodoID{overdue.ID()}},
+		{"recurring", application.TodoListFilter{Recurring: &yes}, []domain.TodoID{upcoming.ID()}},
+		{"not recurring sorted by title", application.TodoListFilter{Recurring: &no, Sort: application.TodoSort{Key: application.TodoSortTitle}}, []domain.TodoID{done.ID(), overdue.ID()}},
+		{"due date ascending puts missing dates last", application.TodoListFilter{Sort: application.TodoSort{Key: application.TodoSortDueDate}}, []domain.TodoID{overdue.ID(), upcoming.ID(), done.ID()}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.filter.Limit = 10
+
+			page, err := qs.GetAllByWorkspace(ctx, ws.ID(), tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ids(page))
+			assert.Empty(t, page.NextCursor)
+		})
+	}
+
+	t.Run("keyset pagination", func(t *testing.T) {
+		for _, sort := range []string{"createdAt", "-createdAt", "dueDate", "-dueDate", "title", "-title"} {
+			s, err := application.ParseTodoSort(sort)
+			require.NoError(t, err)
+
+			all, err := qs.GetAllByWorkspace(ctx, ws.ID(), application.TodoListFilter{Sort: s, Limit: 10})
+			require.NoError(t, err)
+
+			var paged []domain.TodoID
+
+			filter := application.TodoListFilter{Sort: s, Limit: 2}
+			for {
+				page, err := qs.GetAllByWorkspace(ctx, ws.ID(), filter)
+				require.NoError(t, err)
+
+				paged = append(paged, ids(page)...)
+				if page.NextCursor == "" {
+					break
+				}
+
+				filter.Cursor = page.NextCursor
+			}
+
+			assert.Equal(t, ids(all), paged, sort)
+		}
+	})
+
+	t.Run("rejects cursor for another sort", func(t *testing.T) {
+		page, err := qs.GetAllByWorkspace(ctx, ws.ID(), application.TodoListFilter{Limit: 1})
+		require.NoError(t, err)
+		require.NotEmpty(t, page.NextCursor)
+
+		_, err = qs.GetAllByWorkspace(ctx, ws.ID(), application.TodoListFilter{
+			Sort:   application.TodoSort{Key: application.TodoSortTitle},
+			Cursor: page.NextCursor,
+			Limit:  1,
+		})
+		assert.ErrorIs(t, err, application.ErrInvalidCursor)
+
+		_, err = qs.GetAllByWorkspace(ctx, ws.ID(), application.TodoListFilter{Cursor: "not-a-cursor", Limit: 1})
+		assert.ErrorIs(t, err, application.ErrInvalidCursor)
+	})
+}
+
+func TestTodoQueryService_Search_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pool := testutils.GetGlobalPostgresPool(t)
+	fixtures := testfixtures.NewFixtures(pool)
+	uow := sharedPg.NewUnitOfWork(pool)
+	repo := todoPg.NewTodoRepo(pool, uow)
+	qs := todoPg.NewTodoQueryService(pool)
+
+	user := fixtures.RandomUser(ctx, t)
+	ws := fixtures.RandomWorkspace(ctx, t, user.ID())
+	otherWs := fixtures.RandomWorkspace(ctx, t, user.ID())
+
+	invoice := mustCreateTodo(t, "Send invoices to accounting", ws.ID())
+	invoiceTwice := mustCreateTodo(t, "Invoice review: check invoice totals", ws.ID())
+	unrelated := mustCreateTodo(t, "Water the plants", ws.ID())
+	elsewhere := mustCreateTodo(t, "Invoice from another workspace", otherWs.ID())
+	markup := mustCreateTodo(t, "<img src=x onerror=alert(1)> payroll & taxes", ws.ID())
+
+	for _, td := range []*domain.Todo{invoice, invoiceTwice, unrelated, elsewhere, markup} {
+		require.NoError(t, repo.Save(ctx, td))
+	}
+
+	results, err := qs.Search(ctx, ws.ID(), "invoice", 10, 0)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	assert.Equal(t, invoiceTwice.ID(), results[0].ID, "more matches should rank first")
+	assert.Equal(t, invoice.ID(), results[1].ID)
+	assert.GreaterOrEqual(t, results[0].Rank, results[1].Rank)
+	assert.Equal(t, "Send <mark>invoices</mark> to accounting", results[1].Highlight)
+
+	results, err = qs.Search(ctx, ws.ID(), "invoice -review", 10, 0)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, invoice.ID(), results[0].ID)
+
+	results, err = qs.Search(ctx, ws.ID(), "invoice", 10, 1)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, invoice.ID(), results[0].ID)
+
+	results, err = qs.Search(ctx, ws.ID(), "payroll", 10, 0)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "&lt;img src=x onerror=alert(1)&gt; <mark>payroll</mark> &amp; taxes", results[0].Highlight)
+}
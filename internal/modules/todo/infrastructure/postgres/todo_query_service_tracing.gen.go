@@ -6,9 +6,11 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	_sourceApplication "github.com/danicc097/todo-ddd-example/internal/modules/todo/application"
 	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
 	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
 	"go.opentelemetry.io/otel/attribute"
 
@@ -41,7 +43,7 @@ func NewTodoQueryServiceWithTracing(base _sourceApplication.TodoQueryService, in
 }
 
 // GetAllByWorkspace implements TodoQueryService
-func (_d TodoQueryServiceWithTracing) GetAllByWorkspace(ctx context.Context, wsID wsDomain.WorkspaceID, limit int32, offset int32) (ta1 []_sourceApplication.TodoReadModel, err error) {
+func (_d TodoQueryServiceWithTracing) GetAllByWorkspace(ctx context.Context, wsID wsDomain.WorkspaceID, filter _sourceApplication.TodoListFilter) (t1 _sourceApplication.TodoPage, err error) {
 	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "TodoQueryService.GetAllByWorkspace", trace.WithAttributes(
 		semconv.DBSystemNamePostgreSQL,
 		semconv.PeerServiceKey.String("postgres"),
@@ -52,9 +54,8 @@ func (_d TodoQueryServiceWithTracing) GetAllByWorkspace(ctx context.Context, wsI
 			_d._spanDecorator(_span, map[string]interface{}{
 				"ctx":    ctx,
 				"wsID":   wsID,
-				"limit":  limit,
-				"offset": offset}, map[string]interface{}{
-				"ta1": ta1,
+				"filter": filter}, map[string]interface{}{
+				"t1":  t1,
 				"err": err})
 		} else if err != nil {
 			_span.RecordError(err)
@@ -67,7 +68,7 @@ func (_d TodoQueryServiceWithTracing) GetAllByWorkspace(ctx context.Context, wsI
 
 		_span.End()
 	}()
-	return _d.TodoQueryService.GetAllByWorkspace(ctx, wsID, limit, offset)
+	return _d.TodoQueryService.GetAllByWorkspace(ctx, wsID, filter)
 }
 
 // GetByID implements TodoQueryService
@@ -97,3 +98,125 @@ func (_d TodoQueryServiceWithTracing) GetByID(ctx context.Context, id domain.Tod
 	}()
 	return _d.TodoQueryService.GetByID(ctx, id)
 }
+
+// ListAssignedTo implements TodoQueryService
+func (_d TodoQueryServiceWithTracing) ListAssignedTo(ctx context.Context, userID userDomain.UserID, limit int32, offset int32) (ta1 []_sourceApplication.TodoReadModel, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "TodoQueryService.ListAssignedTo", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "ListAssignedTo"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"userID": userID,
+				"limit":  limit,
+				"offset": offset}, map[string]interface{}{
+				"ta1": ta1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.TodoQueryService.ListAssignedTo(ctx, userID, limit, offset)
+}
+
+// ListComments implements TodoQueryService
+func (_d TodoQueryServiceWithTracing) ListComments(ctx context.Context, todoID domain.TodoID, limit int32, offset int32) (ca1 []_sourceApplication.CommentReadModel, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "TodoQueryService.ListComments", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "ListComments"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"todoID": todoID,
+				"limit":  limit,
+				"offset": offset}, map[string]interface{}{
+				"ca1": ca1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.TodoQueryService.ListComments(ctx, todoID, limit, offset)
+}
+
+// ListUnassignedDue implements TodoQueryService
+func (_d TodoQueryServiceWithTracing) ListUnassignedDue(ctx context.Context, userID userDomain.UserID, dueBefore time.Time, limit int32, offset int32) (ta1 []_sourceApplication.TodoReadModel, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "TodoQueryService.ListUnassignedDue", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "ListUnassignedDue"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":       ctx,
+				"userID":    userID,
+				"dueBefore": dueBefore,
+				"limit":     limit,
+				"offset":    offset}, map[string]interface{}{
+				"ta1": ta1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.TodoQueryService.ListUnassignedDue(ctx, userID, dueBefore, limit, offset)
+}
+
+// Search implements TodoQueryService
+func (_d TodoQueryServiceWithTracing) Search(ctx context.Context, wsID wsDomain.WorkspaceID, query string, limit int32, offset int32) (ta1 []_sourceApplication.TodoSearchResultReadModel, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "TodoQueryService.Search", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "Search"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"wsID":   wsID,
+				"query":  query,
+				"limit":  limit,
+				"offset": offset}, map[string]interface{}{
+				"ta1": ta1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.TodoQueryService.Search(ctx, wsID, query, limit, offset)
+}
@@ -63,7 +63,7 @@ func TestTodoRepo_Integration(t *testing.T) {
 	t.Run("focus sessions", func(t *testing.T) {
 		ftodo := mustCreateTodo(t, "Focus Todo", ws.ID())
 		sessionID := domain.FocusSessionID(uuid.New())
-		require.NoError(t, ftodo.StartFocus(user.ID(), sessionID))
+		require.NoError(t, ftodo.StartFocus(user.ID(), sessionID, 0))
 		require.NoError(t, repo.Save(ctx, ftodo))
 
 		found, err := repo.FindByID(ctx, ftodo.ID())
@@ -78,6 +78,73 @@ func TestTodoRepo_Integration(t *testing.T) {
 		assert.False(t, found2.Sessions()[0].IsActive())
 	})
 
+	t.Run("checklist", func(t *testing.T) {
+		ctodo := mustCreateTodo(t, "Checklist Todo", ws.ID())
+		actorID := userDomain.UserID(user.ID())
+		now := time.Now()
+
+		var ids []domain.ChecklistItemID
+
+		for _, name := range []string{"first", "second", "third"} {
+			title, _ := domain.NewTodoTitle(name)
+			item := domain.NewChecklistItem(domain.ChecklistItemID(uuid.New()), title, true)
+			require.NoError(t, ctodo.AddChecklistItem(item, actorID, now))
+			ids = append(ids, item.ID())
+		}
+
+		require.NoError(t, repo.Save(ctx, ctodo))
+
+		found, err := repo.FindByID(ctx, ctodo.ID())
+		require.NoError(t, err)
+		assert.Equal(t, ctodo.Checklist(), found.Checklist())
+
+		require.NoError(t, found.ReorderChecklist([]domain.ChecklistItemID{ids[2], ids[0], ids[1]}, actorID, now))
+		require.NoError(t, found.ToggleChecklistItem(ids[0], actorID, now))
+		require.NoError(t, found.RemoveChecklistItem(ids[1], actorID, now))
+		require.NoError(t, repo.Save(ctx, found))
+
+		found2, err := repo.FindByID(ctx, ctodo.ID())
+		require.NoError(t, err)
+		require.Len(t, found2.Checklist(), 2)
+		assert.Equal(t, ids[2], found2.Checklist()[0].ID())
+		assert.Equal(t, ids[0], found2.Checklist()[1].ID())
+		assert.True(t, found2.Checklist()[1].Done())
+	})
+
+	t.Run("blockers", func(t *testing.T) {
+		blocker := mustCreateTodo(t, "Blocker Todo", ws.ID())
+		dependent := mustCreateTodo(t, "Dependent Todo", ws.ID())
+		actorID := userDomain.UserID(user.ID())
+		now := time.Now()
+
+		require.NoError(t, repo.Save(ctx, blocker))
+		require.NoError(t, domain.NewDependencyService(repo).AddBlocker(ctx, dependent, blocker, actorID, now))
+		require.NoError(t, repo.Save(ctx, dependent))
+
+		found, err := repo.FindByID(ctx, dependent.ID())
+		require.NoError(t, err)
+		assert.Equal(t, []domain.TodoID{blocker.ID()}, found.BlockedBy())
+
+		blockerIDs, err := repo.FindBlockerIDs(ctx, dependent.ID())
+		require.NoError(t, err)
+		assert.Equal(t, []domain.TodoID{blocker.ID()}, blockerIDs)
+
+		err = domain.NewDependencyService(repo).AddBlocker(ctx, blocker, dependent, actorID, now)
+		require.ErrorIs(t, err, domain.ErrDependencyCycle)
+
+		dependents, err := repo.FindDependents(ctx, blocker.ID())
+		require.NoError(t, err)
+		require.Len(t, dependents, 1)
+		assert.Equal(t, dependent.ID(), dependents[0].ID())
+
+		dependents[0].ResolveBlocker(blocker.ID(), now)
+		require.NoError(t, repo.Save(ctx, dependents[0]))
+
+		dependents, err = repo.FindDependents(ctx, blocker.ID())
+		require.NoError(t, err)
+		assert.Empty(t, dependents)
+	})
+
 	t.Run("find all", func(t *testing.T) {
 		todos, err := repo.FindAllByWorkspace(ctx, ws.ID())
 		require.NoError(t, err)
@@ -6,8 +6,10 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	_sourceDomain "github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
 	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
 	"go.opentelemetry.io/otel/attribute"
 
@@ -94,6 +96,63 @@ func (_d TodoRepositoryWithTracing) FindAllByWorkspace(ctx context.Context, wsID
 	return _d.TodoRepository.FindAllByWorkspace(ctx, wsID)
 }
 
+// FindAssignedInWorkspace implements TodoRepository
+func (_d TodoRepositoryWithTracing) FindAssignedInWorkspace(ctx context.Context, wsID wsDomain.WorkspaceID, assigneeID userDomain.UserID) (tpa1 []*_sourceDomain.Todo, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "TodoRepository.FindAssignedInWorkspace", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "FindAssignedInWorkspace"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":        ctx,
+				"wsID":       wsID,
+				"assigneeID": assigneeID}, map[string]interface{}{
+				"tpa1": tpa1,
+				"err":  err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.TodoRepository.FindAssignedInWorkspace(ctx, wsID, assigneeID)
+}
+
+// FindBlockerIDs implements TodoRepository
+func (_d TodoRepositoryWithTracing) FindBlockerIDs(ctx context.Context, id _sourceDomain.TodoID) (ta1 []_sourceDomain.TodoID, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "TodoRepository.FindBlockerIDs", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "FindBlockerIDs"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx": ctx,
+				"id":  id}, map[string]interface{}{
+				"ta1": ta1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.TodoRepository.FindBlockerIDs(ctx, id)
+}
+
 // FindByID implements TodoRepository
 func (_d TodoRepositoryWithTracing) FindByID(ctx context.Context, id _sourceDomain.TodoID) (tp1 *_sourceDomain.Todo, err error) {
 	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "TodoRepository.FindByID", trace.WithAttributes(
@@ -122,6 +181,175 @@ func (_d TodoRepositoryWithTracing) FindByID(ctx context.Context, id _sourceDoma
 	return _d.TodoRepository.FindByID(ctx, id)
 }
 
+// FindByIDForUpdate implements TodoRepository
+func (_d TodoRepositoryWithTracing) FindByIDForUpdate(ctx context.Context, id _sourceDomain.TodoID) (tp1 *_sourceDomain.Todo, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "TodoRepository.FindByIDForUpdate", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "FindByIDForUpdate"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx": ctx,
+				"id":  id}, map[string]interface{}{
+				"tp1": tp1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.TodoRepository.FindByIDForUpdate(ctx, id)
+}
+
+// FindByTag implements TodoRepository
+func (_d TodoRepositoryWithTracing) FindByTag(ctx context.Context, tagID _sourceDomain.TagID) (tpa1 []*_sourceDomain.Todo, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "TodoRepository.FindByTag", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "FindByTag"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":   ctx,
+				"tagID": tagID}, map[string]interface{}{
+				"tpa1": tpa1,
+				"err":  err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.TodoRepository.FindByTag(ctx, tagID)
+}
+
+// FindDependents implements TodoRepository
+func (_d TodoRepositoryWithTracing) FindDependents(ctx context.Context, blockerID _sourceDomain.TodoID) (tpa1 []*_sourceDomain.Todo, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "TodoRepository.FindDependents", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "FindDependents"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":       ctx,
+				"blockerID": blockerID}, map[string]interface{}{
+				"tpa1": tpa1,
+				"err":  err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.TodoRepository.FindDependents(ctx, blockerID)
+}
+
+// FindElapsedFocus implements TodoRepository
+func (_d TodoRepositoryWithTracing) FindElapsedFocus(ctx context.Context, now time.Time, maxDuration time.Duration, limit int32) (ta1 []_sourceDomain.TodoID, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "TodoRepository.FindElapsedFocus", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "FindElapsedFocus"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":         ctx,
+				"now":         now,
+				"maxDuration": maxDuration,
+				"limit":       limit}, map[string]interface{}{
+				"ta1": ta1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.TodoRepository.FindElapsedFocus(ctx, now, maxDuration, limit)
+}
+
+// HasActiveFocusSession implements TodoRepository
+func (_d TodoRepositoryWithTracing) HasActiveFocusSession(ctx context.Context, userID userDomain.UserID) (b1 bool, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "TodoRepository.HasActiveFocusSession", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "HasActiveFocusSession"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"userID": userID}, map[string]interface{}{
+				"b1":  b1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.TodoRepository.HasActiveFocusSession(ctx, userID)
+}
+
+// LockDependencies implements TodoRepository
+func (_d TodoRepositoryWithTracing) LockDependencies(ctx context.Context, wsID wsDomain.WorkspaceID) (err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "TodoRepository.LockDependencies", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "LockDependencies"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":  ctx,
+				"wsID": wsID}, map[string]interface{}{
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.TodoRepository.LockDependencies(ctx, wsID)
+}
+
 // Save implements TodoRepository
 func (_d TodoRepositoryWithTracing) Save(ctx context.Context, todo *_sourceDomain.Todo) (err error) {
 	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "TodoRepository.Save", trace.WithAttributes(
@@ -11,24 +11,37 @@ import (
 )
 
 type TodoCacheDTO struct {
-	ID                 uuid.UUID              `json:"id"`
-	WorkspaceID        uuid.UUID              `json:"workspace_id"`
-	Title              string                 `json:"title"`
-	Status             string                 `json:"status"`
-	CreatedAt          time.Time              `json:"created_at"`
-	Tags               []uuid.UUID            `json:"tags"`
-	DueDate            *time.Time             `json:"due_date"`
-	RecurrenceInterval *string                `json:"recurrence_interval"`
-	RecurrenceAmount   *int                   `json:"recurrence_amount"`
-	LastCompletedAt    *time.Time             `json:"last_completed_at"`
-	Sessions           []FocusSessionCacheDTO `json:"sessions"`
+	ID                 uuid.UUID               `json:"id"`
+	WorkspaceID        uuid.UUID               `json:"workspace_id"`
+	Title              string                  `json:"title"`
+	Status             string                  `json:"status"`
+	CreatedAt          time.Time               `json:"created_at"`
+	Tags               []uuid.UUID             `json:"tags"`
+	DueDate            *time.Time              `json:"due_date"`
+	RecurrenceInterval *string                 `json:"recurrence_interval"`
+	RecurrenceAmount   *int                    `json:"recurrence_amount"`
+	LastCompletedAt    *time.Time              `json:"last_completed_at"`
+	Sessions           []FocusSessionCacheDTO  `json:"sessions"`
+	RecurrenceRule     *string                 `json:"recurrence_rule"`
+	Occurrences        int                     `json:"recurrence_occurrences"`
+	Checklist          []ChecklistItemCacheDTO `json:"checklist"`
+	BlockedBy          []uuid.UUID             `json:"blocked_by"`
+	AssigneeID         *uuid.UUID              `json:"assignee_id"`
 }
 
 type FocusSessionCacheDTO struct {
-	ID        uuid.UUID  `json:"id"`
-	UserID    uuid.UUID  `json:"user_id"`
-	StartTime time.Time  `json:"start_time"`
-	EndTime   *time.Time `json:"end_time"`
+	ID              uuid.UUID     `json:"id"`
+	UserID          uuid.UUID     `json:"user_id"`
+	StartTime       time.Time     `json:"start_time"`
+	EndTime         *time.Time    `json:"end_time"`
+	PlannedDuration time.Duration `json:"planned_duration"`
+}
+
+type ChecklistItemCacheDTO struct {
+	ID       uuid.UUID `json:"id"`
+	Title    string    `json:"title"`
+	Done     bool      `json:"done"`
+	Required bool      `json:"required"`
 }
 
 func ToTodoCacheDTO(t *domain.Todo) TodoCacheDTO {
@@ -40,25 +53,50 @@ func ToTodoCacheDTO(t *domain.Todo) TodoCacheDTO {
 	var (
 		rInterval *string
 		rAmount   *int
+		rRule     *string
 	)
 
 	if t.Recurrence() != nil {
 		interval := t.Recurrence().Interval()
 		amount := t.Recurrence().Amount()
+		rule := t.Recurrence().String()
 		rInterval = &interval
 		rAmount = &amount
+		rRule = &rule
 	}
 
 	sessions := make([]FocusSessionCacheDTO, len(t.Sessions()))
 	for i, s := range t.Sessions() {
 		sessions[i] = FocusSessionCacheDTO{
-			ID:        s.ID().UUID(),
-			UserID:    s.UserID().UUID(),
-			StartTime: s.StartTime(),
-			EndTime:   s.EndTime(),
+			ID:              s.ID().UUID(),
+			UserID:          s.UserID().UUID(),
+			StartTime:       s.StartTime(),
+			EndTime:         s.EndTime(),
+			PlannedDuration: s.PlannedDuration(),
+		}
+	}
+
+	checklist := make([]ChecklistItemCacheDTO, len(t.Checklist()))
+	for i, it := range t.Checklist() {
+		checklist[i] = ChecklistItemCacheDTO{
+			ID:       it.ID().UUID(),
+			Title:    it.Title().String(),
+			Done:     it.Done(),
+			Required: it.Required(),
 		}
 	}
 
+	blockedBy := make([]uuid.UUID, len(t.BlockedBy()))
+	for i, id := range t.BlockedBy() {
+		blockedBy[i] = id.UUID()
+	}
+
+	var assigneeID *uuid.UUID
+	if t.AssigneeID() != nil {
+		id := t.AssigneeID().UUID()
+		assigneeID = &id
+	}
+
 	return TodoCacheDTO{
 		ID:                 t.ID().UUID(),
 		WorkspaceID:        t.WorkspaceID().UUID(),
@@ -71,6 +109,11 @@ func ToTodoCacheDTO(t *domain.Todo) TodoCacheDTO {
 		RecurrenceAmount:   rAmount,
 		LastCompletedAt:    t.LastCompletedAt(),
 		Sessions:           sessions,
+		RecurrenceRule:     rRule,
+		Occurrences:        t.CompletedOccurrences(),
+		Checklist:          checklist,
+		BlockedBy:          blockedBy,
+		AssigneeID:         assigneeID,
 	}
 }
 
@@ -84,7 +127,10 @@ func FromTodoCacheDTO(dto TodoCacheDTO) *domain.Todo {
 
 	var recurrence *domain.RecurrenceRule
 
-	if dto.RecurrenceInterval != nil && dto.RecurrenceAmount != nil {
+	if dto.RecurrenceRule != nil {
+		r, _ := domain.ParseRecurrenceRule(*dto.RecurrenceRule)
+		recurrence = &r
+	} else if dto.RecurrenceInterval != nil && dto.RecurrenceAmount != nil {
 		r, _ := domain.NewRecurrenceRule(*dto.RecurrenceInterval, *dto.RecurrenceAmount)
 		recurrence = &r
 	}
@@ -92,13 +138,36 @@ func FromTodoCacheDTO(dto TodoCacheDTO) *domain.Todo {
 	sessions := make([]domain.FocusSession, len(dto.Sessions))
 	for i, s := range dto.Sessions {
 		sessions[i] = domain.ReconstituteFocusSession(domain.ReconstituteFocusSessionArgs{
-			ID:        domain.FocusSessionID(s.ID),
-			UserID:    userDomain.UserID(s.UserID),
-			StartTime: s.StartTime,
-			EndTime:   s.EndTime,
+			ID:              domain.FocusSessionID(s.ID),
+			UserID:          userDomain.UserID(s.UserID),
+			StartTime:       s.StartTime,
+			EndTime:         s.EndTime,
+			PlannedDuration: s.PlannedDuration,
 		})
 	}
 
+	checklist := make([]domain.ChecklistItem, len(dto.Checklist))
+	for i, it := range dto.Checklist {
+		title, _ := domain.NewTodoTitle(it.Title)
+		checklist[i] = domain.ReconstituteChecklistItem(domain.ReconstituteChecklistItemArgs{
+			ID:       domain.ChecklistItemID(it.ID),
+			Title:    title,
+			Done:     it.Done,
+			Required: it.Required,
+		})
+	}
+
+	blockedBy := make([]domain.TodoID, len(dto.BlockedBy))
+	for i, id := range dto.BlockedBy {
+		blockedBy[i] = domain.TodoID(id)
+	}
+
+	var assigneeID *userDomain.UserID
+	if dto.AssigneeID != nil {
+		id := userDomain.UserID(*dto.AssigneeID)
+		assigneeID = &id
+	}
+
 	return domain.ReconstituteTodo(domain.ReconstituteTodoArgs{
 		ID:              domain.TodoID(dto.ID),
 		Title:           title,
@@ -110,12 +179,18 @@ func FromTodoCacheDTO(dto TodoCacheDTO) *domain.Todo {
 		Recurrence:      recurrence,
 		LastCompletedAt: dto.LastCompletedAt,
 		Sessions:        sessions,
+		Checklist:       checklist,
+		BlockedBy:       blockedBy,
+		AssigneeID:      assigneeID,
+
+		CompletedOccurrences: dto.Occurrences,
 	})
 }
 
 type TagCacheDTO struct {
 	ID          uuid.UUID `json:"id"`
 	Name        string    `json:"name"`
+	Color       string    `json:"color"`
 	WorkspaceID uuid.UUID `json:"workspace_id"`
 }
 
@@ -123,6 +198,7 @@ func ToTagCacheDTO(t *domain.Tag) TagCacheDTO {
 	return TagCacheDTO{
 		ID:          t.ID().UUID(),
 		Name:        t.Name().String(),
+		Color:       t.Color().String(),
 		WorkspaceID: t.WorkspaceID().UUID(),
 	}
 }
@@ -130,9 +206,16 @@ func ToTagCacheDTO(t *domain.Tag) TagCacheDTO {
 func FromTagCacheDTO(dto TagCacheDTO) *domain.Tag {
 	name, _ := domain.NewTagName(dto.Name)
 
+	// entries cached before colors existed decode without one
+	color, err := domain.NewTagColor(dto.Color)
+	if err != nil {
+		color = domain.DefaultTagColor
+	}
+
 	return domain.ReconstituteTag(domain.ReconstituteTagArgs{
 		ID:          domain.TagID(dto.ID),
 		Name:        name,
+		Color:       color,
 		WorkspaceID: wsDomain.WorkspaceID(dto.WorkspaceID),
 	})
 }
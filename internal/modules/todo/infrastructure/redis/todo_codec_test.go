@@ -10,6 +10,7 @@ import (
 
 	"github.com/danicc097/todo-ddd-example/internal/modules/todo/domain"
 	"github.com/danicc097/todo-ddd-example/internal/modules/todo/infrastructure/redis"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
 	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
 )
 
@@ -45,4 +46,24 @@ func TestTodoCacheCodec_Symmetry(t *testing.T) {
 		assert.Equal(t, original.ID(), reconstituted.ID())
 		assert.Equal(t, original.Title().String(), reconstituted.Title().String())
 	})
+
+	t.Run("checklist order and state", func(t *testing.T) {
+		title, _ := domain.NewTodoTitle("checklist")
+		original := domain.NewTodo(title, wsDomain.WorkspaceID(uuid.New()))
+
+		for _, name := range []string{"first", "second"} {
+			itemTitle, _ := domain.NewTodoTitle(name)
+			require.NoError(t, original.AddChecklistItem(domain.NewChecklistItem(domain.ChecklistItemID(uuid.New()), itemTitle, true), userDomain.UserID(uuid.New()), time.Now()))
+		}
+
+		require.NoError(t, original.ToggleChecklistItem(original.Checklist()[1].ID(), userDomain.UserID(uuid.New()), time.Now()))
+
+		data, err := codec.Marshal(original)
+		require.NoError(t, err)
+
+		reconstituted, err := codec.Unmarshal(data)
+		require.NoError(t, err)
+
+		assert.Equal(t, original.Checklist(), reconstituted.Checklist())
+	})
 }
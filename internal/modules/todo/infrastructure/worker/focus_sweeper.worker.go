@@ -0,0 +1,63 @@
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/todo/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+// FocusSweeperWorker periodically stops elapsed and stale focus sessions.
+type FocusSweeperWorker struct {
+	sweeper  *application.FocusSessionSweeper
+	interval time.Duration
+}
+
+func NewFocusSweeperWorker(sweeper *application.FocusSessionSweeper, interval time.Duration) *FocusSweeperWorker {
+	return &FocusSweeperWorker{
+		sweeper:  sweeper,
+		interval: interval,
+	}
+}
+
+func (w *FocusSweeperWorker) Start(ctx context.Context) {
+	slog.InfoContext(ctx, "Focus session sweeper started")
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "Focus session sweeper stopped")
+
+			return
+		case <-ticker.C:
+			w.run(context.WithoutCancel(ctx))
+		}
+	}
+}
+
+func (w *FocusSweeperWorker) run(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, w.interval)
+	defer cancel()
+
+	ctx = causation.WithMetadata(ctx, causation.Metadata{
+		CorrelationID:   uuid.NewString(),
+		IsSystemRequest: true,
+	})
+
+	n, err := w.sweeper.Sweep(ctx, time.Now())
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to sweep focus sessions", slog.String("error", err.Error()))
+		return
+	}
+
+	if n > 0 {
+		slog.InfoContext(ctx, "stopped elapsed focus sessions", slog.Int("count", n))
+	}
+}
@@ -0,0 +1,140 @@
+package application
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+)
+
+type DataExportRequestedEventPayload struct {
+	ID uuid.UUID `json:"id"`
+}
+
+// DataExportRequestedEventHandler builds the archive of a pending data export,
+// with the profile and every personal data source as a JSON file each.
+type DataExportRequestedEventHandler struct {
+	userRepo   domain.UserRepository
+	exportRepo domain.DataExportRepository
+	sources    []PersonalDataSource
+	uow        application.UnitOfWork
+}
+
+func NewDataExportRequestedEventHandler(
+	userRepo domain.UserRepository,
+	exportRepo domain.DataExportRepository,
+	uow application.UnitOfWork,
+	sources ...PersonalDataSource,
+) *DataExportRequestedEventHandler {
+	return &DataExportRequestedEventHandler{
+		userRepo:   userRepo,
+		exportRepo: exportRepo,
+		sources:    sources,
+		uow:        uow,
+	}
+}
+
+func (h *DataExportRequestedEventHandler) Handle(ctx context.Context, data []byte) error {
+	var envelope struct {
+		Data DataExportRequestedEventPayload `json:"data"`
+	}
+
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		if err := json.Unmarshal(data, &envelope.Data); err != nil {
+			return fmt.Errorf("failed to unmarshal DataExportRequested event: %w", err)
+		}
+	}
+
+	return h.uow.Execute(ctx, func(ctx context.Context) error {
+		export, err := h.exportRepo.FindByID(ctx, domain.DataExportID(envelope.Data.ID))
+		if err != nil {
+			return err
+		}
+
+		if export.Status() != domain.DataExportPending {
+			return nil
+		}
+
+		archive, err := h.buildArchive(ctx, export.UserID())
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to build data export", slog.String("export_id", export.ID().String()), slog.Any("error", err))
+			export.Fail(time.Now())
+		} else {
+			export.Complete(archive, time.Now())
+		}
+
+		return h.exportRepo.Save(ctx, export)
+	})
+}
+
+func (h *DataExportRequestedEventHandler) buildArchive(ctx context.Context, userID domain.UserID) ([]byte, error) {
+	u, err := h.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	zw := zip.NewWriter(&buf)
+
+	profile := struct {
+		ID            domain.UserID `json:"id"`
+		Email         string        `json:"email"`
+		Name          string        `json:"name"`
+		Timezone      string        `json:"timezone"`
+		DailyCapacity int           `json:"daily_capacity"`
+		CreatedAt     time.Time     `json:"created_at"`
+	}{
+		ID:            u.ID(),
+		Email:         u.Email().String(),
+		Name:          u.Name().String(),
+		Timezone:      u.Timezone().String(),
+		DailyCapacity: u.DailyCapacity().Int(),
+		CreatedAt:     u.CreatedAt(),
+	}
+
+	if err := writeSection(zw, "profile", profile); err != nil {
+		return nil, err
+	}
+
+	for _, src := range h.sources {
+		v, err := src.Collect(ctx, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to collect %s: %w", src.Section(), err)
+		}
+
+		if err := writeSection(zw, src.Section(), v); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close data export archive: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeSection(zw *zip.Writer, section string, v any) error {
+	w, err := zw.Create(section + ".json")
+	if err != nil {
+		return fmt.Errorf("failed to create %s entry: %w", section, err)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(v); err != nil {
+		return fmt.Errorf("failed to encode %s: %w", section, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package application_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/user/application"
+	"github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	userPg "github.com/danicc097/todo-ddd-example/internal/modules/user/infrastructure/postgres"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+	"github.com/danicc097/todo-ddd-example/internal/testutils"
+)
+
+type staticDataSource struct{}
+
+func (staticDataSource) Section() string { return "notes" }
+
+func (staticDataSource) Collect(_ context.Context, userID domain.UserID) (any, error) {
+	return []string{"note of " + userID.String()}, nil
+}
+
+func TestDataExport_Integration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	pool := testutils.GetGlobalPostgresPool(t)
+	uow := sharedPg.NewUnitOfWork(pool)
+	userRepo := userPg.NewUserRepo(pool, uow)
+	exportRepo := userPg.NewDataExportRepo(pool, uow)
+
+	request := application.NewRequestDataExportHandler(userRepo, exportRepo)
+	download := application.NewDownloadDataExportHandler(exportRepo)
+	builder := application.NewDataExportRequestedEventHandler(userRepo, exportRepo, uow, staticDataSource{})
+
+	uid := uuid.New().String()[:8]
+	registered, err := application.NewRegisterUserUseCase(userRepo).Execute(ctx, application.RegisterUserCommand{
+		Email: fmt.Sprintf("export-%s@example.com", uid),
+		Name:  "user " + uid,
+	})
+	require.NoError(t, err)
+
+	userCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: registered.ID.UUID()})
+
+	t.Run("only for oneself", func(t *testing.T) {
+		otherCtx := causation.WithMetadata(ctx, causation.Metadata{UserID: uuid.New()})
+
+		_, err := request.Handle(otherCtx, application.RequestDataExportCommand{UserID: registered.ID})
+		assert.ErrorIs(t, err, domain.ErrNotSameUser)
+	})
+
+	t.Run("builds the archive", func(t *testing.T) {
+		var resp application.RequestDataExportResponse
+
+		err := uow.Execute(userCtx, func(ctx context.Context) error {
+			var err error
+			resp, err = request.Handle(ctx, application.RequestDataExportCommand{UserID: registered.ID})
+
+			return err
+		})
+		require.NoError(t, err)
+		assert.Equal(t, domain.DataExportPending, resp.Export.Status)
+
+		q := application.DownloadDataExportQuery{UserID: registered.ID, ID: resp.Export.ID}
+
+		_, err = download.Handle(userCtx, q)
+		require.ErrorIs(t, err, domain.ErrDataExportNotReady)
+
+		payload, err := json.Marshal(map[string]any{"data": map[string]any{"id": resp.Export.ID}})
+		require.NoError(t, err)
+		require.NoError(t, builder.Handle(ctx, payload))
+
+		got, err := download.Handle(userCtx, q)
+		require.NoError(t, err)
+
+		zr, err := zip.NewReader(bytes.NewReader(got.Archive), int64(len(got.Archive)))
+		require.NoError(t, err)
+
+		files := map[string][]byte{}
+		for _, f := range zr.File {
+			rc, err := f.Open()
+			require.NoError(t, err)
+
+			b, err := io.ReadAll(rc)
+			require.NoError(t, err)
+			require.NoError(t, rc.Close())
+
+			files[f.Name] = b
+		}
+
+		require.Contains(t, files, "profile.json")
+		require.Contains(t, files, "notes.json")
+		assert.Contains(t, string(files["profile.json"]), fmt.Sprintf("export-%s@example.com", uid))
+	})
+}
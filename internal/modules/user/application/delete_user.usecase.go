@@ -25,9 +25,13 @@ func NewDeleteUserHandler(repo domain.UserRepository) *DeleteUserHandler {
 }
 
 func (h *DeleteUserHandler) Handle(ctx context.Context, cmd DeleteUserCommand) (DeleteUserResponse, error) {
-	_ = causation.FromContext(ctx)
+	meta := causation.FromContext(ctx)
 
-	// TODO: should check authz
+	if domain.UserID(meta.UserID) != cmd.ID && !meta.IsSystem() {
+		return DeleteUserResponse{}, domain.ErrNotSameUser
+	}
+
+	// memberships, sessions and comments cascade, while audit entries are pseudonymized on user.deleted
 	if err := h.repo.Delete(ctx, cmd.ID); err != nil {
 		return DeleteUserResponse{}, err
 	}
@@ -0,0 +1,41 @@
+package application
+
+import (
+	"context"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+)
+
+type DownloadDataExportQuery struct {
+	UserID domain.UserID
+	ID     domain.DataExportID
+}
+
+type DownloadDataExportResponse struct {
+	Archive []byte
+}
+
+type DownloadDataExportHandler struct {
+	repo domain.DataExportRepository
+}
+
+var _ application.RequestHandler[DownloadDataExportQuery, DownloadDataExportResponse] = (*DownloadDataExportHandler)(nil)
+
+func NewDownloadDataExportHandler(repo domain.DataExportRepository) *DownloadDataExportHandler {
+	return &DownloadDataExportHandler{repo: repo}
+}
+
+func (h *DownloadDataExportHandler) Handle(ctx context.Context, q DownloadDataExportQuery) (DownloadDataExportResponse, error) {
+	export, err := findOwnDataExport(ctx, h.repo, q.UserID, q.ID)
+	if err != nil {
+		return DownloadDataExportResponse{}, err
+	}
+
+	archive, err := export.DownloadArchive()
+	if err != nil {
+		return DownloadDataExportResponse{}, err
+	}
+
+	return DownloadDataExportResponse{Archive: archive}, nil
+}
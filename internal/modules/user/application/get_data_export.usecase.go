@@ -0,0 +1,64 @@
+package application
+
+import (
+	"context"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type GetDataExportQuery struct {
+	UserID domain.UserID
+	ID     domain.DataExportID
+}
+
+type GetDataExportResponse struct {
+	Export DataExportReadModel
+}
+
+type GetDataExportHandler struct {
+	repo domain.DataExportRepository
+}
+
+var _ application.RequestHandler[GetDataExportQuery, GetDataExportResponse] = (*GetDataExportHandler)(nil)
+
+func NewGetDataExportHandler(repo domain.DataExportRepository) *GetDataExportHandler {
+	return &GetDataExportHandler{repo: repo}
+}
+
+func (h *GetDataExportHandler) Handle(ctx context.Context, q GetDataExportQuery) (GetDataExportResponse, error) {
+	export, err := findOwnDataExport(ctx, h.repo, q.UserID, q.ID)
+	if err != nil {
+		return GetDataExportResponse{}, err
+	}
+
+	return GetDataExportResponse{
+		Export: DataExportReadModel{
+			ID:          export.ID(),
+			Status:      export.Status(),
+			RequestedAt: export.RequestedAt(),
+			CompletedAt: export.CompletedAt(),
+		},
+	}, nil
+}
+
+// findOwnDataExport hides exports of other users behind a not found error.
+func findOwnDataExport(ctx context.Context, repo domain.DataExportRepository, userID domain.UserID, id domain.DataExportID) (*domain.DataExport, error) {
+	meta := causation.FromContext(ctx)
+
+	if domain.UserID(meta.UserID) != userID && !meta.IsSystem() {
+		return nil, domain.ErrNotSameUser
+	}
+
+	export, err := repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if export.UserID() != userID {
+		return nil, domain.ErrDataExportNotFound
+	}
+
+	return export, nil
+}
@@ -21,8 +21,10 @@ func (uc *GetUserUseCase) Execute(ctx context.Context, id domain.UserID) (UserRe
 	}
 
 	return UserReadModel{
-		ID:    u.ID(),
-		Email: u.Email().String(),
-		Name:  u.Name().String(),
+		ID:            u.ID(),
+		Email:         u.Email().String(),
+		Name:          u.Name().String(),
+		Timezone:      u.Timezone().String(),
+		DailyCapacity: u.DailyCapacity().Int(),
 	}, nil
 }
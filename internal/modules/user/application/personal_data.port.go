@@ -0,0 +1,15 @@
+package application
+
+import (
+	"context"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+)
+
+// PersonalDataSource collects what a module stores about a user for data exports.
+type PersonalDataSource interface {
+	// Section names the archive entry the data is written to.
+	Section() string
+	// Collect returns a JSON-serializable view of the user's data.
+	Collect(ctx context.Context, userID domain.UserID) (any, error)
+}
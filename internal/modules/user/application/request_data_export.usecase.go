@@ -0,0 +1,56 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type RequestDataExportCommand struct {
+	UserID domain.UserID
+}
+
+type RequestDataExportResponse struct {
+	Export DataExportReadModel
+}
+
+// RequestDataExportHandler stores a pending export, which is built once its event is consumed.
+type RequestDataExportHandler struct {
+	userRepo   domain.UserRepository
+	exportRepo domain.DataExportRepository
+}
+
+var _ application.RequestHandler[RequestDataExportCommand, RequestDataExportResponse] = (*RequestDataExportHandler)(nil)
+
+func NewRequestDataExportHandler(userRepo domain.UserRepository, exportRepo domain.DataExportRepository) *RequestDataExportHandler {
+	return &RequestDataExportHandler{userRepo: userRepo, exportRepo: exportRepo}
+}
+
+func (h *RequestDataExportHandler) Handle(ctx context.Context, cmd RequestDataExportCommand) (RequestDataExportResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	if domain.UserID(meta.UserID) != cmd.UserID && !meta.IsSystem() {
+		return RequestDataExportResponse{}, domain.ErrNotSameUser
+	}
+
+	if _, err := h.userRepo.FindByID(ctx, cmd.UserID); err != nil {
+		return RequestDataExportResponse{}, err
+	}
+
+	export := domain.NewDataExport(cmd.UserID, time.Now())
+
+	if err := h.exportRepo.Save(ctx, export); err != nil {
+		return RequestDataExportResponse{}, err
+	}
+
+	return RequestDataExportResponse{
+		Export: DataExportReadModel{
+			ID:          export.ID(),
+			Status:      export.Status(),
+			RequestedAt: export.RequestedAt(),
+		},
+	}, nil
+}
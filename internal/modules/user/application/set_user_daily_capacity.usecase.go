@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type SetUserDailyCapacityCommand struct {
+	ID       domain.UserID
+	Capacity int
+}
+
+func (c *SetUserDailyCapacityCommand) Validate() error {
+	_, err := domain.NewDailyCapacity(c.Capacity)
+	return err
+}
+
+type SetUserDailyCapacityResponse struct{}
+
+type SetUserDailyCapacityHandler struct {
+	repo domain.UserRepository
+}
+
+var _ application.RequestHandler[SetUserDailyCapacityCommand, SetUserDailyCapacityResponse] = (*SetUserDailyCapacityHandler)(nil)
+
+func NewSetUserDailyCapacityHandler(repo domain.UserRepository) *SetUserDailyCapacityHandler {
+	return &SetUserDailyCapacityHandler{repo: repo}
+}
+
+func (h *SetUserDailyCapacityHandler) Handle(ctx context.Context, cmd SetUserDailyCapacityCommand) (SetUserDailyCapacityResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	if domain.UserID(meta.UserID) != cmd.ID && !meta.IsSystem() {
+		return SetUserDailyCapacityResponse{}, domain.ErrNotSameUser
+	}
+
+	capacity, err := domain.NewDailyCapacity(cmd.Capacity)
+	if err != nil {
+		return SetUserDailyCapacityResponse{}, err
+	}
+
+	u, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return SetUserDailyCapacityResponse{}, err
+	}
+
+	u.SetDailyCapacity(capacity, time.Now())
+
+	return SetUserDailyCapacityResponse{}, h.repo.Save(ctx, u)
+}
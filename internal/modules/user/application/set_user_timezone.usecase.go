@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type SetUserTimezoneCommand struct {
+	ID       domain.UserID
+	Timezone string
+}
+
+func (c *SetUserTimezoneCommand) Validate() error {
+	_, err := domain.NewUserTimezone(c.Timezone)
+	return err
+}
+
+type SetUserTimezoneResponse struct{}
+
+type SetUserTimezoneHandler struct {
+	repo domain.UserRepository
+}
+
+var _ application.RequestHandler[SetUserTimezoneCommand, SetUserTimezoneResponse] = (*SetUserTimezoneHandler)(nil)
+
+func NewSetUserTimezoneHandler(repo domain.UserRepository) *SetUserTimezoneHandler {
+	return &SetUserTimezoneHandler{repo: repo}
+}
+
+func (h *SetUserTimezoneHandler) Handle(ctx context.Context, cmd SetUserTimezoneCommand) (SetUserTimezoneResponse, error) {
+	meta := causation.FromContext(ctx)
+
+	if domain.UserID(meta.UserID) != cmd.ID && !meta.IsSystem() {
+		return SetUserTimezoneResponse{}, domain.ErrNotSameUser
+	}
+
+	tz, err := domain.NewUserTimezone(cmd.Timezone)
+	if err != nil {
+		return SetUserTimezoneResponse{}, err
+	}
+
+	u, err := h.repo.FindByID(ctx, cmd.ID)
+	if err != nil {
+		return SetUserTimezoneResponse{}, err
+	}
+
+	u.SetTimezone(tz, time.Now())
+
+	return SetUserTimezoneResponse{}, h.repo.Save(ctx, u)
+}
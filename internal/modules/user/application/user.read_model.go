@@ -1,11 +1,22 @@
 package application
 
 import (
+	"time"
+
 	"github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
 )
 
 type UserReadModel struct {
-	ID    domain.UserID
-	Email string
-	Name  string
+	ID            domain.UserID
+	Email         string
+	Name          string
+	Timezone      string
+	DailyCapacity int
+}
+
+type DataExportReadModel struct {
+	ID          domain.DataExportID
+	Status      domain.DataExportStatus
+	RequestedAt time.Time
+	CompletedAt *time.Time
 }
@@ -0,0 +1,15 @@
+package application
+
+import (
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+)
+
+type UserUseCases struct {
+	SetTimezone      application.RequestHandler[SetUserTimezoneCommand, SetUserTimezoneResponse]
+	SetDailyCapacity application.RequestHandler[SetUserDailyCapacityCommand, SetUserDailyCapacityResponse]
+	Delete           application.RequestHandler[DeleteUserCommand, DeleteUserResponse]
+
+	RequestDataExport  application.RequestHandler[RequestDataExportCommand, RequestDataExportResponse]
+	GetDataExport      application.RequestHandler[GetDataExportQuery, GetDataExportResponse]
+	DownloadDataExport application.RequestHandler[DownloadDataExportQuery, DownloadDataExportResponse]
+}
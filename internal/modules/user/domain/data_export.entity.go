@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/apperrors"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+var (
+	ErrDataExportNotFound = shared.NewDomainError(apperrors.NotFound, "data export not found")
+	ErrDataExportNotReady = shared.NewDomainError(apperrors.Conflict, "data export is not ready")
+)
+
+type DataExportID = shared.ID[DataExport]
+
+type DataExportStatus string
+
+const (
+	DataExportPending DataExportStatus = "PENDING"
+	DataExportReady   DataExportStatus = "READY"
+	DataExportFailed  DataExportStatus = "FAILED"
+)
+
+// DataExport is a user's request for a copy of their personal data.
+// The archive is built asynchronously after the request is stored.
+type DataExport struct {
+	shared.AggregateRoot
+
+	id          DataExportID
+	userID      UserID
+	status      DataExportStatus
+	requestedAt time.Time
+	completedAt *time.Time
+	archive     []byte
+}
+
+func NewDataExport(userID UserID, now time.Time) *DataExport {
+	id := shared.NewID[DataExport]()
+	e := &DataExport{
+		id:          id,
+		userID:      userID,
+		status:      DataExportPending,
+		requestedAt: now,
+	}
+
+	e.RecordEvent(DataExportRequestedEvent{
+		ID:       id,
+		UserID:   userID,
+		Occurred: now,
+	})
+
+	return e
+}
+
+type ReconstituteDataExportArgs struct {
+	ID          DataExportID
+	UserID      UserID
+	Status      DataExportStatus
+	RequestedAt time.Time
+	CompletedAt *time.Time
+	Archive     []byte
+}
+
+func ReconstituteDataExport(args ReconstituteDataExportArgs) *DataExport {
+	return &DataExport{
+		id:          args.ID,
+		userID:      args.UserID,
+		status:      args.Status,
+		requestedAt: args.RequestedAt,
+		completedAt: args.CompletedAt,
+		archive:     args.Archive,
+	}
+}
+
+func (e *DataExport) ID() DataExportID         { return e.id }
+func (e *DataExport) UserID() UserID           { return e.userID }
+func (e *DataExport) Status() DataExportStatus { return e.status }
+func (e *DataExport) RequestedAt() time.Time   { return e.requestedAt }
+func (e *DataExport) CompletedAt() *time.Time  { return e.completedAt }
+func (e *DataExport) Archive() []byte          { return e.archive }
+
+// Complete attaches the built archive. Exports that already finished are left untouched,
+// so a redelivered request doesn't rebuild them.
+func (e *DataExport) Complete(archive []byte, now time.Time) {
+	if e.status != DataExportPending {
+		return
+	}
+
+	e.status = DataExportReady
+	e.archive = archive
+	e.completedAt = &now
+}
+
+func (e *DataExport) Fail(now time.Time) {
+	if e.status != DataExportPending {
+		return
+	}
+
+	e.status = DataExportFailed
+	e.completedAt = &now
+}
+
+// DownloadArchive returns the archive once the export is ready.
+func (e *DataExport) DownloadArchive() ([]byte, error) {
+	if e.status != DataExportReady {
+		return nil, ErrDataExportNotReady
+	}
+
+	return e.archive, nil
+}
@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+func TestDataExport(t *testing.T) {
+	t.Parallel()
+
+	userID := shared.NewID[User]()
+	now := time.Now()
+
+	t.Run("requested export is pending", func(t *testing.T) {
+		e := NewDataExport(userID, now)
+
+		assert.Equal(t, DataExportPending, e.Status())
+		require.Len(t, e.Events(), 1)
+
+		evt, ok := e.Events()[0].(DataExportRequestedEvent)
+		require.True(t, ok)
+		assert.Equal(t, e.ID(), evt.ID)
+		assert.Equal(t, userID, evt.UserID)
+
+		_, err := e.DownloadArchive()
+		assert.ErrorIs(t, err, ErrDataExportNotReady)
+	})
+
+	t.Run("completed export can be downloaded", func(t *testing.T) {
+		e := NewDataExport(userID, now)
+		e.Complete([]byte("zip"), now)
+
+		assert.Equal(t, DataExportReady, e.Status())
+		require.NotNil(t, e.CompletedAt())
+
+		archive, err := e.DownloadArchive()
+		require.NoError(t, err)
+		assert.Equal(t, []byte("zip"), archive)
+	})
+
+	t.Run("finished export is not rebuilt", func(t *testing.T) {
+		e := NewDataExport(userID, now)
+		e.Fail(now)
+		e.Complete([]byte("zip"), now)
+
+		assert.Equal(t, DataExportFailed, e.Status())
+		assert.Nil(t, e.Archive())
+	})
+}
@@ -29,3 +29,36 @@ func (e UserDeletedEvent) EventName() shared.EventType         { return shared.U
 func (e UserDeletedEvent) OccurredAt() time.Time               { return e.Occurred }
 func (e UserDeletedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
 func (e UserDeletedEvent) AggregateType() shared.AggregateType { return shared.AggUser }
+
+type UserTimezoneChangedEvent struct {
+	ID       UserID
+	Timezone UserTimezone
+	Occurred time.Time
+}
+
+func (e UserTimezoneChangedEvent) EventName() shared.EventType         { return shared.UserTimezoneChanged }
+func (e UserTimezoneChangedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e UserTimezoneChangedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e UserTimezoneChangedEvent) AggregateType() shared.AggregateType { return shared.AggUser }
+
+type UserCapacityChangedEvent struct {
+	ID            UserID
+	DailyCapacity DailyCapacity
+	Occurred      time.Time
+}
+
+func (e UserCapacityChangedEvent) EventName() shared.EventType         { return shared.UserCapacityChanged }
+func (e UserCapacityChangedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e UserCapacityChangedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e UserCapacityChangedEvent) AggregateType() shared.AggregateType { return shared.AggUser }
+
+type DataExportRequestedEvent struct {
+	ID       DataExportID
+	UserID   UserID
+	Occurred time.Time
+}
+
+func (e DataExportRequestedEvent) EventName() shared.EventType         { return shared.UserDataExportRequested }
+func (e DataExportRequestedEvent) OccurredAt() time.Time               { return e.Occurred }
+func (e DataExportRequestedEvent) AggregateID() uuid.UUID              { return e.ID.UUID() }
+func (e DataExportRequestedEvent) AggregateType() shared.AggregateType { return shared.AggDataExport }
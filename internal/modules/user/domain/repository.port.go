@@ -11,3 +11,9 @@ type UserRepository interface {
 	FindByEmail(ctx context.Context, email UserEmail) (*User, error)
 	Delete(ctx context.Context, id UserID) error
 }
+
+//go:generate go tool gowrap gen -g -i DataExportRepository -t ../../../../templates/opentelemetry.gotmpl -o ../infrastructure/postgres/data_export_repository_tracing.gen.go
+type DataExportRepository interface {
+	Save(ctx context.Context, export *DataExport) error
+	FindByID(ctx context.Context, id DataExportID) (*DataExport, error)
+}
@@ -7,28 +7,42 @@ import (
 	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
 )
 
-var ErrUserNotFound = shared.NewDomainError(apperrors.NotFound, "user not found")
+var (
+	ErrUserNotFound = shared.NewDomainError(apperrors.NotFound, "user not found")
+	ErrNotSameUser  = shared.NewDomainError(apperrors.Unauthorized, "users can only modify their own profile")
+)
 
 type UserID = shared.ID[User]
 
 type User struct {
 	shared.AggregateRoot
 
-	id        UserID
-	email     UserEmail
-	name      UserName
-	createdAt time.Time
+	id            UserID
+	email         UserEmail
+	name          UserName
+	timezone      UserTimezone
+	dailyCapacity DailyCapacity
+	createdAt     time.Time
 }
 
 type ReconstituteUserArgs struct {
-	ID        UserID
-	Email     UserEmail
-	Name      UserName
-	CreatedAt time.Time
+	ID            UserID
+	Email         UserEmail
+	Name          UserName
+	Timezone      UserTimezone
+	DailyCapacity DailyCapacity
+	CreatedAt     time.Time
 }
 
 func ReconstituteUser(args ReconstituteUserArgs) *User {
-	return &User{id: args.ID, email: args.Email, name: args.Name, createdAt: args.CreatedAt}
+	return &User{
+		id:            args.ID,
+		email:         args.Email,
+		name:          args.Name,
+		timezone:      args.Timezone,
+		dailyCapacity: args.DailyCapacity,
+		createdAt:     args.CreatedAt,
+	}
 }
 
 func NewUser(email UserEmail, name UserName) *User {
@@ -50,10 +64,39 @@ func NewUser(email UserEmail, name UserName) *User {
 	return u
 }
 
-func (u *User) ID() UserID           { return u.id }
-func (u *User) Email() UserEmail     { return u.email }
-func (u *User) Name() UserName       { return u.name }
-func (u *User) CreatedAt() time.Time { return u.createdAt }
+func (u *User) ID() UserID                   { return u.id }
+func (u *User) Email() UserEmail             { return u.email }
+func (u *User) Name() UserName               { return u.name }
+func (u *User) Timezone() UserTimezone       { return u.timezone }
+func (u *User) DailyCapacity() DailyCapacity { return u.dailyCapacity }
+func (u *User) CreatedAt() time.Time         { return u.createdAt }
+
+func (u *User) SetTimezone(tz UserTimezone, now time.Time) {
+	if u.timezone.String() == tz.String() {
+		return
+	}
+
+	u.timezone = tz
+	u.RecordEvent(UserTimezoneChangedEvent{
+		ID:       u.id,
+		Timezone: tz,
+		Occurred: now,
+	})
+}
+
+// SetDailyCapacity changes the default capacity of schedules created from now on.
+func (u *User) SetDailyCapacity(c DailyCapacity, now time.Time) {
+	if u.dailyCapacity.Int() == c.Int() {
+		return
+	}
+
+	u.dailyCapacity = c
+	u.RecordEvent(UserCapacityChangedEvent{
+		ID:            u.id,
+		DailyCapacity: c,
+		Occurred:      now,
+	})
+}
 
 func (u *User) Delete() {
 	u.RecordEvent(UserDeletedEvent{
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"github.com/danicc097/todo-ddd-example/internal/apperrors"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+var ErrInvalidDailyCapacity = shared.NewDomainError(apperrors.InvalidInput, "daily capacity must be between 1 and 100")
+
+const (
+	DefaultDailyCapacity = 10
+	maxDailyCapacity     = 100
+)
+
+// DailyCapacity is the energy a user can commit to a day unless that day's schedule overrides it.
+type DailyCapacity struct {
+	value int
+}
+
+func NewDailyCapacity(val int) (DailyCapacity, error) {
+	if val < 1 || val > maxDailyCapacity {
+		return DailyCapacity{}, ErrInvalidDailyCapacity
+	}
+
+	return DailyCapacity{value: val}, nil
+}
+
+// Int returns the capacity, defaulting to DefaultDailyCapacity.
+func (c DailyCapacity) Int() int {
+	if c.value == 0 {
+		return DefaultDailyCapacity
+	}
+
+	return c.value
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDailyCapacity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   int
+		wantErr bool
+	}{
+		{"min", 1, false},
+		{"max", maxDailyCapacity, false},
+		{"zero", 0, true},
+		{"negative", -3, true},
+		{"too high", maxDailyCapacity + 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewDailyCapacity(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidDailyCapacity)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.input, c.Int())
+			}
+		})
+	}
+
+	t.Run("zero value is the default", func(t *testing.T) {
+		assert.Equal(t, DefaultDailyCapacity, DailyCapacity{}.Int())
+	})
+}
+
+func TestUser_SetDailyCapacity(t *testing.T) {
+	t.Parallel()
+
+	u := ReconstituteUser(ReconstituteUserArgs{ID: UserID(uuid.New())})
+
+	same, _ := NewDailyCapacity(DefaultDailyCapacity)
+	u.SetDailyCapacity(same, time.Now())
+	assert.Empty(t, u.Events())
+
+	c, _ := NewDailyCapacity(6)
+	u.SetDailyCapacity(c, time.Now())
+	require.Len(t, u.Events(), 1)
+	assert.Equal(t, 6, u.Events()[0].(UserCapacityChangedEvent).DailyCapacity.Int())
+	assert.Equal(t, 6, u.DailyCapacity().Int())
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/danicc097/todo-ddd-example/internal/apperrors"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+var ErrInvalidTimezone = shared.NewDomainError(apperrors.InvalidInput, "timezone must be a valid IANA name")
+
+const DefaultTimezone = "UTC"
+
+// UserTimezone is the IANA zone used to compute a user's calendar days.
+type UserTimezone struct {
+	loc *time.Location
+}
+
+func NewUserTimezone(val string) (UserTimezone, error) {
+	val = strings.TrimSpace(val)
+	// LoadLocation maps "" and "Local" to the server zone, which is exactly what we want to avoid
+	if val == "" || val == "Local" {
+		return UserTimezone{}, ErrInvalidTimezone
+	}
+
+	loc, err := time.LoadLocation(val)
+	if err != nil {
+		return UserTimezone{}, ErrInvalidTimezone
+	}
+
+	return UserTimezone{loc: loc}, nil
+}
+
+// Location returns the zone, defaulting to UTC.
+func (tz UserTimezone) Location() *time.Location {
+	if tz.loc == nil {
+		return time.UTC
+	}
+
+	return tz.loc
+}
+
+func (tz UserTimezone) String() string {
+	return tz.Location().String()
+}
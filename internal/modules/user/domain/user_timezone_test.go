@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUserTimezone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{"valid zone", "America/Los_Angeles", false},
+		{"utc", "UTC", false},
+		{"trimmed", " Europe/Madrid ", false},
+		{"unknown zone", "Mars/Olympus_Mons", true},
+		{"empty", "", true},
+		{"server local", "Local", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tz, err := NewUserTimezone(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidTimezone)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, strings.TrimSpace(tt.input), tz.String())
+			}
+		})
+	}
+
+	t.Run("zero value is utc", func(t *testing.T) {
+		assert.Equal(t, time.UTC, UserTimezone{}.Location())
+	})
+}
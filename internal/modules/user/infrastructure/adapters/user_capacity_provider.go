@@ -0,0 +1,30 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+)
+
+type UserCapacityProvider struct {
+	Repo userDomain.UserRepository
+}
+
+func NewUserCapacityProvider(repo userDomain.UserRepository) *UserCapacityProvider {
+	return &UserCapacityProvider{Repo: repo}
+}
+
+// DailyCapacity returns the user's default capacity, falling back to the global default for unknown users such as system actors.
+func (p *UserCapacityProvider) DailyCapacity(ctx context.Context, userID userDomain.UserID) (int, error) {
+	u, err := p.Repo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, userDomain.ErrUserNotFound) {
+			return userDomain.DefaultDailyCapacity, nil
+		}
+
+		return 0, err
+	}
+
+	return u.DailyCapacity().Int(), nil
+}
@@ -0,0 +1,31 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+)
+
+type UserTimezoneProvider struct {
+	Repo userDomain.UserRepository
+}
+
+func NewUserTimezoneProvider(repo userDomain.UserRepository) *UserTimezoneProvider {
+	return &UserTimezoneProvider{Repo: repo}
+}
+
+// Location returns the user's zone, falling back to UTC for unknown users such as system actors.
+func (p *UserTimezoneProvider) Location(ctx context.Context, userID userDomain.UserID) (*time.Location, error) {
+	u, err := p.Repo.FindByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, userDomain.ErrUserNotFound) {
+			return time.UTC, nil
+		}
+
+		return nil, err
+	}
+
+	return u.Timezone().Location(), nil
+}
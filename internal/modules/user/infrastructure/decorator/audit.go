@@ -0,0 +1,63 @@
+package decorator
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	auditDomain "github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+	auditDecorator "github.com/danicc097/todo-ddd-example/internal/modules/audit/infrastructure/decorator"
+	"github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+var _ domain.UserRepository = (*UserAuditWrapper)(nil)
+
+// UserAuditWrapper logs user changes. Users don't belong to a workspace, so their logs are unscoped.
+// Email and name are redacted, since logs outlive the user and erasure only pseudonymizes actors.
+type UserAuditWrapper struct {
+	base    domain.UserRepository
+	auditor *auditDecorator.AuditRepoDecorator[*domain.User, domain.UserID]
+}
+
+func NewUserAuditWrapper(base domain.UserRepository, auditRepo auditDomain.AuditRepository) *UserAuditWrapper {
+	extractor := func(u *domain.User) map[string]any {
+		return map[string]any{
+			"email":          u.Email().String(),
+			"name":           u.Name().String(),
+			"timezone":       u.Timezone().String(),
+			"daily_capacity": u.DailyCapacity().Int(),
+		}
+	}
+
+	return &UserAuditWrapper{
+		base: base,
+		auditor: auditDecorator.NewAuditRepoDecorator(
+			auditRepo,
+			shared.AggUser,
+			extractor,
+			func(id domain.UserID) uuid.UUID { return id.UUID() },
+			nil,
+		).WithRedactedFields("email", "name"),
+	}
+}
+
+func (w *UserAuditWrapper) Save(ctx context.Context, user *domain.User) error {
+	return w.auditor.AuditSave(ctx, user, auditDomain.OpUpsert, w.base.FindByID, w.base.Save)
+}
+
+func (w *UserAuditWrapper) Delete(ctx context.Context, id domain.UserID) error {
+	return w.auditor.AuditDelete(ctx, id, w.base.FindByID, w.base.Delete)
+}
+
+/*
+* Read methods bypass audit
+ */
+
+func (w *UserAuditWrapper) FindByID(ctx context.Context, id domain.UserID) (*domain.User, error) {
+	return w.base.FindByID(ctx, id)
+}
+
+func (w *UserAuditWrapper) FindByEmail(ctx context.Context, email domain.UserEmail) (*domain.User, error) {
+	return w.base.FindByEmail(ctx, email)
+}
@@ -0,0 +1,109 @@
+package decorator_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	auditApp "github.com/danicc097/todo-ddd-example/internal/modules/audit/application"
+	auditDomain "github.com/danicc097/todo-ddd-example/internal/modules/audit/domain"
+	"github.com/danicc097/todo-ddd-example/internal/modules/audit/infrastructure/memory"
+	"github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"github.com/danicc097/todo-ddd-example/internal/modules/user/infrastructure/decorator"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
+)
+
+type userStore map[domain.UserID]*domain.User
+
+func (s userStore) Save(_ context.Context, u *domain.User) error {
+	s[u.ID()] = u
+	return nil
+}
+
+func (s userStore) FindByID(_ context.Context, id domain.UserID) (*domain.User, error) {
+	u, ok := s[id]
+	if !ok {
+		return nil, domain.ErrUserNotFound
+	}
+
+	return u, nil
+}
+
+func (s userStore) FindByEmail(_ context.Context, email domain.UserEmail) (*domain.User, error) {
+	for _, u := range s {
+		if u.Email() == email {
+			return u, nil
+		}
+	}
+
+	return nil, domain.ErrUserNotFound
+}
+
+func (s userStore) Delete(_ context.Context, id domain.UserID) error {
+	delete(s, id)
+	return nil
+}
+
+func TestUserAuditWrapper_ErasureLeavesNoPII(t *testing.T) {
+	t.Parallel()
+
+	email, _ := domain.NewUserEmail("erased@example.com")
+	name, _ := domain.NewUserName("Erased Person")
+	user := domain.NewUser(email, name)
+
+	ctx := causation.WithMetadata(context.Background(), causation.Metadata{
+		CorrelationID: "corr",
+		UserID:        user.ID().UUID(),
+		UserIP:        "203.0.113.7",
+	})
+
+	auditRepo := memory.NewAuditRepository()
+	repo := decorator.NewUserAuditWrapper(userStore{}, auditRepo)
+
+	require.NoError(t, repo.Save(ctx, user))
+	require.NoError(t, repo.Delete(ctx, user.ID()))
+
+	payload, err := json.Marshal(map[string]any{"data": map[string]any{"id": user.ID().UUID()}})
+	require.NoError(t, err)
+	require.NoError(t, auditApp.NewUserDeletedEventHandler(auditRepo).Handle(context.Background(), payload))
+
+	logs := auditRepo.FindAll()
+	require.Len(t, logs, 3)
+
+	v := auditDomain.NewChainVerifier()
+
+	for _, l := range logs[:2] {
+		stored, err := json.Marshal(l.Changes())
+		require.NoError(t, err)
+
+		assert.NotContains(t, string(stored), email.String())
+		assert.NotContains(t, string(stored), name.String())
+		assert.Equal(t, auditDomain.RedactedValue, fieldValue(l.Changes()["email"]))
+		assert.NotEqual(t, user.ID().UUID(), *l.ActorID())
+		assert.NotEqual(t, user.ID().UUID(), l.AggregateID())
+		assert.Empty(t, l.ActorIP())
+	}
+
+	assert.Equal(t, auditDomain.OpErase.String(), logs[2].Operation())
+
+	for _, l := range logs {
+		brk, err := v.Check(l)
+		require.NoError(t, err)
+		assert.Nil(t, brk)
+	}
+
+	assert.Nil(t, v.Finish())
+}
+
+// fieldValue returns whichever side of the change is set.
+func fieldValue(c auditDomain.FieldChange) string {
+	if c.After() != nil {
+		return fmt.Sprint(c.After())
+	}
+
+	return fmt.Sprint(c.Before())
+}
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -9,15 +10,18 @@ import (
 	"github.com/danicc097/todo-ddd-example/internal/modules/user/application"
 	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
 	workspaceApp "github.com/danicc097/todo-ddd-example/internal/modules/workspace/application"
+	infraHttp "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/http"
 )
 
 type UserHandler struct {
+	uc                    application.UserUseCases
 	getUserUC             *application.GetUserUseCase
 	workspaceQueryService workspaceApp.WorkspaceQueryService
 }
 
-func NewUserHandler(g *application.GetUserUseCase, wqs workspaceApp.WorkspaceQueryService) *UserHandler {
+func NewUserHandler(uc application.UserUseCases, g *application.GetUserUseCase, wqs workspaceApp.WorkspaceQueryService) *UserHandler {
 	return &UserHandler{
+		uc:                    uc,
 		getUserUC:             g,
 		workspaceQueryService: wqs,
 	}
@@ -31,12 +35,76 @@ func (h *UserHandler) GetUserByID(c *gin.Context, id userDomain.UserID) {
 	}
 
 	c.JSON(http.StatusOK, api.User{
-		Id:    user.ID,
-		Email: user.Email,
-		Name:  user.Name,
+		Id:            user.ID,
+		Email:         user.Email,
+		Name:          user.Name,
+		Timezone:      user.Timezone,
+		DailyCapacity: user.DailyCapacity,
 	})
 }
 
+func (h *UserHandler) SetUserTimezone(c *gin.Context, id userDomain.UserID, params api.SetUserTimezoneParams) {
+	req, ok := infraHttp.BindJSON[api.SetUserTimezoneRequest](c)
+	if !ok {
+		return
+	}
+
+	if _, ok := infraHttp.Execute(c, h.uc.SetTimezone, application.SetUserTimezoneCommand{
+		ID:       id,
+		Timezone: req.Timezone,
+	}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *UserHandler) SetUserDailyCapacity(c *gin.Context, id userDomain.UserID, params api.SetUserDailyCapacityParams) {
+	req, ok := infraHttp.BindJSON[api.SetUserDailyCapacityRequest](c)
+	if !ok {
+		return
+	}
+
+	if _, ok := infraHttp.Execute(c, h.uc.SetDailyCapacity, application.SetUserDailyCapacityCommand{
+		ID:       id,
+		Capacity: req.Capacity,
+	}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *UserHandler) DeleteUser(c *gin.Context, id userDomain.UserID) {
+	if _, ok := infraHttp.Execute(c, h.uc.Delete, application.DeleteUserCommand{ID: id}); ok {
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func (h *UserHandler) RequestUserDataExport(c *gin.Context, id userDomain.UserID, params api.RequestUserDataExportParams) {
+	if resp, ok := infraHttp.Execute(c, h.uc.RequestDataExport, application.RequestDataExportCommand{UserID: id}); ok {
+		c.JSON(http.StatusAccepted, toAPIDataExport(resp.Export))
+	}
+}
+
+func (h *UserHandler) GetUserDataExport(c *gin.Context, id userDomain.UserID, exportID userDomain.DataExportID) {
+	if resp, ok := infraHttp.Execute(c, h.uc.GetDataExport, application.GetDataExportQuery{UserID: id, ID: exportID}); ok {
+		c.JSON(http.StatusOK, toAPIDataExport(resp.Export))
+	}
+}
+
+func (h *UserHandler) DownloadUserDataExport(c *gin.Context, id userDomain.UserID, exportID userDomain.DataExportID) {
+	if resp, ok := infraHttp.Execute(c, h.uc.DownloadDataExport, application.DownloadDataExportQuery{UserID: id, ID: exportID}); ok {
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="data-export-%s.zip"`, exportID))
+		c.Data(http.StatusOK, "application/zip", resp.Archive)
+	}
+}
+
+func toAPIDataExport(e application.DataExportReadModel) api.DataExport {
+	return api.DataExport{
+		Id:          e.ID,
+		Status:      api.DataExportStatus(e.Status),
+		RequestedAt: e.RequestedAt,
+		CompletedAt: e.CompletedAt,
+	}
+}
+
 func (h *UserHandler) GetUserWorkspaces(c *gin.Context, id userDomain.UserID, params api.GetUserWorkspacesParams) {
 	workspaces, err := h.workspaceQueryService.ListByUserID(c.Request.Context(), id)
 	if err != nil {
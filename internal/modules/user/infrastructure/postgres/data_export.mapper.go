@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"github.com/danicc097/todo-ddd-example/internal/generated/db"
+	"github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	shared "github.com/danicc097/todo-ddd-example/internal/shared/domain"
+)
+
+type DataExportMapper struct{}
+
+func (m *DataExportMapper) ToDomain(row db.UserDataExports) *domain.DataExport {
+	return domain.ReconstituteDataExport(domain.ReconstituteDataExportArgs{
+		ID:          row.ID,
+		UserID:      row.UserID,
+		Status:      domain.DataExportStatus(row.Status),
+		RequestedAt: row.RequestedAt,
+		CompletedAt: row.CompletedAt,
+		Archive:     row.Archive,
+	})
+}
+
+func (m *DataExportMapper) ToPersistence(e *domain.DataExport) db.UserDataExports {
+	return db.UserDataExports{
+		ID:          e.ID(),
+		UserID:      e.UserID(),
+		Status:      string(e.Status()),
+		RequestedAt: e.RequestedAt(),
+		CompletedAt: e.CompletedAt(),
+		Archive:     e.Archive(),
+	}
+}
+
+type DataExportRequestedDTO struct {
+	ID           domain.DataExportID `json:"id"`
+	UserID       domain.UserID       `json:"user_id"`
+	EventVersion int                 `json:"event_version"`
+}
+
+func (m *DataExportMapper) MapEvent(e shared.DomainEvent) (shared.EventType, any, error) {
+	switch evt := e.(type) {
+	case domain.DataExportRequestedEvent:
+		return shared.UserDataExportRequested, DataExportRequestedDTO{
+			ID:           evt.ID,
+			UserID:       evt.UserID,
+			EventVersion: 1,
+		}, nil
+	}
+
+	return "", nil, nil
+}
@@ -0,0 +1,67 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/danicc097/todo-ddd-example/internal/generated/db"
+	infraDB "github.com/danicc097/todo-ddd-example/internal/infrastructure/db"
+	"github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+)
+
+type DataExportRepo struct {
+	q      *db.Queries
+	pool   *pgxpool.Pool
+	mapper *DataExportMapper
+	uow    application.UnitOfWork
+}
+
+var _ domain.DataExportRepository = (*DataExportRepo)(nil)
+
+func NewDataExportRepo(pool *pgxpool.Pool, uow application.UnitOfWork) *DataExportRepo {
+	return &DataExportRepo{
+		q:      db.New(),
+		pool:   pool,
+		mapper: &DataExportMapper{},
+		uow:    uow,
+	}
+}
+
+func (r *DataExportRepo) getDB(ctx context.Context) db.DBTX {
+	if tx := infraDB.ExtractTx(ctx); tx != nil {
+		return tx
+	}
+
+	return r.pool
+}
+
+func (r *DataExportRepo) Save(ctx context.Context, e *domain.DataExport) error {
+	p := r.mapper.ToPersistence(e)
+
+	if err := r.q.UpsertUserDataExport(ctx, r.getDB(ctx), db.UpsertUserDataExportParams(p)); err != nil {
+		return fmt.Errorf("failed to save data export %s: %w", e.ID(), sharedPg.ParseDBError(err))
+	}
+
+	r.uow.Collect(ctx, r.mapper, e)
+
+	return nil
+}
+
+func (r *DataExportRepo) FindByID(ctx context.Context, id domain.DataExportID) (*domain.DataExport, error) {
+	row, err := r.q.GetUserDataExportByID(ctx, r.getDB(ctx), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrDataExportNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get data export %s: %w", id, sharedPg.ParseDBError(err))
+	}
+
+	return r.mapper.ToDomain(row), nil
+}
@@ -0,0 +1,94 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../../../../../templates/opentelemetry.gotmpl
+// gowrap: http://github.com/hexdigest/gowrap
+
+package postgres
+
+import (
+	"context"
+
+	_sourceDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	"go.opentelemetry.io/otel/attribute"
+
+	"go.opentelemetry.io/otel"
+	_codes "go.opentelemetry.io/otel/codes"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DataExportRepositoryWithTracing implements DataExportRepository interface instrumented with open telemetry spans
+type DataExportRepositoryWithTracing struct {
+	_sourceDomain.DataExportRepository
+	_instance      string
+	_spanDecorator func(span trace.Span, params, results map[string]interface{})
+}
+
+// NewDataExportRepositoryWithTracing returns DataExportRepositoryWithTracing
+func NewDataExportRepositoryWithTracing(base _sourceDomain.DataExportRepository, instance string, spanDecorator ...func(span trace.Span, params, results map[string]interface{})) DataExportRepositoryWithTracing {
+	d := DataExportRepositoryWithTracing{
+		DataExportRepository: base,
+		_instance:            instance,
+	}
+
+	if len(spanDecorator) > 0 && spanDecorator[0] != nil {
+		d._spanDecorator = spanDecorator[0]
+	}
+
+	return d
+}
+
+// FindByID implements DataExportRepository
+func (_d DataExportRepositoryWithTracing) FindByID(ctx context.Context, id _sourceDomain.DataExportID) (dp1 *_sourceDomain.DataExport, err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "DataExportRepository.FindByID", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "FindByID"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx": ctx,
+				"id":  id}, map[string]interface{}{
+				"dp1": dp1,
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.DataExportRepository.FindByID(ctx, id)
+}
+
+// Save implements DataExportRepository
+func (_d DataExportRepositoryWithTracing) Save(ctx context.Context, export *_sourceDomain.DataExport) (err error) {
+	ctx, _span := otel.Tracer(_d._instance).Start(ctx, "DataExportRepository.Save", trace.WithAttributes(
+		semconv.DBSystemNamePostgreSQL,
+		semconv.PeerServiceKey.String("postgres"),
+		attribute.String("db.operation", "Save"),
+	))
+	defer func() {
+		if _d._spanDecorator != nil {
+			_d._spanDecorator(_span, map[string]interface{}{
+				"ctx":    ctx,
+				"export": export}, map[string]interface{}{
+				"err": err})
+		} else if err != nil {
+			_span.RecordError(err)
+			_span.SetStatus(_codes.Error, err.Error())
+			_span.SetAttributes(
+				attribute.String("event", "error"),
+				attribute.String("message", err.Error()),
+			)
+		}
+
+		_span.End()
+	}()
+	return _d.DataExportRepository.Save(ctx, export)
+}
@@ -11,21 +11,27 @@ type UserMapper struct{}
 func (m *UserMapper) ToDomain(row db.Users) *domain.User {
 	email, _ := domain.NewUserEmail(row.Email)
 	name, _ := domain.NewUserName(row.Name)
+	tz, _ := domain.NewUserTimezone(row.Timezone)
+	capacity, _ := domain.NewDailyCapacity(int(row.DailyCapacity))
 
 	return domain.ReconstituteUser(domain.ReconstituteUserArgs{
-		ID:        row.ID,
-		Email:     email,
-		Name:      name,
-		CreatedAt: row.CreatedAt,
+		ID:            row.ID,
+		Email:         email,
+		Name:          name,
+		Timezone:      tz,
+		DailyCapacity: capacity,
+		CreatedAt:     row.CreatedAt,
 	})
 }
 
 func (m *UserMapper) ToPersistence(u *domain.User) db.Users {
 	return db.Users{
-		ID:        u.ID(),
-		Email:     u.Email().String(),
-		Name:      u.Name().String(),
-		CreatedAt: u.CreatedAt(),
+		ID:            u.ID(),
+		Email:         u.Email().String(),
+		Name:          u.Name().String(),
+		CreatedAt:     u.CreatedAt(),
+		Timezone:      u.Timezone().String(),
+		DailyCapacity: int32(u.DailyCapacity().Int()),
 	}
 }
 
@@ -36,6 +42,18 @@ type UserCreatedDTO struct {
 	EventVersion int           `json:"event_version"`
 }
 
+type UserTimezoneChangedDTO struct {
+	ID           domain.UserID `json:"id"`
+	Timezone     string        `json:"timezone"`
+	EventVersion int           `json:"event_version"`
+}
+
+type UserCapacityChangedDTO struct {
+	ID            domain.UserID `json:"id"`
+	DailyCapacity int           `json:"daily_capacity"`
+	EventVersion  int           `json:"event_version"`
+}
+
 type UserDeletedDTO struct {
 	ID           domain.UserID `json:"id"`
 	EventVersion int           `json:"event_version"`
@@ -50,6 +68,18 @@ func (m *UserMapper) MapEvent(e shared.DomainEvent) (shared.EventType, any, erro
 			Name:         evt.Name.String(),
 			EventVersion: 1,
 		}, nil
+	case domain.UserTimezoneChangedEvent:
+		return shared.UserTimezoneChanged, UserTimezoneChangedDTO{
+			ID:           evt.ID,
+			Timezone:     evt.Timezone.String(),
+			EventVersion: 1,
+		}, nil
+	case domain.UserCapacityChangedEvent:
+		return shared.UserCapacityChanged, UserCapacityChangedDTO{
+			ID:            evt.ID,
+			DailyCapacity: evt.DailyCapacity.Int(),
+			EventVersion:  1,
+		}, nil
 	case domain.UserDeletedEvent:
 		return shared.UserDeleted, UserDeletedDTO{
 			ID:           evt.ID,
@@ -43,7 +43,7 @@ func (r *UserRepo) Save(ctx context.Context, u *domain.User) error {
 	dbtx := r.getDB(ctx)
 	p := r.mapper.ToPersistence(u)
 
-	_, err := r.q.CreateUser(ctx, dbtx, db.CreateUserParams(p))
+	_, err := r.q.UpsertUser(ctx, dbtx, db.UpsertUserParams(p))
 	if err != nil {
 		return fmt.Errorf("failed to save user %s: %w", u.ID(), sharedPg.ParseDBError(err))
 	}
@@ -10,6 +10,8 @@ import (
 	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
 )
 
+var ErrStepUpRequired = apperrors.New(apperrors.MFARequired, "MFA, or re-entering the password for users without TOTP, required for this privileged action")
+
 type DeleteWorkspaceCommand struct {
 	ID domain.WorkspaceID
 }
@@ -30,8 +32,8 @@ func (h *DeleteWorkspaceHandler) Handle(ctx context.Context, cmd DeleteWorkspace
 	meta := causation.FromContext(ctx)
 
 	// domain agnostic step-up auth check
-	if !meta.MFAVerified {
-		return DeleteWorkspaceResponse{}, apperrors.New(apperrors.MFARequired, "MFA required for this privileged action")
+	if !meta.SteppedUpWithin(0) {
+		return DeleteWorkspaceResponse{}, ErrStepUpRequired
 	}
 
 	ws, err := h.repo.FindByID(ctx, cmd.ID)
@@ -2,12 +2,18 @@ package application
 
 import (
 	"context"
+	"time"
 
 	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
 	"github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
 	"github.com/danicc097/todo-ddd-example/internal/shared/application"
+	"github.com/danicc097/todo-ddd-example/internal/shared/causation"
 )
 
+// ownerRemovalMaxStepUpAge matches the x-recentStepUp policy of the API, which can't apply
+// since only owners take a step-up to remove.
+const ownerRemovalMaxStepUpAge = 10 * time.Minute
+
 type RemoveWorkspaceMemberCommand struct {
 	WorkspaceID domain.WorkspaceID
 	MemberID    userDomain.UserID
@@ -31,6 +37,11 @@ func (h *RemoveWorkspaceMemberHandler) Handle(ctx context.Context, cmd RemoveWor
 		return RemoveWorkspaceMemberResponse{}, err
 	}
 
+	// losing an owner hands the workspace to whoever is left, so it takes the same step-up as deleting it
+	if ws.IsOwner(cmd.MemberID) && !causation.FromContext(ctx).SteppedUpWithin(ownerRemovalMaxStepUpAge) {
+		return RemoveWorkspaceMemberResponse{}, ErrStepUpRequired
+	}
+
 	if err := ws.RemoveMember(cmd.MemberID); err != nil {
 		return RemoveWorkspaceMemberResponse{}, err
 	}
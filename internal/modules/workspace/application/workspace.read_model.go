@@ -12,6 +12,7 @@ type WorkspaceReadModel struct {
 }
 
 type TagReadModel struct {
-	ID   todoDomain.TagID
-	Name string
+	ID    todoDomain.TagID
+	Name  string
+	Color string
 }
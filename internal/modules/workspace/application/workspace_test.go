@@ -3,6 +3,7 @@ package application_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -79,6 +80,34 @@ func TestWorkspaceUseCases_Integration(t *testing.T) {
 		assert.NotContains(t, found.Members(), member.ID())
 	})
 
+	t.Run("remove owner requiring step-up", func(t *testing.T) {
+		owner := fixtures.RandomUser(ctx, t)
+		coOwner := fixtures.RandomUser(ctx, t)
+
+		ws := fixtures.RandomWorkspace(ctx, t, owner.ID())
+		require.NoError(t, ws.AddMember(coOwner.ID(), wsDomain.RoleOwner))
+		require.NoError(t, repo.Save(ctx, ws))
+
+		handler := sharedApp.WithUoW(application.NewRemoveWorkspaceMemberHandler(repo), uow)
+
+		cmd := application.RemoveWorkspaceMemberCommand{
+			WorkspaceID: ws.ID(),
+			MemberID:    coOwner.ID(),
+		}
+
+		staleMFA := causation.WithMetadata(ctx, causation.Metadata{UserID: owner.ID().UUID(), MFAVerified: true, MFAVerifiedAt: time.Now().Add(-time.Hour)})
+		_, err := handler.Handle(staleMFA, cmd)
+		require.ErrorIs(t, err, application.ErrStepUpRequired)
+
+		reauthenticated := causation.WithMetadata(ctx, causation.Metadata{UserID: owner.ID().UUID(), ReauthenticatedAt: time.Now()})
+		_, err = handler.Handle(reauthenticated, cmd)
+		require.NoError(t, err)
+
+		found, err := repo.FindByID(ctx, ws.ID())
+		require.NoError(t, err)
+		assert.NotContains(t, found.Members(), coOwner.ID())
+	})
+
 	t.Run("delete workspace requiring MFA", func(t *testing.T) {
 		owner := fixtures.RandomUser(ctx, t)
 		ws := fixtures.RandomWorkspace(ctx, t, owner.ID())
@@ -104,4 +133,18 @@ func TestWorkspaceUseCases_Integration(t *testing.T) {
 		_, err = repo.FindByID(ctx, ws.ID())
 		assert.ErrorIs(t, err, wsDomain.ErrWorkspaceNotFound)
 	})
+
+	t.Run("delete workspace after password re-authentication", func(t *testing.T) {
+		owner := fixtures.RandomUser(ctx, t)
+		ws := fixtures.RandomWorkspace(ctx, t, owner.ID())
+
+		handler := sharedApp.WithUoW(application.NewDeleteWorkspaceHandler(repo), uow)
+
+		reauthenticated := causation.WithMetadata(ctx, causation.Metadata{UserID: owner.ID().UUID(), ReauthenticatedAt: time.Now()})
+		_, err := handler.Handle(reauthenticated, application.DeleteWorkspaceCommand{ID: ws.ID()})
+		require.NoError(t, err)
+
+		_, err = repo.FindByID(ctx, ws.ID())
+		assert.ErrorIs(t, err, wsDomain.ErrWorkspaceNotFound)
+	})
 }
@@ -0,0 +1,33 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	auditApp "github.com/danicc097/todo-ddd-example/internal/modules/audit/application"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	wsDomain "github.com/danicc097/todo-ddd-example/internal/modules/workspace/domain"
+)
+
+type AuditWorkspaceProvider struct {
+	Repo wsDomain.WorkspaceRepository
+}
+
+var _ auditApp.WorkspaceProvider = (*AuditWorkspaceProvider)(nil)
+
+func NewAuditWorkspaceProvider(repo wsDomain.WorkspaceRepository) *AuditWorkspaceProvider {
+	return &AuditWorkspaceProvider{Repo: repo}
+}
+
+func (g *AuditWorkspaceProvider) IsOwner(ctx context.Context, wsID wsDomain.WorkspaceID, userID userDomain.UserID) (bool, error) {
+	ws, err := g.Repo.FindByID(ctx, wsID)
+	if err != nil {
+		if errors.Is(err, wsDomain.ErrWorkspaceNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return ws.IsOwner(userID), nil
+}
@@ -33,3 +33,18 @@ func (g *TodoWorkspaceProvider) IsMember(ctx context.Context, wsID wsDomain.Work
 
 	return isMember, nil
 }
+
+func (g *TodoWorkspaceProvider) MemberRole(ctx context.Context, wsID wsDomain.WorkspaceID, userID userDomain.UserID) (wsDomain.WorkspaceRole, bool, error) {
+	ws, err := g.Repo.FindByID(ctx, wsID)
+	if err != nil {
+		if errors.Is(err, wsDomain.ErrWorkspaceNotFound) {
+			return "", false, nil
+		}
+
+		return "", false, err
+	}
+
+	role, isMember := ws.Members()[userID]
+
+	return role, isMember, nil
+}
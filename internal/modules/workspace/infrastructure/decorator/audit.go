@@ -24,7 +24,7 @@ func NewWorkspaceAuditWrapper(
 ) *WorkspaceAuditWrapper {
 	extractor := func(w *wsDomain.Workspace) map[string]any {
 		return map[string]any{
-			"name":       w.Name(),
+			"name":       w.Name().String(),
 			"members":    w.Members(),
 			"created_at": w.CreatedAt(),
 		}
@@ -37,6 +37,10 @@ func NewWorkspaceAuditWrapper(
 		func(id wsDomain.WorkspaceID) uuid.UUID {
 			return id.UUID()
 		},
+		func(w *wsDomain.Workspace) *uuid.UUID {
+			id := w.ID().UUID()
+			return &id
+		},
 	)
 
 	return &WorkspaceAuditWrapper{
@@ -46,7 +50,7 @@ func NewWorkspaceAuditWrapper(
 }
 
 func (w *WorkspaceAuditWrapper) Save(ctx context.Context, entity *wsDomain.Workspace) error {
-	return w.auditor.AuditSave(ctx, entity, auditDomain.OpUpsert, w.base.Save)
+	return w.auditor.AuditSave(ctx, entity, auditDomain.OpUpsert, w.base.FindByID, w.base.Save)
 }
 
 func (w *WorkspaceAuditWrapper) Delete(ctx context.Context, id wsDomain.WorkspaceID) error {
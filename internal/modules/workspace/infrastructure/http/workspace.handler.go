@@ -123,8 +123,9 @@ func (h *WorkspaceHandler) GetWorkspaceTags(c *gin.Context, id domain.WorkspaceI
 	apiTags := make([]api.Tag, len(tags))
 	for i, t := range tags {
 		apiTags[i] = api.Tag{
-			Id:   t.ID,
-			Name: t.Name,
+			Id:    t.ID,
+			Name:  t.Name,
+			Color: t.Color,
 		}
 	}
 
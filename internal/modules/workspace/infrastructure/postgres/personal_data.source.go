@@ -0,0 +1,37 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/danicc097/todo-ddd-example/internal/generated/db"
+	userApp "github.com/danicc097/todo-ddd-example/internal/modules/user/application"
+	userDomain "github.com/danicc097/todo-ddd-example/internal/modules/user/domain"
+	sharedPg "github.com/danicc097/todo-ddd-example/internal/shared/infrastructure/postgres"
+)
+
+type membershipDataSource struct {
+	q    *db.Queries
+	pool *pgxpool.Pool
+}
+
+// NewPersonalDataSource exports the workspaces a user belongs to and their role in each.
+func NewPersonalDataSource(pool *pgxpool.Pool) userApp.PersonalDataSource {
+	return &membershipDataSource{
+		q:    db.New(),
+		pool: pool,
+	}
+}
+
+func (s *membershipDataSource) Section() string { return "memberships" }
+
+func (s *membershipDataSource) Collect(ctx context.Context, userID userDomain.UserID) (any, error) {
+	rows, err := s.q.ListUserMemberships(ctx, s.pool, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list memberships of user %s: %w", userID, sharedPg.ParseDBError(err))
+	}
+
+	return rows, nil
+}
@@ -71,8 +71,9 @@ func (s *workspaceQueryService) ListTagsByWorkspaceID(ctx context.Context, works
 	tags := make([]application.TagReadModel, len(rows))
 	for i, r := range rows {
 		tags[i] = application.TagReadModel{
-			ID:   r.ID,
-			Name: r.Name,
+			ID:    r.ID,
+			Name:  r.Name,
+			Color: r.Color,
 		}
 	}
 
@@ -2,6 +2,7 @@ package causation
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -25,13 +26,17 @@ const (
 
 // Metadata carries traceability info.
 type Metadata struct {
-	CorrelationID   string    // original request ID
-	CausationID     string    // ID of the event that triggered this
-	UserID          uuid.UUID // who
-	UserIP          string    // where
-	UserAgent       string    // how
-	IsSystemRequest bool
-	MFAVerified     bool
+	CorrelationID     string    // original request ID
+	CausationID       string    // ID of the event that triggered this
+	UserID            uuid.UUID // who
+	UserIP            string    // where
+	UserAgent         string    // how
+	IsSystemRequest   bool
+	MFAVerified       bool
+	MFAVerifiedAt     time.Time // when MFA was completed, zero if unknown
+	ReauthenticatedAt time.Time // when a user without TOTP last re-entered their password, zero if never
+	TokenID           string    // jti of the access token, if any
+	TokenExpiresAt    time.Time // when that token stops being accepted anyway
 }
 
 func (m Metadata) IsUser() bool {
@@ -42,6 +47,23 @@ func (m Metadata) IsSystem() bool {
 	return m.IsSystemRequest
 }
 
+// MFAVerifiedWithin reports whether MFA was completed within maxAge. A zero maxAge accepts any MFA session.
+func (m Metadata) MFAVerifiedWithin(maxAge time.Duration) bool {
+	return m.MFAVerified && (maxAge == 0 || time.Since(m.MFAVerifiedAt) <= maxAge)
+}
+
+// ReauthenticatedWithin reports whether a user without TOTP re-entered their password within maxAge.
+// A zero maxAge accepts any re-authentication.
+func (m Metadata) ReauthenticatedWithin(maxAge time.Duration) bool {
+	return !m.ReauthenticatedAt.IsZero() && (maxAge == 0 || time.Since(m.ReauthenticatedAt) <= maxAge)
+}
+
+// SteppedUpWithin reports whether the user proved their identity again within maxAge, for privileged actions
+// that users without TOTP must still be able to perform, such as erasing their data.
+func (m Metadata) SteppedUpWithin(maxAge time.Duration) bool {
+	return m.MFAVerifiedWithin(maxAge) || m.ReauthenticatedWithin(maxAge)
+}
+
 func FromContext(ctx context.Context) Metadata {
 	if md, ok := ctx.Value(metadataKey).(Metadata); ok {
 		return md
@@ -3,11 +3,13 @@ package domain
 type AggregateType string
 
 const (
-	AggWorkspace AggregateType = "WORKSPACE"
-	AggTodo      AggregateType = "TODO"
-	AggUser      AggregateType = "USER"
-	AggTag       AggregateType = "TAG"
-	AggSchedule  AggregateType = "SCHEDULE"
+	AggWorkspace  AggregateType = "WORKSPACE"
+	AggTodo       AggregateType = "TODO"
+	AggUser       AggregateType = "USER"
+	AggTag        AggregateType = "TAG"
+	AggSchedule   AggregateType = "SCHEDULE"
+	AggComment    AggregateType = "COMMENT"
+	AggDataExport AggregateType = "DATA_EXPORT"
 )
 
 func (a AggregateType) String() string {
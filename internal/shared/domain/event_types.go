@@ -3,18 +3,53 @@ package domain
 type EventType string
 
 const (
-	TodoCreated            EventType = "todo.created"
-	TodoCompleted          EventType = "todo.completed"
-	TodoTagAdded           EventType = "todo.tag_added"
-	TodoTagCreated         EventType = "todo.tag_created"
-	WorkspaceCreated       EventType = "workspace.created"
-	WorkspaceDeleted       EventType = "workspace.deleted"
-	WorkspaceMemberAdded   EventType = "workspace.member_added"
-	WorkspaceMemberRemoved EventType = "workspace.member_removed"
-	UserCreated            EventType = "user.created"
-	UserDeleted            EventType = "user.deleted"
-	TodoRolledOver         EventType = "todo.rolled_over"
-	TodoDeleted            EventType = "todo.deleted"
-	ScheduleCreated        EventType = "schedule.created"
-	TaskCommitted          EventType = "schedule.task_committed"
+	TodoCreated              EventType = "todo.created"
+	TodoCompleted            EventType = "todo.completed"
+	TodoTagAdded             EventType = "todo.tag_added"
+	TodoTagCreated           EventType = "todo.tag_created"
+	WorkspaceCreated         EventType = "workspace.created"
+	WorkspaceDeleted         EventType = "workspace.deleted"
+	WorkspaceMemberAdded     EventType = "workspace.member_added"
+	WorkspaceMemberRemoved   EventType = "workspace.member_removed"
+	UserCreated              EventType = "user.created"
+	UserDeleted              EventType = "user.deleted"
+	TodoRolledOver           EventType = "todo.rolled_over"
+	TodoDeleted              EventType = "todo.deleted"
+	ScheduleCreated          EventType = "schedule.created"
+	TaskCommitted            EventType = "schedule.task_committed"
+	TodoRenamed              EventType = "todo.renamed"
+	TodoArchived             EventType = "todo.archived"
+	TodoReopened             EventType = "todo.reopened"
+	TodoDueDateChanged       EventType = "todo.due_date_changed"
+	TodoRecurrenceChanged    EventType = "todo.recurrence_changed"
+	UserTimezoneChanged      EventType = "user.timezone_changed"
+	TodoChecklistItemAdded   EventType = "todo.checklist_item_added"
+	TodoChecklistItemToggled EventType = "todo.checklist_item_toggled"
+	TodoChecklistReordered   EventType = "todo.checklist_reordered"
+	TodoChecklistItemRemoved EventType = "todo.checklist_item_removed"
+	TodoBlocked              EventType = "todo.blocked"
+	TodoBlockerRemoved       EventType = "todo.blocker_removed"
+	TodoUnblocked            EventType = "todo.unblocked"
+	TodoAssigned             EventType = "todo.assigned"
+	TodoUnassigned           EventType = "todo.unassigned"
+	TodoCommentAdded         EventType = "todo.comment_added"
+	TodoCommentEdited        EventType = "todo.comment_edited"
+	TodoCommentDeleted       EventType = "todo.comment_deleted"
+	TodoTagRemoved           EventType = "todo.tag_removed"
+	TodoTagRenamed           EventType = "todo.tag_renamed"
+	TodoTagRecolored         EventType = "todo.tag_recolored"
+	TodoTagDeleted           EventType = "todo.tag_deleted"
+	UserCapacityChanged      EventType = "user.daily_capacity_changed"
+	ScheduleCapacityChanged  EventType = "schedule.capacity_changed"
+	TaskRemoved              EventType = "schedule.task_removed"
+	TaskCarriedOver          EventType = "schedule.task_carried_over"
+	TaskCarryOverSkipped     EventType = "schedule.task_carry_over_skipped"
+	TaskAutoPlanned          EventType = "schedule.task_auto_planned"
+	TaskCompleted            EventType = "schedule.task_completed"
+	TodoFocusElapsed         EventType = "todo.focus_elapsed"
+	UserDataExportRequested  EventType = "user.data_export_requested"
+	AuthTOTPActivated        EventType = "auth.totp_activated"
+	AuthTOTPDisabled         EventType = "auth.totp_disabled"
+	AuthTOTPReset            EventType = "auth.totp_reset"
+	AuthRecoveryCodeUsed     EventType = "auth.recovery_code_used"
 )
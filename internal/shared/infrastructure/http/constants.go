@@ -14,6 +14,7 @@ const (
 	RouteDocs        = APIV1Prefix + "/docs"
 	RouteOpenAPISpec = "/openapi.yaml"
 	RouteFavicon     = "/favicon.ico"
+	RouteJWKS        = "/.well-known/jwks.json"
 )
 
 var SensitiveFields = map[string]struct{}{
@@ -71,7 +71,7 @@ func (f *Fixtures) RandomTag(ctx context.Context, t *testing.T, wsID wsDomain.Wo
 	name, err := domain.NewTagName("Tag-" + uid)
 	require.NoError(t, err)
 
-	tag := domain.NewTag(name, wsID)
+	tag := domain.NewTag(name, domain.DefaultTagColor, wsID)
 	require.NoError(t, f.TagRepo.Save(ctx, tag))
 
 	return tag
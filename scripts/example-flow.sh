@@ -56,7 +56,8 @@ role="MEMBER"
 echo "Bob added as $role"
 
 echo -e "\n=== ATTEMPT TO DELETE WORKSPACE (WITHOUT MFA) ==="
-if ./todo-cli delete-workspace "$WS_ID" -d; then
+# stdin is not a terminal, so the cli fails instead of prompting for a code
+if ./todo-cli delete-workspace "$WS_ID" -d </dev/null; then
 	echo "Error: Deleting workspace without MFA should have failed!"
 	exit 1
 fi
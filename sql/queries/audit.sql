@@ -0,0 +1,109 @@
+-- name: InsertAuditLog :exec
+INSERT INTO audit_logs(id, workspace_id, correlation_id, causation_id, actor_id, actor_ip, user_agent_hash, aggregate_type, aggregate_id, operation, changes, occurred_at, prev_hash, hash, pii_salt, pii_digest)
+  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16);
+
+-- Serializes appends to a chain until the transaction ends. The key matches audit_logs.chain_key:
+-- the workspace, or the aggregate for unscoped logs, so unrelated unscoped writes don't wait on each other.
+-- name: LockAuditChain :exec
+SELECT
+  pg_advisory_xact_lock(hashtextextended('audit_logs:' || coalesce(sqlc.narg(workspace_id)::uuid::text, sqlc.arg(aggregate_type)::text || ':' || sqlc.arg(aggregate_id)::uuid::text), 0));
+
+-- name: GetAuditChainHead :one
+SELECT
+  a.hash
+FROM
+  audit_logs a
+WHERE
+  a.chain_key = coalesce(sqlc.narg(workspace_id)::uuid::text, sqlc.arg(aggregate_type)::text || ':' || sqlc.arg(aggregate_id)::uuid::text)
+ORDER BY
+  a.seq DESC
+LIMIT 1;
+
+-- name: ListAuditChain :many
+SELECT
+  *
+FROM
+  audit_logs a
+WHERE
+  a.chain_key = coalesce(sqlc.narg(workspace_id)::uuid::text, sqlc.arg(aggregate_type)::text || ':' || sqlc.arg(aggregate_id)::uuid::text)
+ORDER BY
+  a.seq ASC
+LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
+
+-- name: ListWorkspaceAuditLogs :many
+SELECT
+  *
+FROM
+  audit_logs a
+WHERE
+  a.workspace_id = sqlc.arg(workspace_id)::uuid
+  AND (sqlc.narg(aggregate_type)::text IS NULL
+    OR a.aggregate_type = sqlc.narg(aggregate_type)::text)
+  AND (sqlc.narg(aggregate_id)::uuid IS NULL
+    OR a.aggregate_id = sqlc.narg(aggregate_id)::uuid)
+  AND (sqlc.narg(actor_id)::uuid IS NULL
+    OR a.actor_id = sqlc.narg(actor_id)::uuid)
+  AND (sqlc.narg(operation)::text IS NULL
+    OR a.operation = sqlc.narg(operation)::text)
+  AND (sqlc.narg(from_time)::timestamptz IS NULL
+    OR a.occurred_at >= sqlc.narg(from_time)::timestamptz)
+  AND (sqlc.narg(to_time)::timestamptz IS NULL
+    OR a.occurred_at < sqlc.narg(to_time)::timestamptz)
+ORDER BY
+  a.occurred_at DESC,
+  a.id DESC
+LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
+
+-- Keyset pages over the chains of logs outside any workspace, which are kept per aggregate.
+-- name: ListUnscopedAuditChains :many
+SELECT DISTINCT
+  a.aggregate_type,
+  a.aggregate_id
+FROM
+  audit_logs a
+WHERE
+  a.workspace_id IS NULL
+  AND (a.aggregate_type, a.aggregate_id) > (sqlc.arg(after_type)::text, sqlc.arg(after_id)::uuid)
+ORDER BY
+  a.aggregate_type,
+  a.aggregate_id
+LIMIT sqlc.arg(lim);
+
+-- Any entry that references the user as actor, aggregate or within its changes, locked for erasure.
+-- name: ListAuditLogsReferencingUserForUpdate :many
+SELECT
+  *
+FROM
+  audit_logs a
+WHERE
+  a.actor_id = sqlc.arg(user_id)::uuid
+  OR a.aggregate_id = sqlc.arg(user_id)::uuid
+  OR a.changes::text LIKE '%' || sqlc.arg(user_id)::uuid::text || '%'
+ORDER BY
+  a.seq ASC
+FOR UPDATE;
+
+-- name: UpdateErasedAuditLog :exec
+UPDATE
+  audit_logs
+SET
+  actor_id = sqlc.narg(actor_id)::uuid,
+  actor_ip = sqlc.arg(actor_ip)::text,
+  aggregate_id = sqlc.arg(aggregate_id)::uuid,
+  changes = sqlc.arg(changes)::jsonb,
+  pii_salt = NULL
+WHERE
+  id = sqlc.arg(id)::uuid;
+
+-- name: ListUserAuditLogs :many
+SELECT
+  *
+FROM
+  audit_logs a
+WHERE
+  a.actor_id = sqlc.arg(user_id)::uuid
+  OR (a.aggregate_type = 'USER'
+    AND a.aggregate_id = sqlc.arg(user_id)::uuid)
+ORDER BY
+  a.occurred_at ASC,
+  a.id ASC;
@@ -6,13 +6,57 @@ FROM
 WHERE
   user_id = $1;
 
+-- name: GetUserAuthForUpdate :one
+SELECT
+  *
+FROM
+  user_auth
+WHERE
+  user_id = $1
+FOR UPDATE;
+
 -- name: UpsertUserAuth :exec
-INSERT INTO user_auth(user_id, totp_status, totp_secret_cipher, totp_secret_nonce, password_hash)
-  VALUES ($1, $2, $3, $4, $5)
+INSERT INTO user_auth(user_id, totp_status, totp_secret_cipher, totp_secret_nonce, totp_recovery_codes, password_hash)
+  VALUES ($1, $2, $3, $4, $5, $6)
 ON CONFLICT (user_id)
   DO UPDATE SET
     totp_status = EXCLUDED.totp_status,
     totp_secret_cipher = EXCLUDED.totp_secret_cipher,
     totp_secret_nonce = EXCLUDED.totp_secret_nonce,
+    totp_recovery_codes = EXCLUDED.totp_recovery_codes,
     password_hash = EXCLUDED.password_hash;
 
+-- name: UpsertRefreshToken :exec
+INSERT INTO refresh_tokens(id, family_id, user_id, token_hash, created_at, expires_at, rotated_at, revoked_at, mfa_verified_at)
+  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (id)
+  DO UPDATE SET
+    rotated_at = EXCLUDED.rotated_at,
+    revoked_at = EXCLUDED.revoked_at;
+
+-- name: GetRefreshTokenByHashForUpdate :one
+SELECT
+  *
+FROM
+  refresh_tokens
+WHERE
+  token_hash = $1
+FOR UPDATE;
+
+-- name: RevokeRefreshTokenFamily :exec
+UPDATE
+  refresh_tokens
+SET
+  revoked_at = sqlc.arg(revoked_at)
+WHERE
+  family_id = sqlc.arg(family_id)
+  AND revoked_at IS NULL;
+
+-- name: RevokeUserRefreshTokens :exec
+UPDATE
+  refresh_tokens
+SET
+  revoked_at = sqlc.arg(revoked_at)
+WHERE
+  user_id = sqlc.arg(user_id)
+  AND revoked_at IS NULL;
@@ -0,0 +1,60 @@
+-- name: UpsertTodoComment :exec
+INSERT INTO todo_comments(id, todo_id, author_id, body, mentions, created_at, edited_at, deleted_at)
+  VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (id)
+  DO UPDATE SET
+    body = EXCLUDED.body,
+    mentions = EXCLUDED.mentions,
+    edited_at = EXCLUDED.edited_at,
+    deleted_at = EXCLUDED.deleted_at;
+
+-- name: GetTodoCommentByID :one
+SELECT
+  c.id,
+  c.todo_id,
+  c.author_id,
+  c.body,
+  c.created_at,
+  c.edited_at,
+  t.workspace_id
+FROM
+  todo_comments c
+  JOIN todos t ON t.id = c.todo_id
+WHERE
+  c.id = $1
+  AND c.deleted_at IS NULL
+  AND t.deleted_at IS NULL;
+
+-- name: ListTodoComments :many
+SELECT
+  c.id,
+  c.todo_id,
+  c.author_id,
+  c.body,
+  c.mentions,
+  c.created_at,
+  c.edited_at
+FROM
+  todo_comments c
+WHERE
+  c.todo_id = $1
+  AND c.deleted_at IS NULL
+ORDER BY
+  c.created_at ASC,
+  c.id ASC
+LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
+
+-- name: ListUserComments :many
+SELECT
+  c.id,
+  c.todo_id,
+  c.body,
+  c.created_at,
+  c.edited_at,
+  c.deleted_at
+FROM
+  todo_comments c
+WHERE
+  c.author_id = $1
+ORDER BY
+  c.created_at;
@@ -0,0 +1,151 @@
+-- Sessions count towards the day they started on. Open sessions are excluded.
+-- name: GetFocusTotals :one
+SELECT
+  COALESCE(SUM(EXTRACT(EPOCH FROM fs.end_time - fs.start_time)), 0)::bigint AS total_seconds,
+  COUNT(*) AS session_count
+FROM
+  todo_focus_sessions fs
+  JOIN todos t ON t.id = fs.todo_id
+WHERE
+  t.workspace_id = sqlc.arg(workspace_id)
+  AND t.deleted_at IS NULL
+  AND fs.end_time IS NOT NULL
+  AND fs.start_time >= sqlc.arg(from_time)
+  AND fs.start_time < sqlc.arg(to_time)
+  AND (sqlc.narg(user_id)::uuid IS NULL
+    OR fs.user_id = sqlc.narg(user_id));
+
+-- name: GetFocusByTodo :many
+SELECT
+  t.id::text AS key,
+  t.title AS label,
+  SUM(EXTRACT(EPOCH FROM fs.end_time - fs.start_time))::bigint AS total_seconds,
+  COUNT(*) AS session_count
+FROM
+  todo_focus_sessions fs
+  JOIN todos t ON t.id = fs.todo_id
+WHERE
+  t.workspace_id = sqlc.arg(workspace_id)
+  AND t.deleted_at IS NULL
+  AND fs.end_time IS NOT NULL
+  AND fs.start_time >= sqlc.arg(from_time)
+  AND fs.start_time < sqlc.arg(to_time)
+  AND (sqlc.narg(user_id)::uuid IS NULL
+    OR fs.user_id = sqlc.narg(user_id))
+GROUP BY
+  t.id,
+  t.title
+ORDER BY
+  total_seconds DESC,
+  key;
+
+-- A session on a todo with several tags counts towards each of them.
+-- name: GetFocusByTag :many
+SELECT
+  tg.id::text AS key,
+  tg.name AS label,
+  SUM(EXTRACT(EPOCH FROM fs.end_time - fs.start_time))::bigint AS total_seconds,
+  COUNT(*) AS session_count
+FROM
+  todo_focus_sessions fs
+  JOIN todos t ON t.id = fs.todo_id
+  JOIN todo_tags tt ON tt.todo_id = t.id
+  JOIN tags tg ON tg.id = tt.tag_id
+WHERE
+  t.workspace_id = sqlc.arg(workspace_id)
+  AND t.deleted_at IS NULL
+  AND fs.end_time IS NOT NULL
+  AND fs.start_time >= sqlc.arg(from_time)
+  AND fs.start_time < sqlc.arg(to_time)
+  AND (sqlc.narg(user_id)::uuid IS NULL
+    OR fs.user_id = sqlc.narg(user_id))
+GROUP BY
+  tg.id,
+  tg.name
+ORDER BY
+  total_seconds DESC,
+  key;
+
+-- name: GetFocusByMember :many
+SELECT
+  u.id::text AS key,
+  u.name AS label,
+  SUM(EXTRACT(EPOCH FROM fs.end_time - fs.start_time))::bigint AS total_seconds,
+  COUNT(*) AS session_count
+FROM
+  todo_focus_sessions fs
+  JOIN todos t ON t.id = fs.todo_id
+  JOIN users u ON u.id = fs.user_id
+WHERE
+  t.workspace_id = sqlc.arg(workspace_id)
+  AND t.deleted_at IS NULL
+  AND fs.end_time IS NOT NULL
+  AND fs.start_time >= sqlc.arg(from_time)
+  AND fs.start_time < sqlc.arg(to_time)
+  AND (sqlc.narg(user_id)::uuid IS NULL
+    OR fs.user_id = sqlc.narg(user_id))
+GROUP BY
+  u.id,
+  u.name
+ORDER BY
+  total_seconds DESC,
+  key;
+
+-- name: GetFocusByDay :many
+SELECT
+  to_char((fs.start_time AT TIME ZONE sqlc.arg(tz)::text)::date, 'YYYY-MM-DD') AS key,
+  SUM(EXTRACT(EPOCH FROM fs.end_time - fs.start_time))::bigint AS total_seconds,
+  COUNT(*) AS session_count
+FROM
+  todo_focus_sessions fs
+  JOIN todos t ON t.id = fs.todo_id
+WHERE
+  t.workspace_id = sqlc.arg(workspace_id)
+  AND t.deleted_at IS NULL
+  AND fs.end_time IS NOT NULL
+  AND fs.start_time >= sqlc.arg(from_time)
+  AND fs.start_time < sqlc.arg(to_time)
+  AND (sqlc.narg(user_id)::uuid IS NULL
+    OR fs.user_id = sqlc.narg(user_id))
+GROUP BY
+  key
+ORDER BY
+  key;
+
+-- Streaks are runs of consecutive days with focus. The current one must reach the
+-- last day or the day before, so a day that hasn't been worked yet doesn't break it.
+-- name: GetFocusStreaks :one
+WITH days AS (
+  SELECT DISTINCT
+    (fs.start_time AT TIME ZONE sqlc.arg(tz)::text)::date AS day
+  FROM
+    todo_focus_sessions fs
+    JOIN todos t ON t.id = fs.todo_id
+  WHERE
+    t.workspace_id = sqlc.arg(workspace_id)
+    AND t.deleted_at IS NULL
+    AND fs.end_time IS NOT NULL
+    AND fs.start_time >= sqlc.arg(from_time)
+    AND fs.start_time < sqlc.arg(to_time)
+    AND (sqlc.narg(user_id)::uuid IS NULL
+      OR fs.user_id = sqlc.narg(user_id))
+),
+runs AS (
+  SELECT
+    MAX(day) AS last_day,
+    COUNT(*) AS length
+  FROM (
+    SELECT
+      day,
+      day - (ROW_NUMBER() OVER (ORDER BY day))::int AS island
+    FROM
+      days) d
+  GROUP BY
+    island
+)
+SELECT
+  COALESCE(MAX(length), 0)::int AS longest_streak,
+  -- to_time is the midnight after the last day
+  COALESCE(MAX(length) FILTER (WHERE last_day >= (sqlc.arg(to_time)::timestamptz AT TIME ZONE sqlc.arg(tz)::text)::date - 2), 0)::int AS current_streak
+FROM
+  runs;
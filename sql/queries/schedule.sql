@@ -8,11 +8,12 @@ WHERE
   AND date = $2;
 
 -- name: UpsertDailySchedule :one
-INSERT INTO daily_schedules(user_id, date, max_capacity, version)
-  VALUES ($1, $2, $3, 1)
+INSERT INTO daily_schedules(user_id, date, max_capacity, version, rolled_over_at)
+  VALUES ($1, $2, $3, 1, $4)
 ON CONFLICT (user_id, date)
   DO UPDATE SET
     max_capacity = EXCLUDED.max_capacity,
+    rolled_over_at = EXCLUDED.rolled_over_at,
     version = daily_schedules.version + 1
   WHERE
     daily_schedules.version = sqlc.arg(current_version)
@@ -54,5 +55,61 @@ SELECT
 FROM
   schedule_tasks
 WHERE
-  todo_id = $1;
+  todo_id = $1
+ORDER BY
+  date;
 
+-- name: MarkScheduleTasksCompleted :exec
+UPDATE
+  schedule_tasks st
+SET
+  completed_at = c.completed_at
+FROM (
+  SELECT
+    UNNEST(sqlc.arg(todo_ids)::uuid[]) AS todo_id,
+    UNNEST(sqlc.arg(completed_ats)::timestamptz[]) AS completed_at) c
+WHERE
+  st.user_id = sqlc.arg(user_id)
+  AND st.date = sqlc.arg(date)
+  AND st.todo_id = c.todo_id
+  AND st.completed_at IS NULL;
+
+-- Pages by (date, user_id) so that schedules skipped or failed in a run don't starve later ones.
+-- name: ListSchedulesPendingRollover :many
+SELECT
+  ds.user_id,
+  ds.date
+FROM
+  daily_schedules ds
+WHERE
+  ds.rolled_over_at IS NULL
+  AND ds.date < sqlc.arg(before)
+  AND EXISTS (
+    SELECT
+      1
+    FROM
+      schedule_tasks st
+    WHERE
+      st.user_id = ds.user_id
+      AND st.date = ds.date)
+  AND (sqlc.narg(after_user_id)::uuid IS NULL
+    OR ds.date > sqlc.narg(after_date)
+    OR (ds.date = sqlc.narg(after_date)
+      AND ds.user_id > sqlc.narg(after_user_id)::uuid))
+ORDER BY
+  ds.date,
+  ds.user_id
+LIMIT sqlc.arg(lim);
+
+-- name: GetLatestTaskCosts :many
+SELECT DISTINCT ON (todo_id)
+  todo_id,
+  energy_cost
+FROM
+  schedule_tasks
+WHERE
+  user_id = sqlc.arg(user_id)
+  AND todo_id = ANY (sqlc.arg(todo_ids)::uuid[])
+ORDER BY
+  todo_id,
+  date DESC;
@@ -1,8 +1,10 @@
--- name: CreateTag :one
-INSERT INTO tags(id, name, workspace_id)
-  VALUES ($1, $2, $3)
-RETURNING
-  *;
+-- name: UpsertTag :exec
+INSERT INTO tags(id, name, workspace_id, color)
+  VALUES ($1, $2, $3, $4)
+ON CONFLICT (id)
+  DO UPDATE SET
+    name = EXCLUDED.name,
+    color = EXCLUDED.color;
 
 -- name: GetTagByID :one
 SELECT
@@ -1,6 +1,6 @@
 -- name: UpsertTodo :one
-INSERT INTO todos(id, title, status, created_at, updated_at, workspace_id, due_date, recurrence_interval, recurrence_amount, last_completed_at, deleted_at)
-  VALUES ($1, $2, $3, $4, $4, $5, $6, $7, $8, $9, NULL)
+INSERT INTO todos(id, title, status, created_at, updated_at, workspace_id, due_date, recurrence_interval, recurrence_amount, last_completed_at, deleted_at, recurrence_rule, recurrence_occurrences, assignee_id)
+  VALUES ($1, $2, $3, $4, $4, $5, $6, $7, $8, $9, NULL, $10, $11, $12)
 ON CONFLICT (id)
   DO UPDATE SET
     title = EXCLUDED.title,
@@ -10,6 +10,9 @@ ON CONFLICT (id)
     recurrence_interval = EXCLUDED.recurrence_interval,
     recurrence_amount = EXCLUDED.recurrence_amount,
     last_completed_at = EXCLUDED.last_completed_at,
+    recurrence_rule = EXCLUDED.recurrence_rule,
+    recurrence_occurrences = EXCLUDED.recurrence_occurrences,
+    assignee_id = EXCLUDED.assignee_id,
     deleted_at = NULL
   RETURNING
     *;
@@ -23,7 +26,19 @@ SELECT
       json_agg(fs.*)
     FROM todo_focus_sessions fs
     WHERE
-      fs.todo_id = t.id), '[]'::json) AS focus_sessions
+      fs.todo_id = t.id), '[]'::json) AS focus_sessions,
+  COALESCE((
+    SELECT
+      json_agg(ci.* ORDER BY ci.position)
+    FROM todo_checklist_items ci
+    WHERE
+      ci.todo_id = t.id), '[]'::json) AS checklist_items,
+  COALESCE((
+    SELECT
+      array_agg(td.blocked_by_id ORDER BY td.created_at, td.blocked_by_id)
+    FROM todo_dependencies td
+    WHERE
+      td.todo_id = t.id), '{}')::uuid[] AS blocked_by
 FROM
   todos t
   LEFT JOIN todo_tags tt ON t.id = tt.todo_id
@@ -42,7 +57,19 @@ SELECT
       json_agg(fs.*)
     FROM todo_focus_sessions fs
     WHERE
-      fs.todo_id = t.id), '[]'::json) AS focus_sessions
+      fs.todo_id = t.id), '[]'::json) AS focus_sessions,
+  COALESCE((
+    SELECT
+      json_agg(ci.* ORDER BY ci.position)
+    FROM todo_checklist_items ci
+    WHERE
+      ci.todo_id = t.id), '[]'::json) AS checklist_items,
+  COALESCE((
+    SELECT
+      array_agg(td.blocked_by_id ORDER BY td.created_at, td.blocked_by_id)
+    FROM todo_dependencies td
+    WHERE
+      td.todo_id = t.id), '{}')::uuid[] AS blocked_by
 FROM
   todos t
   LEFT JOIN todo_tags tt ON t.id = tt.todo_id
@@ -53,6 +80,9 @@ GROUP BY
   t.id;
 
 -- name: ListTodosByWorkspaceID :many
+-- Keyset pagination: the cursor holds the sort value of the last row (cursor_time for
+-- timestamp keys, cursor_text for title) plus its id as tiebreaker. A missing due date
+-- sorts as infinity on both sides so the key is never NULL.
 SELECT
   t.*,
   COALESCE(array_remove(array_agg(DISTINCT tt.tag_id), NULL), '{}')::uuid[] AS tags,
@@ -61,18 +91,100 @@ SELECT
       json_agg(fs.*)
     FROM todo_focus_sessions fs
     WHERE
-      fs.todo_id = t.id), '[]'::json) AS focus_sessions
+      fs.todo_id = t.id), '[]'::json) AS focus_sessions,
+  COALESCE((
+    SELECT
+      json_agg(ci.* ORDER BY ci.position)
+    FROM todo_checklist_items ci
+    WHERE
+      ci.todo_id = t.id), '[]'::json) AS checklist_items,
+  COALESCE((
+    SELECT
+      array_agg(td.blocked_by_id ORDER BY td.created_at, td.blocked_by_id)
+    FROM todo_dependencies td
+    WHERE
+      td.todo_id = t.id), '{}')::uuid[] AS blocked_by
 FROM
   todos t
   LEFT JOIN todo_tags tt ON t.id = tt.todo_id
 WHERE
-  t.workspace_id = $1
+  t.workspace_id = sqlc.arg(workspace_id)
   AND t.deleted_at IS NULL
+  AND (sqlc.narg(statuses)::text[] IS NULL
+    OR t.status = ANY (sqlc.narg(statuses)::text[]))
+  AND (sqlc.narg(tag_ids)::uuid[] IS NULL
+    OR EXISTS (
+      SELECT
+        1
+      FROM
+        todo_tags ft
+      WHERE
+        ft.todo_id = t.id
+        AND ft.tag_id = ANY (sqlc.narg(tag_ids)::uuid[])))
+  AND (sqlc.narg(due_before)::timestamptz IS NULL
+    OR t.due_date < sqlc.narg(due_before)::timestamptz)
+  AND (sqlc.narg(due_after)::timestamptz IS NULL
+    OR t.due_date >= sqlc.narg(due_after)::timestamptz)
+  AND (sqlc.narg(overdue)::boolean IS NULL
+    OR (t.status = 'PENDING'
+      AND t.due_date IS NOT NULL
+      AND t.due_date < NOW()) = sqlc.narg(overdue)::boolean)
+  AND (sqlc.narg(recurring)::boolean IS NULL
+    OR (t.recurrence_rule IS NOT NULL
+      OR t.recurrence_interval IS NOT NULL) = sqlc.narg(recurring)::boolean)
+  AND (sqlc.narg(cursor_id)::uuid IS NULL
+    OR (sqlc.arg(sort_key)::text = 'created_at'
+      AND sqlc.arg(sort_desc)::boolean
+      AND (t.created_at, t.id) < (sqlc.narg(cursor_time)::timestamptz, sqlc.narg(cursor_id)::uuid))
+    OR (sqlc.arg(sort_key)::text = 'created_at'
+      AND NOT sqlc.arg(sort_desc)::boolean
+      AND (t.created_at, t.id) > (sqlc.narg(cursor_time)::timestamptz, sqlc.narg(cursor_id)::uuid))
+    OR (sqlc.arg(sort_key)::text = 'due_date'
+      AND sqlc.arg(sort_desc)::boolean
+      AND (COALESCE(t.due_date, 'infinity'), t.id) < (COALESCE(sqlc.narg(cursor_time)::timestamptz, 'infinity'), sqlc.narg(cursor_id)::uuid))
+    OR (sqlc.arg(sort_key)::text = 'due_date'
+      AND NOT sqlc.arg(sort_desc)::boolean
+      AND (COALESCE(t.due_date, 'infinity'), t.id) > (COALESCE(sqlc.narg(cursor_time)::timestamptz, 'infinity'), sqlc.narg(cursor_id)::uuid))
+    OR (sqlc.arg(sort_key)::text = 'title'
+      AND sqlc.arg(sort_desc)::boolean
+      AND (t.title, t.id) < (sqlc.narg(cursor_text)::text, sqlc.narg(cursor_id)::uuid))
+    OR (sqlc.arg(sort_key)::text = 'title'
+      AND NOT sqlc.arg(sort_desc)::boolean
+      AND (t.title, t.id) > (sqlc.narg(cursor_text)::text, sqlc.narg(cursor_id)::uuid)))
 GROUP BY
   t.id
 ORDER BY
-  t.created_at DESC
-LIMIT $2 OFFSET $3;
+  CASE WHEN sqlc.arg(sort_key)::text = 'created_at'
+    AND NOT sqlc.arg(sort_desc)::boolean THEN
+    t.created_at
+  END ASC,
+  CASE WHEN sqlc.arg(sort_key)::text = 'created_at'
+    AND sqlc.arg(sort_desc)::boolean THEN
+    t.created_at
+  END DESC,
+  CASE WHEN sqlc.arg(sort_key)::text = 'due_date'
+    AND NOT sqlc.arg(sort_desc)::boolean THEN
+    COALESCE(t.due_date, 'infinity')
+  END ASC,
+  CASE WHEN sqlc.arg(sort_key)::text = 'due_date'
+    AND sqlc.arg(sort_desc)::boolean THEN
+    COALESCE(t.due_date, 'infinity')
+  END DESC,
+  CASE WHEN sqlc.arg(sort_key)::text = 'title'
+    AND NOT sqlc.arg(sort_desc)::boolean THEN
+    t.title
+  END ASC,
+  CASE WHEN sqlc.arg(sort_key)::text = 'title'
+    AND sqlc.arg(sort_desc)::boolean THEN
+    t.title
+  END DESC,
+  CASE WHEN NOT sqlc.arg(sort_desc)::boolean THEN
+    t.id
+  END ASC,
+  CASE WHEN sqlc.arg(sort_desc)::boolean THEN
+    t.id
+  END DESC
+LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
 
 -- name: BulkAddTagsToTodo :exec
 INSERT INTO todo_tags(todo_id, tag_id)
@@ -103,13 +215,14 @@ ON CONFLICT (id)
     end_time = EXCLUDED.end_time;
 
 -- name: BulkUpsertFocusSessions :exec
-INSERT INTO todo_focus_sessions(id, todo_id, user_id, start_time, end_time)
+INSERT INTO todo_focus_sessions(id, todo_id, user_id, start_time, end_time, planned_duration_seconds)
 SELECT
   UNNEST(sqlc.arg(ids)::uuid[]),
   UNNEST(sqlc.arg(todo_ids)::uuid[]),
   UNNEST(sqlc.arg(user_ids)::uuid[]),
   UNNEST(sqlc.arg(start_times)::timestamptz[]),
-  NULLIF(UNNEST(sqlc.arg(end_times)::timestamptz[]), '0001-01-01 00:00:00+00'::timestamptz)
+  NULLIF(UNNEST(sqlc.arg(end_times)::timestamptz[]), '0001-01-01 00:00:00+00'::timestamptz),
+  NULLIF(UNNEST(sqlc.arg(planned_durations_seconds)::integer[]), 0)
 ON CONFLICT (id)
   DO UPDATE SET
     end_time = EXCLUDED.end_time;
@@ -119,3 +232,272 @@ DELETE FROM todo_focus_sessions
 WHERE todo_id = $1
   AND NOT (id = ANY (sqlc.arg(session_ids)::uuid[]));
 
+-- name: HasActiveFocusSession :one
+SELECT
+  EXISTS (
+    SELECT
+      1
+    FROM
+      todo_focus_sessions
+    WHERE
+      user_id = sqlc.arg(user_id)::uuid
+      AND end_time IS NULL);
+
+-- A session elapses at its planned end or after max_seconds, whichever comes first.
+-- name: ListElapsedFocusTodoIDs :many
+SELECT
+  fs.todo_id
+FROM
+  todo_focus_sessions fs
+WHERE
+  fs.end_time IS NULL
+  AND fs.start_time + make_interval(secs => LEAST(COALESCE(fs.planned_duration_seconds, sqlc.arg(max_seconds)::integer), sqlc.arg(max_seconds)::integer)) <= sqlc.arg(now)::timestamptz
+ORDER BY
+  fs.start_time
+LIMIT sqlc.arg(lim)::integer;
+
+-- name: BulkUpsertChecklistItems :exec
+INSERT INTO todo_checklist_items(id, todo_id, title, done, required, position)
+SELECT
+  UNNEST(sqlc.arg(ids)::uuid[]),
+  UNNEST(sqlc.arg(todo_ids)::uuid[]),
+  UNNEST(sqlc.arg(titles)::text[]),
+  UNNEST(sqlc.arg(dones)::boolean[]),
+  UNNEST(sqlc.arg(requireds)::boolean[]),
+  UNNEST(sqlc.arg(positions)::integer[])
+ON CONFLICT (id)
+  DO UPDATE SET
+    title = EXCLUDED.title,
+    done = EXCLUDED.done,
+    required = EXCLUDED.required,
+    position = EXCLUDED.position;
+
+-- name: RemoveMissingChecklistItemsFromTodo :exec
+DELETE FROM todo_checklist_items
+WHERE todo_id = $1
+  AND NOT (id = ANY (sqlc.arg(item_ids)::uuid[]));
+
+-- name: BulkAddTodoDependencies :exec
+INSERT INTO todo_dependencies(todo_id, blocked_by_id)
+SELECT
+  UNNEST(sqlc.arg(todo_ids)::uuid[]),
+  UNNEST(sqlc.arg(blocked_by_ids)::uuid[])
+ON CONFLICT
+  DO NOTHING;
+
+-- name: RemoveMissingTodoDependencies :exec
+DELETE FROM todo_dependencies
+WHERE todo_id = $1
+  AND NOT (blocked_by_id = ANY (sqlc.arg(blocked_by_ids)::uuid[]));
+
+-- Serializes blocked-by changes in a workspace until the transaction ends, so that
+-- concurrent cycle checks cannot miss each other's links.
+-- name: LockTodoDependencies :exec
+SELECT
+  pg_advisory_xact_lock(hashtextextended('todo_dependencies:' || sqlc.arg(workspace_id)::uuid::text, 0));
+
+-- name: LockTodo :exec
+SELECT
+  1
+FROM
+  todos
+WHERE
+  id = $1
+FOR UPDATE;
+
+-- name: ListTodoBlockerIDs :many
+SELECT
+  blocked_by_id
+FROM
+  todo_dependencies
+WHERE
+  todo_id = $1;
+
+-- name: ListTodoDependentIDs :many
+SELECT
+  td.todo_id
+FROM
+  todo_dependencies td
+  JOIN todos t ON t.id = td.todo_id
+WHERE
+  td.blocked_by_id = $1
+  AND t.deleted_at IS NULL;
+
+-- name: ListTodosByAssigneeID :many
+SELECT
+  t.*,
+  COALESCE(array_remove(array_agg(DISTINCT tt.tag_id), NULL), '{}')::uuid[] AS tags,
+  COALESCE((
+    SELECT
+      json_agg(fs.*)
+    FROM todo_focus_sessions fs
+    WHERE
+      fs.todo_id = t.id), '[]'::json) AS focus_sessions,
+  COALESCE((
+    SELECT
+      json_agg(ci.* ORDER BY ci.position)
+    FROM todo_checklist_items ci
+    WHERE
+      ci.todo_id = t.id), '[]'::json) AS checklist_items,
+  COALESCE((
+    SELECT
+      array_agg(td.blocked_by_id ORDER BY td.created_at, td.blocked_by_id)
+    FROM todo_dependencies td
+    WHERE
+      td.todo_id = t.id), '{}')::uuid[] AS blocked_by
+FROM
+  todos t
+  LEFT JOIN todo_tags tt ON t.id = tt.todo_id
+WHERE
+  t.assignee_id = sqlc.arg(assignee_id)
+  AND t.deleted_at IS NULL
+GROUP BY
+  t.id
+ORDER BY
+  COALESCE(t.due_date, 'infinity') ASC,
+  t.created_at DESC,
+  t.id
+LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
+
+-- name: ListUnassignedTodosDueForMember :many
+SELECT
+  t.*,
+  COALESCE(array_remove(array_agg(DISTINCT tt.tag_id), NULL), '{}')::uuid[] AS tags,
+  COALESCE((
+    SELECT
+      json_agg(fs.*)
+    FROM todo_focus_sessions fs
+    WHERE
+      fs.todo_id = t.id), '[]'::json) AS focus_sessions,
+  COALESCE((
+    SELECT
+      json_agg(ci.* ORDER BY ci.position)
+    FROM todo_checklist_items ci
+    WHERE
+      ci.todo_id = t.id), '[]'::json) AS checklist_items,
+  COALESCE((
+    SELECT
+      array_agg(td.blocked_by_id ORDER BY td.created_at, td.blocked_by_id)
+    FROM todo_dependencies td
+    WHERE
+      td.todo_id = t.id), '{}')::uuid[] AS blocked_by
+FROM
+  todos t
+  JOIN workspace_members wm ON wm.workspace_id = t.workspace_id
+  LEFT JOIN todo_tags tt ON t.id = tt.todo_id
+WHERE
+  wm.user_id = sqlc.arg(user_id)::uuid
+  AND t.assignee_id IS NULL
+  AND t.status = 'PENDING'
+  AND t.due_date < sqlc.arg(due_before)::timestamptz
+  AND t.deleted_at IS NULL
+GROUP BY
+  t.id
+ORDER BY
+  t.due_date ASC,
+  t.created_at DESC,
+  t.id
+LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
+
+-- name: ListTodoIDsAssignedInWorkspace :many
+SELECT
+  id
+FROM
+  todos
+WHERE
+  workspace_id = $1
+  AND assignee_id = $2
+  AND deleted_at IS NULL;
+
+-- name: ListTodoIDsByTagID :many
+SELECT
+  t.id
+FROM
+  todos t
+  JOIN todo_tags tt ON tt.todo_id = t.id
+WHERE
+  tt.tag_id = $1
+  AND t.deleted_at IS NULL;
+
+-- name: SearchTodosByWorkspaceID :many
+WITH q AS (
+  SELECT
+    websearch_to_tsquery('english', sqlc.arg(query)::text) AS query
+)
+SELECT
+  t.id,
+  t.workspace_id,
+  t.title,
+  t.status,
+  t.created_at,
+  t.due_date,
+  ts_rank(t.search_vector, q.query)::real AS rank,
+  -- escape the title so that <mark> is the only markup in the highlight
+  ts_headline('english', replace(replace(replace(replace(replace(t.title, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;'), q.query, 'StartSel=<mark>, StopSel=</mark>, HighlightAll=true')::text AS highlight
+FROM
+  todos t,
+  q
+WHERE
+  t.workspace_id = sqlc.arg(workspace_id)
+  AND t.deleted_at IS NULL
+  AND t.search_vector @@ q.query
+ORDER BY
+  rank DESC,
+  t.created_at DESC,
+  t.id
+LIMIT sqlc.arg(lim) OFFSET sqlc.arg(off);
+
+-- name: ListTodosTouchedByUser :many
+SELECT
+  t.id,
+  t.workspace_id,
+  t.title,
+  t.status,
+  (t.assignee_id IS NOT DISTINCT FROM sqlc.arg(user_id)::uuid)::boolean AS assigned,
+  t.created_at,
+  t.updated_at
+FROM
+  todos t
+WHERE
+  t.assignee_id = sqlc.arg(user_id)::uuid
+  OR EXISTS (
+    SELECT
+      1
+    FROM
+      todo_completion_logs l
+    WHERE
+      l.todo_id = t.id
+      AND l.actor_id = sqlc.arg(user_id)::uuid)
+  OR EXISTS (
+    SELECT
+      1
+    FROM
+      todo_comments c
+    WHERE
+      c.todo_id = t.id
+      AND c.author_id = sqlc.arg(user_id)::uuid)
+  OR EXISTS (
+    SELECT
+      1
+    FROM
+      todo_focus_sessions fs
+    WHERE
+      fs.todo_id = t.id
+      AND fs.user_id = sqlc.arg(user_id)::uuid)
+ORDER BY
+  t.created_at,
+  t.id;
+
+-- name: ListUserFocusSessions :many
+SELECT
+  fs.id,
+  fs.todo_id,
+  fs.start_time,
+  fs.end_time,
+  fs.planned_duration_seconds
+FROM
+  todo_focus_sessions fs
+WHERE
+  fs.user_id = sqlc.arg(user_id)::uuid
+ORDER BY
+  fs.start_time;
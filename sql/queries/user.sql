@@ -1,8 +1,14 @@
--- name: CreateUser :one
-INSERT INTO users(id, email, name, created_at)
-  VALUES ($1, $2, $3, $4)
-RETURNING
-  *;
+-- name: UpsertUser :one
+INSERT INTO users(id, email, name, created_at, timezone, daily_capacity)
+  VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (id)
+  DO UPDATE SET
+    email = EXCLUDED.email,
+    name = EXCLUDED.name,
+    timezone = EXCLUDED.timezone,
+    daily_capacity = EXCLUDED.daily_capacity
+  RETURNING
+    *;
 
 -- name: GetUserByID :one
 SELECT
@@ -24,3 +30,19 @@ WHERE
 DELETE FROM users
 WHERE id = $1;
 
+-- name: UpsertUserDataExport :exec
+INSERT INTO user_data_exports(id, user_id, status, requested_at, completed_at, archive)
+  VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (id)
+  DO UPDATE SET
+    status = EXCLUDED.status,
+    completed_at = EXCLUDED.completed_at,
+    archive = EXCLUDED.archive;
+
+-- name: GetUserDataExportByID :one
+SELECT
+  *
+FROM
+  user_data_exports
+WHERE
+  id = $1;
@@ -69,3 +69,15 @@ WHERE
 ORDER BY
   w.created_at DESC;
 
+-- name: ListUserMemberships :many
+SELECT
+  w.id,
+  w.name,
+  wm.role
+FROM
+  workspace_members wm
+  JOIN workspaces w ON w.id = wm.workspace_id
+WHERE
+  wm.user_id = $1
+ORDER BY
+  w.name;
@@ -14,11 +14,41 @@ ALTER SCHEMA public OWNER TO pg_database_owner;
 COMMENT ON SCHEMA public IS 'standard public schema';
 SET default_tablespace = '';
 SET default_table_access_method = heap;
+CREATE TABLE public.audit_logs (
+    id uuid NOT NULL,
+    workspace_id uuid,
+    correlation_id text NOT NULL,
+    causation_id text NOT NULL,
+    actor_id uuid,
+    actor_ip text NOT NULL,
+    user_agent_hash text NOT NULL,
+    aggregate_type text NOT NULL,
+    aggregate_id uuid NOT NULL,
+    operation text NOT NULL,
+    changes jsonb NOT NULL,
+    occurred_at timestamp with time zone NOT NULL,
+    prev_hash text DEFAULT ''::text NOT NULL,
+    hash text DEFAULT ''::text NOT NULL,
+    seq bigint NOT NULL,
+    pii_salt bytea,
+    pii_digest text DEFAULT ''::text NOT NULL,
+    chain_key text GENERATED ALWAYS AS (COALESCE((workspace_id)::text, ((aggregate_type || ':'::text) || (aggregate_id)::text))) STORED
+);
+ALTER TABLE public.audit_logs OWNER TO postgres;
+ALTER TABLE public.audit_logs ALTER COLUMN seq ADD GENERATED ALWAYS AS IDENTITY (
+    SEQUENCE NAME public.audit_logs_seq_seq
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1
+);
 CREATE TABLE public.daily_schedules (
     user_id uuid NOT NULL,
     date timestamp with time zone NOT NULL,
     max_capacity integer NOT NULL,
-    version integer DEFAULT 1 NOT NULL
+    version integer DEFAULT 1 NOT NULL,
+    rolled_over_at timestamp with time zone
 );
 ALTER TABLE public.daily_schedules OWNER TO postgres;
 CREATE TABLE public.idempotency_keys (
@@ -44,19 +74,53 @@ CREATE TABLE public.outbox (
     last_attempted_at timestamp with time zone
 );
 ALTER TABLE public.outbox OWNER TO postgres;
+CREATE TABLE public.refresh_tokens (
+    id uuid NOT NULL,
+    family_id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    token_hash text NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    expires_at timestamp with time zone NOT NULL,
+    rotated_at timestamp with time zone,
+    revoked_at timestamp with time zone,
+    mfa_verified_at timestamp with time zone
+);
+ALTER TABLE public.refresh_tokens OWNER TO postgres;
 CREATE TABLE public.schedule_tasks (
     user_id uuid NOT NULL,
     date timestamp with time zone NOT NULL,
     todo_id uuid NOT NULL,
-    energy_cost integer NOT NULL
+    energy_cost integer NOT NULL,
+    completed_at timestamp with time zone
 );
 ALTER TABLE public.schedule_tasks OWNER TO postgres;
 CREATE TABLE public.tags (
     id uuid NOT NULL,
     name text NOT NULL,
-    workspace_id uuid NOT NULL
+    workspace_id uuid NOT NULL,
+    color text DEFAULT '#9ca3af'::text NOT NULL
 );
 ALTER TABLE public.tags OWNER TO postgres;
+CREATE TABLE public.todo_checklist_items (
+    id uuid NOT NULL,
+    todo_id uuid NOT NULL,
+    title text NOT NULL,
+    done boolean DEFAULT false NOT NULL,
+    required boolean DEFAULT true NOT NULL,
+    "position" integer NOT NULL
+);
+ALTER TABLE public.todo_checklist_items OWNER TO postgres;
+CREATE TABLE public.todo_comments (
+    id uuid NOT NULL,
+    todo_id uuid NOT NULL,
+    author_id uuid NOT NULL,
+    body text NOT NULL,
+    mentions uuid[] DEFAULT '{}'::uuid[] NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    edited_at timestamp with time zone,
+    deleted_at timestamp with time zone
+);
+ALTER TABLE public.todo_comments OWNER TO postgres;
 CREATE TABLE public.todo_completion_logs (
     id uuid NOT NULL,
     todo_id uuid NOT NULL,
@@ -64,12 +128,19 @@ CREATE TABLE public.todo_completion_logs (
     occurred_at timestamp with time zone DEFAULT now() NOT NULL
 );
 ALTER TABLE public.todo_completion_logs OWNER TO postgres;
+CREATE TABLE public.todo_dependencies (
+    todo_id uuid NOT NULL,
+    blocked_by_id uuid NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+ALTER TABLE public.todo_dependencies OWNER TO postgres;
 CREATE TABLE public.todo_focus_sessions (
     id uuid NOT NULL,
     todo_id uuid NOT NULL,
     user_id uuid,
     start_time timestamp with time zone NOT NULL,
-    end_time timestamp with time zone
+    end_time timestamp with time zone,
+    planned_duration_seconds integer
 );
 ALTER TABLE public.todo_focus_sessions OWNER TO postgres;
 CREATE TABLE public.todo_tags (
@@ -88,7 +159,11 @@ CREATE TABLE public.todos (
     recurrence_interval text,
     recurrence_amount integer,
     last_completed_at timestamp with time zone,
-    deleted_at timestamp with time zone
+    deleted_at timestamp with time zone,
+    recurrence_rule text,
+    recurrence_occurrences integer DEFAULT 0 NOT NULL,
+    search_vector tsvector GENERATED ALWAYS AS (to_tsvector('english'::regconfig, title)) STORED,
+    assignee_id uuid
 );
 ALTER TABLE public.todos OWNER TO postgres;
 CREATE TABLE public.user_auth (
@@ -96,14 +171,26 @@ CREATE TABLE public.user_auth (
     password_hash text,
     totp_status text DEFAULT 'DISABLED'::text NOT NULL,
     totp_secret_cipher bytea,
-    totp_secret_nonce bytea
+    totp_secret_nonce bytea,
+    totp_recovery_codes text[] DEFAULT '{}'::text[] NOT NULL
 );
 ALTER TABLE public.user_auth OWNER TO postgres;
+CREATE TABLE public.user_data_exports (
+    id uuid NOT NULL,
+    user_id uuid NOT NULL,
+    status text NOT NULL,
+    requested_at timestamp with time zone NOT NULL,
+    completed_at timestamp with time zone,
+    archive bytea
+);
+ALTER TABLE public.user_data_exports OWNER TO postgres;
 CREATE TABLE public.users (
     id uuid NOT NULL,
     email text NOT NULL,
     name text NOT NULL,
-    created_at timestamp with time zone DEFAULT now() NOT NULL
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    timezone text DEFAULT 'UTC'::text NOT NULL,
+    daily_capacity integer DEFAULT 10 NOT NULL
 );
 ALTER TABLE public.users OWNER TO postgres;
 CREATE TABLE public.workspace_members (
@@ -119,12 +206,18 @@ CREATE TABLE public.workspaces (
     created_at timestamp with time zone DEFAULT now() NOT NULL
 );
 ALTER TABLE public.workspaces OWNER TO postgres;
+ALTER TABLE ONLY public.audit_logs
+    ADD CONSTRAINT audit_logs_pkey PRIMARY KEY (id);
 ALTER TABLE ONLY public.daily_schedules
     ADD CONSTRAINT daily_schedules_pkey PRIMARY KEY (user_id, date);
 ALTER TABLE ONLY public.idempotency_keys
     ADD CONSTRAINT idempotency_keys_pkey PRIMARY KEY (id);
 ALTER TABLE ONLY public.outbox
     ADD CONSTRAINT outbox_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.refresh_tokens
+    ADD CONSTRAINT refresh_tokens_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.refresh_tokens
+    ADD CONSTRAINT refresh_tokens_token_hash_key UNIQUE (token_hash);
 ALTER TABLE ONLY public.schedule_tasks
     ADD CONSTRAINT schedule_tasks_pkey PRIMARY KEY (user_id, date, todo_id);
 ALTER TABLE ONLY public.tags
@@ -133,6 +226,12 @@ ALTER TABLE ONLY public.tags
     ADD CONSTRAINT tags_workspace_id_name_key UNIQUE (workspace_id, name);
 ALTER TABLE ONLY public.todo_completion_logs
     ADD CONSTRAINT todo_completion_logs_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.todo_checklist_items
+    ADD CONSTRAINT todo_checklist_items_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.todo_comments
+    ADD CONSTRAINT todo_comments_pkey PRIMARY KEY (id);
+ALTER TABLE ONLY public.todo_dependencies
+    ADD CONSTRAINT todo_dependencies_pkey PRIMARY KEY (todo_id, blocked_by_id);
 ALTER TABLE ONLY public.todo_focus_sessions
     ADD CONSTRAINT todo_focus_sessions_pkey PRIMARY KEY (id);
 ALTER TABLE ONLY public.todo_tags
@@ -141,6 +240,8 @@ ALTER TABLE ONLY public.todos
     ADD CONSTRAINT todos_pkey PRIMARY KEY (id);
 ALTER TABLE ONLY public.user_auth
     ADD CONSTRAINT user_auth_pkey PRIMARY KEY (user_id);
+ALTER TABLE ONLY public.user_data_exports
+    ADD CONSTRAINT user_data_exports_pkey PRIMARY KEY (id);
 ALTER TABLE ONLY public.users
     ADD CONSTRAINT users_email_key UNIQUE (email);
 ALTER TABLE ONLY public.users
@@ -149,20 +250,46 @@ ALTER TABLE ONLY public.workspace_members
     ADD CONSTRAINT workspace_members_pkey PRIMARY KEY (workspace_id, user_id);
 ALTER TABLE ONLY public.workspaces
     ADD CONSTRAINT workspaces_pkey PRIMARY KEY (id);
+CREATE INDEX idx_audit_logs_actor_id ON public.audit_logs USING btree (actor_id);
+CREATE INDEX idx_audit_logs_chain_key ON public.audit_logs USING btree (chain_key, seq);
+CREATE INDEX idx_audit_logs_workspace_id_occurred_at ON public.audit_logs USING btree (workspace_id, occurred_at);
+CREATE INDEX idx_daily_schedules_pending_rollover ON public.daily_schedules USING btree (date) WHERE (rolled_over_at IS NULL);
 CREATE INDEX idx_outbox_unprocessed ON public.outbox USING btree (created_at) WHERE (processed_at IS NULL);
+CREATE INDEX idx_refresh_tokens_family_id ON public.refresh_tokens USING btree (family_id);
+CREATE INDEX idx_refresh_tokens_user_id ON public.refresh_tokens USING btree (user_id);
+CREATE INDEX idx_todo_checklist_items_todo_id ON public.todo_checklist_items USING btree (todo_id);
+CREATE INDEX idx_todo_comments_todo_id_created_at ON public.todo_comments USING btree (todo_id, created_at);
+CREATE INDEX idx_todo_focus_sessions_todo_id_start_time ON public.todo_focus_sessions USING btree (todo_id, start_time);
+CREATE INDEX idx_todo_dependencies_blocked_by_id ON public.todo_dependencies USING btree (blocked_by_id);
+CREATE INDEX idx_todos_assignee_id ON public.todos USING btree (assignee_id);
+CREATE INDEX idx_todos_search_vector ON public.todos USING gin (search_vector);
 CREATE INDEX idx_todos_workspace_id ON public.todos USING btree (workspace_id);
 CREATE INDEX idx_todos_workspace_updated_at ON public.todos USING btree (workspace_id, updated_at);
+CREATE INDEX idx_user_data_exports_user_id ON public.user_data_exports USING btree (user_id);
 CREATE UNIQUE INDEX one_active_session_per_todo ON public.todo_focus_sessions USING btree (todo_id) WHERE (end_time IS NULL);
+CREATE UNIQUE INDEX one_active_session_per_user ON public.todo_focus_sessions USING btree (user_id) WHERE (end_time IS NULL);
 ALTER TABLE ONLY public.daily_schedules
     ADD CONSTRAINT fk_daily_schedules_user_id FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.refresh_tokens
+    ADD CONSTRAINT fk_refresh_tokens_user_id FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
 ALTER TABLE ONLY public.schedule_tasks
     ADD CONSTRAINT fk_schedule_tasks_schedule FOREIGN KEY (user_id, date) REFERENCES public.daily_schedules(user_id, date) ON DELETE CASCADE;
 ALTER TABLE ONLY public.tags
     ADD CONSTRAINT fk_tags_workspace_id FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
 ALTER TABLE ONLY public.todo_completion_logs
     ADD CONSTRAINT fk_todo_completion_logs_actor_id FOREIGN KEY (actor_id) REFERENCES public.users(id) ON DELETE SET NULL;
+ALTER TABLE ONLY public.todo_checklist_items
+    ADD CONSTRAINT fk_todo_checklist_items_todo_id FOREIGN KEY (todo_id) REFERENCES public.todos(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.todo_comments
+    ADD CONSTRAINT fk_todo_comments_author_id FOREIGN KEY (author_id) REFERENCES public.users(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.todo_comments
+    ADD CONSTRAINT fk_todo_comments_todo_id FOREIGN KEY (todo_id) REFERENCES public.todos(id) ON DELETE CASCADE;
 ALTER TABLE ONLY public.todo_completion_logs
     ADD CONSTRAINT fk_todo_completion_logs_todo_id FOREIGN KEY (todo_id) REFERENCES public.todos(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.todo_dependencies
+    ADD CONSTRAINT fk_todo_dependencies_blocked_by_id FOREIGN KEY (blocked_by_id) REFERENCES public.todos(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.todo_dependencies
+    ADD CONSTRAINT fk_todo_dependencies_todo_id FOREIGN KEY (todo_id) REFERENCES public.todos(id) ON DELETE CASCADE;
 ALTER TABLE ONLY public.todo_focus_sessions
     ADD CONSTRAINT fk_todo_focus_sessions_todo_id FOREIGN KEY (todo_id) REFERENCES public.todos(id) ON DELETE CASCADE;
 ALTER TABLE ONLY public.todo_focus_sessions
@@ -171,10 +298,14 @@ ALTER TABLE ONLY public.todo_tags
     ADD CONSTRAINT fk_todo_tags_tag_id FOREIGN KEY (tag_id) REFERENCES public.tags(id) ON DELETE CASCADE;
 ALTER TABLE ONLY public.todo_tags
     ADD CONSTRAINT fk_todo_tags_todo_id FOREIGN KEY (todo_id) REFERENCES public.todos(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.todos
+    ADD CONSTRAINT fk_todos_assignee_id FOREIGN KEY (assignee_id) REFERENCES public.users(id) ON DELETE SET NULL;
 ALTER TABLE ONLY public.todos
     ADD CONSTRAINT fk_todos_workspace_id FOREIGN KEY (workspace_id) REFERENCES public.workspaces(id) ON DELETE CASCADE;
 ALTER TABLE ONLY public.user_auth
     ADD CONSTRAINT fk_user_auth_user_id FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+ALTER TABLE ONLY public.user_data_exports
+    ADD CONSTRAINT fk_user_data_exports_user_id FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
 ALTER TABLE ONLY public.workspace_members
     ADD CONSTRAINT fk_wm_user FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
 ALTER TABLE ONLY public.workspace_members